@@ -26,6 +26,7 @@ import (
 	"github.com/tbechar/camp-manager-backend/pkg/csvimport"
 	"github.com/tbechar/camp-manager-backend/pkg/csvimport/entities"
 	"github.com/tbechar/camp-manager-backend/pkg/logger"
+	"github.com/tbechar/camp-manager-backend/pkg/storage"
 )
 
 func main() {
@@ -84,10 +85,12 @@ func main() {
 	sessionsRepo := repository.NewSessionsRepository(db)
 	groupsRepo := repository.NewGroupsRepository(db)
 	campersRepo := repository.NewCampersRepository(db)
+	camperEnrollmentsRepo := repository.NewCamperEnrollmentsRepository(db)
+	customFieldsRepo := repository.NewCustomFieldsRepository(db)
 	
 	// Create validators and mappers for import entities
-	camperValidator := entities.NewCamperImportValidator(sessionsRepo, groupsRepo)
-	camperMapper := entities.NewCamperImportMapper(sessionsRepo, groupsRepo)
+	camperValidator := entities.NewCamperImportValidator(sessionsRepo, groupsRepo, customFieldsRepo)
+	camperMapper := entities.NewCamperImportMapper(sessionsRepo, groupsRepo, customFieldsRepo)
 	
 	validators := map[domain.ImportEntityType]csvimport.EntityValidator{
 		domain.ImportEntityTypeCampers: camperValidator,
@@ -98,7 +101,7 @@ func main() {
 	}
 	
 	// Initialize campers service for import worker
-	campersService := service.NewCampersService(campersRepo, sessionsRepo, groupsRepo)
+	campersService := service.NewCampersService(campersRepo, sessionsRepo, groupsRepo, camperEnrollmentsRepo, customFieldsRepo)
 	
 	// Initialize import worker
 	importWorker := worker.NewImportWorker(
@@ -119,6 +122,20 @@ func main() {
 	
 	log.Info("Import worker started")
 
+	// Initialize camp clone worker
+	campCloneJobsRepo := repository.NewCampCloneJobsRepository(db)
+	campsService := service.NewCampsService(repository.NewCampsRepository(db), campCloneJobsRepo)
+	campCloneWorker := worker.NewCampCloneWorker(
+		campCloneJobsRepo,
+		campsService,
+		worker.CampCloneWorkerConfig{
+			PollInterval: 10 * time.Second,
+		},
+	)
+	go campCloneWorker.Start(workerCtx)
+
+	log.Info("Camp clone worker started")
+
 	// Initialize and start cleanup worker (if enabled)
 	if cfg.Cleanup.Enabled {
 		cleanupWorker := worker.NewCleanupWorker(
@@ -139,8 +156,17 @@ func main() {
 		log.Info("Cleanup worker disabled")
 	}
 
+	// Initialize attachment storage
+	attachmentStorage, err := storage.New(storage.Config{
+		Backend:  cfg.Storage.Backend,
+		LocalDir: cfg.Storage.LocalDir,
+	})
+	if err != nil {
+		log.Fatal("Failed to initialize attachment storage", zap.Error(err))
+	}
+
 	// Initialize handlers
-	h := handler.NewHandler(db, cfg)
+	h := handler.NewHandler(db, cfg, attachmentStorage)
 	healthHandler := handler.NewHealthHandler(db)
 
 	// Initialize JWT middleware
@@ -179,6 +205,11 @@ func main() {
 	r.Post("/api/v1/auth/login", h.Login)
 	r.Post("/api/v1/auth/signup", h.Signup)
 
+	// Signed attachment downloads (public - the token in the URL authorizes the download)
+	r.Get("/api/v1/attachments/download/{token}", func(w http.ResponseWriter, r *http.Request) {
+		h.DownloadAttachment(w, r, chi.URLParam(r, "token"))
+	})
+
 	// Create HTTP server
 	srv := &http.Server{
 		Addr:         cfg.Server.GetAddress(),
@@ -14,6 +14,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Download an attachment using a signed URL (no authorization header needed)
+	// (GET /api/v1/attachments/download/{token})
+	DownloadAttachment(w http.ResponseWriter, r *http.Request, token string)
 	// Authenticate user
 	// (POST /api/v1/auth/login)
 	Login(w http.ResponseWriter, r *http.Request)
@@ -47,6 +50,30 @@ type ServerInterface interface {
 	// Update activity by ID
 	// (PUT /api/v1/camps/{camp_id}/activities/{id})
 	UpdateActivityById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Ineligible campers in the events of a range of days
+	// (GET /api/v1/camps/{camp_id}/activity-eligibility)
+	GetEligibilityReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetEligibilityReportParams)
+	// List all camper applications
+	// (GET /api/v1/camps/{camp_id}/applications)
+	ListApplications(w http.ResponseWriter, r *http.Request, campId CampId, params ListApplicationsParams)
+	// Create a new camper application (starts as a draft)
+	// (POST /api/v1/camps/{camp_id}/applications)
+	CreateApplication(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete camper application (not allowed once accepted or enrolled)
+	// (DELETE /api/v1/camps/{camp_id}/applications/{id})
+	DeleteApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get camper application by ID
+	// (GET /api/v1/camps/{camp_id}/applications/{id})
+	GetApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update camper application (only before it is accepted)
+	// (PUT /api/v1/camps/{camp_id}/applications/{id})
+	UpdateApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the status history of a camper application
+	// (GET /api/v1/camps/{camp_id}/applications/{id}/transitions)
+	ListApplicationTransitions(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Move a camper application to a new status
+	// (POST /api/v1/camps/{camp_id}/applications/{id}/transitions)
+	TransitionApplication(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all areas
 	// (GET /api/v1/camps/{camp_id}/areas)
 	ListAreas(w http.ResponseWriter, r *http.Request, campId CampId, params ListAreasParams)
@@ -62,12 +89,84 @@ type ServerInterface interface {
 	// Update area
 	// (PUT /api/v1/camps/{camp_id}/areas/{id})
 	UpdateAreaById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List attachments
+	// (GET /api/v1/camps/{camp_id}/attachments)
+	ListAttachments(w http.ResponseWriter, r *http.Request, campId CampId, params ListAttachmentsParams)
+	// Upload a document or photo for a camper, staff member, certification or incident
+	// (POST /api/v1/camps/{camp_id}/attachments)
+	UploadAttachment(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete attachment and its stored file
+	// (DELETE /api/v1/camps/{camp_id}/attachments/{id})
+	DeleteAttachmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get attachment by ID
+	// (GET /api/v1/camps/{camp_id}/attachments/{id})
+	GetAttachmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Create a short-lived signed URL to download the attachment
+	// (POST /api/v1/camps/{camp_id}/attachments/{id}/download-url)
+	CreateAttachmentDownloadUrl(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the sign-in and sign-out records of a day
+	// (GET /api/v1/camps/{camp_id}/attendance)
+	ListAttendanceRecords(w http.ResponseWriter, r *http.Request, campId CampId, params ListAttendanceRecordsParams)
+	// Sign a camper in on arrival
+	// (POST /api/v1/camps/{camp_id}/attendance/check-in)
+	CheckInCamper(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Sign a camper out, verifying the pickup person against the authorized pickup list
+	// (POST /api/v1/camps/{camp_id}/attendance/check-out)
+	CheckOutCamper(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Campers on site now, with counts per group and housing room
+	// (GET /api/v1/camps/{camp_id}/attendance/on-site)
+	GetOnSiteReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetOnSiteReportParams)
+	// Delete an attendance record entered by mistake
+	// (DELETE /api/v1/camps/{camp_id}/attendance/{id})
+	DeleteAttendanceRecord(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List bed assignments by start
+	// (GET /api/v1/camps/{camp_id}/bed-assignments)
+	ListBedAssignments(w http.ResponseWriter, r *http.Request, campId CampId, params ListBedAssignmentsParams)
+	// Assign a camper or staff member to a bed
+	// (POST /api/v1/camps/{camp_id}/bed-assignments)
+	CreateBedAssignment(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete bed assignment by ID
+	// (DELETE /api/v1/camps/{camp_id}/bed-assignments/{id})
+	DeleteBedAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Move a bed assignment to another bed or other nights
+	// (PUT /api/v1/camps/{camp_id}/bed-assignments/{id})
+	UpdateBedAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List beds by room and label
+	// (GET /api/v1/camps/{camp_id}/beds)
+	ListBeds(w http.ResponseWriter, r *http.Request, campId CampId, params ListBedsParams)
+	// Create a bed
+	// (POST /api/v1/camps/{camp_id}/beds)
+	CreateBed(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete bed by ID
+	// (DELETE /api/v1/camps/{camp_id}/beds/{id})
+	DeleteBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get bed by ID
+	// (GET /api/v1/camps/{camp_id}/beds/{id})
+	GetBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update bed by ID
+	// (PUT /api/v1/camps/{camp_id}/beds/{id})
+	UpdateBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List bunkmate requests
+	// (GET /api/v1/camps/{camp_id}/bunk-requests)
+	ListBunkRequests(w http.ResponseWriter, r *http.Request, campId CampId, params ListBunkRequestsParams)
+	// Record a camper's bunkmate request
+	// (POST /api/v1/camps/{camp_id}/bunk-requests)
+	CreateBunkRequest(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Withdraw a bunkmate request
+	// (DELETE /api/v1/camps/{camp_id}/bunk-requests/{id})
+	DeleteBunkRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List camper merges
+	// (GET /api/v1/camps/{camp_id}/camper-merges)
+	ListCamperMerges(w http.ResponseWriter, r *http.Request, campId CampId)
 	// List all campers
 	// (GET /api/v1/camps/{camp_id}/campers)
 	ListCampers(w http.ResponseWriter, r *http.Request, campId CampId, params ListCampersParams)
 	// Create a new camper
 	// (POST /api/v1/camps/{camp_id}/campers)
 	CreateCamper(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Find likely duplicate campers
+	// (GET /api/v1/camps/{camp_id}/campers/duplicates)
+	ListCamperDuplicates(w http.ResponseWriter, r *http.Request, campId CampId, params ListCamperDuplicatesParams)
 	// Delete camper
 	// (DELETE /api/v1/camps/{camp_id}/campers/{id})
 	DeleteCamperById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
@@ -77,12 +176,48 @@ type ServerInterface interface {
 	// Update camper
 	// (PUT /api/v1/camps/{camp_id}/campers/{id})
 	UpdateCamperById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get a camper's guardians, emergency contacts and authorized pickups
+	// (GET /api/v1/camps/{camp_id}/campers/{id}/contacts)
+	GetCamperContacts(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List a camper's session enrollments
+	// (GET /api/v1/camps/{camp_id}/campers/{id}/enrollments)
+	ListCamperEnrollments(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Enroll a camper in a session
+	// (POST /api/v1/camps/{camp_id}/campers/{id}/enrollments)
+	CreateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Remove a camper's session enrollment
+	// (DELETE /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id})
+	DeleteCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, enrollmentId openapi_types.UUID)
+	// Update a camper's session enrollment
+	// (PUT /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id})
+	UpdateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, enrollmentId openapi_types.UUID)
+	// Get a camper's guardians and siblings
+	// (GET /api/v1/camps/{camp_id}/campers/{id}/family)
+	GetCamperFamily(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Merge a duplicate camper into this camper
+	// (POST /api/v1/camps/{camp_id}/campers/{id}/merge)
+	MergeCamper(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Record a skill assessment of a camper
+	// (POST /api/v1/camps/{camp_id}/campers/{id}/skill-assessments)
+	CreateSkillAssessment(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Delete a skill assessment recorded by mistake, with the badge it awarded
+	// (DELETE /api/v1/camps/{camp_id}/campers/{id}/skill-assessments/{assessment_id})
+	DeleteSkillAssessment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, assessmentId AssessmentId)
+	// Get a camper's skill levels, badges and assessment history
+	// (GET /api/v1/camps/{camp_id}/campers/{id}/skills)
+	GetCamperSkills(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get a camper's timeline of notes, attendance, incidents and group changes
+	// (GET /api/v1/camps/{camp_id}/campers/{id}/timeline)
+	GetCamperTimeline(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params GetCamperTimelineParams)
 	// List all certifications
 	// (GET /api/v1/camps/{camp_id}/certifications)
 	ListCertifications(w http.ResponseWriter, r *http.Request, campId CampId, params ListCertificationsParams)
 	// Create a new certification
 	// (POST /api/v1/camps/{camp_id}/certifications)
 	CreateCertification(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Staff certifications that expired, expire soon or are missing for upcoming events
+	// (GET /api/v1/camps/{camp_id}/certifications/compliance)
+	GetCertificationCompliance(w http.ResponseWriter, r *http.Request, campId CampId, params GetCertificationComplianceParams)
 	// Delete certification by ID
 	// (DELETE /api/v1/camps/{camp_id}/certifications/{id})
 	DeleteCertificationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
@@ -92,6 +227,15 @@ type ServerInterface interface {
 	// Update certification by ID
 	// (PUT /api/v1/camps/{camp_id}/certifications/{id})
 	UpdateCertificationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Copy a camp's configuration into a new camp
+	// (POST /api/v1/camps/{camp_id}/clone)
+	CloneCamp(w http.ResponseWriter, r *http.Request, campId CampId)
+	// List the clone jobs of a camp, most recent first
+	// (GET /api/v1/camps/{camp_id}/clone-jobs)
+	ListCampCloneJobs(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Get the status of a clone job
+	// (GET /api/v1/camps/{camp_id}/clone-jobs/{id})
+	GetCampCloneJobById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all colors
 	// (GET /api/v1/camps/{camp_id}/colors)
 	ListColors(w http.ResponseWriter, r *http.Request, campId CampId, params ListColorsParams)
@@ -107,6 +251,102 @@ type ServerInterface interface {
 	// Update color by ID
 	// (PUT /api/v1/camps/{camp_id}/colors/{id})
 	UpdateColorById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List custom field definitions
+	// (GET /api/v1/camps/{camp_id}/custom-fields)
+	ListCustomFields(w http.ResponseWriter, r *http.Request, campId CampId, params ListCustomFieldsParams)
+	// Define a custom field for campers, staff members or groups
+	// (POST /api/v1/camps/{camp_id}/custom-fields)
+	CreateCustomField(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete custom field definition and remove its values from all records
+	// (DELETE /api/v1/camps/{camp_id}/custom-fields/{id})
+	DeleteCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get custom field definition by ID
+	// (GET /api/v1/camps/{camp_id}/custom-fields/{id})
+	GetCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update custom field definition by ID
+	// (PUT /api/v1/camps/{camp_id}/custom-fields/{id})
+	UpdateCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the camp's duty rotation templates by name
+	// (GET /api/v1/camps/{camp_id}/duty-rotations)
+	ListDutyRotations(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create a duty rotation template
+	// (POST /api/v1/camps/{camp_id}/duty-rotations)
+	CreateDutyRotation(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete duty rotation by ID
+	// (DELETE /api/v1/camps/{camp_id}/duty-rotations/{id})
+	DeleteDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get duty rotation by ID
+	// (GET /api/v1/camps/{camp_id}/duty-rotations/{id})
+	GetDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update duty rotation by ID
+	// (PUT /api/v1/camps/{camp_id}/duty-rotations/{id})
+	UpdateDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Generate the shifts of a rotation for a range of days
+	// (POST /api/v1/camps/{camp_id}/duty-rotations/{id}/generate)
+	GenerateDutyRotationShifts(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List duty shifts by start
+	// (GET /api/v1/camps/{camp_id}/duty-shifts)
+	ListDutyShifts(w http.ResponseWriter, r *http.Request, campId CampId, params ListDutyShiftsParams)
+	// Create a duty shift
+	// (POST /api/v1/camps/{camp_id}/duty-shifts)
+	CreateDutyShift(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Fill the open positions of the duty shifts in a range of days
+	// (POST /api/v1/camps/{camp_id}/duty-shifts/auto-assign)
+	AutoAssignDutyShifts(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Roster conflicts of the duty shifts in a range of days
+	// (GET /api/v1/camps/{camp_id}/duty-shifts/conflicts)
+	GetDutyShiftConflicts(w http.ResponseWriter, r *http.Request, campId CampId, params GetDutyShiftConflictsParams)
+	// Delete duty shift by ID
+	// (DELETE /api/v1/camps/{camp_id}/duty-shifts/{id})
+	DeleteDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get duty shift by ID
+	// (GET /api/v1/camps/{camp_id}/duty-shifts/{id})
+	GetDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update duty shift by ID
+	// (PUT /api/v1/camps/{camp_id}/duty-shifts/{id})
+	UpdateDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the camp's duty types by name
+	// (GET /api/v1/camps/{camp_id}/duty-types)
+	ListDutyTypes(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create a duty type
+	// (POST /api/v1/camps/{camp_id}/duty-types)
+	CreateDutyType(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete duty type by ID, together with its rotations and shifts
+	// (DELETE /api/v1/camps/{camp_id}/duty-types/{id})
+	DeleteDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get duty type by ID
+	// (GET /api/v1/camps/{camp_id}/duty-types/{id})
+	GetDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update duty type by ID
+	// (PUT /api/v1/camps/{camp_id}/duty-types/{id})
+	UpdateDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List equipment items by name
+	// (GET /api/v1/camps/{camp_id}/equipment)
+	ListEquipmentItems(w http.ResponseWriter, r *http.Request, campId CampId, params ListEquipmentItemsParams)
+	// Add an equipment item to the inventory
+	// (POST /api/v1/camps/{camp_id}/equipment)
+	CreateEquipmentItem(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Equipment shortages of concurrent events in a range of days
+	// (GET /api/v1/camps/{camp_id}/equipment-conflicts)
+	GetEquipmentConflicts(w http.ResponseWriter, r *http.Request, campId CampId, params GetEquipmentConflictsParams)
+	// Delete equipment item by ID
+	// (DELETE /api/v1/camps/{camp_id}/equipment/{id})
+	DeleteEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get equipment item by ID
+	// (GET /api/v1/camps/{camp_id}/equipment/{id})
+	GetEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update equipment item by ID
+	// (PUT /api/v1/camps/{camp_id}/equipment/{id})
+	UpdateEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Check-out history of an equipment item, most recent first
+	// (GET /api/v1/camps/{camp_id}/equipment/{id}/checkouts)
+	ListEquipmentCheckouts(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params ListEquipmentCheckoutsParams)
+	// Check out units of an equipment item as the current user
+	// (POST /api/v1/camps/{camp_id}/equipment/{id}/checkouts)
+	CheckOutEquipment(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Check checked-out units back in as the current user
+	// (POST /api/v1/camps/{camp_id}/equipment/{id}/checkouts/{checkout_id}/return)
+	ReturnEquipment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, checkoutId CheckoutId)
 	// List all events
 	// (GET /api/v1/camps/{camp_id}/events)
 	ListEvents(w http.ResponseWriter, r *http.Request, campId CampId, params ListEventsParams)
@@ -122,6 +362,9 @@ type ServerInterface interface {
 	// Update event
 	// (PUT /api/v1/camps/{camp_id}/events/{id})
 	UpdateEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params UpdateEventByIdParams)
+	// Campers of an event who are not eligible for its activity
+	// (GET /api/v1/camps/{camp_id}/events/{id}/eligibility)
+	GetEventEligibility(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all groups
 	// (GET /api/v1/camps/{camp_id}/groups)
 	ListGroups(w http.ResponseWriter, r *http.Request, campId CampId, params ListGroupsParams)
@@ -137,6 +380,36 @@ type ServerInterface interface {
 	// Update group by ID
 	// (PUT /api/v1/camps/{camp_id}/groups/{id})
 	UpdateGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List all guardians
+	// (GET /api/v1/camps/{camp_id}/guardians)
+	ListGuardians(w http.ResponseWriter, r *http.Request, campId CampId, params ListGuardiansParams)
+	// Create a new guardian
+	// (POST /api/v1/camps/{camp_id}/guardians)
+	CreateGuardian(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete guardian
+	// (DELETE /api/v1/camps/{camp_id}/guardians/{id})
+	DeleteGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get guardian by ID
+	// (GET /api/v1/camps/{camp_id}/guardians/{id})
+	GetGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update guardian
+	// (PUT /api/v1/camps/{camp_id}/guardians/{id})
+	UpdateGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List housing assignment proposals
+	// (GET /api/v1/camps/{camp_id}/housing-assignments)
+	ListHousingAssignments(w http.ResponseWriter, r *http.Request, campId CampId, params ListHousingAssignmentsParams)
+	// Generate a housing assignment proposal for a session
+	// (POST /api/v1/camps/{camp_id}/housing-assignments)
+	CreateHousingAssignment(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Get a housing assignment proposal
+	// (GET /api/v1/camps/{camp_id}/housing-assignments/{id})
+	GetHousingAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Accept a housing assignment proposal
+	// (POST /api/v1/camps/{camp_id}/housing-assignments/{id}/accept)
+	AcceptHousingAssignment(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Occupancy of the housing rooms per night
+	// (GET /api/v1/camps/{camp_id}/housing-occupancy)
+	GetHousingOccupancy(w http.ResponseWriter, r *http.Request, campId CampId, params GetHousingOccupancyParams)
 	// List all housing rooms
 	// (GET /api/v1/camps/{camp_id}/housing-rooms)
 	ListHousingRooms(w http.ResponseWriter, r *http.Request, campId CampId, params ListHousingRoomsParams)
@@ -167,6 +440,30 @@ type ServerInterface interface {
 	// Get import job status by ID
 	// (GET /api/v1/camps/{camp_id}/imports/{job_id})
 	GetImportJobById(w http.ResponseWriter, r *http.Request, campId CampId, jobId openapi_types.UUID)
+	// List all incident reports
+	// (GET /api/v1/camps/{camp_id}/incidents)
+	ListIncidents(w http.ResponseWriter, r *http.Request, campId CampId, params ListIncidentsParams)
+	// Create a new incident report (starts as a draft)
+	// (POST /api/v1/camps/{camp_id}/incidents)
+	CreateIncident(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Incident counts by location, activity or week for safety reviews
+	// (GET /api/v1/camps/{camp_id}/incidents/report)
+	GetIncidentReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetIncidentReportParams)
+	// Delete incident report
+	// (DELETE /api/v1/camps/{camp_id}/incidents/{id})
+	DeleteIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get incident report by ID
+	// (GET /api/v1/camps/{camp_id}/incidents/{id})
+	GetIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update incident report (not allowed once approved)
+	// (PUT /api/v1/camps/{camp_id}/incidents/{id})
+	UpdateIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Approve or return a submitted incident report
+	// (POST /api/v1/camps/{camp_id}/incidents/{id}/review)
+	ReviewIncident(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Submit an incident report for review
+	// (POST /api/v1/camps/{camp_id}/incidents/{id}/submit)
+	SubmitIncident(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all locations
 	// (GET /api/v1/camps/{camp_id}/locations)
 	ListLocations(w http.ResponseWriter, r *http.Request, campId CampId, params ListLocationsParams)
@@ -182,6 +479,147 @@ type ServerInterface interface {
 	// Update location by ID
 	// (PUT /api/v1/camps/{camp_id}/locations/{id})
 	UpdateLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List maintenance tickets, most urgent and oldest first
+	// (GET /api/v1/camps/{camp_id}/maintenance-tickets)
+	ListMaintenanceTickets(w http.ResponseWriter, r *http.Request, campId CampId, params ListMaintenanceTicketsParams)
+	// Report a problem with a location, housing room or area
+	// (POST /api/v1/camps/{camp_id}/maintenance-tickets)
+	CreateMaintenanceTicket(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete maintenance ticket by ID
+	// (DELETE /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+	DeleteMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get maintenance ticket by ID
+	// (GET /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+	GetMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update maintenance ticket by ID
+	// (PUT /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+	UpdateMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Record a dose as given, refused or missed
+	// (POST /api/v1/camps/{camp_id}/mar/doses)
+	RecordMedicationDose(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Correct a recorded dose
+	// (PUT /api/v1/camps/{camp_id}/mar/doses/{id})
+	UpdateMedicationDose(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the doses due on a day, generated from medication schedules
+	// (GET /api/v1/camps/{camp_id}/mar/due-doses)
+	ListDueDoses(w http.ResponseWriter, r *http.Request, campId CampId, params ListDueDosesParams)
+	// Daily medication administration record per camper or per session
+	// (GET /api/v1/camps/{camp_id}/mar/report)
+	GetMarReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetMarReportParams)
+	// Get the current user's weekly availability
+	// (GET /api/v1/camps/{camp_id}/me/availability)
+	GetMyAvailability(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Replace the current user's weekly availability
+	// (PUT /api/v1/camps/{camp_id}/me/availability)
+	UpdateMyAvailability(w http.ResponseWriter, r *http.Request, campId CampId)
+	// List the campers in the current user's groups
+	// (GET /api/v1/camps/{camp_id}/me/campers)
+	ListMyCampers(w http.ResponseWriter, r *http.Request, campId CampId)
+	// List the groups the current user's staff member belongs to
+	// (GET /api/v1/camps/{camp_id}/me/groups)
+	ListMyGroups(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Get the current user's onboarding checklist
+	// (GET /api/v1/camps/{camp_id}/me/onboarding)
+	GetMyOnboarding(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Get the current user's schedule
+	// (GET /api/v1/camps/{camp_id}/me/schedule)
+	GetMySchedule(w http.ResponseWriter, r *http.Request, campId CampId, params GetMyScheduleParams)
+	// Get the staff member linked to the current user
+	// (GET /api/v1/camps/{camp_id}/me/staff-member)
+	GetMyStaffMember(w http.ResponseWriter, r *http.Request, campId CampId)
+	// List the current user's time-off requests, most recent first
+	// (GET /api/v1/camps/{camp_id}/me/time-off-requests)
+	ListMyTimeOffRequests(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Request time off
+	// (POST /api/v1/camps/{camp_id}/me/time-off-requests)
+	CreateMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Withdraw one of the current user's time-off requests
+	// (DELETE /api/v1/camps/{camp_id}/me/time-off-requests/{id})
+	CancelMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the camp's daily meal periods in order
+	// (GET /api/v1/camps/{camp_id}/meal-periods)
+	ListMealPeriods(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create a daily meal period
+	// (POST /api/v1/camps/{camp_id}/meal-periods)
+	CreateMealPeriod(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete meal period by ID, together with its menus
+	// (DELETE /api/v1/camps/{camp_id}/meal-periods/{id})
+	DeleteMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get meal period by ID
+	// (GET /api/v1/camps/{camp_id}/meal-periods/{id})
+	GetMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update meal period by ID
+	// (PUT /api/v1/camps/{camp_id}/meal-periods/{id})
+	UpdateMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Meals to prepare per meal period of a day, with dietary counts and allergen warnings
+	// (GET /api/v1/camps/{camp_id}/meals/headcounts)
+	GetMealHeadcounts(w http.ResponseWriter, r *http.Request, campId CampId, params GetMealHeadcountsParams)
+	// List all camper medications
+	// (GET /api/v1/camps/{camp_id}/medications)
+	ListMedications(w http.ResponseWriter, r *http.Request, campId CampId, params ListMedicationsParams)
+	// Add a medication to a camper's medication list
+	// (POST /api/v1/camps/{camp_id}/medications)
+	CreateMedication(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete medication (recorded doses are kept)
+	// (DELETE /api/v1/camps/{camp_id}/medications/{id})
+	DeleteMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get medication by ID
+	// (GET /api/v1/camps/{camp_id}/medications/{id})
+	GetMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update medication
+	// (PUT /api/v1/camps/{camp_id}/medications/{id})
+	UpdateMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List menus by day and meal period
+	// (GET /api/v1/camps/{camp_id}/menus)
+	ListMenus(w http.ResponseWriter, r *http.Request, campId CampId, params ListMenusParams)
+	// Plan the menu of a meal
+	// (POST /api/v1/camps/{camp_id}/menus)
+	CreateMenu(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete menu by ID
+	// (DELETE /api/v1/camps/{camp_id}/menus/{id})
+	DeleteMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get menu by ID
+	// (GET /api/v1/camps/{camp_id}/menus/{id})
+	GetMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update the dishes of a menu
+	// (PUT /api/v1/camps/{camp_id}/menus/{id})
+	UpdateMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List notes visible to the current user, pinned notes first
+	// (GET /api/v1/camps/{camp_id}/notes)
+	ListNotes(w http.ResponseWriter, r *http.Request, campId CampId, params ListNotesParams)
+	// Write a note about a camper or staff member
+	// (POST /api/v1/camps/{camp_id}/notes)
+	CreateNote(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete note by ID (author or admin only)
+	// (DELETE /api/v1/camps/{camp_id}/notes/{id})
+	DeleteNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get note by ID
+	// (GET /api/v1/camps/{camp_id}/notes/{id})
+	GetNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update note by ID (author or admin only)
+	// (PUT /api/v1/camps/{camp_id}/notes/{id})
+	UpdateNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get the onboarding progress and readiness of the camp's staff members
+	// (GET /api/v1/camps/{camp_id}/onboarding-report)
+	GetOnboardingReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetOnboardingReportParams)
+	// List the camp's onboarding templates by name
+	// (GET /api/v1/camps/{camp_id}/onboarding-templates)
+	ListOnboardingTemplates(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create an onboarding template
+	// (POST /api/v1/camps/{camp_id}/onboarding-templates)
+	CreateOnboardingTemplate(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete onboarding template by ID
+	// (DELETE /api/v1/camps/{camp_id}/onboarding-templates/{id})
+	DeleteOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get onboarding template by ID
+	// (GET /api/v1/camps/{camp_id}/onboarding-templates/{id})
+	GetOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update onboarding template by ID
+	// (PUT /api/v1/camps/{camp_id}/onboarding-templates/{id})
+	UpdateOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Periods locations, housing rooms and areas are out of service
+	// (GET /api/v1/camps/{camp_id}/out-of-service)
+	ListOutOfServicePeriods(w http.ResponseWriter, r *http.Request, campId CampId, params ListOutOfServicePeriodsParams)
 	// List all programs
 	// (GET /api/v1/camps/{camp_id}/programs)
 	ListPrograms(w http.ResponseWriter, r *http.Request, campId CampId, params ListProgramsParams)
@@ -197,6 +635,24 @@ type ServerInterface interface {
 	// Update program by ID
 	// (PUT /api/v1/camps/{camp_id}/programs/{id})
 	UpdateProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List the camp's supervision ratio policies by name
+	// (GET /api/v1/camps/{camp_id}/ratio-policies)
+	ListRatioPolicies(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create a supervision ratio policy
+	// (POST /api/v1/camps/{camp_id}/ratio-policies)
+	CreateRatioPolicy(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Supervision ratio compliance of events and housing groups in a range of days
+	// (GET /api/v1/camps/{camp_id}/ratio-policies/compliance)
+	GetRatioCompliance(w http.ResponseWriter, r *http.Request, campId CampId, params GetRatioComplianceParams)
+	// Delete ratio policy by ID
+	// (DELETE /api/v1/camps/{camp_id}/ratio-policies/{id})
+	DeleteRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get ratio policy by ID
+	// (GET /api/v1/camps/{camp_id}/ratio-policies/{id})
+	GetRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update ratio policy by ID
+	// (PUT /api/v1/camps/{camp_id}/ratio-policies/{id})
+	UpdateRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all roles
 	// (GET /api/v1/camps/{camp_id}/roles)
 	ListRoles(w http.ResponseWriter, r *http.Request, campId CampId, params ListRolesParams)
@@ -227,6 +683,21 @@ type ServerInterface interface {
 	// Update session by ID
 	// (PUT /api/v1/camps/{camp_id}/sessions/{id})
 	UpdateSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List skill tracks by name
+	// (GET /api/v1/camps/{camp_id}/skill-tracks)
+	ListSkillTracks(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Create a skill track with its ordered levels
+	// (POST /api/v1/camps/{camp_id}/skill-tracks)
+	CreateSkillTrack(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete a skill track with its assessments and badges
+	// (DELETE /api/v1/camps/{camp_id}/skill-tracks/{id})
+	DeleteSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get a skill track
+	// (GET /api/v1/camps/{camp_id}/skill-tracks/{id})
+	GetSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Update a skill track and its levels
+	// (PUT /api/v1/camps/{camp_id}/skill-tracks/{id})
+	UpdateSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
 	// List all staff members
 	// (GET /api/v1/camps/{camp_id}/staff-members)
 	ListStaffMembers(w http.ResponseWriter, r *http.Request, campId CampId, params ListStaffMembersParams)
@@ -242,6 +713,30 @@ type ServerInterface interface {
 	// Update staff member by ID
 	// (PUT /api/v1/camps/{camp_id}/staff-members/{id})
 	UpdateStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get a staff member's weekly availability
+	// (GET /api/v1/camps/{camp_id}/staff-members/{id}/availability)
+	GetStaffMemberAvailability(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Mark a certificate of a staff member as verified by the current admin
+	// (POST /api/v1/camps/{camp_id}/staff-members/{id}/certifications/verify)
+	VerifyStaffMemberCertification(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Clock a staff member in, opening a time entry
+	// (POST /api/v1/camps/{camp_id}/staff-members/{id}/clock-in)
+	ClockInStaffMember(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Clock a staff member out, closing their open time entry
+	// (POST /api/v1/camps/{camp_id}/staff-members/{id}/clock-out)
+	ClockOutStaffMember(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get a staff member's onboarding progress and readiness
+	// (GET /api/v1/camps/{camp_id}/staff-members/{id}/onboarding)
+	GetStaffMemberOnboarding(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Mark an onboarding checklist item of a staff member not completed
+	// (DELETE /api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id})
+	UncompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId CampId, id Id, itemId OnboardingItemId)
+	// Mark an onboarding checklist item of a staff member completed
+	// (PUT /api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id})
+	CompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId CampId, id Id, itemId OnboardingItemId)
+	// Get a staff member's timeline of notes, attendance, incidents and group changes
+	// (GET /api/v1/camps/{camp_id}/staff-members/{id}/timeline)
+	GetStaffMemberTimeline(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params GetStaffMemberTimelineParams)
 	// List all time blocks
 	// (GET /api/v1/camps/{camp_id}/time-blocks)
 	ListTimeBlocks(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeBlocksParams)
@@ -257,6 +752,36 @@ type ServerInterface interface {
 	// Update time block by ID
 	// (PUT /api/v1/camps/{camp_id}/time-blocks/{id})
 	UpdateTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List time entries by clock-in time
+	// (GET /api/v1/camps/{camp_id}/time-entries)
+	ListTimeEntries(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeEntriesParams)
+	// Record hours a staff member worked
+	// (POST /api/v1/camps/{camp_id}/time-entries)
+	CreateTimeEntry(w http.ResponseWriter, r *http.Request, campId CampId)
+	// Delete a time entry that is not approved
+	// (DELETE /api/v1/camps/{camp_id}/time-entries/{id})
+	DeleteTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Get time entry by ID
+	// (GET /api/v1/camps/{camp_id}/time-entries/{id})
+	GetTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Correct a time entry that is not approved
+	// (PUT /api/v1/camps/{camp_id}/time-entries/{id})
+	UpdateTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Approve or reject a time entry, or reopen it as pending
+	// (POST /api/v1/camps/{camp_id}/time-entries/{id}/review)
+	ReviewTimeEntry(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// List time-off requests by start
+	// (GET /api/v1/camps/{camp_id}/time-off-requests)
+	ListTimeOffRequests(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeOffRequestsParams)
+	// Approve or reject a time-off request, or reopen it as pending
+	// (POST /api/v1/camps/{camp_id}/time-off-requests/{id}/review)
+	ReviewTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id)
+	// Weekly hours of each staff member in a pay period
+	// (GET /api/v1/camps/{camp_id}/timesheets)
+	GetTimesheets(w http.ResponseWriter, r *http.Request, campId CampId, params GetTimesheetsParams)
+	// Download the weekly hours of a pay period as CSV for payroll
+	// (GET /api/v1/camps/{camp_id}/timesheets/export)
+	ExportTimesheets(w http.ResponseWriter, r *http.Request, campId CampId, params ExportTimesheetsParams)
 	// Delete camp by ID
 	// (DELETE /api/v1/camps/{id})
 	DeleteCampById(w http.ResponseWriter, r *http.Request, id Id)
@@ -278,6 +803,12 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// Download an attachment using a signed URL (no authorization header needed)
+// (GET /api/v1/attachments/download/{token})
+func (_ Unimplemented) DownloadAttachment(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authenticate user
 // (POST /api/v1/auth/login)
 func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
@@ -344,6 +875,54 @@ func (_ Unimplemented) UpdateActivityById(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Ineligible campers in the events of a range of days
+// (GET /api/v1/camps/{camp_id}/activity-eligibility)
+func (_ Unimplemented) GetEligibilityReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetEligibilityReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all camper applications
+// (GET /api/v1/camps/{camp_id}/applications)
+func (_ Unimplemented) ListApplications(w http.ResponseWriter, r *http.Request, campId CampId, params ListApplicationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new camper application (starts as a draft)
+// (POST /api/v1/camps/{camp_id}/applications)
+func (_ Unimplemented) CreateApplication(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete camper application (not allowed once accepted or enrolled)
+// (DELETE /api/v1/camps/{camp_id}/applications/{id})
+func (_ Unimplemented) DeleteApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get camper application by ID
+// (GET /api/v1/camps/{camp_id}/applications/{id})
+func (_ Unimplemented) GetApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update camper application (only before it is accepted)
+// (PUT /api/v1/camps/{camp_id}/applications/{id})
+func (_ Unimplemented) UpdateApplicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the status history of a camper application
+// (GET /api/v1/camps/{camp_id}/applications/{id}/transitions)
+func (_ Unimplemented) ListApplicationTransitions(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a camper application to a new status
+// (POST /api/v1/camps/{camp_id}/applications/{id}/transitions)
+func (_ Unimplemented) TransitionApplication(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all areas
 // (GET /api/v1/camps/{camp_id}/areas)
 func (_ Unimplemented) ListAreas(w http.ResponseWriter, r *http.Request, campId CampId, params ListAreasParams) {
@@ -374,6 +953,144 @@ func (_ Unimplemented) UpdateAreaById(w http.ResponseWriter, r *http.Request, ca
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List attachments
+// (GET /api/v1/camps/{camp_id}/attachments)
+func (_ Unimplemented) ListAttachments(w http.ResponseWriter, r *http.Request, campId CampId, params ListAttachmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a document or photo for a camper, staff member, certification or incident
+// (POST /api/v1/camps/{camp_id}/attachments)
+func (_ Unimplemented) UploadAttachment(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete attachment and its stored file
+// (DELETE /api/v1/camps/{camp_id}/attachments/{id})
+func (_ Unimplemented) DeleteAttachmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get attachment by ID
+// (GET /api/v1/camps/{camp_id}/attachments/{id})
+func (_ Unimplemented) GetAttachmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a short-lived signed URL to download the attachment
+// (POST /api/v1/camps/{camp_id}/attachments/{id}/download-url)
+func (_ Unimplemented) CreateAttachmentDownloadUrl(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the sign-in and sign-out records of a day
+// (GET /api/v1/camps/{camp_id}/attendance)
+func (_ Unimplemented) ListAttendanceRecords(w http.ResponseWriter, r *http.Request, campId CampId, params ListAttendanceRecordsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign a camper in on arrival
+// (POST /api/v1/camps/{camp_id}/attendance/check-in)
+func (_ Unimplemented) CheckInCamper(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign a camper out, verifying the pickup person against the authorized pickup list
+// (POST /api/v1/camps/{camp_id}/attendance/check-out)
+func (_ Unimplemented) CheckOutCamper(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Campers on site now, with counts per group and housing room
+// (GET /api/v1/camps/{camp_id}/attendance/on-site)
+func (_ Unimplemented) GetOnSiteReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetOnSiteReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an attendance record entered by mistake
+// (DELETE /api/v1/camps/{camp_id}/attendance/{id})
+func (_ Unimplemented) DeleteAttendanceRecord(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List bed assignments by start
+// (GET /api/v1/camps/{camp_id}/bed-assignments)
+func (_ Unimplemented) ListBedAssignments(w http.ResponseWriter, r *http.Request, campId CampId, params ListBedAssignmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assign a camper or staff member to a bed
+// (POST /api/v1/camps/{camp_id}/bed-assignments)
+func (_ Unimplemented) CreateBedAssignment(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete bed assignment by ID
+// (DELETE /api/v1/camps/{camp_id}/bed-assignments/{id})
+func (_ Unimplemented) DeleteBedAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a bed assignment to another bed or other nights
+// (PUT /api/v1/camps/{camp_id}/bed-assignments/{id})
+func (_ Unimplemented) UpdateBedAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List beds by room and label
+// (GET /api/v1/camps/{camp_id}/beds)
+func (_ Unimplemented) ListBeds(w http.ResponseWriter, r *http.Request, campId CampId, params ListBedsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a bed
+// (POST /api/v1/camps/{camp_id}/beds)
+func (_ Unimplemented) CreateBed(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete bed by ID
+// (DELETE /api/v1/camps/{camp_id}/beds/{id})
+func (_ Unimplemented) DeleteBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get bed by ID
+// (GET /api/v1/camps/{camp_id}/beds/{id})
+func (_ Unimplemented) GetBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update bed by ID
+// (PUT /api/v1/camps/{camp_id}/beds/{id})
+func (_ Unimplemented) UpdateBedById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List bunkmate requests
+// (GET /api/v1/camps/{camp_id}/bunk-requests)
+func (_ Unimplemented) ListBunkRequests(w http.ResponseWriter, r *http.Request, campId CampId, params ListBunkRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record a camper's bunkmate request
+// (POST /api/v1/camps/{camp_id}/bunk-requests)
+func (_ Unimplemented) CreateBunkRequest(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Withdraw a bunkmate request
+// (DELETE /api/v1/camps/{camp_id}/bunk-requests/{id})
+func (_ Unimplemented) DeleteBunkRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List camper merges
+// (GET /api/v1/camps/{camp_id}/camper-merges)
+func (_ Unimplemented) ListCamperMerges(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all campers
 // (GET /api/v1/camps/{camp_id}/campers)
 func (_ Unimplemented) ListCampers(w http.ResponseWriter, r *http.Request, campId CampId, params ListCampersParams) {
@@ -386,6 +1103,12 @@ func (_ Unimplemented) CreateCamper(w http.ResponseWriter, r *http.Request, camp
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Find likely duplicate campers
+// (GET /api/v1/camps/{camp_id}/campers/duplicates)
+func (_ Unimplemented) ListCamperDuplicates(w http.ResponseWriter, r *http.Request, campId CampId, params ListCamperDuplicatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete camper
 // (DELETE /api/v1/camps/{camp_id}/campers/{id})
 func (_ Unimplemented) DeleteCamperById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
@@ -404,6 +1127,72 @@ func (_ Unimplemented) UpdateCamperById(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a camper's guardians, emergency contacts and authorized pickups
+// (GET /api/v1/camps/{camp_id}/campers/{id}/contacts)
+func (_ Unimplemented) GetCamperContacts(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a camper's session enrollments
+// (GET /api/v1/camps/{camp_id}/campers/{id}/enrollments)
+func (_ Unimplemented) ListCamperEnrollments(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enroll a camper in a session
+// (POST /api/v1/camps/{camp_id}/campers/{id}/enrollments)
+func (_ Unimplemented) CreateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a camper's session enrollment
+// (DELETE /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id})
+func (_ Unimplemented) DeleteCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, enrollmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a camper's session enrollment
+// (PUT /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id})
+func (_ Unimplemented) UpdateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, enrollmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a camper's guardians and siblings
+// (GET /api/v1/camps/{camp_id}/campers/{id}/family)
+func (_ Unimplemented) GetCamperFamily(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Merge a duplicate camper into this camper
+// (POST /api/v1/camps/{camp_id}/campers/{id}/merge)
+func (_ Unimplemented) MergeCamper(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record a skill assessment of a camper
+// (POST /api/v1/camps/{camp_id}/campers/{id}/skill-assessments)
+func (_ Unimplemented) CreateSkillAssessment(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a skill assessment recorded by mistake, with the badge it awarded
+// (DELETE /api/v1/camps/{camp_id}/campers/{id}/skill-assessments/{assessment_id})
+func (_ Unimplemented) DeleteSkillAssessment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, assessmentId AssessmentId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a camper's skill levels, badges and assessment history
+// (GET /api/v1/camps/{camp_id}/campers/{id}/skills)
+func (_ Unimplemented) GetCamperSkills(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a camper's timeline of notes, attendance, incidents and group changes
+// (GET /api/v1/camps/{camp_id}/campers/{id}/timeline)
+func (_ Unimplemented) GetCamperTimeline(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params GetCamperTimelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all certifications
 // (GET /api/v1/camps/{camp_id}/certifications)
 func (_ Unimplemented) ListCertifications(w http.ResponseWriter, r *http.Request, campId CampId, params ListCertificationsParams) {
@@ -416,6 +1205,12 @@ func (_ Unimplemented) CreateCertification(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Staff certifications that expired, expire soon or are missing for upcoming events
+// (GET /api/v1/camps/{camp_id}/certifications/compliance)
+func (_ Unimplemented) GetCertificationCompliance(w http.ResponseWriter, r *http.Request, campId CampId, params GetCertificationComplianceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete certification by ID
 // (DELETE /api/v1/camps/{camp_id}/certifications/{id})
 func (_ Unimplemented) DeleteCertificationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
@@ -434,6 +1229,24 @@ func (_ Unimplemented) UpdateCertificationById(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Copy a camp's configuration into a new camp
+// (POST /api/v1/camps/{camp_id}/clone)
+func (_ Unimplemented) CloneCamp(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the clone jobs of a camp, most recent first
+// (GET /api/v1/camps/{camp_id}/clone-jobs)
+func (_ Unimplemented) ListCampCloneJobs(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of a clone job
+// (GET /api/v1/camps/{camp_id}/clone-jobs/{id})
+func (_ Unimplemented) GetCampCloneJobById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all colors
 // (GET /api/v1/camps/{camp_id}/colors)
 func (_ Unimplemented) ListColors(w http.ResponseWriter, r *http.Request, campId CampId, params ListColorsParams) {
@@ -464,459 +1277,7356 @@ func (_ Unimplemented) UpdateColorById(w http.ResponseWriter, r *http.Request, c
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all events
-// (GET /api/v1/camps/{camp_id}/events)
-func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, campId CampId, params ListEventsParams) {
+// List custom field definitions
+// (GET /api/v1/camps/{camp_id}/custom-fields)
+func (_ Unimplemented) ListCustomFields(w http.ResponseWriter, r *http.Request, campId CampId, params ListCustomFieldsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new event
-// (POST /api/v1/camps/{camp_id}/events)
-func (_ Unimplemented) CreateEvent(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Define a custom field for campers, staff members or groups
+// (POST /api/v1/camps/{camp_id}/custom-fields)
+func (_ Unimplemented) CreateCustomField(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete event
-// (DELETE /api/v1/camps/{camp_id}/events/{id})
-func (_ Unimplemented) DeleteEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params DeleteEventByIdParams) {
+// Delete custom field definition and remove its values from all records
+// (DELETE /api/v1/camps/{camp_id}/custom-fields/{id})
+func (_ Unimplemented) DeleteCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get event by ID
-// (GET /api/v1/camps/{camp_id}/events/{id})
-func (_ Unimplemented) GetEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Get custom field definition by ID
+// (GET /api/v1/camps/{camp_id}/custom-fields/{id})
+func (_ Unimplemented) GetCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update event
-// (PUT /api/v1/camps/{camp_id}/events/{id})
-func (_ Unimplemented) UpdateEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params UpdateEventByIdParams) {
+// Update custom field definition by ID
+// (PUT /api/v1/camps/{camp_id}/custom-fields/{id})
+func (_ Unimplemented) UpdateCustomFieldById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all groups
-// (GET /api/v1/camps/{camp_id}/groups)
-func (_ Unimplemented) ListGroups(w http.ResponseWriter, r *http.Request, campId CampId, params ListGroupsParams) {
+// List the camp's duty rotation templates by name
+// (GET /api/v1/camps/{camp_id}/duty-rotations)
+func (_ Unimplemented) ListDutyRotations(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new group
-// (POST /api/v1/camps/{camp_id}/groups)
-func (_ Unimplemented) CreateGroup(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Create a duty rotation template
+// (POST /api/v1/camps/{camp_id}/duty-rotations)
+func (_ Unimplemented) CreateDutyRotation(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete group by ID
-// (DELETE /api/v1/camps/{camp_id}/groups/{id})
-func (_ Unimplemented) DeleteGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Delete duty rotation by ID
+// (DELETE /api/v1/camps/{camp_id}/duty-rotations/{id})
+func (_ Unimplemented) DeleteDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get group by ID
-// (GET /api/v1/camps/{camp_id}/groups/{id})
-func (_ Unimplemented) GetGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Get duty rotation by ID
+// (GET /api/v1/camps/{camp_id}/duty-rotations/{id})
+func (_ Unimplemented) GetDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update group by ID
-// (PUT /api/v1/camps/{camp_id}/groups/{id})
-func (_ Unimplemented) UpdateGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Update duty rotation by ID
+// (PUT /api/v1/camps/{camp_id}/duty-rotations/{id})
+func (_ Unimplemented) UpdateDutyRotationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all housing rooms
-// (GET /api/v1/camps/{camp_id}/housing-rooms)
-func (_ Unimplemented) ListHousingRooms(w http.ResponseWriter, r *http.Request, campId CampId, params ListHousingRoomsParams) {
+// Generate the shifts of a rotation for a range of days
+// (POST /api/v1/camps/{camp_id}/duty-rotations/{id}/generate)
+func (_ Unimplemented) GenerateDutyRotationShifts(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new housing room
-// (POST /api/v1/camps/{camp_id}/housing-rooms)
-func (_ Unimplemented) CreateHousingRoom(w http.ResponseWriter, r *http.Request, campId CampId) {
+// List duty shifts by start
+// (GET /api/v1/camps/{camp_id}/duty-shifts)
+func (_ Unimplemented) ListDutyShifts(w http.ResponseWriter, r *http.Request, campId CampId, params ListDutyShiftsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete housing room by ID
-// (DELETE /api/v1/camps/{camp_id}/housing-rooms/{id})
-func (_ Unimplemented) DeleteHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Create a duty shift
+// (POST /api/v1/camps/{camp_id}/duty-shifts)
+func (_ Unimplemented) CreateDutyShift(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get housing room by ID
-// (GET /api/v1/camps/{camp_id}/housing-rooms/{id})
-func (_ Unimplemented) GetHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Fill the open positions of the duty shifts in a range of days
+// (POST /api/v1/camps/{camp_id}/duty-shifts/auto-assign)
+func (_ Unimplemented) AutoAssignDutyShifts(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update housing room
-// (PUT /api/v1/camps/{camp_id}/housing-rooms/{id})
-func (_ Unimplemented) UpdateHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Roster conflicts of the duty shifts in a range of days
+// (GET /api/v1/camps/{camp_id}/duty-shifts/conflicts)
+func (_ Unimplemented) GetDutyShiftConflicts(w http.ResponseWriter, r *http.Request, campId CampId, params GetDutyShiftConflictsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all import jobs for a camp
-// (GET /api/v1/camps/{camp_id}/imports)
-func (_ Unimplemented) ListImportJobs(w http.ResponseWriter, r *http.Request, campId CampId, params ListImportJobsParams) {
+// Delete duty shift by ID
+// (DELETE /api/v1/camps/{camp_id}/duty-shifts/{id})
+func (_ Unimplemented) DeleteDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Start an asynchronous CSV import
-// (POST /api/v1/camps/{camp_id}/imports/{entity_type})
-func (_ Unimplemented) StartImport(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType, params StartImportParams) {
+// Get duty shift by ID
+// (GET /api/v1/camps/{camp_id}/duty-shifts/{id})
+func (_ Unimplemented) GetDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Download CSV template for entity type
-// (GET /api/v1/camps/{camp_id}/imports/{entity_type}/template)
-func (_ Unimplemented) GetImportTemplate(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType) {
+// Update duty shift by ID
+// (PUT /api/v1/camps/{camp_id}/duty-shifts/{id})
+func (_ Unimplemented) UpdateDutyShiftById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Validate a CSV file without importing (dry-run)
-// (POST /api/v1/camps/{camp_id}/imports/{entity_type}/validate)
-func (_ Unimplemented) ValidateImport(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType) {
+// List the camp's duty types by name
+// (GET /api/v1/camps/{camp_id}/duty-types)
+func (_ Unimplemented) ListDutyTypes(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get import job status by ID
-// (GET /api/v1/camps/{camp_id}/imports/{job_id})
-func (_ Unimplemented) GetImportJobById(w http.ResponseWriter, r *http.Request, campId CampId, jobId openapi_types.UUID) {
+// Create a duty type
+// (POST /api/v1/camps/{camp_id}/duty-types)
+func (_ Unimplemented) CreateDutyType(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all locations
-// (GET /api/v1/camps/{camp_id}/locations)
-func (_ Unimplemented) ListLocations(w http.ResponseWriter, r *http.Request, campId CampId, params ListLocationsParams) {
+// Delete duty type by ID, together with its rotations and shifts
+// (DELETE /api/v1/camps/{camp_id}/duty-types/{id})
+func (_ Unimplemented) DeleteDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new location
-// (POST /api/v1/camps/{camp_id}/locations)
-func (_ Unimplemented) CreateLocation(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Get duty type by ID
+// (GET /api/v1/camps/{camp_id}/duty-types/{id})
+func (_ Unimplemented) GetDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete location by ID
-// (DELETE /api/v1/camps/{camp_id}/locations/{id})
-func (_ Unimplemented) DeleteLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Update duty type by ID
+// (PUT /api/v1/camps/{camp_id}/duty-types/{id})
+func (_ Unimplemented) UpdateDutyTypeById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get location by ID
-// (GET /api/v1/camps/{camp_id}/locations/{id})
-func (_ Unimplemented) GetLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// List equipment items by name
+// (GET /api/v1/camps/{camp_id}/equipment)
+func (_ Unimplemented) ListEquipmentItems(w http.ResponseWriter, r *http.Request, campId CampId, params ListEquipmentItemsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update location by ID
-// (PUT /api/v1/camps/{camp_id}/locations/{id})
-func (_ Unimplemented) UpdateLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Add an equipment item to the inventory
+// (POST /api/v1/camps/{camp_id}/equipment)
+func (_ Unimplemented) CreateEquipmentItem(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all programs
-// (GET /api/v1/camps/{camp_id}/programs)
-func (_ Unimplemented) ListPrograms(w http.ResponseWriter, r *http.Request, campId CampId, params ListProgramsParams) {
+// Equipment shortages of concurrent events in a range of days
+// (GET /api/v1/camps/{camp_id}/equipment-conflicts)
+func (_ Unimplemented) GetEquipmentConflicts(w http.ResponseWriter, r *http.Request, campId CampId, params GetEquipmentConflictsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new program
-// (POST /api/v1/camps/{camp_id}/programs)
-func (_ Unimplemented) CreateProgram(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Delete equipment item by ID
+// (DELETE /api/v1/camps/{camp_id}/equipment/{id})
+func (_ Unimplemented) DeleteEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete program by ID
-// (DELETE /api/v1/camps/{camp_id}/programs/{id})
-func (_ Unimplemented) DeleteProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params DeleteProgramByIdParams) {
+// Get equipment item by ID
+// (GET /api/v1/camps/{camp_id}/equipment/{id})
+func (_ Unimplemented) GetEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get program by ID
-// (GET /api/v1/camps/{camp_id}/programs/{id})
-func (_ Unimplemented) GetProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Update equipment item by ID
+// (PUT /api/v1/camps/{camp_id}/equipment/{id})
+func (_ Unimplemented) UpdateEquipmentItemById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update program by ID
-// (PUT /api/v1/camps/{camp_id}/programs/{id})
-func (_ Unimplemented) UpdateProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Check-out history of an equipment item, most recent first
+// (GET /api/v1/camps/{camp_id}/equipment/{id}/checkouts)
+func (_ Unimplemented) ListEquipmentCheckouts(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params ListEquipmentCheckoutsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all roles
-// (GET /api/v1/camps/{camp_id}/roles)
-func (_ Unimplemented) ListRoles(w http.ResponseWriter, r *http.Request, campId CampId, params ListRolesParams) {
+// Check out units of an equipment item as the current user
+// (POST /api/v1/camps/{camp_id}/equipment/{id}/checkouts)
+func (_ Unimplemented) CheckOutEquipment(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new role
-// (POST /api/v1/camps/{camp_id}/roles)
-func (_ Unimplemented) CreateRole(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Check checked-out units back in as the current user
+// (POST /api/v1/camps/{camp_id}/equipment/{id}/checkouts/{checkout_id}/return)
+func (_ Unimplemented) ReturnEquipment(w http.ResponseWriter, r *http.Request, campId CampId, id Id, checkoutId CheckoutId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete role by ID
-// (DELETE /api/v1/camps/{camp_id}/roles/{id})
-func (_ Unimplemented) DeleteRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// List all events
+// (GET /api/v1/camps/{camp_id}/events)
+func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, campId CampId, params ListEventsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get role by ID
-// (GET /api/v1/camps/{camp_id}/roles/{id})
-func (_ Unimplemented) GetRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Create a new event
+// (POST /api/v1/camps/{camp_id}/events)
+func (_ Unimplemented) CreateEvent(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update role by ID
-// (PUT /api/v1/camps/{camp_id}/roles/{id})
-func (_ Unimplemented) UpdateRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Delete event
+// (DELETE /api/v1/camps/{camp_id}/events/{id})
+func (_ Unimplemented) DeleteEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params DeleteEventByIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all sessions
-// (GET /api/v1/camps/{camp_id}/sessions)
-func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request, campId CampId, params ListSessionsParams) {
+// Get event by ID
+// (GET /api/v1/camps/{camp_id}/events/{id})
+func (_ Unimplemented) GetEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new session
-// (POST /api/v1/camps/{camp_id}/sessions)
-func (_ Unimplemented) CreateSession(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Update event
+// (PUT /api/v1/camps/{camp_id}/events/{id})
+func (_ Unimplemented) UpdateEventById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params UpdateEventByIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete session by ID
-// (DELETE /api/v1/camps/{camp_id}/sessions/{id})
-func (_ Unimplemented) DeleteSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Campers of an event who are not eligible for its activity
+// (GET /api/v1/camps/{camp_id}/events/{id}/eligibility)
+func (_ Unimplemented) GetEventEligibility(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get session by ID
-// (GET /api/v1/camps/{camp_id}/sessions/{id})
-func (_ Unimplemented) GetSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// List all groups
+// (GET /api/v1/camps/{camp_id}/groups)
+func (_ Unimplemented) ListGroups(w http.ResponseWriter, r *http.Request, campId CampId, params ListGroupsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update session by ID
-// (PUT /api/v1/camps/{camp_id}/sessions/{id})
-func (_ Unimplemented) UpdateSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Create a new group
+// (POST /api/v1/camps/{camp_id}/groups)
+func (_ Unimplemented) CreateGroup(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all staff members
-// (GET /api/v1/camps/{camp_id}/staff-members)
-func (_ Unimplemented) ListStaffMembers(w http.ResponseWriter, r *http.Request, campId CampId, params ListStaffMembersParams) {
+// Delete group by ID
+// (DELETE /api/v1/camps/{camp_id}/groups/{id})
+func (_ Unimplemented) DeleteGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new staff member
-// (POST /api/v1/camps/{camp_id}/staff-members)
-func (_ Unimplemented) CreateStaffMember(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Get group by ID
+// (GET /api/v1/camps/{camp_id}/groups/{id})
+func (_ Unimplemented) GetGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete staff member by ID
-// (DELETE /api/v1/camps/{camp_id}/staff-members/{id})
-func (_ Unimplemented) DeleteStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Update group by ID
+// (PUT /api/v1/camps/{camp_id}/groups/{id})
+func (_ Unimplemented) UpdateGroupById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get staff member by ID
-// (GET /api/v1/camps/{camp_id}/staff-members/{id})
-func (_ Unimplemented) GetStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// List all guardians
+// (GET /api/v1/camps/{camp_id}/guardians)
+func (_ Unimplemented) ListGuardians(w http.ResponseWriter, r *http.Request, campId CampId, params ListGuardiansParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update staff member by ID
-// (PUT /api/v1/camps/{camp_id}/staff-members/{id})
-func (_ Unimplemented) UpdateStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Create a new guardian
+// (POST /api/v1/camps/{camp_id}/guardians)
+func (_ Unimplemented) CreateGuardian(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all time blocks
-// (GET /api/v1/camps/{camp_id}/time-blocks)
-func (_ Unimplemented) ListTimeBlocks(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeBlocksParams) {
+// Delete guardian
+// (DELETE /api/v1/camps/{camp_id}/guardians/{id})
+func (_ Unimplemented) DeleteGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new time block
-// (POST /api/v1/camps/{camp_id}/time-blocks)
-func (_ Unimplemented) CreateTimeBlock(w http.ResponseWriter, r *http.Request, campId CampId) {
+// Get guardian by ID
+// (GET /api/v1/camps/{camp_id}/guardians/{id})
+func (_ Unimplemented) GetGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete time block by ID
-// (DELETE /api/v1/camps/{camp_id}/time-blocks/{id})
-func (_ Unimplemented) DeleteTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Update guardian
+// (PUT /api/v1/camps/{camp_id}/guardians/{id})
+func (_ Unimplemented) UpdateGuardianById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get time block by ID
-// (GET /api/v1/camps/{camp_id}/time-blocks/{id})
-func (_ Unimplemented) GetTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// List housing assignment proposals
+// (GET /api/v1/camps/{camp_id}/housing-assignments)
+func (_ Unimplemented) ListHousingAssignments(w http.ResponseWriter, r *http.Request, campId CampId, params ListHousingAssignmentsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update time block by ID
-// (PUT /api/v1/camps/{camp_id}/time-blocks/{id})
-func (_ Unimplemented) UpdateTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+// Generate a housing assignment proposal for a session
+// (POST /api/v1/camps/{camp_id}/housing-assignments)
+func (_ Unimplemented) CreateHousingAssignment(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete camp by ID
-// (DELETE /api/v1/camps/{id})
-func (_ Unimplemented) DeleteCampById(w http.ResponseWriter, r *http.Request, id Id) {
+// Get a housing assignment proposal
+// (GET /api/v1/camps/{camp_id}/housing-assignments/{id})
+func (_ Unimplemented) GetHousingAssignmentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get camp by ID
-// (GET /api/v1/camps/{id})
-func (_ Unimplemented) GetCampById(w http.ResponseWriter, r *http.Request, id Id) {
+// Accept a housing assignment proposal
+// (POST /api/v1/camps/{camp_id}/housing-assignments/{id}/accept)
+func (_ Unimplemented) AcceptHousingAssignment(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update camp by ID
-// (PUT /api/v1/camps/{id})
-func (_ Unimplemented) UpdateCampById(w http.ResponseWriter, r *http.Request, id Id) {
+// Occupancy of the housing rooms per night
+// (GET /api/v1/camps/{camp_id}/housing-occupancy)
+func (_ Unimplemented) GetHousingOccupancy(w http.ResponseWriter, r *http.Request, campId CampId, params GetHousingOccupancyParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get all tenants
-// (GET /api/v1/tenants)
-func (_ Unimplemented) GetTenants(w http.ResponseWriter, r *http.Request) {
+// List all housing rooms
+// (GET /api/v1/camps/{camp_id}/housing-rooms)
+func (_ Unimplemented) ListHousingRooms(w http.ResponseWriter, r *http.Request, campId CampId, params ListHousingRoomsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get tenant by ID
-// (GET /api/v1/tenants/{id})
-func (_ Unimplemented) GetTenantById(w http.ResponseWriter, r *http.Request, id Id) {
+// Create a new housing room
+// (POST /api/v1/camps/{camp_id}/housing-rooms)
+func (_ Unimplemented) CreateHousingRoom(w http.ResponseWriter, r *http.Request, campId CampId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Delete housing room by ID
+// (DELETE /api/v1/camps/{camp_id}/housing-rooms/{id})
+func (_ Unimplemented) DeleteHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// Get housing room by ID
+// (GET /api/v1/camps/{camp_id}/housing-rooms/{id})
+func (_ Unimplemented) GetHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+// Update housing room
+// (PUT /api/v1/camps/{camp_id}/housing-rooms/{id})
+func (_ Unimplemented) UpdateHousingRoomById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// List all import jobs for a camp
+// (GET /api/v1/camps/{camp_id}/imports)
+func (_ Unimplemented) ListImportJobs(w http.ResponseWriter, r *http.Request, campId CampId, params ListImportJobsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Start an asynchronous CSV import
+// (POST /api/v1/camps/{camp_id}/imports/{entity_type})
+func (_ Unimplemented) StartImport(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType, params StartImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+// Download CSV template for entity type
+// (GET /api/v1/camps/{camp_id}/imports/{entity_type}/template)
+func (_ Unimplemented) GetImportTemplate(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(w, r)
-	}))
+// Validate a CSV file without importing (dry-run)
+// (POST /api/v1/camps/{camp_id}/imports/{entity_type}/validate)
+func (_ Unimplemented) ValidateImport(w http.ResponseWriter, r *http.Request, campId CampId, entityType ImportEntityType) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Get import job status by ID
+// (GET /api/v1/camps/{camp_id}/imports/{job_id})
+func (_ Unimplemented) GetImportJobById(w http.ResponseWriter, r *http.Request, campId CampId, jobId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List all incident reports
+// (GET /api/v1/camps/{camp_id}/incidents)
+func (_ Unimplemented) ListIncidents(w http.ResponseWriter, r *http.Request, campId CampId, params ListIncidentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+// Create a new incident report (starts as a draft)
+// (POST /api/v1/camps/{camp_id}/incidents)
+func (_ Unimplemented) CreateIncident(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentUser(w, r)
-	}))
+// Incident counts by location, activity or week for safety reviews
+// (GET /api/v1/camps/{camp_id}/incidents/report)
+func (_ Unimplemented) GetIncidentReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetIncidentReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Delete incident report
+// (DELETE /api/v1/camps/{camp_id}/incidents/{id})
+func (_ Unimplemented) DeleteIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Get incident report by ID
+// (GET /api/v1/camps/{camp_id}/incidents/{id})
+func (_ Unimplemented) GetIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Signup operation middleware
-func (siw *ServerInterfaceWrapper) Signup(w http.ResponseWriter, r *http.Request) {
+// Update incident report (not allowed once approved)
+// (PUT /api/v1/camps/{camp_id}/incidents/{id})
+func (_ Unimplemented) UpdateIncidentById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Signup(w, r)
-	}))
+// Approve or return a submitted incident report
+// (POST /api/v1/camps/{camp_id}/incidents/{id}/review)
+func (_ Unimplemented) ReviewIncident(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Submit an incident report for review
+// (POST /api/v1/camps/{camp_id}/incidents/{id}/submit)
+func (_ Unimplemented) SubmitIncident(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List all locations
+// (GET /api/v1/camps/{camp_id}/locations)
+func (_ Unimplemented) ListLocations(w http.ResponseWriter, r *http.Request, campId CampId, params ListLocationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetCamps operation middleware
-func (siw *ServerInterfaceWrapper) GetCamps(w http.ResponseWriter, r *http.Request) {
+// Create a new location
+// (POST /api/v1/camps/{camp_id}/locations)
+func (_ Unimplemented) CreateLocation(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Delete location by ID
+// (DELETE /api/v1/camps/{camp_id}/locations/{id})
+func (_ Unimplemented) DeleteLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCampsParams
+// Get location by ID
+// (GET /api/v1/camps/{camp_id}/locations/{id})
+func (_ Unimplemented) GetLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// Update location by ID
+// (PUT /api/v1/camps/{camp_id}/locations/{id})
+func (_ Unimplemented) UpdateLocationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// List maintenance tickets, most urgent and oldest first
+// (GET /api/v1/camps/{camp_id}/maintenance-tickets)
+func (_ Unimplemented) ListMaintenanceTickets(w http.ResponseWriter, r *http.Request, campId CampId, params ListMaintenanceTicketsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "offset" -------------
+// Report a problem with a location, housing room or area
+// (POST /api/v1/camps/{camp_id}/maintenance-tickets)
+func (_ Unimplemented) CreateMaintenanceTicket(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+// Delete maintenance ticket by ID
+// (DELETE /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+func (_ Unimplemented) DeleteMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "search" -------------
+// Get maintenance ticket by ID
+// (GET /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+func (_ Unimplemented) GetMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
+// Update maintenance ticket by ID
+// (PUT /api/v1/camps/{camp_id}/maintenance-tickets/{id})
+func (_ Unimplemented) UpdateMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "filterBy" -------------
+// Record a dose as given, refused or missed
+// (POST /api/v1/camps/{camp_id}/mar/doses)
+func (_ Unimplemented) RecordMedicationDose(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+// Correct a recorded dose
+// (PUT /api/v1/camps/{camp_id}/mar/doses/{id})
+func (_ Unimplemented) UpdateMedicationDose(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "sortBy" -------------
+// List the doses due on a day, generated from medication schedules
+// (GET /api/v1/camps/{camp_id}/mar/due-doses)
+func (_ Unimplemented) ListDueDoses(w http.ResponseWriter, r *http.Request, campId CampId, params ListDueDosesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Daily medication administration record per camper or per session
+// (GET /api/v1/camps/{camp_id}/mar/report)
+func (_ Unimplemented) GetMarReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetMarReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's weekly availability
+// (GET /api/v1/camps/{camp_id}/me/availability)
+func (_ Unimplemented) GetMyAvailability(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the current user's weekly availability
+// (PUT /api/v1/camps/{camp_id}/me/availability)
+func (_ Unimplemented) UpdateMyAvailability(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the campers in the current user's groups
+// (GET /api/v1/camps/{camp_id}/me/campers)
+func (_ Unimplemented) ListMyCampers(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the groups the current user's staff member belongs to
+// (GET /api/v1/camps/{camp_id}/me/groups)
+func (_ Unimplemented) ListMyGroups(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's onboarding checklist
+// (GET /api/v1/camps/{camp_id}/me/onboarding)
+func (_ Unimplemented) GetMyOnboarding(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's schedule
+// (GET /api/v1/camps/{camp_id}/me/schedule)
+func (_ Unimplemented) GetMySchedule(w http.ResponseWriter, r *http.Request, campId CampId, params GetMyScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the staff member linked to the current user
+// (GET /api/v1/camps/{camp_id}/me/staff-member)
+func (_ Unimplemented) GetMyStaffMember(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the current user's time-off requests, most recent first
+// (GET /api/v1/camps/{camp_id}/me/time-off-requests)
+func (_ Unimplemented) ListMyTimeOffRequests(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request time off
+// (POST /api/v1/camps/{camp_id}/me/time-off-requests)
+func (_ Unimplemented) CreateMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Withdraw one of the current user's time-off requests
+// (DELETE /api/v1/camps/{camp_id}/me/time-off-requests/{id})
+func (_ Unimplemented) CancelMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the camp's daily meal periods in order
+// (GET /api/v1/camps/{camp_id}/meal-periods)
+func (_ Unimplemented) ListMealPeriods(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a daily meal period
+// (POST /api/v1/camps/{camp_id}/meal-periods)
+func (_ Unimplemented) CreateMealPeriod(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete meal period by ID, together with its menus
+// (DELETE /api/v1/camps/{camp_id}/meal-periods/{id})
+func (_ Unimplemented) DeleteMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get meal period by ID
+// (GET /api/v1/camps/{camp_id}/meal-periods/{id})
+func (_ Unimplemented) GetMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update meal period by ID
+// (PUT /api/v1/camps/{camp_id}/meal-periods/{id})
+func (_ Unimplemented) UpdateMealPeriodById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Meals to prepare per meal period of a day, with dietary counts and allergen warnings
+// (GET /api/v1/camps/{camp_id}/meals/headcounts)
+func (_ Unimplemented) GetMealHeadcounts(w http.ResponseWriter, r *http.Request, campId CampId, params GetMealHeadcountsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all camper medications
+// (GET /api/v1/camps/{camp_id}/medications)
+func (_ Unimplemented) ListMedications(w http.ResponseWriter, r *http.Request, campId CampId, params ListMedicationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a medication to a camper's medication list
+// (POST /api/v1/camps/{camp_id}/medications)
+func (_ Unimplemented) CreateMedication(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete medication (recorded doses are kept)
+// (DELETE /api/v1/camps/{camp_id}/medications/{id})
+func (_ Unimplemented) DeleteMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get medication by ID
+// (GET /api/v1/camps/{camp_id}/medications/{id})
+func (_ Unimplemented) GetMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update medication
+// (PUT /api/v1/camps/{camp_id}/medications/{id})
+func (_ Unimplemented) UpdateMedicationById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List menus by day and meal period
+// (GET /api/v1/camps/{camp_id}/menus)
+func (_ Unimplemented) ListMenus(w http.ResponseWriter, r *http.Request, campId CampId, params ListMenusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Plan the menu of a meal
+// (POST /api/v1/camps/{camp_id}/menus)
+func (_ Unimplemented) CreateMenu(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete menu by ID
+// (DELETE /api/v1/camps/{camp_id}/menus/{id})
+func (_ Unimplemented) DeleteMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get menu by ID
+// (GET /api/v1/camps/{camp_id}/menus/{id})
+func (_ Unimplemented) GetMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the dishes of a menu
+// (PUT /api/v1/camps/{camp_id}/menus/{id})
+func (_ Unimplemented) UpdateMenuById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List notes visible to the current user, pinned notes first
+// (GET /api/v1/camps/{camp_id}/notes)
+func (_ Unimplemented) ListNotes(w http.ResponseWriter, r *http.Request, campId CampId, params ListNotesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Write a note about a camper or staff member
+// (POST /api/v1/camps/{camp_id}/notes)
+func (_ Unimplemented) CreateNote(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete note by ID (author or admin only)
+// (DELETE /api/v1/camps/{camp_id}/notes/{id})
+func (_ Unimplemented) DeleteNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get note by ID
+// (GET /api/v1/camps/{camp_id}/notes/{id})
+func (_ Unimplemented) GetNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update note by ID (author or admin only)
+// (PUT /api/v1/camps/{camp_id}/notes/{id})
+func (_ Unimplemented) UpdateNoteById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the onboarding progress and readiness of the camp's staff members
+// (GET /api/v1/camps/{camp_id}/onboarding-report)
+func (_ Unimplemented) GetOnboardingReport(w http.ResponseWriter, r *http.Request, campId CampId, params GetOnboardingReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the camp's onboarding templates by name
+// (GET /api/v1/camps/{camp_id}/onboarding-templates)
+func (_ Unimplemented) ListOnboardingTemplates(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an onboarding template
+// (POST /api/v1/camps/{camp_id}/onboarding-templates)
+func (_ Unimplemented) CreateOnboardingTemplate(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete onboarding template by ID
+// (DELETE /api/v1/camps/{camp_id}/onboarding-templates/{id})
+func (_ Unimplemented) DeleteOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get onboarding template by ID
+// (GET /api/v1/camps/{camp_id}/onboarding-templates/{id})
+func (_ Unimplemented) GetOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update onboarding template by ID
+// (PUT /api/v1/camps/{camp_id}/onboarding-templates/{id})
+func (_ Unimplemented) UpdateOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Periods locations, housing rooms and areas are out of service
+// (GET /api/v1/camps/{camp_id}/out-of-service)
+func (_ Unimplemented) ListOutOfServicePeriods(w http.ResponseWriter, r *http.Request, campId CampId, params ListOutOfServicePeriodsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all programs
+// (GET /api/v1/camps/{camp_id}/programs)
+func (_ Unimplemented) ListPrograms(w http.ResponseWriter, r *http.Request, campId CampId, params ListProgramsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new program
+// (POST /api/v1/camps/{camp_id}/programs)
+func (_ Unimplemented) CreateProgram(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete program by ID
+// (DELETE /api/v1/camps/{camp_id}/programs/{id})
+func (_ Unimplemented) DeleteProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params DeleteProgramByIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get program by ID
+// (GET /api/v1/camps/{camp_id}/programs/{id})
+func (_ Unimplemented) GetProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update program by ID
+// (PUT /api/v1/camps/{camp_id}/programs/{id})
+func (_ Unimplemented) UpdateProgramById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the camp's supervision ratio policies by name
+// (GET /api/v1/camps/{camp_id}/ratio-policies)
+func (_ Unimplemented) ListRatioPolicies(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a supervision ratio policy
+// (POST /api/v1/camps/{camp_id}/ratio-policies)
+func (_ Unimplemented) CreateRatioPolicy(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Supervision ratio compliance of events and housing groups in a range of days
+// (GET /api/v1/camps/{camp_id}/ratio-policies/compliance)
+func (_ Unimplemented) GetRatioCompliance(w http.ResponseWriter, r *http.Request, campId CampId, params GetRatioComplianceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete ratio policy by ID
+// (DELETE /api/v1/camps/{camp_id}/ratio-policies/{id})
+func (_ Unimplemented) DeleteRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get ratio policy by ID
+// (GET /api/v1/camps/{camp_id}/ratio-policies/{id})
+func (_ Unimplemented) GetRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update ratio policy by ID
+// (PUT /api/v1/camps/{camp_id}/ratio-policies/{id})
+func (_ Unimplemented) UpdateRatioPolicyById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all roles
+// (GET /api/v1/camps/{camp_id}/roles)
+func (_ Unimplemented) ListRoles(w http.ResponseWriter, r *http.Request, campId CampId, params ListRolesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new role
+// (POST /api/v1/camps/{camp_id}/roles)
+func (_ Unimplemented) CreateRole(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete role by ID
+// (DELETE /api/v1/camps/{camp_id}/roles/{id})
+func (_ Unimplemented) DeleteRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get role by ID
+// (GET /api/v1/camps/{camp_id}/roles/{id})
+func (_ Unimplemented) GetRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update role by ID
+// (PUT /api/v1/camps/{camp_id}/roles/{id})
+func (_ Unimplemented) UpdateRoleById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all sessions
+// (GET /api/v1/camps/{camp_id}/sessions)
+func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request, campId CampId, params ListSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new session
+// (POST /api/v1/camps/{camp_id}/sessions)
+func (_ Unimplemented) CreateSession(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete session by ID
+// (DELETE /api/v1/camps/{camp_id}/sessions/{id})
+func (_ Unimplemented) DeleteSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get session by ID
+// (GET /api/v1/camps/{camp_id}/sessions/{id})
+func (_ Unimplemented) GetSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update session by ID
+// (PUT /api/v1/camps/{camp_id}/sessions/{id})
+func (_ Unimplemented) UpdateSessionById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List skill tracks by name
+// (GET /api/v1/camps/{camp_id}/skill-tracks)
+func (_ Unimplemented) ListSkillTracks(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a skill track with its ordered levels
+// (POST /api/v1/camps/{camp_id}/skill-tracks)
+func (_ Unimplemented) CreateSkillTrack(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a skill track with its assessments and badges
+// (DELETE /api/v1/camps/{camp_id}/skill-tracks/{id})
+func (_ Unimplemented) DeleteSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a skill track
+// (GET /api/v1/camps/{camp_id}/skill-tracks/{id})
+func (_ Unimplemented) GetSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a skill track and its levels
+// (PUT /api/v1/camps/{camp_id}/skill-tracks/{id})
+func (_ Unimplemented) UpdateSkillTrackById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all staff members
+// (GET /api/v1/camps/{camp_id}/staff-members)
+func (_ Unimplemented) ListStaffMembers(w http.ResponseWriter, r *http.Request, campId CampId, params ListStaffMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new staff member
+// (POST /api/v1/camps/{camp_id}/staff-members)
+func (_ Unimplemented) CreateStaffMember(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete staff member by ID
+// (DELETE /api/v1/camps/{camp_id}/staff-members/{id})
+func (_ Unimplemented) DeleteStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get staff member by ID
+// (GET /api/v1/camps/{camp_id}/staff-members/{id})
+func (_ Unimplemented) GetStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update staff member by ID
+// (PUT /api/v1/camps/{camp_id}/staff-members/{id})
+func (_ Unimplemented) UpdateStaffMemberById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a staff member's weekly availability
+// (GET /api/v1/camps/{camp_id}/staff-members/{id}/availability)
+func (_ Unimplemented) GetStaffMemberAvailability(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a certificate of a staff member as verified by the current admin
+// (POST /api/v1/camps/{camp_id}/staff-members/{id}/certifications/verify)
+func (_ Unimplemented) VerifyStaffMemberCertification(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clock a staff member in, opening a time entry
+// (POST /api/v1/camps/{camp_id}/staff-members/{id}/clock-in)
+func (_ Unimplemented) ClockInStaffMember(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clock a staff member out, closing their open time entry
+// (POST /api/v1/camps/{camp_id}/staff-members/{id}/clock-out)
+func (_ Unimplemented) ClockOutStaffMember(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a staff member's onboarding progress and readiness
+// (GET /api/v1/camps/{camp_id}/staff-members/{id}/onboarding)
+func (_ Unimplemented) GetStaffMemberOnboarding(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark an onboarding checklist item of a staff member not completed
+// (DELETE /api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id})
+func (_ Unimplemented) UncompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId CampId, id Id, itemId OnboardingItemId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark an onboarding checklist item of a staff member completed
+// (PUT /api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id})
+func (_ Unimplemented) CompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId CampId, id Id, itemId OnboardingItemId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a staff member's timeline of notes, attendance, incidents and group changes
+// (GET /api/v1/camps/{camp_id}/staff-members/{id}/timeline)
+func (_ Unimplemented) GetStaffMemberTimeline(w http.ResponseWriter, r *http.Request, campId CampId, id Id, params GetStaffMemberTimelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all time blocks
+// (GET /api/v1/camps/{camp_id}/time-blocks)
+func (_ Unimplemented) ListTimeBlocks(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeBlocksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new time block
+// (POST /api/v1/camps/{camp_id}/time-blocks)
+func (_ Unimplemented) CreateTimeBlock(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete time block by ID
+// (DELETE /api/v1/camps/{camp_id}/time-blocks/{id})
+func (_ Unimplemented) DeleteTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get time block by ID
+// (GET /api/v1/camps/{camp_id}/time-blocks/{id})
+func (_ Unimplemented) GetTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update time block by ID
+// (PUT /api/v1/camps/{camp_id}/time-blocks/{id})
+func (_ Unimplemented) UpdateTimeBlockById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List time entries by clock-in time
+// (GET /api/v1/camps/{camp_id}/time-entries)
+func (_ Unimplemented) ListTimeEntries(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record hours a staff member worked
+// (POST /api/v1/camps/{camp_id}/time-entries)
+func (_ Unimplemented) CreateTimeEntry(w http.ResponseWriter, r *http.Request, campId CampId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a time entry that is not approved
+// (DELETE /api/v1/camps/{camp_id}/time-entries/{id})
+func (_ Unimplemented) DeleteTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get time entry by ID
+// (GET /api/v1/camps/{camp_id}/time-entries/{id})
+func (_ Unimplemented) GetTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Correct a time entry that is not approved
+// (PUT /api/v1/camps/{camp_id}/time-entries/{id})
+func (_ Unimplemented) UpdateTimeEntryById(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve or reject a time entry, or reopen it as pending
+// (POST /api/v1/camps/{camp_id}/time-entries/{id}/review)
+func (_ Unimplemented) ReviewTimeEntry(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List time-off requests by start
+// (GET /api/v1/camps/{camp_id}/time-off-requests)
+func (_ Unimplemented) ListTimeOffRequests(w http.ResponseWriter, r *http.Request, campId CampId, params ListTimeOffRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve or reject a time-off request, or reopen it as pending
+// (POST /api/v1/camps/{camp_id}/time-off-requests/{id}/review)
+func (_ Unimplemented) ReviewTimeOffRequest(w http.ResponseWriter, r *http.Request, campId CampId, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Weekly hours of each staff member in a pay period
+// (GET /api/v1/camps/{camp_id}/timesheets)
+func (_ Unimplemented) GetTimesheets(w http.ResponseWriter, r *http.Request, campId CampId, params GetTimesheetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download the weekly hours of a pay period as CSV for payroll
+// (GET /api/v1/camps/{camp_id}/timesheets/export)
+func (_ Unimplemented) ExportTimesheets(w http.ResponseWriter, r *http.Request, campId CampId, params ExportTimesheetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete camp by ID
+// (DELETE /api/v1/camps/{id})
+func (_ Unimplemented) DeleteCampById(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get camp by ID
+// (GET /api/v1/camps/{id})
+func (_ Unimplemented) GetCampById(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update camp by ID
+// (PUT /api/v1/camps/{id})
+func (_ Unimplemented) UpdateCampById(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all tenants
+// (GET /api/v1/tenants)
+func (_ Unimplemented) GetTenants(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get tenant by ID
+// (GET /api/v1/tenants/{id})
+func (_ Unimplemented) GetTenantById(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DownloadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadAttachment(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Signup operation middleware
+func (siw *ServerInterfaceWrapper) Signup(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Signup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamps operation middleware
+func (siw *ServerInterfaceWrapper) GetCamps(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCampsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamps(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCamp operation middleware
+func (siw *ServerInterfaceWrapper) CreateCamp(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCamp(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListActivities(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListActivitiesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListActivities(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateActivity operation middleware
+func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateActivity(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteActivityById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteActivityById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteActivityByIdParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteActivityById(w, r, campId, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetActivityById operation middleware
+func (siw *ServerInterfaceWrapper) GetActivityById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivityById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateActivityById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateActivityById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateActivityById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEligibilityReport operation middleware
+func (siw *ServerInterfaceWrapper) GetEligibilityReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEligibilityReportParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEligibilityReport(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplications(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApplication operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplication(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApplicationById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApplicationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApplicationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApplicationById operation middleware
+func (siw *ServerInterfaceWrapper) GetApplicationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplicationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateApplicationById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApplicationTransitions operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationTransitions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationTransitions(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransitionApplication operation middleware
+func (siw *ServerInterfaceWrapper) TransitionApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransitionApplication(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAreas operation middleware
+func (siw *ServerInterfaceWrapper) ListAreas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAreasParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAreas(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateArea operation middleware
+func (siw *ServerInterfaceWrapper) CreateArea(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateArea(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAreaById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAreaById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAreaById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAreaById operation middleware
+func (siw *ServerInterfaceWrapper) GetAreaById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAreaById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAreaById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAreaById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAreaById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAttachments operation middleware
+func (siw *ServerInterfaceWrapper) ListAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAttachmentsParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAttachments(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadAttachment(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAttachmentById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAttachmentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAttachmentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAttachmentById operation middleware
+func (siw *ServerInterfaceWrapper) GetAttachmentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAttachmentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAttachmentDownloadUrl operation middleware
+func (siw *ServerInterfaceWrapper) CreateAttachmentDownloadUrl(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAttachmentDownloadUrl(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAttendanceRecords operation middleware
+func (siw *ServerInterfaceWrapper) ListAttendanceRecords(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAttendanceRecordsParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "camperId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "camperId", r.URL.Query(), &params.CamperId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camperId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAttendanceRecords(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckInCamper operation middleware
+func (siw *ServerInterfaceWrapper) CheckInCamper(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckInCamper(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckOutCamper operation middleware
+func (siw *ServerInterfaceWrapper) CheckOutCamper(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckOutCamper(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOnSiteReport operation middleware
+func (siw *ServerInterfaceWrapper) GetOnSiteReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOnSiteReportParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOnSiteReport(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAttendanceRecord operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAttendanceRecord(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAttendanceRecord(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBedAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListBedAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBedAssignmentsParams
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "housingRoomId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "housingRoomId", r.URL.Query(), &params.HousingRoomId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "housingRoomId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBedAssignments(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBedAssignment operation middleware
+func (siw *ServerInterfaceWrapper) CreateBedAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBedAssignment(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBedAssignmentById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBedAssignmentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBedAssignmentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBedAssignmentById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBedAssignmentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBedAssignmentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBeds operation middleware
+func (siw *ServerInterfaceWrapper) ListBeds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBedsParams
+
+	// ------------- Optional query parameter "housingRoomId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "housingRoomId", r.URL.Query(), &params.HousingRoomId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "housingRoomId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBeds(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBed operation middleware
+func (siw *ServerInterfaceWrapper) CreateBed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBed(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBedById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBedById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBedById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBedById operation middleware
+func (siw *ServerInterfaceWrapper) GetBedById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBedById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBedById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBedById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBedById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBunkRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListBunkRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBunkRequestsParams
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "camperId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "camperId", r.URL.Query(), &params.CamperId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camperId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBunkRequests(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBunkRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateBunkRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBunkRequest(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBunkRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBunkRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBunkRequest(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCamperMerges operation middleware
+func (siw *ServerInterfaceWrapper) ListCamperMerges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCamperMerges(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCampers operation middleware
+func (siw *ServerInterfaceWrapper) ListCampers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCampersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCampers(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCamper operation middleware
+func (siw *ServerInterfaceWrapper) CreateCamper(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCamper(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCamperDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) ListCamperDuplicates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCamperDuplicatesParams
+
+	// ------------- Optional query parameter "minScore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minScore", r.URL.Query(), &params.MinScore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minScore", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCamperDuplicates(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCamperById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCamperById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCamperById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamperById operation middleware
+func (siw *ServerInterfaceWrapper) GetCamperById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamperById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCamperById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCamperById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCamperById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamperContacts operation middleware
+func (siw *ServerInterfaceWrapper) GetCamperContacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamperContacts(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCamperEnrollments operation middleware
+func (siw *ServerInterfaceWrapper) ListCamperEnrollments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCamperEnrollments(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCamperEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) CreateCamperEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCamperEnrollment(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCamperEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCamperEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "enrollment_id" -------------
+	var enrollmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enrollment_id", chi.URLParam(r, "enrollment_id"), &enrollmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollment_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCamperEnrollment(w, r, campId, id, enrollmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCamperEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCamperEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "enrollment_id" -------------
+	var enrollmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "enrollment_id", chi.URLParam(r, "enrollment_id"), &enrollmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollment_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCamperEnrollment(w, r, campId, id, enrollmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamperFamily operation middleware
+func (siw *ServerInterfaceWrapper) GetCamperFamily(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamperFamily(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergeCamper operation middleware
+func (siw *ServerInterfaceWrapper) MergeCamper(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeCamper(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSkillAssessment operation middleware
+func (siw *ServerInterfaceWrapper) CreateSkillAssessment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSkillAssessment(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSkillAssessment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSkillAssessment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "assessment_id" -------------
+	var assessmentId AssessmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assessment_id", chi.URLParam(r, "assessment_id"), &assessmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assessment_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSkillAssessment(w, r, campId, id, assessmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamperSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetCamperSkills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamperSkills(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCamperTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetCamperTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCamperTimelineParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCamperTimeline(w, r, campId, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCertifications operation middleware
+func (siw *ServerInterfaceWrapper) ListCertifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCertificationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCertifications(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCertification operation middleware
+func (siw *ServerInterfaceWrapper) CreateCertification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCertification(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCertificationCompliance operation middleware
+func (siw *ServerInterfaceWrapper) GetCertificationCompliance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCertificationComplianceParams
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCertificationCompliance(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCertificationById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCertificationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCertificationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCertificationById operation middleware
+func (siw *ServerInterfaceWrapper) GetCertificationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCertificationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCertificationById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCertificationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCertificationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneCamp operation middleware
+func (siw *ServerInterfaceWrapper) CloneCamp(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneCamp(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCampCloneJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListCampCloneJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCampCloneJobs(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCampCloneJobById operation middleware
+func (siw *ServerInterfaceWrapper) GetCampCloneJobById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCampCloneJobById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListColors operation middleware
+func (siw *ServerInterfaceWrapper) ListColors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListColorsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListColors(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateColor operation middleware
+func (siw *ServerInterfaceWrapper) CreateColor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateColor(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteColorById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteColorById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteColorById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetColorById operation middleware
+func (siw *ServerInterfaceWrapper) GetColorById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetColorById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateColorById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateColorById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateColorById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCustomFieldsParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCustomFields(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCustomField operation middleware
+func (siw *ServerInterfaceWrapper) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCustomField(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomFieldById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomFieldById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomFieldById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCustomFieldById operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomFieldById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCustomFieldById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCustomFieldById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCustomFieldById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCustomFieldById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDutyRotations operation middleware
+func (siw *ServerInterfaceWrapper) ListDutyRotations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDutyRotations(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDutyRotation operation middleware
+func (siw *ServerInterfaceWrapper) CreateDutyRotation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDutyRotation(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDutyRotationById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDutyRotationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDutyRotationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDutyRotationById operation middleware
+func (siw *ServerInterfaceWrapper) GetDutyRotationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDutyRotationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDutyRotationById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDutyRotationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDutyRotationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GenerateDutyRotationShifts operation middleware
+func (siw *ServerInterfaceWrapper) GenerateDutyRotationShifts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GenerateDutyRotationShifts(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDutyShifts operation middleware
+func (siw *ServerInterfaceWrapper) ListDutyShifts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDutyShiftsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "staffMemberId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "staffMemberId", r.URL.Query(), &params.StaffMemberId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "staffMemberId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dutyTypeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dutyTypeId", r.URL.Query(), &params.DutyTypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dutyTypeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDutyShifts(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDutyShift operation middleware
+func (siw *ServerInterfaceWrapper) CreateDutyShift(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDutyShift(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoAssignDutyShifts operation middleware
+func (siw *ServerInterfaceWrapper) AutoAssignDutyShifts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoAssignDutyShifts(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDutyShiftConflicts operation middleware
+func (siw *ServerInterfaceWrapper) GetDutyShiftConflicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDutyShiftConflictsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDutyShiftConflicts(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDutyShiftById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDutyShiftById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDutyShiftById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDutyShiftById operation middleware
+func (siw *ServerInterfaceWrapper) GetDutyShiftById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDutyShiftById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDutyShiftById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDutyShiftById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDutyShiftById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDutyTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListDutyTypes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDutyTypes(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDutyType operation middleware
+func (siw *ServerInterfaceWrapper) CreateDutyType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDutyType(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDutyTypeById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDutyTypeById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDutyTypeById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDutyTypeById operation middleware
+func (siw *ServerInterfaceWrapper) GetDutyTypeById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDutyTypeById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateDutyTypeById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDutyTypeById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateDutyTypeById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEquipmentItems operation middleware
+func (siw *ServerInterfaceWrapper) ListEquipmentItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEquipmentItemsParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "homeLocationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "homeLocationId", r.URL.Query(), &params.HomeLocationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "homeLocationId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "condition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "condition", r.URL.Query(), &params.Condition)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "condition", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEquipmentItems(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEquipmentItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateEquipmentItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEquipmentItem(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEquipmentConflicts operation middleware
+func (siw *ServerInterfaceWrapper) GetEquipmentConflicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEquipmentConflictsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEquipmentConflicts(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEquipmentItemById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEquipmentItemById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEquipmentItemById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEquipmentItemById operation middleware
+func (siw *ServerInterfaceWrapper) GetEquipmentItemById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEquipmentItemById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEquipmentItemById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEquipmentItemById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEquipmentItemById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEquipmentCheckouts operation middleware
+func (siw *ServerInterfaceWrapper) ListEquipmentCheckouts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEquipmentCheckoutsParams
+
+	// ------------- Optional query parameter "open" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open", r.URL.Query(), &params.Open)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEquipmentCheckouts(w, r, campId, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckOutEquipment operation middleware
+func (siw *ServerInterfaceWrapper) CheckOutEquipment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckOutEquipment(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReturnEquipment operation middleware
+func (siw *ServerInterfaceWrapper) ReturnEquipment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "checkout_id" -------------
+	var checkoutId CheckoutId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checkout_id", chi.URLParam(r, "checkout_id"), &checkoutId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checkout_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReturnEquipment(w, r, campId, id, checkoutId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEvents(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvent(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEventById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteEventByIdParams
+
+	// ------------- Optional query parameter "deleteScope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleteScope", r.URL.Query(), &params.DeleteScope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleteScope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEventById(w, r, campId, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventById operation middleware
+func (siw *ServerInterfaceWrapper) GetEventById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEventById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEventById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateEventByIdParams
+
+	// ------------- Optional query parameter "updateScope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updateScope", r.URL.Query(), &params.UpdateScope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updateScope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEventById(w, r, campId, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventEligibility operation middleware
+func (siw *ServerInterfaceWrapper) GetEventEligibility(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventEligibility(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGroupsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGroups(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGroup(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGroupById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroupById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGroupById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGroupById operation middleware
+func (siw *ServerInterfaceWrapper) GetGroupById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroupById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateGroupById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroupById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGroupById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListGuardians operation middleware
+func (siw *ServerInterfaceWrapper) ListGuardians(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGuardiansParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGuardians(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGuardian operation middleware
+func (siw *ServerInterfaceWrapper) CreateGuardian(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGuardian(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGuardianById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGuardianById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGuardianById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGuardianById operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardianById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGuardianById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateGuardianById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGuardianById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGuardianById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHousingAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListHousingAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHousingAssignmentsParams
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHousingAssignments(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHousingAssignment operation middleware
+func (siw *ServerInterfaceWrapper) CreateHousingAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHousingAssignment(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHousingAssignmentById operation middleware
+func (siw *ServerInterfaceWrapper) GetHousingAssignmentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHousingAssignmentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptHousingAssignment operation middleware
+func (siw *ServerInterfaceWrapper) AcceptHousingAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptHousingAssignment(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHousingOccupancy operation middleware
+func (siw *ServerInterfaceWrapper) GetHousingOccupancy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHousingOccupancyParams
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHousingOccupancy(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHousingRooms operation middleware
+func (siw *ServerInterfaceWrapper) ListHousingRooms(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHousingRoomsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHousingRooms(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHousingRoom operation middleware
+func (siw *ServerInterfaceWrapper) CreateHousingRoom(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHousingRoom(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHousingRoomById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHousingRoomById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHousingRoomById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHousingRoomById operation middleware
+func (siw *ServerInterfaceWrapper) GetHousingRoomById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHousingRoomById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateHousingRoomById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHousingRoomById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHousingRoomById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImportJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListImportJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListImportJobsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImportJobs(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartImport operation middleware
+func (siw *ServerInterfaceWrapper) StartImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ImportEntityType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartImportParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartImport(w, r, campId, entityType, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetImportTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetImportTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ImportEntityType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImportTemplate(w, r, campId, entityType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ValidateImport operation middleware
+func (siw *ServerInterfaceWrapper) ValidateImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "entity_type" -------------
+	var entityType ImportEntityType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateImport(w, r, campId, entityType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetImportJobById operation middleware
+func (siw *ServerInterfaceWrapper) GetImportJobById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "job_id", chi.URLParam(r, "job_id"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImportJobById(w, r, campId, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListIncidents operation middleware
+func (siw *ServerInterfaceWrapper) ListIncidents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListIncidentsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIncidents(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateIncident operation middleware
+func (siw *ServerInterfaceWrapper) CreateIncident(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIncident(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIncidentReport operation middleware
+func (siw *ServerInterfaceWrapper) GetIncidentReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIncidentReportParams
+
+	// ------------- Required query parameter "groupBy" -------------
+
+	if paramValue := r.URL.Query().Get("groupBy"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "groupBy"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIncidentReport(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteIncidentById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIncidentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteIncidentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIncidentById operation middleware
+func (siw *ServerInterfaceWrapper) GetIncidentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIncidentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateIncidentById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIncidentById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateIncidentById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReviewIncident operation middleware
+func (siw *ServerInterfaceWrapper) ReviewIncident(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewIncident(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitIncident operation middleware
+func (siw *ServerInterfaceWrapper) SubmitIncident(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitIncident(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLocations operation middleware
+func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLocationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filterBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLocations(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLocation operation middleware
+func (siw *ServerInterfaceWrapper) CreateLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLocation(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLocationById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLocationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLocationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLocationById operation middleware
+func (siw *ServerInterfaceWrapper) GetLocationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLocationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateLocationById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLocationById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLocationById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMaintenanceTickets operation middleware
+func (siw *ServerInterfaceWrapper) ListMaintenanceTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaintenanceTicketsParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assigneeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMaintenanceTickets(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMaintenanceTicket operation middleware
+func (siw *ServerInterfaceWrapper) CreateMaintenanceTicket(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMaintenanceTicket(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMaintenanceTicketById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaintenanceTicketById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaintenanceTicketById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMaintenanceTicketById operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceTicketById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceTicketById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMaintenanceTicketById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMaintenanceTicketById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMaintenanceTicketById(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecordMedicationDose operation middleware
+func (siw *ServerInterfaceWrapper) RecordMedicationDose(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordMedicationDose(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMedicationDose operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMedicationDose(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMedicationDose(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDueDoses operation middleware
+func (siw *ServerInterfaceWrapper) ListDueDoses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDueDosesParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if paramValue := r.URL.Query().Get("date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "camperId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "camperId", r.URL.Query(), &params.CamperId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camperId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDueDoses(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMarReport operation middleware
+func (siw *ServerInterfaceWrapper) GetMarReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMarReportParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if paramValue := r.URL.Query().Get("date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "camperId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "camperId", r.URL.Query(), &params.CamperId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camperId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", r.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMarReport(w, r, campId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetMyAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyAvailability(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMyAvailability operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMyAvailability(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyCampers operation middleware
+func (siw *ServerInterfaceWrapper) ListMyCampers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyCampers(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListMyGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyGroups(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyOnboarding operation middleware
+func (siw *ServerInterfaceWrapper) GetMyOnboarding(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyOnboarding(w, r, campId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMySchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetMySchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyScheduleParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCamps(w, r, params)
+		siw.Handler.GetMySchedule(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -926,11 +8636,22 @@ func (siw *ServerInterfaceWrapper) GetCamps(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCamp operation middleware
-func (siw *ServerInterfaceWrapper) CreateCamp(w http.ResponseWriter, r *http.Request) {
+// GetMyStaffMember operation middleware
+func (siw *ServerInterfaceWrapper) GetMyStaffMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCamp(w, r)
+		siw.Handler.GetMyStaffMember(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -940,8 +8661,8 @@ func (siw *ServerInterfaceWrapper) CreateCamp(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListActivities operation middleware
-func (siw *ServerInterfaceWrapper) ListActivities(w http.ResponseWriter, r *http.Request) {
+// ListMyTimeOffRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListMyTimeOffRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -954,59 +8675,92 @@ func (siw *ServerInterfaceWrapper) ListActivities(w http.ResponseWriter, r *http
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListActivitiesParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyTimeOffRequests(w, r, campId)
+	}))
 
-	// ------------- Optional query parameter "limit" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMyTimeOffRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateMyTimeOffRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMyTimeOffRequest(w, r, campId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+// CancelMyTimeOffRequest operation middleware
+func (siw *ServerInterfaceWrapper) CancelMyTimeOffRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "filterBy" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelMyTimeOffRequest(w, r, campId, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+// ListMealPeriods operation middleware
+func (siw *ServerInterfaceWrapper) ListMealPeriods(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListActivities(w, r, campId, params)
+		siw.Handler.ListMealPeriods(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1016,8 +8770,8 @@ func (siw *ServerInterfaceWrapper) ListActivities(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateActivity operation middleware
-func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http.Request) {
+// CreateMealPeriod operation middleware
+func (siw *ServerInterfaceWrapper) CreateMealPeriod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1031,7 +8785,7 @@ func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateActivity(w, r, campId)
+		siw.Handler.CreateMealPeriod(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1041,8 +8795,8 @@ func (siw *ServerInterfaceWrapper) CreateActivity(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteActivityById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteActivityById(w http.ResponseWriter, r *http.Request) {
+// DeleteMealPeriodById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMealPeriodById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1064,19 +8818,42 @@ func (siw *ServerInterfaceWrapper) DeleteActivityById(w http.ResponseWriter, r *
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteActivityByIdParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMealPeriodById(w, r, campId, id)
+	}))
 
-	// ------------- Optional query parameter "force" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	handler.ServeHTTP(w, r)
+}
+
+// GetMealPeriodById operation middleware
+func (siw *ServerInterfaceWrapper) GetMealPeriodById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteActivityById(w, r, campId, id, params)
+		siw.Handler.GetMealPeriodById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1086,8 +8863,8 @@ func (siw *ServerInterfaceWrapper) DeleteActivityById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetActivityById operation middleware
-func (siw *ServerInterfaceWrapper) GetActivityById(w http.ResponseWriter, r *http.Request) {
+// UpdateMealPeriodById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMealPeriodById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1110,7 +8887,7 @@ func (siw *ServerInterfaceWrapper) GetActivityById(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetActivityById(w, r, campId, id)
+		siw.Handler.UpdateMealPeriodById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1120,8 +8897,8 @@ func (siw *ServerInterfaceWrapper) GetActivityById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateActivityById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateActivityById(w http.ResponseWriter, r *http.Request) {
+// GetMealHeadcounts operation middleware
+func (siw *ServerInterfaceWrapper) GetMealHeadcounts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1134,17 +8911,19 @@ func (siw *ServerInterfaceWrapper) UpdateActivityById(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMealHeadcountsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateActivityById(w, r, campId, id)
+		siw.Handler.GetMealHeadcounts(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1154,8 +8933,8 @@ func (siw *ServerInterfaceWrapper) UpdateActivityById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListAreas operation middleware
-func (siw *ServerInterfaceWrapper) ListAreas(w http.ResponseWriter, r *http.Request) {
+// ListMedications operation middleware
+func (siw *ServerInterfaceWrapper) ListMedications(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1169,7 +8948,7 @@ func (siw *ServerInterfaceWrapper) ListAreas(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAreasParams
+	var params ListMedicationsParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -1220,7 +8999,7 @@ func (siw *ServerInterfaceWrapper) ListAreas(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAreas(w, r, campId, params)
+		siw.Handler.ListMedications(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1230,8 +9009,8 @@ func (siw *ServerInterfaceWrapper) ListAreas(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateArea operation middleware
-func (siw *ServerInterfaceWrapper) CreateArea(w http.ResponseWriter, r *http.Request) {
+// CreateMedication operation middleware
+func (siw *ServerInterfaceWrapper) CreateMedication(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1245,7 +9024,7 @@ func (siw *ServerInterfaceWrapper) CreateArea(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateArea(w, r, campId)
+		siw.Handler.CreateMedication(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1255,8 +9034,8 @@ func (siw *ServerInterfaceWrapper) CreateArea(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAreaById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAreaById(w http.ResponseWriter, r *http.Request) {
+// DeleteMedicationById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMedicationById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1279,7 +9058,7 @@ func (siw *ServerInterfaceWrapper) DeleteAreaById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAreaById(w, r, campId, id)
+		siw.Handler.DeleteMedicationById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1289,8 +9068,8 @@ func (siw *ServerInterfaceWrapper) DeleteAreaById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetAreaById operation middleware
-func (siw *ServerInterfaceWrapper) GetAreaById(w http.ResponseWriter, r *http.Request) {
+// GetMedicationById operation middleware
+func (siw *ServerInterfaceWrapper) GetMedicationById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1313,7 +9092,7 @@ func (siw *ServerInterfaceWrapper) GetAreaById(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAreaById(w, r, campId, id)
+		siw.Handler.GetMedicationById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1323,8 +9102,8 @@ func (siw *ServerInterfaceWrapper) GetAreaById(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateAreaById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAreaById(w http.ResponseWriter, r *http.Request) {
+// UpdateMedicationById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMedicationById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1347,7 +9126,7 @@ func (siw *ServerInterfaceWrapper) UpdateAreaById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateAreaById(w, r, campId, id)
+		siw.Handler.UpdateMedicationById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1357,8 +9136,8 @@ func (siw *ServerInterfaceWrapper) UpdateAreaById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListCampers operation middleware
-func (siw *ServerInterfaceWrapper) ListCampers(w http.ResponseWriter, r *http.Request) {
+// ListMenus operation middleware
+func (siw *ServerInterfaceWrapper) ListMenus(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1372,58 +9151,26 @@ func (siw *ServerInterfaceWrapper) ListCampers(w http.ResponseWriter, r *http.Re
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCampersParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "filterBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+	var params ListMenusParams
 
-	// ------------- Optional query parameter "sortBy" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCampers(w, r, campId, params)
+		siw.Handler.ListMenus(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1433,8 +9180,8 @@ func (siw *ServerInterfaceWrapper) ListCampers(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCamper operation middleware
-func (siw *ServerInterfaceWrapper) CreateCamper(w http.ResponseWriter, r *http.Request) {
+// CreateMenu operation middleware
+func (siw *ServerInterfaceWrapper) CreateMenu(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1448,7 +9195,7 @@ func (siw *ServerInterfaceWrapper) CreateCamper(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCamper(w, r, campId)
+		siw.Handler.CreateMenu(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1458,8 +9205,8 @@ func (siw *ServerInterfaceWrapper) CreateCamper(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCamperById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCamperById(w http.ResponseWriter, r *http.Request) {
+// DeleteMenuById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMenuById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1482,7 +9229,7 @@ func (siw *ServerInterfaceWrapper) DeleteCamperById(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCamperById(w, r, campId, id)
+		siw.Handler.DeleteMenuById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1492,8 +9239,8 @@ func (siw *ServerInterfaceWrapper) DeleteCamperById(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetCamperById operation middleware
-func (siw *ServerInterfaceWrapper) GetCamperById(w http.ResponseWriter, r *http.Request) {
+// GetMenuById operation middleware
+func (siw *ServerInterfaceWrapper) GetMenuById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1516,7 +9263,7 @@ func (siw *ServerInterfaceWrapper) GetCamperById(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCamperById(w, r, campId, id)
+		siw.Handler.GetMenuById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1526,8 +9273,8 @@ func (siw *ServerInterfaceWrapper) GetCamperById(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCamperById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCamperById(w http.ResponseWriter, r *http.Request) {
+// UpdateMenuById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMenuById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1550,7 +9297,7 @@ func (siw *ServerInterfaceWrapper) UpdateCamperById(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCamperById(w, r, campId, id)
+		siw.Handler.UpdateMenuById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1560,8 +9307,8 @@ func (siw *ServerInterfaceWrapper) UpdateCamperById(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListCertifications operation middleware
-func (siw *ServerInterfaceWrapper) ListCertifications(w http.ResponseWriter, r *http.Request) {
+// ListNotes operation middleware
+func (siw *ServerInterfaceWrapper) ListNotes(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1575,58 +9322,26 @@ func (siw *ServerInterfaceWrapper) ListCertifications(w http.ResponseWriter, r *
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCertificationsParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
+	var params ListNotesParams
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "filterBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "sortBy" -------------
+	// ------------- Optional query parameter "entityType" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Optional query parameter "entityId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCertifications(w, r, campId, params)
+		siw.Handler.ListNotes(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1636,9 +9351,9 @@ func (siw *ServerInterfaceWrapper) ListCertifications(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCertification operation middleware
-func (siw *ServerInterfaceWrapper) CreateCertification(w http.ResponseWriter, r *http.Request) {
-
+// CreateNote operation middleware
+func (siw *ServerInterfaceWrapper) CreateNote(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
 	// ------------- Path parameter "camp_id" -------------
@@ -1651,7 +9366,7 @@ func (siw *ServerInterfaceWrapper) CreateCertification(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCertification(w, r, campId)
+		siw.Handler.CreateNote(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1661,8 +9376,8 @@ func (siw *ServerInterfaceWrapper) CreateCertification(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCertificationById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCertificationById(w http.ResponseWriter, r *http.Request) {
+// DeleteNoteById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNoteById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1685,7 +9400,7 @@ func (siw *ServerInterfaceWrapper) DeleteCertificationById(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCertificationById(w, r, campId, id)
+		siw.Handler.DeleteNoteById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1695,8 +9410,8 @@ func (siw *ServerInterfaceWrapper) DeleteCertificationById(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetCertificationById operation middleware
-func (siw *ServerInterfaceWrapper) GetCertificationById(w http.ResponseWriter, r *http.Request) {
+// GetNoteById operation middleware
+func (siw *ServerInterfaceWrapper) GetNoteById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1719,7 +9434,7 @@ func (siw *ServerInterfaceWrapper) GetCertificationById(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCertificationById(w, r, campId, id)
+		siw.Handler.GetNoteById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1729,8 +9444,8 @@ func (siw *ServerInterfaceWrapper) GetCertificationById(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCertificationById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCertificationById(w http.ResponseWriter, r *http.Request) {
+// UpdateNoteById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNoteById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1753,7 +9468,7 @@ func (siw *ServerInterfaceWrapper) UpdateCertificationById(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCertificationById(w, r, campId, id)
+		siw.Handler.UpdateNoteById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1763,8 +9478,8 @@ func (siw *ServerInterfaceWrapper) UpdateCertificationById(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// ListColors operation middleware
-func (siw *ServerInterfaceWrapper) ListColors(w http.ResponseWriter, r *http.Request) {
+// GetOnboardingReport operation middleware
+func (siw *ServerInterfaceWrapper) GetOnboardingReport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1778,58 +9493,68 @@ func (siw *ServerInterfaceWrapper) ListColors(w http.ResponseWriter, r *http.Req
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListColorsParams
+	var params GetOnboardingReportParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "ready" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "ready", r.URL.Query(), &params.Ready)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ready", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOnboardingReport(w, r, campId, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
+// ListOnboardingTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListOnboardingTemplates(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "filterBy" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOnboardingTemplates(w, r, campId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+// CreateOnboardingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListColors(w, r, campId, params)
+		siw.Handler.CreateOnboardingTemplate(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1839,8 +9564,8 @@ func (siw *ServerInterfaceWrapper) ListColors(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateColor operation middleware
-func (siw *ServerInterfaceWrapper) CreateColor(w http.ResponseWriter, r *http.Request) {
+// DeleteOnboardingTemplateById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOnboardingTemplateById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1853,8 +9578,17 @@ func (siw *ServerInterfaceWrapper) CreateColor(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateColor(w, r, campId)
+		siw.Handler.DeleteOnboardingTemplateById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1864,8 +9598,8 @@ func (siw *ServerInterfaceWrapper) CreateColor(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteColorById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteColorById(w http.ResponseWriter, r *http.Request) {
+// GetOnboardingTemplateById operation middleware
+func (siw *ServerInterfaceWrapper) GetOnboardingTemplateById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1888,7 +9622,7 @@ func (siw *ServerInterfaceWrapper) DeleteColorById(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteColorById(w, r, campId, id)
+		siw.Handler.GetOnboardingTemplateById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1898,8 +9632,8 @@ func (siw *ServerInterfaceWrapper) DeleteColorById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetColorById operation middleware
-func (siw *ServerInterfaceWrapper) GetColorById(w http.ResponseWriter, r *http.Request) {
+// UpdateOnboardingTemplateById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOnboardingTemplateById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1922,7 +9656,7 @@ func (siw *ServerInterfaceWrapper) GetColorById(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetColorById(w, r, campId, id)
+		siw.Handler.UpdateOnboardingTemplateById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1932,8 +9666,8 @@ func (siw *ServerInterfaceWrapper) GetColorById(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateColorById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateColorById(w http.ResponseWriter, r *http.Request) {
+// ListOutOfServicePeriods operation middleware
+func (siw *ServerInterfaceWrapper) ListOutOfServicePeriods(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1946,17 +9680,27 @@ func (siw *ServerInterfaceWrapper) UpdateColorById(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOutOfServicePeriodsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateColorById(w, r, campId, id)
+		siw.Handler.ListOutOfServicePeriods(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1966,8 +9710,8 @@ func (siw *ServerInterfaceWrapper) UpdateColorById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListEvents operation middleware
-func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
+// ListPrograms operation middleware
+func (siw *ServerInterfaceWrapper) ListPrograms(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1981,7 +9725,7 @@ func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Req
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListEventsParams
+	var params ListProgramsParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -2032,7 +9776,7 @@ func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEvents(w, r, campId, params)
+		siw.Handler.ListPrograms(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2042,8 +9786,8 @@ func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEvent operation middleware
-func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+// CreateProgram operation middleware
+func (siw *ServerInterfaceWrapper) CreateProgram(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2057,7 +9801,7 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEvent(w, r, campId)
+		siw.Handler.CreateProgram(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2067,8 +9811,8 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEventById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEventById(w http.ResponseWriter, r *http.Request) {
+// DeleteProgramById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProgramById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2091,18 +9835,18 @@ func (siw *ServerInterfaceWrapper) DeleteEventById(w http.ResponseWriter, r *htt
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteEventByIdParams
+	var params DeleteProgramByIdParams
 
-	// ------------- Optional query parameter "deleteScope" -------------
+	// ------------- Optional query parameter "force" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "deleteScope", r.URL.Query(), &params.DeleteScope)
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deleteScope", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEventById(w, r, campId, id, params)
+		siw.Handler.DeleteProgramById(w, r, campId, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2112,8 +9856,8 @@ func (siw *ServerInterfaceWrapper) DeleteEventById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetEventById operation middleware
-func (siw *ServerInterfaceWrapper) GetEventById(w http.ResponseWriter, r *http.Request) {
+// GetProgramById operation middleware
+func (siw *ServerInterfaceWrapper) GetProgramById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2136,7 +9880,7 @@ func (siw *ServerInterfaceWrapper) GetEventById(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEventById(w, r, campId, id)
+		siw.Handler.GetProgramById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2146,8 +9890,8 @@ func (siw *ServerInterfaceWrapper) GetEventById(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateEventById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateEventById(w http.ResponseWriter, r *http.Request) {
+// UpdateProgramById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProgramById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2169,19 +9913,8 @@ func (siw *ServerInterfaceWrapper) UpdateEventById(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateEventByIdParams
-
-	// ------------- Optional query parameter "updateScope" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "updateScope", r.URL.Query(), &params.UpdateScope)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updateScope", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateEventById(w, r, campId, id, params)
+		siw.Handler.UpdateProgramById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2191,8 +9924,8 @@ func (siw *ServerInterfaceWrapper) UpdateEventById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
+// ListRatioPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListRatioPolicies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2205,59 +9938,33 @@ func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListGroupsParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "search" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRatioPolicies(w, r, campId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "filterBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "sortBy" -------------
+// CreateRatioPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateRatioPolicy(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListGroups(w, r, campId, params)
+		siw.Handler.CreateRatioPolicy(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2267,8 +9974,8 @@ func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+// GetRatioCompliance operation middleware
+func (siw *ServerInterfaceWrapper) GetRatioCompliance(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2281,8 +9988,27 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRatioComplianceParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroup(w, r, campId)
+		siw.Handler.GetRatioCompliance(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2292,8 +10018,8 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteGroupById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGroupById(w http.ResponseWriter, r *http.Request) {
+// DeleteRatioPolicyById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRatioPolicyById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2316,7 +10042,7 @@ func (siw *ServerInterfaceWrapper) DeleteGroupById(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGroupById(w, r, campId, id)
+		siw.Handler.DeleteRatioPolicyById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2326,8 +10052,8 @@ func (siw *ServerInterfaceWrapper) DeleteGroupById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetGroupById operation middleware
-func (siw *ServerInterfaceWrapper) GetGroupById(w http.ResponseWriter, r *http.Request) {
+// GetRatioPolicyById operation middleware
+func (siw *ServerInterfaceWrapper) GetRatioPolicyById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2350,7 +10076,7 @@ func (siw *ServerInterfaceWrapper) GetGroupById(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroupById(w, r, campId, id)
+		siw.Handler.GetRatioPolicyById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2360,8 +10086,8 @@ func (siw *ServerInterfaceWrapper) GetGroupById(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateGroupById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroupById(w http.ResponseWriter, r *http.Request) {
+// UpdateRatioPolicyById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRatioPolicyById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2384,7 +10110,7 @@ func (siw *ServerInterfaceWrapper) UpdateGroupById(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroupById(w, r, campId, id)
+		siw.Handler.UpdateRatioPolicyById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2394,8 +10120,8 @@ func (siw *ServerInterfaceWrapper) UpdateGroupById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListHousingRooms operation middleware
-func (siw *ServerInterfaceWrapper) ListHousingRooms(w http.ResponseWriter, r *http.Request) {
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2409,7 +10135,7 @@ func (siw *ServerInterfaceWrapper) ListHousingRooms(w http.ResponseWriter, r *ht
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListHousingRoomsParams
+	var params ListRolesParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -2460,7 +10186,7 @@ func (siw *ServerInterfaceWrapper) ListHousingRooms(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListHousingRooms(w, r, campId, params)
+		siw.Handler.ListRoles(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2470,8 +10196,8 @@ func (siw *ServerInterfaceWrapper) ListHousingRooms(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateHousingRoom operation middleware
-func (siw *ServerInterfaceWrapper) CreateHousingRoom(w http.ResponseWriter, r *http.Request) {
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2485,7 +10211,7 @@ func (siw *ServerInterfaceWrapper) CreateHousingRoom(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateHousingRoom(w, r, campId)
+		siw.Handler.CreateRole(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2495,8 +10221,8 @@ func (siw *ServerInterfaceWrapper) CreateHousingRoom(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteHousingRoomById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteHousingRoomById(w http.ResponseWriter, r *http.Request) {
+// DeleteRoleById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoleById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2519,7 +10245,7 @@ func (siw *ServerInterfaceWrapper) DeleteHousingRoomById(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteHousingRoomById(w, r, campId, id)
+		siw.Handler.DeleteRoleById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2529,8 +10255,8 @@ func (siw *ServerInterfaceWrapper) DeleteHousingRoomById(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetHousingRoomById operation middleware
-func (siw *ServerInterfaceWrapper) GetHousingRoomById(w http.ResponseWriter, r *http.Request) {
+// GetRoleById operation middleware
+func (siw *ServerInterfaceWrapper) GetRoleById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2553,7 +10279,7 @@ func (siw *ServerInterfaceWrapper) GetHousingRoomById(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHousingRoomById(w, r, campId, id)
+		siw.Handler.GetRoleById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2563,9 +10289,9 @@ func (siw *ServerInterfaceWrapper) GetHousingRoomById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateHousingRoomById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateHousingRoomById(w http.ResponseWriter, r *http.Request) {
-
+// UpdateRoleById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRoleById(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
 	// ------------- Path parameter "camp_id" -------------
@@ -2587,7 +10313,7 @@ func (siw *ServerInterfaceWrapper) UpdateHousingRoomById(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateHousingRoomById(w, r, campId, id)
+		siw.Handler.UpdateRoleById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2597,8 +10323,8 @@ func (siw *ServerInterfaceWrapper) UpdateHousingRoomById(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListImportJobs operation middleware
-func (siw *ServerInterfaceWrapper) ListImportJobs(w http.ResponseWriter, r *http.Request) {
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2612,7 +10338,7 @@ func (siw *ServerInterfaceWrapper) ListImportJobs(w http.ResponseWriter, r *http
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListImportJobsParams
+	var params ListSessionsParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -2630,53 +10356,40 @@ func (siw *ServerInterfaceWrapper) ListImportJobs(w http.ResponseWriter, r *http
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListImportJobs(w, r, campId, params)
-	}))
+	// ------------- Optional query parameter "search" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// StartImport operation middleware
-func (siw *ServerInterfaceWrapper) StartImport(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "camp_id" -------------
-	var campId CampId
+	// ------------- Optional query parameter "filterBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "entity_type" -------------
-	var entityType ImportEntityType
+	// ------------- Optional query parameter "sortBy" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StartImportParams
-
-	// ------------- Optional query parameter "mode" -------------
+	// ------------- Optional query parameter "sortOrder" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartImport(w, r, campId, entityType, params)
+		siw.Handler.ListSessions(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2686,8 +10399,8 @@ func (siw *ServerInterfaceWrapper) StartImport(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetImportTemplate operation middleware
-func (siw *ServerInterfaceWrapper) GetImportTemplate(w http.ResponseWriter, r *http.Request) {
+// CreateSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateSession(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2700,17 +10413,8 @@ func (siw *ServerInterfaceWrapper) GetImportTemplate(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "entity_type" -------------
-	var entityType ImportEntityType
-
-	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetImportTemplate(w, r, campId, entityType)
+		siw.Handler.CreateSession(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2720,8 +10424,8 @@ func (siw *ServerInterfaceWrapper) GetImportTemplate(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ValidateImport operation middleware
-func (siw *ServerInterfaceWrapper) ValidateImport(w http.ResponseWriter, r *http.Request) {
+// DeleteSessionById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSessionById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2734,17 +10438,17 @@ func (siw *ServerInterfaceWrapper) ValidateImport(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "entity_type" -------------
-	var entityType ImportEntityType
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entity_type", chi.URLParam(r, "entity_type"), &entityType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ValidateImport(w, r, campId, entityType)
+		siw.Handler.DeleteSessionById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2754,8 +10458,8 @@ func (siw *ServerInterfaceWrapper) ValidateImport(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetImportJobById operation middleware
-func (siw *ServerInterfaceWrapper) GetImportJobById(w http.ResponseWriter, r *http.Request) {
+// GetSessionById operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2768,17 +10472,17 @@ func (siw *ServerInterfaceWrapper) GetImportJobById(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "job_id" -------------
-	var jobId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "job_id", chi.URLParam(r, "job_id"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetImportJobById(w, r, campId, jobId)
+		siw.Handler.GetSessionById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2788,8 +10492,8 @@ func (siw *ServerInterfaceWrapper) GetImportJobById(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListLocations operation middleware
-func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.Request) {
+// UpdateSessionById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSessionById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2802,59 +10506,42 @@ func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLocationsParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSessionById(w, r, campId, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "filterBy" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "sortBy" -------------
+// ListSkillTracks operation middleware
+func (siw *ServerInterfaceWrapper) ListSkillTracks(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLocations(w, r, campId, params)
+		siw.Handler.ListSkillTracks(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2864,8 +10551,8 @@ func (siw *ServerInterfaceWrapper) ListLocations(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLocation operation middleware
-func (siw *ServerInterfaceWrapper) CreateLocation(w http.ResponseWriter, r *http.Request) {
+// CreateSkillTrack operation middleware
+func (siw *ServerInterfaceWrapper) CreateSkillTrack(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2879,7 +10566,7 @@ func (siw *ServerInterfaceWrapper) CreateLocation(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLocation(w, r, campId)
+		siw.Handler.CreateSkillTrack(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2889,8 +10576,8 @@ func (siw *ServerInterfaceWrapper) CreateLocation(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteLocationById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLocationById(w http.ResponseWriter, r *http.Request) {
+// DeleteSkillTrackById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSkillTrackById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2913,7 +10600,7 @@ func (siw *ServerInterfaceWrapper) DeleteLocationById(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLocationById(w, r, campId, id)
+		siw.Handler.DeleteSkillTrackById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2923,8 +10610,8 @@ func (siw *ServerInterfaceWrapper) DeleteLocationById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetLocationById operation middleware
-func (siw *ServerInterfaceWrapper) GetLocationById(w http.ResponseWriter, r *http.Request) {
+// GetSkillTrackById operation middleware
+func (siw *ServerInterfaceWrapper) GetSkillTrackById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2947,7 +10634,7 @@ func (siw *ServerInterfaceWrapper) GetLocationById(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLocationById(w, r, campId, id)
+		siw.Handler.GetSkillTrackById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2957,8 +10644,8 @@ func (siw *ServerInterfaceWrapper) GetLocationById(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateLocationById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLocationById(w http.ResponseWriter, r *http.Request) {
+// UpdateSkillTrackById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSkillTrackById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2981,7 +10668,7 @@ func (siw *ServerInterfaceWrapper) UpdateLocationById(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLocationById(w, r, campId, id)
+		siw.Handler.UpdateSkillTrackById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2991,8 +10678,8 @@ func (siw *ServerInterfaceWrapper) UpdateLocationById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListPrograms operation middleware
-func (siw *ServerInterfaceWrapper) ListPrograms(w http.ResponseWriter, r *http.Request) {
+// ListStaffMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListStaffMembers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3006,7 +10693,7 @@ func (siw *ServerInterfaceWrapper) ListPrograms(w http.ResponseWriter, r *http.R
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListProgramsParams
+	var params ListStaffMembersParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -3057,7 +10744,7 @@ func (siw *ServerInterfaceWrapper) ListPrograms(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPrograms(w, r, campId, params)
+		siw.Handler.ListStaffMembers(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3067,8 +10754,8 @@ func (siw *ServerInterfaceWrapper) ListPrograms(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProgram operation middleware
-func (siw *ServerInterfaceWrapper) CreateProgram(w http.ResponseWriter, r *http.Request) {
+// CreateStaffMember operation middleware
+func (siw *ServerInterfaceWrapper) CreateStaffMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3082,7 +10769,7 @@ func (siw *ServerInterfaceWrapper) CreateProgram(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProgram(w, r, campId)
+		siw.Handler.CreateStaffMember(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3092,8 +10779,8 @@ func (siw *ServerInterfaceWrapper) CreateProgram(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteProgramById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProgramById(w http.ResponseWriter, r *http.Request) {
+// DeleteStaffMemberById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStaffMemberById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3115,19 +10802,42 @@ func (siw *ServerInterfaceWrapper) DeleteProgramById(w http.ResponseWriter, r *h
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteProgramByIdParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStaffMemberById(w, r, campId, id)
+	}))
 
-	// ------------- Optional query parameter "force" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	handler.ServeHTTP(w, r)
+}
+
+// GetStaffMemberById operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffMemberById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProgramById(w, r, campId, id, params)
+		siw.Handler.GetStaffMemberById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3137,8 +10847,8 @@ func (siw *ServerInterfaceWrapper) DeleteProgramById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetProgramById operation middleware
-func (siw *ServerInterfaceWrapper) GetProgramById(w http.ResponseWriter, r *http.Request) {
+// UpdateStaffMemberById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStaffMemberById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3161,7 +10871,7 @@ func (siw *ServerInterfaceWrapper) GetProgramById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProgramById(w, r, campId, id)
+		siw.Handler.UpdateStaffMemberById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3171,8 +10881,42 @@ func (siw *ServerInterfaceWrapper) GetProgramById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProgramById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProgramById(w http.ResponseWriter, r *http.Request) {
+// GetStaffMemberAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffMemberAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStaffMemberAvailability(w, r, campId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyStaffMemberCertification operation middleware
+func (siw *ServerInterfaceWrapper) VerifyStaffMemberCertification(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3195,7 +10939,7 @@ func (siw *ServerInterfaceWrapper) UpdateProgramById(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProgramById(w, r, campId, id)
+		siw.Handler.VerifyStaffMemberCertification(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3205,8 +10949,8 @@ func (siw *ServerInterfaceWrapper) UpdateProgramById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ListRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+// ClockInStaffMember operation middleware
+func (siw *ServerInterfaceWrapper) ClockInStaffMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3219,59 +10963,51 @@ func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRolesParams
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClockInStaffMember(w, r, campId, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "filterBy" -------------
+// ClockOutStaffMember operation middleware
+func (siw *ServerInterfaceWrapper) ClockOutStaffMember(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "sortBy" -------------
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRoles(w, r, campId, params)
+		siw.Handler.ClockOutStaffMember(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3281,8 +11017,8 @@ func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRole operation middleware
-func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Request) {
+// GetStaffMemberOnboarding operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffMemberOnboarding(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3295,8 +11031,17 @@ func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRole(w, r, campId)
+		siw.Handler.GetStaffMemberOnboarding(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3306,8 +11051,8 @@ func (siw *ServerInterfaceWrapper) CreateRole(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteRoleById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRoleById(w http.ResponseWriter, r *http.Request) {
+// UncompleteStaffMemberOnboardingItem operation middleware
+func (siw *ServerInterfaceWrapper) UncompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3329,8 +11074,17 @@ func (siw *ServerInterfaceWrapper) DeleteRoleById(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "item_id" -------------
+	var itemId OnboardingItemId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRoleById(w, r, campId, id)
+		siw.Handler.UncompleteStaffMemberOnboardingItem(w, r, campId, id, itemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3340,8 +11094,8 @@ func (siw *ServerInterfaceWrapper) DeleteRoleById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetRoleById operation middleware
-func (siw *ServerInterfaceWrapper) GetRoleById(w http.ResponseWriter, r *http.Request) {
+// CompleteStaffMemberOnboardingItem operation middleware
+func (siw *ServerInterfaceWrapper) CompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3363,8 +11117,17 @@ func (siw *ServerInterfaceWrapper) GetRoleById(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Path parameter "item_id" -------------
+	var itemId OnboardingItemId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", chi.URLParam(r, "item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRoleById(w, r, campId, id)
+		siw.Handler.CompleteStaffMemberOnboardingItem(w, r, campId, id, itemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3374,8 +11137,8 @@ func (siw *ServerInterfaceWrapper) GetRoleById(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateRoleById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRoleById(w http.ResponseWriter, r *http.Request) {
+// GetStaffMemberTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffMemberTimeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3397,8 +11160,27 @@ func (siw *ServerInterfaceWrapper) UpdateRoleById(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStaffMemberTimelineParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRoleById(w, r, campId, id)
+		siw.Handler.GetStaffMemberTimeline(w, r, campId, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3408,8 +11190,8 @@ func (siw *ServerInterfaceWrapper) UpdateRoleById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListSessions operation middleware
-func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
+// ListTimeBlocks operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeBlocks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3423,7 +11205,7 @@ func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.R
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSessionsParams
+	var params ListTimeBlocksParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -3474,7 +11256,7 @@ func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSessions(w, r, campId, params)
+		siw.Handler.ListTimeBlocks(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3484,8 +11266,8 @@ func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateSession operation middleware
-func (siw *ServerInterfaceWrapper) CreateSession(w http.ResponseWriter, r *http.Request) {
+// CreateTimeBlock operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeBlock(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3499,7 +11281,7 @@ func (siw *ServerInterfaceWrapper) CreateSession(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSession(w, r, campId)
+		siw.Handler.CreateTimeBlock(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3509,8 +11291,8 @@ func (siw *ServerInterfaceWrapper) CreateSession(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteSessionById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSessionById(w http.ResponseWriter, r *http.Request) {
+// DeleteTimeBlockById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeBlockById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3533,7 +11315,7 @@ func (siw *ServerInterfaceWrapper) DeleteSessionById(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSessionById(w, r, campId, id)
+		siw.Handler.DeleteTimeBlockById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3543,8 +11325,8 @@ func (siw *ServerInterfaceWrapper) DeleteSessionById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetSessionById operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionById(w http.ResponseWriter, r *http.Request) {
+// GetTimeBlockById operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeBlockById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3567,7 +11349,7 @@ func (siw *ServerInterfaceWrapper) GetSessionById(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSessionById(w, r, campId, id)
+		siw.Handler.GetTimeBlockById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3577,8 +11359,8 @@ func (siw *ServerInterfaceWrapper) GetSessionById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSessionById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSessionById(w http.ResponseWriter, r *http.Request) {
+// UpdateTimeBlockById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeBlockById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3601,7 +11383,7 @@ func (siw *ServerInterfaceWrapper) UpdateSessionById(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSessionById(w, r, campId, id)
+		siw.Handler.UpdateTimeBlockById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3611,8 +11393,8 @@ func (siw *ServerInterfaceWrapper) UpdateSessionById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// ListStaffMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListStaffMembers(w http.ResponseWriter, r *http.Request) {
+// ListTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3626,58 +11408,67 @@ func (siw *ServerInterfaceWrapper) ListStaffMembers(w http.ResponseWriter, r *ht
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListStaffMembersParams
+	var params ListTimeEntriesParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "staffMemberId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "staffMemberId", r.URL.Query(), &params.StaffMemberId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "staffMemberId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "filterBy" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeEntries(w, r, campId, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+// CreateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListStaffMembers(w, r, campId, params)
+		siw.Handler.CreateTimeEntry(w, r, campId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3687,8 +11478,8 @@ func (siw *ServerInterfaceWrapper) ListStaffMembers(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateStaffMember operation middleware
-func (siw *ServerInterfaceWrapper) CreateStaffMember(w http.ResponseWriter, r *http.Request) {
+// DeleteTimeEntryById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntryById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3701,8 +11492,17 @@ func (siw *ServerInterfaceWrapper) CreateStaffMember(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateStaffMember(w, r, campId)
+		siw.Handler.DeleteTimeEntryById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3712,8 +11512,8 @@ func (siw *ServerInterfaceWrapper) CreateStaffMember(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteStaffMemberById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStaffMemberById(w http.ResponseWriter, r *http.Request) {
+// GetTimeEntryById operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeEntryById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3736,7 +11536,7 @@ func (siw *ServerInterfaceWrapper) DeleteStaffMemberById(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStaffMemberById(w, r, campId, id)
+		siw.Handler.GetTimeEntryById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3746,8 +11546,8 @@ func (siw *ServerInterfaceWrapper) DeleteStaffMemberById(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetStaffMemberById operation middleware
-func (siw *ServerInterfaceWrapper) GetStaffMemberById(w http.ResponseWriter, r *http.Request) {
+// UpdateTimeEntryById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeEntryById(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3770,7 +11570,7 @@ func (siw *ServerInterfaceWrapper) GetStaffMemberById(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStaffMemberById(w, r, campId, id)
+		siw.Handler.UpdateTimeEntryById(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3780,8 +11580,8 @@ func (siw *ServerInterfaceWrapper) GetStaffMemberById(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateStaffMemberById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateStaffMemberById(w http.ResponseWriter, r *http.Request) {
+// ReviewTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) ReviewTimeEntry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3804,7 +11604,7 @@ func (siw *ServerInterfaceWrapper) UpdateStaffMemberById(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateStaffMemberById(w, r, campId, id)
+		siw.Handler.ReviewTimeEntry(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3814,8 +11614,8 @@ func (siw *ServerInterfaceWrapper) UpdateStaffMemberById(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListTimeBlocks operation middleware
-func (siw *ServerInterfaceWrapper) ListTimeBlocks(w http.ResponseWriter, r *http.Request) {
+// ListTimeOffRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeOffRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3829,58 +11629,60 @@ func (siw *ServerInterfaceWrapper) ListTimeBlocks(w http.ResponseWriter, r *http
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTimeBlocksParams
+	var params ListTimeOffRequestsParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "staffMemberId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "staffMemberId", r.URL.Query(), &params.StaffMemberId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "staffMemberId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeOffRequests(w, r, campId, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "filterBy" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "filterBy", r.URL.Query(), &params.FilterBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filterBy", Err: err})
-		return
-	}
+// ReviewTimeOffRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReviewTimeOffRequest(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "sortBy" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	// ------------- Path parameter "camp_id" -------------
+	var campId CampId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTimeBlocks(w, r, campId, params)
+		siw.Handler.ReviewTimeOffRequest(w, r, campId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3890,8 +11692,8 @@ func (siw *ServerInterfaceWrapper) ListTimeBlocks(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTimeBlock operation middleware
-func (siw *ServerInterfaceWrapper) CreateTimeBlock(w http.ResponseWriter, r *http.Request) {
+// GetTimesheets operation middleware
+func (siw *ServerInterfaceWrapper) GetTimesheets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3904,42 +11706,57 @@ func (siw *ServerInterfaceWrapper) CreateTimeBlock(w http.ResponseWriter, r *htt
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTimeBlock(w, r, campId)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimesheetsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-// DeleteTimeBlockById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTimeBlockById(w http.ResponseWriter, r *http.Request) {
+	// ------------- Required query parameter "to" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "camp_id" -------------
-	var campId CampId
+	// ------------- Optional query parameter "staffMemberId" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "staffMemberId", r.URL.Query(), &params.StaffMemberId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "staffMemberId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Optional query parameter "overtimeThreshold" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "overtimeThreshold", r.URL.Query(), &params.OvertimeThreshold)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overtimeThreshold", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTimeBlockById(w, r, campId, id)
+		siw.Handler.GetTimesheets(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3949,8 +11766,8 @@ func (siw *ServerInterfaceWrapper) DeleteTimeBlockById(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetTimeBlockById operation middleware
-func (siw *ServerInterfaceWrapper) GetTimeBlockById(w http.ResponseWriter, r *http.Request) {
+// ExportTimesheets operation middleware
+func (siw *ServerInterfaceWrapper) ExportTimesheets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3963,51 +11780,57 @@ func (siw *ServerInterfaceWrapper) GetTimeBlockById(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTimesheetsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTimeBlockById(w, r, campId, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Required query parameter "to" -------------
 
-// UpdateTimeBlockById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTimeBlockById(w http.ResponseWriter, r *http.Request) {
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
 
-	var err error
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
 
-	// ------------- Path parameter "camp_id" -------------
-	var campId CampId
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "camp_id", chi.URLParam(r, "camp_id"), &campId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "staffMemberId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "staffMemberId", r.URL.Query(), &params.StaffMemberId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "camp_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "staffMemberId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Optional query parameter "overtimeThreshold" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "overtimeThreshold", r.URL.Query(), &params.OvertimeThreshold)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overtimeThreshold", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTimeBlockById(w, r, campId, id)
+		siw.Handler.ExportTimesheets(w, r, campId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4217,125 +12040,365 @@ func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
 	return HandlerWithOptions(si, ChiServerOptions{
 		BaseRouter: r,
 	})
-}
-
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/attachments/download/{token}", wrapper.DownloadAttachment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/auth/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/auth/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/auth/me", wrapper.GetCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/auth/signup", wrapper.Signup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps", wrapper.GetCamps)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps", wrapper.CreateCamp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/activities", wrapper.ListActivities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/activities", wrapper.CreateActivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.DeleteActivityById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.GetActivityById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.UpdateActivityById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/activity-eligibility", wrapper.GetEligibilityReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/applications", wrapper.ListApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/applications", wrapper.CreateApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/applications/{id}", wrapper.DeleteApplicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/applications/{id}", wrapper.GetApplicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/applications/{id}", wrapper.UpdateApplicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/applications/{id}/transitions", wrapper.ListApplicationTransitions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/applications/{id}/transitions", wrapper.TransitionApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/areas", wrapper.ListAreas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/areas", wrapper.CreateArea)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.DeleteAreaById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.GetAreaById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.UpdateAreaById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/attachments", wrapper.ListAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/attachments", wrapper.UploadAttachment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/attachments/{id}", wrapper.DeleteAttachmentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/attachments/{id}", wrapper.GetAttachmentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/attachments/{id}/download-url", wrapper.CreateAttachmentDownloadUrl)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/attendance", wrapper.ListAttendanceRecords)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/attendance/check-in", wrapper.CheckInCamper)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/attendance/check-out", wrapper.CheckOutCamper)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/attendance/on-site", wrapper.GetOnSiteReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/attendance/{id}", wrapper.DeleteAttendanceRecord)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/bed-assignments", wrapper.ListBedAssignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/bed-assignments", wrapper.CreateBedAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/bed-assignments/{id}", wrapper.DeleteBedAssignmentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/bed-assignments/{id}", wrapper.UpdateBedAssignmentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/beds", wrapper.ListBeds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/beds", wrapper.CreateBed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/beds/{id}", wrapper.DeleteBedById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/beds/{id}", wrapper.GetBedById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/beds/{id}", wrapper.UpdateBedById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/bunk-requests", wrapper.ListBunkRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/bunk-requests", wrapper.CreateBunkRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/bunk-requests/{id}", wrapper.DeleteBunkRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/camper-merges", wrapper.ListCamperMerges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers", wrapper.ListCampers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/campers", wrapper.CreateCamper)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/duplicates", wrapper.ListCamperDuplicates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.DeleteCamperById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.GetCamperById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.UpdateCamperById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/contacts", wrapper.GetCamperContacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/enrollments", wrapper.ListCamperEnrollments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/enrollments", wrapper.CreateCamperEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id}", wrapper.DeleteCamperEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id}", wrapper.UpdateCamperEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/family", wrapper.GetCamperFamily)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/merge", wrapper.MergeCamper)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/skill-assessments", wrapper.CreateSkillAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/skill-assessments/{assessment_id}", wrapper.DeleteSkillAssessment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/skills", wrapper.GetCamperSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}/timeline", wrapper.GetCamperTimeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/certifications", wrapper.ListCertifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/certifications", wrapper.CreateCertification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/compliance", wrapper.GetCertificationCompliance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.DeleteCertificationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.GetCertificationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.UpdateCertificationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/clone", wrapper.CloneCamp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/clone-jobs", wrapper.ListCampCloneJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/clone-jobs/{id}", wrapper.GetCampCloneJobById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/colors", wrapper.ListColors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/colors", wrapper.CreateColor)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.DeleteColorById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.GetColorById)
 	})
-}
-
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
-
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/auth/login", wrapper.Login)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.UpdateColorById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/auth/logout", wrapper.Logout)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/custom-fields", wrapper.ListCustomFields)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/auth/me", wrapper.GetCurrentUser)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/custom-fields", wrapper.CreateCustomField)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/auth/signup", wrapper.Signup)
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/custom-fields/{id}", wrapper.DeleteCustomFieldById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps", wrapper.GetCamps)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/custom-fields/{id}", wrapper.GetCustomFieldById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps", wrapper.CreateCamp)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/custom-fields/{id}", wrapper.UpdateCustomFieldById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/activities", wrapper.ListActivities)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations", wrapper.ListDutyRotations)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/activities", wrapper.CreateActivity)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations", wrapper.CreateDutyRotation)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.DeleteActivityById)
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations/{id}", wrapper.DeleteDutyRotationById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.GetActivityById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations/{id}", wrapper.GetDutyRotationById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/activities/{id}", wrapper.UpdateActivityById)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations/{id}", wrapper.UpdateDutyRotationById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/areas", wrapper.ListAreas)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/duty-rotations/{id}/generate", wrapper.GenerateDutyRotationShifts)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/areas", wrapper.CreateArea)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts", wrapper.ListDutyShifts)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.DeleteAreaById)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts", wrapper.CreateDutyShift)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.GetAreaById)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts/auto-assign", wrapper.AutoAssignDutyShifts)
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/areas/{id}", wrapper.UpdateAreaById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts/conflicts", wrapper.GetDutyShiftConflicts)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers", wrapper.ListCampers)
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts/{id}", wrapper.DeleteDutyShiftById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/campers", wrapper.CreateCamper)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts/{id}", wrapper.GetDutyShiftById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.DeleteCamperById)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/duty-shifts/{id}", wrapper.UpdateDutyShiftById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.GetCamperById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-types", wrapper.ListDutyTypes)
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/campers/{id}", wrapper.UpdateCamperById)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/duty-types", wrapper.CreateDutyType)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/certifications", wrapper.ListCertifications)
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/duty-types/{id}", wrapper.DeleteDutyTypeById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/certifications", wrapper.CreateCertification)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/duty-types/{id}", wrapper.GetDutyTypeById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.DeleteCertificationById)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/duty-types/{id}", wrapper.UpdateDutyTypeById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.GetCertificationById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/equipment", wrapper.ListEquipmentItems)
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/certifications/{id}", wrapper.UpdateCertificationById)
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/equipment", wrapper.CreateEquipmentItem)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/colors", wrapper.ListColors)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/equipment-conflicts", wrapper.GetEquipmentConflicts)
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/colors", wrapper.CreateColor)
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}", wrapper.DeleteEquipmentItemById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.DeleteColorById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}", wrapper.GetEquipmentItemById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.GetColorById)
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}", wrapper.UpdateEquipmentItemById)
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/colors/{id}", wrapper.UpdateColorById)
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}/checkouts", wrapper.ListEquipmentCheckouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}/checkouts", wrapper.CheckOutEquipment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/equipment/{id}/checkouts/{checkout_id}/return", wrapper.ReturnEquipment)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/events", wrapper.ListEvents)
@@ -4352,6 +12415,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/events/{id}", wrapper.UpdateEventById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/events/{id}/eligibility", wrapper.GetEventEligibility)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/groups", wrapper.ListGroups)
 	})
@@ -4367,6 +12433,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/groups/{id}", wrapper.UpdateGroupById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/guardians", wrapper.ListGuardians)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/guardians", wrapper.CreateGuardian)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/guardians/{id}", wrapper.DeleteGuardianById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/guardians/{id}", wrapper.GetGuardianById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/guardians/{id}", wrapper.UpdateGuardianById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/housing-assignments", wrapper.ListHousingAssignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/housing-assignments", wrapper.CreateHousingAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/housing-assignments/{id}", wrapper.GetHousingAssignmentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/housing-assignments/{id}/accept", wrapper.AcceptHousingAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/housing-occupancy", wrapper.GetHousingOccupancy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/housing-rooms", wrapper.ListHousingRooms)
 	})
@@ -4397,6 +12493,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/imports/{job_id}", wrapper.GetImportJobById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/incidents", wrapper.ListIncidents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/incidents", wrapper.CreateIncident)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/report", wrapper.GetIncidentReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/{id}", wrapper.DeleteIncidentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/{id}", wrapper.GetIncidentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/{id}", wrapper.UpdateIncidentById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/{id}/review", wrapper.ReviewIncident)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/incidents/{id}/submit", wrapper.SubmitIncident)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/locations", wrapper.ListLocations)
 	})
@@ -4412,6 +12532,147 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/locations/{id}", wrapper.UpdateLocationById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/maintenance-tickets", wrapper.ListMaintenanceTickets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/maintenance-tickets", wrapper.CreateMaintenanceTicket)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/maintenance-tickets/{id}", wrapper.DeleteMaintenanceTicketById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/maintenance-tickets/{id}", wrapper.GetMaintenanceTicketById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/maintenance-tickets/{id}", wrapper.UpdateMaintenanceTicketById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/mar/doses", wrapper.RecordMedicationDose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/mar/doses/{id}", wrapper.UpdateMedicationDose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/mar/due-doses", wrapper.ListDueDoses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/mar/report", wrapper.GetMarReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/availability", wrapper.GetMyAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/me/availability", wrapper.UpdateMyAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/campers", wrapper.ListMyCampers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/groups", wrapper.ListMyGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/onboarding", wrapper.GetMyOnboarding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/schedule", wrapper.GetMySchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/staff-member", wrapper.GetMyStaffMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/me/time-off-requests", wrapper.ListMyTimeOffRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/me/time-off-requests", wrapper.CreateMyTimeOffRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/me/time-off-requests/{id}", wrapper.CancelMyTimeOffRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/meal-periods", wrapper.ListMealPeriods)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/meal-periods", wrapper.CreateMealPeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/meal-periods/{id}", wrapper.DeleteMealPeriodById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/meal-periods/{id}", wrapper.GetMealPeriodById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/meal-periods/{id}", wrapper.UpdateMealPeriodById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/meals/headcounts", wrapper.GetMealHeadcounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/medications", wrapper.ListMedications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/medications", wrapper.CreateMedication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/medications/{id}", wrapper.DeleteMedicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/medications/{id}", wrapper.GetMedicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/medications/{id}", wrapper.UpdateMedicationById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/menus", wrapper.ListMenus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/menus", wrapper.CreateMenu)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/menus/{id}", wrapper.DeleteMenuById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/menus/{id}", wrapper.GetMenuById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/menus/{id}", wrapper.UpdateMenuById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/notes", wrapper.ListNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/notes", wrapper.CreateNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/notes/{id}", wrapper.DeleteNoteById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/notes/{id}", wrapper.GetNoteById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/notes/{id}", wrapper.UpdateNoteById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-report", wrapper.GetOnboardingReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-templates", wrapper.ListOnboardingTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-templates", wrapper.CreateOnboardingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-templates/{id}", wrapper.DeleteOnboardingTemplateById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-templates/{id}", wrapper.GetOnboardingTemplateById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/onboarding-templates/{id}", wrapper.UpdateOnboardingTemplateById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/out-of-service", wrapper.ListOutOfServicePeriods)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/programs", wrapper.ListPrograms)
 	})
@@ -4427,6 +12688,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/programs/{id}", wrapper.UpdateProgramById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies", wrapper.ListRatioPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies", wrapper.CreateRatioPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies/compliance", wrapper.GetRatioCompliance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies/{id}", wrapper.DeleteRatioPolicyById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies/{id}", wrapper.GetRatioPolicyById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/ratio-policies/{id}", wrapper.UpdateRatioPolicyById)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/roles", wrapper.ListRoles)
 	})
@@ -4457,6 +12736,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/sessions/{id}", wrapper.UpdateSessionById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/skill-tracks", wrapper.ListSkillTracks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/skill-tracks", wrapper.CreateSkillTrack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/skill-tracks/{id}", wrapper.DeleteSkillTrackById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/skill-tracks/{id}", wrapper.GetSkillTrackById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/skill-tracks/{id}", wrapper.UpdateSkillTrackById)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members", wrapper.ListStaffMembers)
 	})
@@ -4472,6 +12766,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}", wrapper.UpdateStaffMemberById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/availability", wrapper.GetStaffMemberAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/certifications/verify", wrapper.VerifyStaffMemberCertification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/clock-in", wrapper.ClockInStaffMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/clock-out", wrapper.ClockOutStaffMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/onboarding", wrapper.GetStaffMemberOnboarding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id}", wrapper.UncompleteStaffMemberOnboardingItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/onboarding/items/{item_id}", wrapper.CompleteStaffMemberOnboardingItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/staff-members/{id}/timeline", wrapper.GetStaffMemberTimeline)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/time-blocks", wrapper.ListTimeBlocks)
 	})
@@ -4487,6 +12805,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/time-blocks/{id}", wrapper.UpdateTimeBlockById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries", wrapper.ListTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries", wrapper.CreateTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries/{id}", wrapper.DeleteTimeEntryById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries/{id}", wrapper.GetTimeEntryById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries/{id}", wrapper.UpdateTimeEntryById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/time-entries/{id}/review", wrapper.ReviewTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/time-off-requests", wrapper.ListTimeOffRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/camps/{camp_id}/time-off-requests/{id}/review", wrapper.ReviewTimeOffRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/timesheets", wrapper.GetTimesheets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/camps/{camp_id}/timesheets/export", wrapper.ExportTimesheets)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/camps/{id}", wrapper.DeleteCampById)
 	})
@@ -12,16 +12,202 @@ import (
 // Defines values for AccessRuleRole.
 const (
 	AccessRuleRoleAdmin        AccessRuleRole = "admin"
+	AccessRuleRoleHealth       AccessRuleRole = "health"
 	AccessRuleRoleProgramAdmin AccessRuleRole = "program-admin"
+	AccessRuleRoleStaff        AccessRuleRole = "staff"
 	AccessRuleRoleViewer       AccessRuleRole = "viewer"
 )
 
+// Defines values for Allergen.
+const (
+	AllergenEggs      Allergen = "eggs"
+	AllergenFish      Allergen = "fish"
+	AllergenGluten    Allergen = "gluten"
+	AllergenMilk      Allergen = "milk"
+	AllergenPeanuts   Allergen = "peanuts"
+	AllergenSesame    Allergen = "sesame"
+	AllergenShellfish Allergen = "shellfish"
+	AllergenSoy       Allergen = "soy"
+	AllergenTreeNuts  Allergen = "tree_nuts"
+	AllergenWheat     Allergen = "wheat"
+)
+
+// Defines values for ApplicationStatus.
+const (
+	ApplicationStatusAccepted   ApplicationStatus = "accepted"
+	ApplicationStatusCancelled  ApplicationStatus = "cancelled"
+	ApplicationStatusDraft      ApplicationStatus = "draft"
+	ApplicationStatusEnrolled   ApplicationStatus = "enrolled"
+	ApplicationStatusSubmitted  ApplicationStatus = "submitted"
+	ApplicationStatusWaitlisted ApplicationStatus = "waitlisted"
+	ApplicationStatusWithdrawn  ApplicationStatus = "withdrawn"
+)
+
+// Defines values for AttachmentCategory.
+const (
+	AttachmentCategoryCertificate AttachmentCategory = "certificate"
+	AttachmentCategoryMedicalForm AttachmentCategory = "medical_form"
+	AttachmentCategoryOther       AttachmentCategory = "other"
+	AttachmentCategoryPhoto       AttachmentCategory = "photo"
+	AttachmentCategoryWaiver      AttachmentCategory = "waiver"
+)
+
+// Defines values for AttachmentEntityType.
+const (
+	AttachmentEntityTypeCamper            AttachmentEntityType = "camper"
+	AttachmentEntityTypeCertification     AttachmentEntityType = "certification"
+	AttachmentEntityTypeIncident          AttachmentEntityType = "incident"
+	AttachmentEntityTypeMaintenanceTicket AttachmentEntityType = "maintenance_ticket"
+	AttachmentEntityTypeStaffMember       AttachmentEntityType = "staff_member"
+)
+
+// Defines values for AttachmentScanStatus.
+const (
+	AttachmentScanStatusClean      AttachmentScanStatus = "clean"
+	AttachmentScanStatusNotScanned AttachmentScanStatus = "not_scanned"
+)
+
+// Defines values for AttendanceMethod.
+const (
+	AttendanceMethodBus      AttendanceMethod = "bus"
+	AttendanceMethodCarpool  AttendanceMethod = "carpool"
+	AttendanceMethodInPerson AttendanceMethod = "in_person"
+	AttendanceMethodOther    AttendanceMethod = "other"
+	AttendanceMethodWalk     AttendanceMethod = "walk"
+)
+
+// Defines values for AttendanceType.
+const (
+	AttendanceTypeCheckIn  AttendanceType = "check_in"
+	AttendanceTypeCheckOut AttendanceType = "check_out"
+)
+
+// Defines values for BedBunkPosition.
+const (
+	BedBunkPositionBottom BedBunkPosition = "bottom"
+	BedBunkPositionNone   BedBunkPosition = "none"
+	BedBunkPositionTop    BedBunkPosition = "top"
+)
+
+// Defines values for CampCloneJobStatus.
+const (
+	CampCloneJobStatusCompleted CampCloneJobStatus = "completed"
+	CampCloneJobStatusFailed    CampCloneJobStatus = "failed"
+	CampCloneJobStatusPending   CampCloneJobStatus = "pending"
+	CampCloneJobStatusRunning   CampCloneJobStatus = "running"
+)
+
+// Defines values for CamperDuplicateReason.
+const (
+	CamperDuplicateReasonSameBirthday   CamperDuplicateReason = "same_birthday"
+	CamperDuplicateReasonSameName       CamperDuplicateReason = "same_name"
+	CamperDuplicateReasonSharedGuardian CamperDuplicateReason = "shared_guardian"
+	CamperDuplicateReasonSimilarName    CamperDuplicateReason = "similar_name"
+)
+
+// Defines values for CamperEnrollmentStatus.
+const (
+	CamperEnrollmentStatusCancelled CamperEnrollmentStatus = "cancelled"
+	CamperEnrollmentStatusCompleted CamperEnrollmentStatus = "completed"
+	CamperEnrollmentStatusEnrolled  CamperEnrollmentStatus = "enrolled"
+)
+
+// Defines values for CertificationComplianceIssueType.
+const (
+	CertificationComplianceIssueTypeExpired              CertificationComplianceIssueType = "expired"
+	CertificationComplianceIssueTypeExpiring             CertificationComplianceIssueType = "expiring"
+	CertificationComplianceIssueTypeMissingCertification CertificationComplianceIssueType = "missing_certification"
+)
+
+// Defines values for CustomFieldEntityType.
+const (
+	CustomFieldEntityTypeCamper      CustomFieldEntityType = "camper"
+	CustomFieldEntityTypeGroup       CustomFieldEntityType = "group"
+	CustomFieldEntityTypeStaffMember CustomFieldEntityType = "staff_member"
+)
+
+// Defines values for CustomFieldType.
+const (
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+	CustomFieldTypeDate    CustomFieldType = "date"
+	CustomFieldTypeEnum    CustomFieldType = "enum"
+	CustomFieldTypeNumber  CustomFieldType = "number"
+	CustomFieldTypeText    CustomFieldType = "text"
+)
+
+// Defines values for DietaryRestriction.
+const (
+	DietaryRestrictionDairyFree  DietaryRestriction = "dairy_free"
+	DietaryRestrictionGlutenFree DietaryRestriction = "gluten_free"
+	DietaryRestrictionHalal      DietaryRestriction = "halal"
+	DietaryRestrictionKosher     DietaryRestriction = "kosher"
+	DietaryRestrictionNutFree    DietaryRestriction = "nut_free"
+	DietaryRestrictionVegan      DietaryRestriction = "vegan"
+	DietaryRestrictionVegetarian DietaryRestriction = "vegetarian"
+)
+
+// Defines values for DueDoseStatus.
+const (
+	DueDoseStatusGiven   DueDoseStatus = "given"
+	DueDoseStatusMissed  DueDoseStatus = "missed"
+	DueDoseStatusPending DueDoseStatus = "pending"
+	DueDoseStatusRefused DueDoseStatus = "refused"
+)
+
+// Defines values for DutyConflictType.
+const (
+	DutyConflictTypeDoubleBooked         DutyConflictType = "double_booked"
+	DutyConflictTypeEventOverlap         DutyConflictType = "event_overlap"
+	DutyConflictTypeInsufficientRest     DutyConflictType = "insufficient_rest"
+	DutyConflictTypeMissingCertification DutyConflictType = "missing_certification"
+	DutyConflictTypeTimeOff              DutyConflictType = "time_off"
+	DutyConflictTypeUnderstaffed         DutyConflictType = "understaffed"
+)
+
+// Defines values for EquipmentCondition.
+const (
+	EquipmentConditionFair        EquipmentCondition = "fair"
+	EquipmentConditionGood        EquipmentCondition = "good"
+	EquipmentConditionNeedsRepair EquipmentCondition = "needs_repair"
+	EquipmentConditionRetired     EquipmentCondition = "retired"
+)
+
 // Defines values for Gender.
 const (
 	GenderFemale Gender = "female"
 	GenderMale   Gender = "male"
 )
 
+// Defines values for GuardianNotificationStatus.
+const (
+	GuardianNotificationStatusNotRequired GuardianNotificationStatus = "not_required"
+	GuardianNotificationStatusNotified    GuardianNotificationStatus = "notified"
+	GuardianNotificationStatusPending     GuardianNotificationStatus = "pending"
+)
+
+// Defines values for GuardianPhoneType.
+const (
+	GuardianPhoneTypeHome   GuardianPhoneType = "home"
+	GuardianPhoneTypeMobile GuardianPhoneType = "mobile"
+	GuardianPhoneTypeOther  GuardianPhoneType = "other"
+	GuardianPhoneTypeWork   GuardianPhoneType = "work"
+)
+
+// Defines values for HousingAssignmentStatus.
+const (
+	HousingAssignmentStatusAccepted   HousingAssignmentStatus = "accepted"
+	HousingAssignmentStatusProposed   HousingAssignmentStatus = "proposed"
+	HousingAssignmentStatusSuperseded HousingAssignmentStatus = "superseded"
+)
+
+// Defines values for HousingRoomGenderPolicy.
+const (
+	HousingRoomGenderPolicyFemale       HousingRoomGenderPolicy = "female"
+	HousingRoomGenderPolicyMale         HousingRoomGenderPolicy = "male"
+	HousingRoomGenderPolicyMixed        HousingRoomGenderPolicy = "mixed"
+	HousingRoomGenderPolicySingleGender HousingRoomGenderPolicy = "single_gender"
+)
+
 // Defines values for HousingRoomSpecBathroom.
 const (
 	HousingRoomSpecBathroomPrivate HousingRoomSpecBathroom = "private"
@@ -51,6 +237,121 @@ const (
 	ImportModeUpsert ImportMode = "upsert"
 )
 
+// Defines values for IncidentReportGrouping.
+const (
+	IncidentReportGroupingActivity IncidentReportGrouping = "activity"
+	IncidentReportGroupingLocation IncidentReportGrouping = "location"
+	IncidentReportGroupingWeek     IncidentReportGrouping = "week"
+)
+
+// Defines values for IncidentReviewRequestStatus.
+const (
+	IncidentReviewRequestStatusApproved IncidentReviewRequestStatus = "approved"
+	IncidentReviewRequestStatusReturned IncidentReviewRequestStatus = "returned"
+)
+
+// Defines values for IncidentSeverity.
+const (
+	IncidentSeverityCritical IncidentSeverity = "critical"
+	IncidentSeverityMinor    IncidentSeverity = "minor"
+	IncidentSeverityModerate IncidentSeverity = "moderate"
+	IncidentSeveritySerious  IncidentSeverity = "serious"
+)
+
+// Defines values for IncidentStatus.
+const (
+	IncidentStatusApproved  IncidentStatus = "approved"
+	IncidentStatusDraft     IncidentStatus = "draft"
+	IncidentStatusReturned  IncidentStatus = "returned"
+	IncidentStatusSubmitted IncidentStatus = "submitted"
+)
+
+// Defines values for IncidentType.
+const (
+	IncidentTypeBehavioral     IncidentType = "behavioral"
+	IncidentTypeIllness        IncidentType = "illness"
+	IncidentTypeInjury         IncidentType = "injury"
+	IncidentTypeNearMiss       IncidentType = "near_miss"
+	IncidentTypeOther          IncidentType = "other"
+	IncidentTypePropertyDamage IncidentType = "property_damage"
+)
+
+// Defines values for MaintenanceTicketEntityType.
+const (
+	MaintenanceTicketEntityTypeArea        MaintenanceTicketEntityType = "area"
+	MaintenanceTicketEntityTypeHousingRoom MaintenanceTicketEntityType = "housing_room"
+	MaintenanceTicketEntityTypeLocation    MaintenanceTicketEntityType = "location"
+)
+
+// Defines values for MaintenanceTicketPriority.
+const (
+	MaintenanceTicketPriorityHigh   MaintenanceTicketPriority = "high"
+	MaintenanceTicketPriorityLow    MaintenanceTicketPriority = "low"
+	MaintenanceTicketPriorityMedium MaintenanceTicketPriority = "medium"
+	MaintenanceTicketPriorityUrgent MaintenanceTicketPriority = "urgent"
+)
+
+// Defines values for MaintenanceTicketStatus.
+const (
+	MaintenanceTicketStatusClosed     MaintenanceTicketStatus = "closed"
+	MaintenanceTicketStatusInProgress MaintenanceTicketStatus = "in_progress"
+	MaintenanceTicketStatusOpen       MaintenanceTicketStatus = "open"
+	MaintenanceTicketStatusResolved   MaintenanceTicketStatus = "resolved"
+)
+
+// Defines values for MealHeadcountBasis.
+const (
+	MealHeadcountBasisAttendance MealHeadcountBasis = "attendance"
+	MealHeadcountBasisEnrollment MealHeadcountBasis = "enrollment"
+)
+
+// Defines values for MedicationDoseStatus.
+const (
+	MedicationDoseStatusGiven   MedicationDoseStatus = "given"
+	MedicationDoseStatusMissed  MedicationDoseStatus = "missed"
+	MedicationDoseStatusRefused MedicationDoseStatus = "refused"
+)
+
+// Defines values for MedicationSpecDaysOfWeek.
+const (
+	MedicationSpecDaysOfWeekFriday    MedicationSpecDaysOfWeek = "friday"
+	MedicationSpecDaysOfWeekMonday    MedicationSpecDaysOfWeek = "monday"
+	MedicationSpecDaysOfWeekSaturday  MedicationSpecDaysOfWeek = "saturday"
+	MedicationSpecDaysOfWeekSunday    MedicationSpecDaysOfWeek = "sunday"
+	MedicationSpecDaysOfWeekThursday  MedicationSpecDaysOfWeek = "thursday"
+	MedicationSpecDaysOfWeekTuesday   MedicationSpecDaysOfWeek = "tuesday"
+	MedicationSpecDaysOfWeekWednesday MedicationSpecDaysOfWeek = "wednesday"
+)
+
+// Defines values for NoteCategory.
+const (
+	NoteCategoryAchievement NoteCategory = "achievement"
+	NoteCategoryBehavior    NoteCategory = "behavior"
+	NoteCategoryFamily      NoteCategory = "family"
+	NoteCategoryHealth      NoteCategory = "health"
+	NoteCategoryObservation NoteCategory = "observation"
+	NoteCategoryOther       NoteCategory = "other"
+)
+
+// Defines values for NoteEntityType.
+const (
+	NoteEntityTypeCamper      NoteEntityType = "camper"
+	NoteEntityTypeStaffMember NoteEntityType = "staff_member"
+)
+
+// Defines values for NoteVisibility.
+const (
+	NoteVisibilityAdmins   NoteVisibility = "admins"
+	NoteVisibilityAllStaff NoteVisibility = "all_staff"
+	NoteVisibilityHealth   NoteVisibility = "health"
+)
+
+// Defines values for RatioViolationType.
+const (
+	RatioViolationTypeEvent     RatioViolationType = "event"
+	RatioViolationTypeOvernight RatioViolationType = "overnight"
+)
+
 // Defines values for RecurrenceRuleEndType.
 const (
 	RecurrenceRuleEndTypeAfter RecurrenceRuleEndType = "after"
@@ -65,6 +366,13 @@ const (
 	RecurrenceRuleFrequencyWeekly  RecurrenceRuleFrequency = "weekly"
 )
 
+// Defines values for ScheduleItemType.
+const (
+	ScheduleItemTypeDuty    ScheduleItemType = "duty"
+	ScheduleItemTypeEvent   ScheduleItemType = "event"
+	ScheduleItemTypeTimeOff ScheduleItemType = "time_off"
+)
+
 // Defines values for ScopeType.
 const (
 	ScopeTypeCamp   ScopeType = "camp"
@@ -83,23 +391,48 @@ const (
 	TimeBlockSpecDaysOfWeekWednesday TimeBlockSpecDaysOfWeek = "wednesday"
 )
 
+// Defines values for TimeEntryStatus.
+const (
+	TimeEntryStatusApproved TimeEntryStatus = "approved"
+	TimeEntryStatusPending  TimeEntryStatus = "pending"
+	TimeEntryStatusRejected TimeEntryStatus = "rejected"
+)
+
+// Defines values for TimeOffRequestStatus.
+const (
+	TimeOffRequestStatusApproved TimeOffRequestStatus = "approved"
+	TimeOffRequestStatusPending  TimeOffRequestStatus = "pending"
+	TimeOffRequestStatusRejected TimeOffRequestStatus = "rejected"
+)
+
+// Defines values for TimelineEntryType.
+const (
+	TimelineEntryTypeCheckIn     TimelineEntryType = "check_in"
+	TimelineEntryTypeCheckOut    TimelineEntryType = "check_out"
+	TimelineEntryTypeGroupJoined TimelineEntryType = "group_joined"
+	TimelineEntryTypeGroupLeft   TimelineEntryType = "group_left"
+	TimelineEntryTypeIncident    TimelineEntryType = "incident"
+	TimelineEntryTypeNote        TimelineEntryType = "note"
+)
+
 // Defines values for ActivitiesSortBy.
 const (
 	ActivitiesSortByName ActivitiesSortBy = "name"
 )
 
-// Defines values for AreasSortBy.
+// Defines values for ApplicationsSortBy.
 const (
-	AreasSortByName AreasSortBy = "name"
+	ApplicationsSortByBirthday         ApplicationsSortBy = "birthday"
+	ApplicationsSortByName             ApplicationsSortBy = "name"
+	ApplicationsSortBySessionId        ApplicationsSortBy = "sessionId"
+	ApplicationsSortByStatus           ApplicationsSortBy = "status"
+	ApplicationsSortBySubmittedAt      ApplicationsSortBy = "submittedAt"
+	ApplicationsSortByWaitlistPosition ApplicationsSortBy = "waitlistPosition"
 )
 
-// Defines values for CampersSortBy.
+// Defines values for AreasSortBy.
 const (
-	CampersSortByBirthday       CampersSortBy = "birthday"
-	CampersSortByGender         CampersSortBy = "gender"
-	CampersSortByHousingGroupId CampersSortBy = "housingGroupId"
-	CampersSortByName           CampersSortBy = "name"
-	CampersSortBySessionId      CampersSortBy = "sessionId"
+	AreasSortByName AreasSortBy = "name"
 )
 
 // Defines values for CampsSortBy.
@@ -125,11 +458,12 @@ const (
 	EventsSortByStartDate EventsSortBy = "startDate"
 )
 
-// Defines values for GroupsSortBy.
+// Defines values for GuardiansSortBy.
 const (
-	GroupsSortByHousingRoomId GroupsSortBy = "housingRoomId"
-	GroupsSortByName          GroupsSortBy = "name"
-	GroupsSortBySessionId     GroupsSortBy = "sessionId"
+	GuardiansSortByEmail             GuardiansSortBy = "email"
+	GuardiansSortByEmergencyPriority GuardiansSortBy = "emergencyPriority"
+	GuardiansSortByName              GuardiansSortBy = "name"
+	GuardiansSortByRelationship      GuardiansSortBy = "relationship"
 )
 
 // Defines values for HousingRoomsSortBy.
@@ -140,6 +474,16 @@ const (
 	HousingRoomsSortByName     HousingRoomsSortBy = "name"
 )
 
+// Defines values for IncidentsSortBy.
+const (
+	IncidentsSortByGuardianNotificationStatus IncidentsSortBy = "guardianNotificationStatus"
+	IncidentsSortByName                       IncidentsSortBy = "name"
+	IncidentsSortByOccurredAt                 IncidentsSortBy = "occurredAt"
+	IncidentsSortBySeverity                   IncidentsSortBy = "severity"
+	IncidentsSortByStatus                     IncidentsSortBy = "status"
+	IncidentsSortByType                       IncidentsSortBy = "type"
+)
+
 // Defines values for LocationsSortBy.
 const (
 	LocationsSortByAreaId   LocationsSortBy = "areaId"
@@ -147,6 +491,15 @@ const (
 	LocationsSortByName     LocationsSortBy = "name"
 )
 
+// Defines values for MedicationsSortBy.
+const (
+	MedicationsSortByCamperId  MedicationsSortBy = "camperId"
+	MedicationsSortByEndDate   MedicationsSortBy = "endDate"
+	MedicationsSortByName      MedicationsSortBy = "name"
+	MedicationsSortByRoute     MedicationsSortBy = "route"
+	MedicationsSortByStartDate MedicationsSortBy = "startDate"
+)
+
 // Defines values for ProgramsSortBy.
 const (
 	ProgramsSortByName ProgramsSortBy = "name"
@@ -164,15 +517,6 @@ const (
 	SessionsSortByStartDate SessionsSortBy = "startDate"
 )
 
-// Defines values for StaffMembersSortBy.
-const (
-	StaffMembersSortByCertificationId StaffMembersSortBy = "certificationId"
-	StaffMembersSortByGender          StaffMembersSortBy = "gender"
-	StaffMembersSortByName            StaffMembersSortBy = "name"
-	StaffMembersSortByPhone           StaffMembersSortBy = "phone"
-	StaffMembersSortByRoleId          StaffMembersSortBy = "roleId"
-)
-
 // Defines values for TimeBlocksSortBy.
 const (
 	TimeBlocksSortByEndTime   TimeBlocksSortBy = "endTime"
@@ -221,6 +565,22 @@ const (
 	ListActivitiesParamsSortOrderDesc ListActivitiesParamsSortOrder = "desc"
 )
 
+// Defines values for ListApplicationsParamsSortBy.
+const (
+	ListApplicationsParamsSortByBirthday         ListApplicationsParamsSortBy = "birthday"
+	ListApplicationsParamsSortByName             ListApplicationsParamsSortBy = "name"
+	ListApplicationsParamsSortBySessionId        ListApplicationsParamsSortBy = "sessionId"
+	ListApplicationsParamsSortByStatus           ListApplicationsParamsSortBy = "status"
+	ListApplicationsParamsSortBySubmittedAt      ListApplicationsParamsSortBy = "submittedAt"
+	ListApplicationsParamsSortByWaitlistPosition ListApplicationsParamsSortBy = "waitlistPosition"
+)
+
+// Defines values for ListApplicationsParamsSortOrder.
+const (
+	ListApplicationsParamsSortOrderAsc  ListApplicationsParamsSortOrder = "asc"
+	ListApplicationsParamsSortOrderDesc ListApplicationsParamsSortOrder = "desc"
+)
+
 // Defines values for ListAreasParamsSortBy.
 const (
 	ListAreasParamsSortByName ListAreasParamsSortBy = "name"
@@ -232,15 +592,6 @@ const (
 	ListAreasParamsSortOrderDesc ListAreasParamsSortOrder = "desc"
 )
 
-// Defines values for ListCampersParamsSortBy.
-const (
-	ListCampersParamsSortByBirthday       ListCampersParamsSortBy = "birthday"
-	ListCampersParamsSortByGender         ListCampersParamsSortBy = "gender"
-	ListCampersParamsSortByHousingGroupId ListCampersParamsSortBy = "housingGroupId"
-	ListCampersParamsSortByName           ListCampersParamsSortBy = "name"
-	ListCampersParamsSortBySessionId      ListCampersParamsSortBy = "sessionId"
-)
-
 // Defines values for ListCampersParamsSortOrder.
 const (
 	ListCampersParamsSortOrderAsc  ListCampersParamsSortOrder = "asc"
@@ -297,19 +648,26 @@ const (
 	UpdateEventByIdParamsUpdateScopeSingle UpdateEventByIdParamsUpdateScope = "single"
 )
 
-// Defines values for ListGroupsParamsSortBy.
-const (
-	ListGroupsParamsSortByHousingRoomId ListGroupsParamsSortBy = "housingRoomId"
-	ListGroupsParamsSortByName          ListGroupsParamsSortBy = "name"
-	ListGroupsParamsSortBySessionId     ListGroupsParamsSortBy = "sessionId"
-)
-
 // Defines values for ListGroupsParamsSortOrder.
 const (
 	ListGroupsParamsSortOrderAsc  ListGroupsParamsSortOrder = "asc"
 	ListGroupsParamsSortOrderDesc ListGroupsParamsSortOrder = "desc"
 )
 
+// Defines values for ListGuardiansParamsSortBy.
+const (
+	ListGuardiansParamsSortByEmail             ListGuardiansParamsSortBy = "email"
+	ListGuardiansParamsSortByEmergencyPriority ListGuardiansParamsSortBy = "emergencyPriority"
+	ListGuardiansParamsSortByName              ListGuardiansParamsSortBy = "name"
+	ListGuardiansParamsSortByRelationship      ListGuardiansParamsSortBy = "relationship"
+)
+
+// Defines values for ListGuardiansParamsSortOrder.
+const (
+	ListGuardiansParamsSortOrderAsc  ListGuardiansParamsSortOrder = "asc"
+	ListGuardiansParamsSortOrderDesc ListGuardiansParamsSortOrder = "desc"
+)
+
 // Defines values for ListHousingRoomsParamsSortBy.
 const (
 	ListHousingRoomsParamsSortByAreaId   ListHousingRoomsParamsSortBy = "areaId"
@@ -324,6 +682,22 @@ const (
 	ListHousingRoomsParamsSortOrderDesc ListHousingRoomsParamsSortOrder = "desc"
 )
 
+// Defines values for ListIncidentsParamsSortBy.
+const (
+	ListIncidentsParamsSortByGuardianNotificationStatus ListIncidentsParamsSortBy = "guardianNotificationStatus"
+	ListIncidentsParamsSortByName                       ListIncidentsParamsSortBy = "name"
+	ListIncidentsParamsSortByOccurredAt                 ListIncidentsParamsSortBy = "occurredAt"
+	ListIncidentsParamsSortBySeverity                   ListIncidentsParamsSortBy = "severity"
+	ListIncidentsParamsSortByStatus                     ListIncidentsParamsSortBy = "status"
+	ListIncidentsParamsSortByType                       ListIncidentsParamsSortBy = "type"
+)
+
+// Defines values for ListIncidentsParamsSortOrder.
+const (
+	ListIncidentsParamsSortOrderAsc  ListIncidentsParamsSortOrder = "asc"
+	ListIncidentsParamsSortOrderDesc ListIncidentsParamsSortOrder = "desc"
+)
+
 // Defines values for ListLocationsParamsSortBy.
 const (
 	ListLocationsParamsSortByAreaId   ListLocationsParamsSortBy = "areaId"
@@ -337,6 +711,21 @@ const (
 	ListLocationsParamsSortOrderDesc ListLocationsParamsSortOrder = "desc"
 )
 
+// Defines values for ListMedicationsParamsSortBy.
+const (
+	ListMedicationsParamsSortByCamperId  ListMedicationsParamsSortBy = "camperId"
+	ListMedicationsParamsSortByEndDate   ListMedicationsParamsSortBy = "endDate"
+	ListMedicationsParamsSortByName      ListMedicationsParamsSortBy = "name"
+	ListMedicationsParamsSortByRoute     ListMedicationsParamsSortBy = "route"
+	ListMedicationsParamsSortByStartDate ListMedicationsParamsSortBy = "startDate"
+)
+
+// Defines values for ListMedicationsParamsSortOrder.
+const (
+	ListMedicationsParamsSortOrderAsc  ListMedicationsParamsSortOrder = "asc"
+	ListMedicationsParamsSortOrderDesc ListMedicationsParamsSortOrder = "desc"
+)
+
 // Defines values for ListProgramsParamsSortBy.
 const (
 	ListProgramsParamsSortByName ListProgramsParamsSortBy = "name"
@@ -372,15 +761,6 @@ const (
 	ListSessionsParamsSortOrderDesc ListSessionsParamsSortOrder = "desc"
 )
 
-// Defines values for ListStaffMembersParamsSortBy.
-const (
-	ListStaffMembersParamsSortByCertificationId ListStaffMembersParamsSortBy = "certificationId"
-	ListStaffMembersParamsSortByGender          ListStaffMembersParamsSortBy = "gender"
-	ListStaffMembersParamsSortByName            ListStaffMembersParamsSortBy = "name"
-	ListStaffMembersParamsSortByPhone           ListStaffMembersParamsSortBy = "phone"
-	ListStaffMembersParamsSortByRoleId          ListStaffMembersParamsSortBy = "roleId"
-)
-
 // Defines values for ListStaffMembersParamsSortOrder.
 const (
 	ListStaffMembersParamsSortOrderAsc  ListStaffMembersParamsSortOrder = "asc"
@@ -454,6 +834,31 @@ type ActivityCreationRequest struct {
 	Spec ActivitySpec              `json:"spec"`
 }
 
+// ActivityEligibility Which campers may take part in an activity. Every rule that is set must be met.
+type ActivityEligibility struct {
+	// Genders Genders allowed; any gender when empty
+	Genders *[]string `json:"genders,omitempty"`
+
+	// MaxAge Oldest age allowed, on the day of the event
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge Youngest age allowed, on the day of the event
+	MinAge *int `json:"minAge,omitempty"`
+
+	// PrerequisiteActivityIds Activities campers must have taken part in, in an event that ended before the event starts
+	PrerequisiteActivityIds *[]openapi_types.UUID            `json:"prerequisiteActivityIds,omitempty"`
+	RequiredSkillLevels     *[]ActivitySkillLevelRequirement `json:"requiredSkillLevels,omitempty"`
+	RequiredSkills          *[]ActivitySkillRequirement      `json:"requiredSkills,omitempty"`
+}
+
+// ActivityEquipmentNeed defines model for ActivityEquipmentNeed.
+type ActivityEquipmentNeed struct {
+	EquipmentItemId openapi_types.UUID `json:"equipmentItemId"`
+
+	// QuantityPerParticipant Units each camper taking part needs
+	QuantityPerParticipant int `json:"quantityPerParticipant"`
+}
+
 // ActivityFixedTime Fixed time for the activity (mutually exclusive with duration and timeBlockId)
 type ActivityFixedTime struct {
 	// DayOffset Number of days the activity spans (0 = same day, 1 = ends next day, etc.)
@@ -475,6 +880,22 @@ type ActivityRequiredStaffPosition struct {
 	RequiredCertificationId *openapi_types.UUID `json:"requiredCertificationId,omitempty"`
 }
 
+// ActivitySkillLevelRequirement A minimum level of a skill track campers must have passed
+type ActivitySkillLevelRequirement struct {
+	// MinimumLevelId Lowest level of the track that is allowed; passing a higher level also qualifies
+	MinimumLevelId openapi_types.UUID `json:"minimumLevelId"`
+	SkillTrackId   openapi_types.UUID `json:"skillTrackId"`
+}
+
+// ActivitySkillRequirement A minimum level campers must have reached in a skill recorded in a camper custom field
+type ActivitySkillRequirement struct {
+	// CustomFieldId ID of a camper custom field of type enum, whose options are the levels from lowest to highest, or number
+	CustomFieldId openapi_types.UUID `json:"customFieldId"`
+
+	// MinimumLevel Lowest option of the enum field, or lowest value of the number field, that is allowed
+	MinimumLevel string `json:"minimumLevel"`
+}
+
 // ActivitySpec defines model for ActivitySpec.
 type ActivitySpec struct {
 	// ActivityConflicts Defines scheduling conflicts with other activities
@@ -486,6 +907,12 @@ type ActivitySpec struct {
 	// Duration Default duration in minutes (mutually exclusive with fixedTime and timeBlockId)
 	Duration *int `json:"duration,omitempty"`
 
+	// Eligibility Which campers may take part in an activity. Every rule that is set must be met.
+	Eligibility *ActivityEligibility `json:"eligibility,omitempty"`
+
+	// EquipmentNeeds Equipment events of the activity need for each participant
+	EquipmentNeeds *[]ActivityEquipmentNeed `json:"equipmentNeeds,omitempty"`
+
 	// FixedTime Fixed time for the activity (mutually exclusive with duration and timeBlockId)
 	FixedTime *ActivityFixedTime `json:"fixedTime,omitempty"`
 
@@ -503,175 +930,2510 @@ type ActivityUpdateRequest struct {
 	Spec ActivitySpec              `json:"spec"`
 }
 
-// Area defines model for Area.
-type Area struct {
-	Meta EntityMeta `json:"meta"`
-	Spec AreaSpec   `json:"spec"`
+// Allergen Food allergen a person is allergic to and a menu item can contain
+type Allergen string
+
+// AllergenCount defines model for AllergenCount.
+type AllergenCount struct {
+	// Allergen Food allergen a person is allergic to and a menu item can contain
+	Allergen Allergen `json:"allergen"`
+
+	// Count Number of people at the meal allergic to the allergen
+	Count int `json:"count"`
 }
 
-// AreaCreationRequest defines model for AreaCreationRequest.
-type AreaCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec AreaSpec                  `json:"spec"`
+// AllergenWarning defines model for AllergenWarning.
+type AllergenWarning struct {
+	// Allergens Allergens of the dish the camper is allergic to
+	Allergens  []Allergen         `json:"allergens"`
+	CamperId   openapi_types.UUID `json:"camperId"`
+	CamperName string             `json:"camperName"`
+
+	// MenuItem Name of the dish containing the allergens
+	MenuItem string `json:"menuItem"`
 }
 
-// AreaSpec defines model for AreaSpec.
-type AreaSpec struct {
-	Capacity  *int      `json:"capacity,omitempty"`
-	Equipment *[]string `json:"equipment,omitempty"`
-	Notes     *string   `json:"notes,omitempty"`
+// Allergies Food allergens the person is allergic to, checked against menus
+type Allergies = []Allergen
+
+// Application defines model for Application.
+type Application struct {
+	Meta EntityMeta      `json:"meta"`
+	Spec ApplicationSpec `json:"spec"`
 }
 
-// AreaUpdateRequest defines model for AreaUpdateRequest.
-type AreaUpdateRequest struct {
+// ApplicationCreationRequest defines model for ApplicationCreationRequest.
+type ApplicationCreationRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec AreaSpec                  `json:"spec"`
+	Spec ApplicationMutationSpec   `json:"spec"`
 }
 
-// AreasListResponse defines model for AreasListResponse.
-type AreasListResponse struct {
-	Items []Area `json:"items"`
+// ApplicationMutationSpec defines model for ApplicationMutationSpec.
+type ApplicationMutationSpec struct {
+	// Birthday Date of birth of the camper or staff member
+	Birthday Birthday `json:"birthday"`
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// CamperId ID of an existing camper applying for another session (a new camper is created on acceptance when omitted)
+	CamperId *openapi_types.UUID `json:"camperId,omitempty"`
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+	// Gender Gender of the camper or staff member
+	Gender Gender `json:"gender"`
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+	// GuardianIds IDs of the guardians submitting the application (linked to the camper on acceptance)
+	GuardianIds *[]openapi_types.UUID `json:"guardianIds,omitempty"`
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
-}
+	// Notes Free-form notes about the application
+	Notes *string `json:"notes,omitempty"`
 
-// AuthMe defines model for AuthMe.
-type AuthMe struct {
-	User User `json:"user"`
+	// SessionId ID of the session the applicant wants to attend
+	SessionId openapi_types.UUID `json:"sessionId"`
 }
 
-// Birthday Date of birth of the camper or staff member
-type Birthday = openapi_types.Date
+// ApplicationSpec defines model for ApplicationSpec.
+type ApplicationSpec struct {
+	// Birthday Date of birth of the camper or staff member
+	Birthday Birthday `json:"birthday"`
 
-// Camp defines model for Camp.
-type Camp struct {
-	Meta struct {
-		// CreatedAt The date and time the camp was created
-		CreatedAt time.Time `json:"createdAt"`
+	// CamperId ID of the camper this application belongs to (set on acceptance for new campers)
+	CamperId *openapi_types.UUID `json:"camperId,omitempty"`
 
-		// Description The description of the camp
-		Description *string `json:"description,omitempty"`
+	// Gender Gender of the camper or staff member
+	Gender Gender `json:"gender"`
 
-		// Id The unique identifier of the camp
-		Id openapi_types.UUID `json:"id"`
+	// GuardianIds IDs of the guardians submitting the application
+	GuardianIds *[]openapi_types.UUID `json:"guardianIds,omitempty"`
 
-		// Name The name of the camp
-		Name string `json:"name"`
+	// Notes Free-form notes about the application
+	Notes *string `json:"notes,omitempty"`
 
-		// TenantId The unique identifier of the tenant that owns the camp
-		TenantId openapi_types.UUID `json:"tenantId"`
+	// SessionId ID of the session the applicant wants to attend
+	SessionId openapi_types.UUID `json:"sessionId"`
 
-		// UpdatedAt The date and time the camp was last updated
-		UpdatedAt time.Time `json:"updatedAt"`
-	} `json:"meta"`
-	Spec CampSpec `json:"spec"`
-}
+	// Status Stage of a camper application in the registration workflow
+	Status ApplicationStatus `json:"status"`
 
-// CampCreationRequest defines model for CampCreationRequest.
-type CampCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CampSpec                  `json:"spec"`
+	// SubmittedAt Timestamp when the application was submitted
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+
+	// WaitlistPosition Position on the session waitlist (1 is next in line, only set while waitlisted)
+	WaitlistPosition *int `json:"waitlistPosition,omitempty"`
 }
 
-// CampSpec defines model for CampSpec.
-type CampSpec struct {
-	Address *struct {
-		City    *string `json:"city,omitempty"`
-		Country *string `json:"country,omitempty"`
-		State   *string `json:"state,omitempty"`
-		Street  *string `json:"street,omitempty"`
-		ZipCode *string `json:"zipCode,omitempty"`
-	} `json:"address,omitempty"`
-	ContactInfo *struct {
-		Email   *openapi_types.Email `json:"email,omitempty"`
-		Phone   *string              `json:"phone,omitempty"`
-		Website *string              `json:"website,omitempty"`
-	} `json:"contactInfo,omitempty"`
+// ApplicationStatus Stage of a camper application in the registration workflow
+type ApplicationStatus string
 
-	// DailyEndTime Daily end time for calendar display (24-hour format HH:MM)
-	DailyEndTime string `json:"dailyEndTime"`
+// ApplicationTransition defines model for ApplicationTransition.
+type ApplicationTransition struct {
+	// ApplicationId ID of the application that was moved
+	ApplicationId openapi_types.UUID `json:"applicationId"`
 
-	// DailyStartTime Daily start time for calendar display (24-hour format HH:MM)
-	DailyStartTime string `json:"dailyStartTime"`
+	// ChangedBy ID of the user who made the transition
+	ChangedBy *openapi_types.UUID `json:"changedBy,omitempty"`
 
-	// EndDate Overall camp season end date
-	EndDate openapi_types.Date `json:"endDate"`
-	LogoUrl *string            `json:"logoUrl,omitempty"`
+	// ChangedByEmail Email of the user who made the transition
+	ChangedByEmail *string `json:"changedByEmail,omitempty"`
 
-	// StartDate Overall camp season start date
-	StartDate openapi_types.Date `json:"startDate"`
+	// CreatedAt Timestamp when the transition happened
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Stage of a camper application in the registration workflow
+	FromStatus *ApplicationStatus `json:"fromStatus,omitempty"`
+
+	// Id Unique identifier for the transition
+	Id openapi_types.UUID `json:"id"`
+
+	// Reason Reason given for the transition
+	Reason *string `json:"reason,omitempty"`
+
+	// ToStatus Stage of a camper application in the registration workflow
+	ToStatus ApplicationStatus `json:"toStatus"`
 }
 
-// CampUpdateRequest defines model for CampUpdateRequest.
-type CampUpdateRequest struct {
+// ApplicationTransitionRequest defines model for ApplicationTransitionRequest.
+type ApplicationTransitionRequest struct {
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// OutOfOrder Accept an application while other applications are waiting ahead of it on the session's waitlist. A reason is required.
+	OutOfOrder *bool `json:"outOfOrder,omitempty"`
+
+	// Reason Why the application is being moved to the new status
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Stage of a camper application in the registration workflow
+	Status ApplicationStatus `json:"status"`
+}
+
+// ApplicationTransitionsListResponse defines model for ApplicationTransitionsListResponse.
+type ApplicationTransitionsListResponse struct {
+	Items []ApplicationTransition `json:"items"`
+}
+
+// ApplicationUpdateRequest defines model for ApplicationUpdateRequest.
+type ApplicationUpdateRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CampSpec                  `json:"spec"`
+	Spec ApplicationMutationSpec   `json:"spec"`
 }
 
-// Camper defines model for Camper.
-type Camper struct {
+// ApplicationsListResponse defines model for ApplicationsListResponse.
+type ApplicationsListResponse struct {
+	Items []Application `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Area defines model for Area.
+type Area struct {
 	Meta EntityMeta `json:"meta"`
-	Spec CamperSpec `json:"spec"`
+	Spec AreaSpec   `json:"spec"`
 }
 
-// CamperCreationRequest defines model for CamperCreationRequest.
-type CamperCreationRequest struct {
+// AreaCreationRequest defines model for AreaCreationRequest.
+type AreaCreationRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CamperMutationSpec        `json:"spec"`
+	Spec AreaSpec                  `json:"spec"`
 }
 
-// CamperMutationSpec defines model for CamperMutationSpec.
-type CamperMutationSpec struct {
-	// Birthday Date of birth of the camper or staff member
-	Birthday Birthday `json:"birthday"`
+// AreaSpec defines model for AreaSpec.
+type AreaSpec struct {
+	Capacity  *int      `json:"capacity,omitempty"`
+	Equipment *[]string `json:"equipment,omitempty"`
+	Notes     *string   `json:"notes,omitempty"`
+}
 
-	// Gender Gender of the camper or staff member
-	Gender Gender `json:"gender"`
+// AreaUpdateRequest defines model for AreaUpdateRequest.
+type AreaUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec AreaSpec                  `json:"spec"`
+}
 
-	// GroupIds IDs of the groups this camper belongs to (max one housing group allowed)
-	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+// AreasListResponse defines model for AreasListResponse.
+type AreasListResponse struct {
+	Items []Area `json:"items"`
 
-	// SessionId ID of the camp session this camper is registered in
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// Category What the attached document is. Photos must be images.
+	Category AttachmentCategory `json:"category"`
+
+	// Checksum SHA-256 checksum of the file contents, hex encoded
+	Checksum string `json:"checksum"`
+
+	// ContentType Content type detected from the file contents
+	ContentType string `json:"contentType"`
+
+	// CreatedAt Timestamp when the file was uploaded
+	CreatedAt   time.Time `json:"createdAt"`
+	Description *string   `json:"description,omitempty"`
+
+	// EntityId ID of the camper, staff member, certification or incident the file belongs to
+	EntityId openapi_types.UUID `json:"entityId"`
+
+	// EntityType Kind of record an attachment belongs to
+	EntityType AttachmentEntityType `json:"entityType"`
+
+	// FileName Original name of the uploaded file
+	FileName string `json:"fileName"`
+
+	// Id Unique identifier for the attachment
+	Id openapi_types.UUID `json:"id"`
+
+	// ScanStatus Result of the virus scan run on upload. Infected files are rejected and never stored.
+	ScanStatus AttachmentScanStatus `json:"scanStatus"`
+
+	// Size File size in bytes
+	Size int64 `json:"size"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// UploadedBy User who uploaded the file
+	UploadedBy *openapi_types.UUID `json:"uploadedBy,omitempty"`
+
+	// UploadedByEmail Email of the user who uploaded the file
+	UploadedByEmail *string `json:"uploadedByEmail,omitempty"`
+}
+
+// AttachmentCategory What the attached document is. Photos must be images.
+type AttachmentCategory string
+
+// AttachmentDownloadUrl defines model for AttachmentDownloadUrl.
+type AttachmentDownloadUrl struct {
+	// ExpiresAt Time after which the URL no longer works
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Url Signed URL the file can be downloaded from without an authorization header, relative to the API host
+	Url string `json:"url"`
+}
+
+// AttachmentEntityType Kind of record an attachment belongs to
+type AttachmentEntityType string
+
+// AttachmentScanStatus Result of the virus scan run on upload. Infected files are rejected and never stored.
+type AttachmentScanStatus string
+
+// AttachmentsListResponse defines model for AttachmentsListResponse.
+type AttachmentsListResponse struct {
+	Items []Attachment `json:"items"`
+}
+
+// AttendanceMethod How the camper arrived or left. In-person and carpool check-outs require an authorized pickup person.
+type AttendanceMethod string
+
+// AttendanceRecord defines model for AttendanceRecord.
+type AttendanceRecord struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CamperId ID of the camper
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// CreatedAt Timestamp when the record was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Date Camp local day the record belongs to
+	Date openapi_types.Date `json:"date"`
+
+	// GuardianId ID of the guardian whose authorized pickup list the pickup person was verified against
+	GuardianId *openapi_types.UUID `json:"guardianId,omitempty"`
+
+	// Id Unique identifier for the attendance record
+	Id openapi_types.UUID `json:"id"`
+
+	// Method How the camper arrived or left. In-person and carpool check-outs require an authorized pickup person.
+	Method AttendanceMethod `json:"method"`
+	Notes  *string          `json:"notes,omitempty"`
+
+	// OccurredAt When the camper was signed in or out
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// PersonName Name of the person who dropped off or picked up the camper
+	PersonName *string `json:"personName,omitempty"`
+
+	// RecordedBy ID of the user who recorded the sign-in or sign-out
+	RecordedBy *openapi_types.UUID `json:"recordedBy,omitempty"`
+
+	// StaffMemberId ID of the staff member who signed the camper in or out
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// Type Whether the camper arrived at or left camp
+	Type AttendanceType `json:"type"`
+
+	// UpdatedAt Timestamp when the record was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AttendanceRecordsListResponse defines model for AttendanceRecordsListResponse.
+type AttendanceRecordsListResponse struct {
+	Date  openapi_types.Date `json:"date"`
+	Items []AttendanceRecord `json:"items"`
+}
+
+// AttendanceRequest defines model for AttendanceRequest.
+type AttendanceRequest struct {
+	// CamperId ID of the camper
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// GuardianId Guardian whose authorized pickup list the pickup person is on (narrows the match when names are shared)
+	GuardianId *openapi_types.UUID `json:"guardianId,omitempty"`
+
+	// Method How the camper arrived or left. In-person and carpool check-outs require an authorized pickup person.
+	Method AttendanceMethod `json:"method"`
+	Notes  *string          `json:"notes,omitempty"`
+
+	// OccurredAt When the camper was signed in or out (defaults to now)
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+
+	// PersonName Name of the person dropping off or picking up the camper. Required for in-person and carpool check-outs and must be on the camper's authorized pickup list.
+	PersonName *string `json:"personName,omitempty"`
+
+	// StaffMemberId ID of the staff member signing the camper in or out
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+}
+
+// AttendanceType Whether the camper arrived at or left camp
+type AttendanceType string
+
+// AuthMe defines model for AuthMe.
+type AuthMe struct {
+	User User `json:"user"`
+}
+
+// AuthorizedPickup defines model for AuthorizedPickup.
+type AuthorizedPickup struct {
+	// GuardianId ID of the guardian this pickup entry comes from
+	GuardianId *openapi_types.UUID `json:"guardianId,omitempty"`
+
+	// Name Full name of the person authorized to pick up
+	Name  string  `json:"name"`
+	Phone *string `json:"phone,omitempty"`
+
+	// PhotoIdNotes Notes on the photo ID to check at pickup (e.g. driver's license last 4 digits)
+	PhotoIdNotes *string `json:"photoIdNotes,omitempty"`
+
+	// Relationship Relationship of the person to the campers
+	Relationship *string `json:"relationship,omitempty"`
+}
+
+// Bed defines model for Bed.
+type Bed struct {
+	// Accessible Whether the bed is accessible for people with reduced mobility
+	Accessible bool `json:"accessible"`
+
+	// BunkPosition Position of the bed in a bunk; none for single beds
+	BunkPosition BedBunkPosition `json:"bunkPosition"`
+
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	CreatedAt time.Time          `json:"createdAt"`
+
+	// HousingRoomId Housing room the bed is in
+	HousingRoomId openapi_types.UUID `json:"housingRoomId"`
+
+	// Id Unique identifier for the bed
+	Id openapi_types.UUID `json:"id"`
+
+	// Label Label of the bed within its room, e.g. "B2"
+	Label string  `json:"label"`
+	Notes *string `json:"notes,omitempty"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// BedAssignment defines model for BedAssignment.
+type BedAssignment struct {
+	BedId openapi_types.UUID `json:"bedId"`
+
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CamperId Camper sleeping in the bed; set unless staffMemberId is
+	CamperId  *openapi_types.UUID `json:"camperId,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+
+	// EndDate Day the bed is left; the night before it is the last one
+	EndDate openapi_types.Date `json:"endDate"`
+
+	// HousingRoomId Housing room of the bed
+	HousingRoomId openapi_types.UUID `json:"housingRoomId"`
+
+	// Id Unique identifier for the bed assignment
+	Id        openapi_types.UUID `json:"id"`
+	Notes     *string            `json:"notes,omitempty"`
+	SessionId openapi_types.UUID `json:"sessionId"`
+
+	// StaffMemberId Staff member sleeping in the bed; set unless camperId is
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+
+	// StartDate First night in the bed
+	StartDate openapi_types.Date `json:"startDate"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// BedAssignmentCreationRequest defines model for BedAssignmentCreationRequest.
+type BedAssignmentCreationRequest struct {
+	BedId openapi_types.UUID `json:"bedId"`
+
+	// CamperId Camper to assign; exactly one of camperId and staffMemberId is required
+	CamperId *openapi_types.UUID `json:"camperId,omitempty"`
+
+	// EndDate Day the bed is left; the session's end when omitted
+	EndDate   *openapi_types.Date `json:"endDate,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
+	SessionId openapi_types.UUID  `json:"sessionId"`
+
+	// StaffMemberId Staff member to assign; exactly one of camperId and staffMemberId is required
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+
+	// StartDate First night in the bed; the session's start when omitted
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+// BedAssignmentUpdateRequest defines model for BedAssignmentUpdateRequest.
+type BedAssignmentUpdateRequest struct {
+	BedId openapi_types.UUID `json:"bedId"`
+
+	// EndDate Day the bed is left; the session's end when omitted
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
+	Notes   *string             `json:"notes,omitempty"`
+
+	// StartDate First night in the bed; the session's start when omitted
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+// BedAssignmentsListResponse defines model for BedAssignmentsListResponse.
+type BedAssignmentsListResponse struct {
+	Items []BedAssignment `json:"items"`
+}
+
+// BedBunkPosition Position of the bed in a bunk; none for single beds
+type BedBunkPosition string
+
+// BedCreationRequest defines model for BedCreationRequest.
+type BedCreationRequest struct {
+	Accessible *bool `json:"accessible,omitempty"`
+
+	// BunkPosition Position of the bed in a bunk; none for single beds
+	BunkPosition  *BedBunkPosition   `json:"bunkPosition,omitempty"`
+	HousingRoomId openapi_types.UUID `json:"housingRoomId"`
+	Label         string             `json:"label"`
+	Notes         *string            `json:"notes,omitempty"`
+}
+
+// BedUpdateRequest defines model for BedUpdateRequest.
+type BedUpdateRequest struct {
+	Accessible *bool `json:"accessible,omitempty"`
+
+	// BunkPosition Position of the bed in a bunk; none for single beds
+	BunkPosition *BedBunkPosition `json:"bunkPosition,omitempty"`
+	Label        string           `json:"label"`
+	Notes        *string          `json:"notes,omitempty"`
+}
+
+// BedsListResponse defines model for BedsListResponse.
+type BedsListResponse struct {
+	Items []Bed `json:"items"`
+}
+
+// Birthday Date of birth of the camper or staff member
+type Birthday = openapi_types.Date
+
+// BunkRequest defines model for BunkRequest.
+type BunkRequest struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CamperId ID of the camper making the request
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// CreatedAt Timestamp when the request was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique identifier for the bunk request
+	Id openapi_types.UUID `json:"id"`
+
+	// Mutual Whether the requested camper has also requested this camper for the session
+	Mutual bool    `json:"mutual"`
+	Notes  *string `json:"notes,omitempty"`
+
+	// RequestedCamperId ID of the camper requested as bunkmate
+	RequestedCamperId openapi_types.UUID `json:"requestedCamperId"`
+
+	// SessionId Session the campers want to share a housing room in
 	SessionId openapi_types.UUID `json:"sessionId"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// UpdatedAt Timestamp when the request was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// CamperSpec defines model for CamperSpec.
-type CamperSpec struct {
-	// Birthday Date of birth of the camper or staff member
-	Birthday Birthday `json:"birthday"`
+// BunkRequestCreationRequest defines model for BunkRequestCreationRequest.
+type BunkRequestCreationRequest struct {
+	// CamperId ID of the camper making the request
+	CamperId openapi_types.UUID `json:"camperId"`
+	Notes    *string            `json:"notes,omitempty"`
 
-	// Gender Gender of the camper or staff member
-	Gender Gender `json:"gender"`
+	// RequestedCamperId ID of the camper requested as bunkmate
+	RequestedCamperId openapi_types.UUID `json:"requestedCamperId"`
+
+	// SessionId Session the campers want to share a housing room in
+	SessionId openapi_types.UUID `json:"sessionId"`
+}
+
+// BunkRequestsListResponse defines model for BunkRequestsListResponse.
+type BunkRequestsListResponse struct {
+	Items []BunkRequest `json:"items"`
+}
+
+// Camp defines model for Camp.
+type Camp struct {
+	Meta struct {
+		// CreatedAt The date and time the camp was created
+		CreatedAt time.Time `json:"createdAt"`
+
+		// Description The description of the camp
+		Description *string `json:"description,omitempty"`
+
+		// Id The unique identifier of the camp
+		Id openapi_types.UUID `json:"id"`
+
+		// Name The name of the camp
+		Name string `json:"name"`
+
+		// TenantId The unique identifier of the tenant that owns the camp
+		TenantId openapi_types.UUID `json:"tenantId"`
+
+		// UpdatedAt The date and time the camp was last updated
+		UpdatedAt time.Time `json:"updatedAt"`
+	} `json:"meta"`
+	Spec CampSpec `json:"spec"`
+}
+
+// CampCloneCounts Number of records copied into the new camp, per kind
+type CampCloneCounts struct {
+	Activities     int `json:"activities"`
+	Areas          int `json:"areas"`
+	Beds           int `json:"beds"`
+	Certifications int `json:"certifications"`
+	Colors         int `json:"colors"`
+
+	// DroppedSkillRequirements Activity eligibility requirements on camper custom fields that were dropped, as custom fields are not copied; set them again on the activities of the new camp
+	DroppedSkillRequirements int `json:"droppedSkillRequirements"`
+	Events                   int `json:"events"`
+
+	// Groups Staff groups of programs, copied without their members
+	Groups       int `json:"groups"`
+	HousingRooms int `json:"housingRooms"`
+	Locations    int `json:"locations"`
+	Programs     int `json:"programs"`
+	Roles        int `json:"roles"`
+	SkillTracks  int `json:"skillTracks"`
+	TimeBlocks   int `json:"timeBlocks"`
+}
+
+// CampCloneJob A background job copying the configuration of a camp into a new camp. Areas, locations, housing rooms with their beds, colors, time blocks, roles, certifications, programs, the staff groups of programs, skill tracks and activities are copied with their references remapped to the copies; references to records that are not copied, such as equipment, custom fields and staff members, are dropped and counted where they were rules.
+type CampCloneJob struct {
+	// CampId Camp being copied
+	CampId      openapi_types.UUID `json:"campId"`
+	CompletedAt *time.Time         `json:"completedAt,omitempty"`
+
+	// Copied Number of records copied into the new camp, per kind
+	Copied      *CampCloneCounts   `json:"copied,omitempty"`
+	CopyEvents  bool               `json:"copyEvents"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+	EndDate     openapi_types.Date `json:"endDate"`
+
+	// Error Why the job failed
+	Error *string            `json:"error,omitempty"`
+	Id    openapi_types.UUID `json:"id"`
+
+	// Name Name of the new camp
+	Name string `json:"name"`
+
+	// NewCampId The new camp, once the job completed
+	NewCampId *openapi_types.UUID `json:"newCampId,omitempty"`
+
+	// RequestedBy User who requested the copy
+	RequestedBy      *openapi_types.UUID `json:"requestedBy,omitempty"`
+	RequestedByEmail *string             `json:"requestedByEmail,omitempty"`
+	StartDate        openapi_types.Date  `json:"startDate"`
+	StartedAt        *time.Time          `json:"startedAt,omitempty"`
+
+	// Status Status of a camp clone job
+	Status    CampCloneJobStatus `json:"status"`
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// CampCloneJobStatus Status of a camp clone job
+type CampCloneJobStatus string
+
+// CampCloneJobsListResponse defines model for CampCloneJobsListResponse.
+type CampCloneJobsListResponse struct {
+	Items []CampCloneJob `json:"items"`
+}
+
+// CampCloneRequest Dates and name of the new camp a camp's configuration is copied into
+type CampCloneRequest struct {
+	// CopyEvents Whether to copy events too, shifted by the days between the start dates of the two camps
+	CopyEvents *bool `json:"copyEvents,omitempty"`
+
+	// Description Description of the new camp; defaults to the description of the copied camp
+	Description *string `json:"description,omitempty"`
+
+	// EndDate End date of the new camp
+	EndDate openapi_types.Date `json:"endDate"`
+
+	// Name Name of the new camp
+	Name string `json:"name"`
+
+	// StartDate Start date of the new camp
+	StartDate openapi_types.Date `json:"startDate"`
+}
+
+// CampCreationRequest defines model for CampCreationRequest.
+type CampCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CampSpec                  `json:"spec"`
+}
+
+// CampSpec defines model for CampSpec.
+type CampSpec struct {
+	Address *struct {
+		City    *string `json:"city,omitempty"`
+		Country *string `json:"country,omitempty"`
+		State   *string `json:"state,omitempty"`
+		Street  *string `json:"street,omitempty"`
+		ZipCode *string `json:"zipCode,omitempty"`
+	} `json:"address,omitempty"`
+	ContactInfo *struct {
+		Email   *openapi_types.Email `json:"email,omitempty"`
+		Phone   *string              `json:"phone,omitempty"`
+		Website *string              `json:"website,omitempty"`
+	} `json:"contactInfo,omitempty"`
+
+	// DailyEndTime Daily end time for calendar display (24-hour format HH:MM)
+	DailyEndTime string `json:"dailyEndTime"`
+
+	// DailyStartTime Daily start time for calendar display (24-hour format HH:MM)
+	DailyStartTime string `json:"dailyStartTime"`
+
+	// EndDate Overall camp season end date
+	EndDate openapi_types.Date `json:"endDate"`
+	LogoUrl *string            `json:"logoUrl,omitempty"`
+
+	// StartDate Overall camp season start date
+	StartDate openapi_types.Date `json:"startDate"`
+}
+
+// CampUpdateRequest defines model for CampUpdateRequest.
+type CampUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CampSpec                  `json:"spec"`
+}
+
+// Camper defines model for Camper.
+type Camper struct {
+	Meta EntityMeta `json:"meta"`
+	Spec CamperSpec `json:"spec"`
+}
+
+// CamperBadge A badge a camper was awarded for passing a level of a skill track
+type CamperBadge struct {
+	// AssessmentId Passed assessment the badge was awarded for; deleting it takes the badge away
+	AssessmentId openapi_types.UUID `json:"assessmentId"`
+	AwardedOn    openapi_types.Date `json:"awardedOn"`
+	CamperId     openapi_types.UUID `json:"camperId"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	Id           openapi_types.UUID `json:"id"`
+	LevelId      openapi_types.UUID `json:"levelId"`
+	Name         string             `json:"name"`
+	SkillTrackId openapi_types.UUID `json:"skillTrackId"`
+}
+
+// CamperContacts defines model for CamperContacts.
+type CamperContacts struct {
+	// AuthorizedPickups Everyone allowed to pick up the camper, including guardians who can pick up
+	AuthorizedPickups []AuthorizedPickup `json:"authorizedPickups"`
+	CamperId          openapi_types.UUID `json:"camperId"`
+
+	// EmergencyContacts Guardians marked as emergency contacts, ordered by emergency priority
+	EmergencyContacts []Guardian `json:"emergencyContacts"`
+
+	// Guardians All guardians linked to the camper
+	Guardians []Guardian `json:"guardians"`
+}
+
+// CamperCreationRequest defines model for CamperCreationRequest.
+type CamperCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CamperMutationSpec        `json:"spec"`
+}
+
+// CamperDuplicate defines model for CamperDuplicate.
+type CamperDuplicate struct {
+	// CamperId The camper registered first, suggested as the survivor of a merge
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// DuplicateCamperId The camper registered later, suggested to be merged into camperId
+	DuplicateCamperId openapi_types.UUID      `json:"duplicateCamperId"`
+	Reasons           []CamperDuplicateReason `json:"reasons"`
+
+	// Score Likelihood that both records describe the same child (100 is certain)
+	Score int `json:"score"`
+}
+
+// CamperDuplicateReason Why two campers look like the same child:
+// - same_name: names are equal ignoring case, punctuation and word order
+// - similar_name: names differ only by a few typos
+// - same_birthday: both campers have the same birthday
+// - shared_guardian: both campers are linked to the same guardian, or to guardians with the same email or name
+type CamperDuplicateReason string
+
+// CamperDuplicatesListResponse defines model for CamperDuplicatesListResponse.
+type CamperDuplicatesListResponse struct {
+	// Items Likely duplicate pairs, most likely first
+	Items []CamperDuplicate `json:"items"`
+}
+
+// CamperEnrollment defines model for CamperEnrollment.
+type CamperEnrollment struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CamperId ID of the enrolled camper
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EndDate Last day the camper attends (defaults to the session end date)
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
+
+	// HousingGroupId Housing group the camper is assigned to for this session
+	HousingGroupId *openapi_types.UUID `json:"housingGroupId,omitempty"`
+
+	// Id Unique identifier for the enrollment
+	Id    openapi_types.UUID `json:"id"`
+	Notes *string            `json:"notes,omitempty"`
+
+	// SessionId ID of the session the camper is enrolled in
+	SessionId openapi_types.UUID `json:"sessionId"`
+
+	// StartDate First day the camper attends (defaults to the session start date)
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+
+	// Status Status of a camper's enrollment in a session
+	Status CamperEnrollmentStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CamperEnrollmentRequest defines model for CamperEnrollmentRequest.
+type CamperEnrollmentRequest struct {
+	// EndDate Last day the camper attends (defaults to the session end date)
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
+
+	// HousingGroupId Housing group the camper is assigned to for this session
+	HousingGroupId *openapi_types.UUID `json:"housingGroupId,omitempty"`
+	Notes          *string             `json:"notes,omitempty"`
+
+	// SessionId ID of the session to enroll the camper in
+	SessionId openapi_types.UUID `json:"sessionId"`
+
+	// StartDate First day the camper attends (defaults to the session start date)
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+
+	// Status Status of a camper's enrollment in a session
+	Status *CamperEnrollmentStatus `json:"status,omitempty"`
+}
+
+// CamperEnrollmentStatus Status of a camper's enrollment in a session
+type CamperEnrollmentStatus string
+
+// CamperEnrollmentsListResponse defines model for CamperEnrollmentsListResponse.
+type CamperEnrollmentsListResponse struct {
+	Items []CamperEnrollment `json:"items"`
+}
+
+// CamperFamily defines model for CamperFamily.
+type CamperFamily struct {
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// Guardians All guardians linked to the camper
+	Guardians []Guardian `json:"guardians"`
+
+	// Siblings Other campers in this camp sharing at least one guardian with the camper
+	Siblings []Camper `json:"siblings"`
+}
+
+// CamperMerge defines model for CamperMerge.
+type CamperMerge struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CreatedAt Timestamp when the campers were merged
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DuplicateBirthday Birthday of the duplicate at the time of the merge
+	DuplicateBirthday openapi_types.Date `json:"duplicateBirthday"`
+
+	// DuplicateCamperId Camper that was merged into the survivor and deleted
+	DuplicateCamperId openapi_types.UUID `json:"duplicateCamperId"`
+
+	// DuplicateName Name of the duplicate at the time of the merge
+	DuplicateName string `json:"duplicateName"`
+
+	// Id Unique identifier for the merge
+	Id openapi_types.UUID `json:"id"`
+
+	// MergedBy User who merged the campers
+	MergedBy *openapi_types.UUID `json:"mergedBy,omitempty"`
+
+	// MergedByEmail Email of the user who merged the campers
+	MergedByEmail *string `json:"mergedByEmail,omitempty"`
+
+	// Moved Number of references moved from the duplicate to the survivor, per kind
+	Moved CamperMergeCounts `json:"moved"`
+
+	// SurvivorCamperId Camper that was kept
+	SurvivorCamperId openapi_types.UUID `json:"survivorCamperId"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
+// CamperMergeCounts Number of references moved from the duplicate to the survivor, per kind
+type CamperMergeCounts struct {
+	Applications      int `json:"applications"`
+	Attachments       int `json:"attachments"`
+	AttendanceRecords int `json:"attendanceRecords"`
+
+	// Badges Badges moved to the survivor; where both campers hold a badge, the earliest award is kept
+	Badges       int `json:"badges"`
+	BunkRequests int `json:"bunkRequests"`
+	Enrollments  int `json:"enrollments"`
+
+	// Events Events whose excluded campers listed the duplicate
+	Events           int `json:"events"`
+	Groups           int `json:"groups"`
+	Guardians        int `json:"guardians"`
+	Incidents        int `json:"incidents"`
+	MedicationDoses  int `json:"medicationDoses"`
+	Medications      int `json:"medications"`
+	Notes            int `json:"notes"`
+	SkillAssessments int `json:"skillAssessments"`
+}
+
+// CamperMergeRequest defines model for CamperMergeRequest.
+type CamperMergeRequest struct {
+	// DuplicateCamperId Camper to merge into the survivor; it is deleted once its references have been moved
+	DuplicateCamperId openapi_types.UUID `json:"duplicateCamperId"`
+}
+
+// CamperMergesListResponse defines model for CamperMergesListResponse.
+type CamperMergesListResponse struct {
+	Items []CamperMerge `json:"items"`
+}
+
+// CamperMutationSpec defines model for CamperMutationSpec.
+type CamperMutationSpec struct {
+	// Allergies Food allergens the person is allergic to, checked against menus
+	Allergies *Allergies `json:"allergies,omitempty"`
+
+	// Birthday Date of birth of the camper or staff member
+	Birthday Birthday `json:"birthday"`
+
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// DietaryRestrictions Diets the person follows, used for meal headcounts
+	DietaryRestrictions *DietaryRestrictions `json:"dietaryRestrictions,omitempty"`
+
+	// Gender Gender of the camper or staff member
+	Gender Gender `json:"gender"`
+
+	// GroupIds IDs of the groups this camper belongs to (max one housing group allowed)
+	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+
+	// SessionId ID of the camp session this camper is registered in
+	SessionId openapi_types.UUID `json:"sessionId"`
+}
+
+// CamperSkillProgress The highest level of a skill track a camper has passed
+type CamperSkillProgress struct {
+	// AchievedOn When the highest level was passed
+	AchievedOn *openapi_types.Date `json:"achievedOn,omitempty"`
+
+	// LevelCount Number of levels of the track
+	LevelCount int `json:"levelCount"`
+
+	// LevelId Highest level passed; not set when the camper has not passed any level
+	LevelId   *openapi_types.UUID `json:"levelId,omitempty"`
+	LevelName *string             `json:"levelName,omitempty"`
+
+	// LevelRank Position of the highest level passed, starting at 1; 0 when no level was passed
+	LevelRank      int                `json:"levelRank"`
+	SkillTrackId   openapi_types.UUID `json:"skillTrackId"`
+	SkillTrackName string             `json:"skillTrackName"`
+}
+
+// CamperSkills Skill progression of a camper
+type CamperSkills struct {
+	// Assessments Assessment history, most recent first
+	Assessments []SkillAssessment `json:"assessments"`
+
+	// Badges Badges awarded, most recent first
+	Badges   []CamperBadge      `json:"badges"`
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// Progress Highest level passed per skill track with assessments, by track name
+	Progress []CamperSkillProgress `json:"progress"`
+}
+
+// CamperSpec defines model for CamperSpec.
+type CamperSpec struct {
+	// Allergies Food allergens the person is allergic to, checked against menus
+	Allergies *Allergies `json:"allergies,omitempty"`
+
+	// Birthday Date of birth of the camper or staff member
+	Birthday Birthday `json:"birthday"`
+
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// DietaryRestrictions Diets the person follows, used for meal headcounts
+	DietaryRestrictions *DietaryRestrictions `json:"dietaryRestrictions,omitempty"`
+
+	// Gender Gender of the camper or staff member
+	Gender Gender `json:"gender"`
+
+	// GroupIds IDs of the groups this camper belongs to
+	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+
+	// HousingGroupId ID of the housing group this camper belongs to (auto-populated from groupIds)
+	HousingGroupId *openapi_types.UUID `json:"housingGroupId,omitempty"`
+
+	// SessionId ID of the camp session this camper originally registered in
+	SessionId openapi_types.UUID `json:"sessionId"`
+
+	// SessionIds IDs of all sessions the camper is enrolled in (derived from enrollments)
+	SessionIds *[]openapi_types.UUID `json:"sessionIds,omitempty"`
+}
+
+// CamperUpdateRequest defines model for CamperUpdateRequest.
+type CamperUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CamperMutationSpec        `json:"spec"`
+}
+
+// CampersListResponse defines model for CampersListResponse.
+type CampersListResponse struct {
+	Items []Camper `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// CampsListResponse defines model for CampsListResponse.
+type CampsListResponse struct {
+	Items []Camp `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Certification defines model for Certification.
+type Certification struct {
+	Meta EntityMeta        `json:"meta"`
+	Spec CertificationSpec `json:"spec"`
+}
+
+// CertificationComplianceIssue defines model for CertificationComplianceIssue.
+type CertificationComplianceIssue struct {
+	CertificationId   openapi_types.UUID `json:"certificationId"`
+	CertificationName string             `json:"certificationName"`
+
+	// EventId Event requiring the certification (missing_certification only)
+	EventId        *openapi_types.UUID `json:"eventId,omitempty"`
+	EventName      *string             `json:"eventName,omitempty"`
+	EventStartDate *time.Time          `json:"eventStartDate,omitempty"`
+
+	// ExpiresOn Last day the certificate is valid, if the staff member holds it
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+
+	// PositionName Position of the event the staff member is assigned to
+	PositionName    *string            `json:"positionName,omitempty"`
+	StaffMemberId   openapi_types.UUID `json:"staffMemberId"`
+	StaffMemberName string             `json:"staffMemberName"`
+
+	// Type expired: the certificate is no longer valid today; expiring: the certificate expires before the
+	// report's cutoff day; missing_certification: the staff member is assigned to an upcoming event position
+	// requiring a certification they do not hold or that will have expired by the day of the event
+	Type CertificationComplianceIssueType `json:"type"`
+}
+
+// CertificationComplianceIssueType expired: the certificate is no longer valid today; expiring: the certificate expires before the
+// report's cutoff day; missing_certification: the staff member is assigned to an upcoming event position
+// requiring a certification they do not hold or that will have expired by the day of the event
+type CertificationComplianceIssueType string
+
+// CertificationComplianceReport defines model for CertificationComplianceReport.
+type CertificationComplianceReport struct {
+	// Before Certificates valid on this day or later are not reported as expiring
+	Before openapi_types.Date `json:"before"`
+
+	// Items Issues ordered by staff member name and certification name, event issues by event start
+	Items []CertificationComplianceIssue `json:"items"`
+}
+
+// CertificationCreationRequest defines model for CertificationCreationRequest.
+type CertificationCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CertificationSpec         `json:"spec"`
+}
+
+// CertificationSpec defines model for CertificationSpec.
+type CertificationSpec = map[string]interface{}
+
+// CertificationUpdateRequest defines model for CertificationUpdateRequest.
+type CertificationUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec CertificationSpec         `json:"spec"`
+}
+
+// CertificationsListResponse defines model for CertificationsListResponse.
+type CertificationsListResponse struct {
+	Items []Certification `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Color defines model for Color.
+type Color struct {
+	Meta EntityMeta `json:"meta"`
+	Spec ColorSpec  `json:"spec"`
+}
+
+// ColorCreationRequest defines model for ColorCreationRequest.
+type ColorCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec ColorSpec                 `json:"spec"`
+}
+
+// ColorSpec defines model for ColorSpec.
+type ColorSpec struct {
+	// Default Whether this is the default color for events
+	Default *bool `json:"default,omitempty"`
+
+	// HexValue Hex color value (e.g., "#FF5733")
+	HexValue string `json:"hexValue"`
+}
+
+// ColorUpdateRequest defines model for ColorUpdateRequest.
+type ColorUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec ColorSpec                 `json:"spec"`
+}
+
+// ColorsListResponse defines model for ColorsListResponse.
+type ColorsListResponse struct {
+	Items []Color `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// CustomFieldDefinition defines model for CustomFieldDefinition.
+type CustomFieldDefinition struct {
+	// CampId Camp ID
+	CampId      openapi_types.UUID `json:"campId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+
+	// EntityType Kind of record a custom field applies to
+	EntityType CustomFieldEntityType `json:"entityType"`
+
+	// Id Unique identifier for the custom field
+	Id openapi_types.UUID `json:"id"`
+
+	// Key Key the value is stored under in customFields and used in filterBy/sortBy as customFields.<key>
+	Key string `json:"key"`
+
+	// Label Display name of the field
+	Label string `json:"label"`
+
+	// Options Allowed values for enum fields
+	Options *[]string `json:"options,omitempty"`
+
+	// Position Display order of the field
+	Position int `json:"position"`
+
+	// Required Whether every record must have a value for this field
+	Required bool `json:"required"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// Type Type of values a custom field accepts
+	Type      CustomFieldType `json:"type"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// CustomFieldDefinitionCreationRequest defines model for CustomFieldDefinitionCreationRequest.
+type CustomFieldDefinitionCreationRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// EntityType Kind of record a custom field applies to
+	EntityType CustomFieldEntityType `json:"entityType"`
+
+	// Key Key the value is stored under; unique per camp and entity type and cannot be changed later
+	Key   string `json:"key"`
+	Label string `json:"label"`
+
+	// Options Allowed values, required for enum fields
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+
+	// Type Type of values a custom field accepts
+	Type CustomFieldType `json:"type"`
+}
+
+// CustomFieldDefinitionUpdateRequest The key, type and entity type of a custom field cannot be changed once created
+type CustomFieldDefinitionUpdateRequest struct {
+	Description *string `json:"description,omitempty"`
+	Label       string  `json:"label"`
+
+	// Options Allowed values, required for enum fields
+	Options  *[]string `json:"options,omitempty"`
+	Position *int      `json:"position,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+}
+
+// CustomFieldDefinitionsListResponse defines model for CustomFieldDefinitionsListResponse.
+type CustomFieldDefinitionsListResponse struct {
+	Items []CustomFieldDefinition `json:"items"`
+}
+
+// CustomFieldEntityType Kind of record a custom field applies to
+type CustomFieldEntityType string
+
+// CustomFieldType Type of values a custom field accepts
+type CustomFieldType string
+
+// CustomFieldValues Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+type CustomFieldValues = map[string]interface{}
+
+// DietCount defines model for DietCount.
+type DietCount struct {
+	// Count Number of people at the meal following the diet
+	Count int `json:"count"`
+
+	// Diet Diet a person follows and a menu item can be suitable for
+	Diet DietaryRestriction `json:"diet"`
+}
+
+// DietaryRestriction Diet a person follows and a menu item can be suitable for
+type DietaryRestriction string
+
+// DietaryRestrictions Diets the person follows, used for meal headcounts
+type DietaryRestrictions = []DietaryRestriction
+
+// DueDose defines model for DueDose.
+type DueDose struct {
+	CamperId       openapi_types.UUID `json:"camperId"`
+	CamperName     string             `json:"camperName"`
+	Dosage         string             `json:"dosage"`
+	Instructions   *string            `json:"instructions,omitempty"`
+	MedicationId   openapi_types.UUID `json:"medicationId"`
+	MedicationName string             `json:"medicationName"`
+
+	// Overdue Whether the dose is still pending more than the grace period after it was due
+	Overdue bool            `json:"overdue"`
+	Record  *MedicationDose `json:"record,omitempty"`
+	Route   *string         `json:"route,omitempty"`
+
+	// ScheduledFor When the dose is due (omitted for as-needed doses that were recorded)
+	ScheduledFor *time.Time `json:"scheduledFor,omitempty"`
+
+	// Status Status of a scheduled dose (pending until a record exists)
+	Status DueDoseStatus `json:"status"`
+}
+
+// DueDoseStatus Status of a scheduled dose (pending until a record exists)
+type DueDoseStatus string
+
+// DueDosesListResponse defines model for DueDosesListResponse.
+type DueDosesListResponse struct {
+	Date  openapi_types.Date `json:"date"`
+	Items []DueDose          `json:"items"`
+}
+
+// DutyConflict defines model for DutyConflict.
+type DutyConflict struct {
+	// CertificationId Certification the staff member lacks (missing_certification)
+	CertificationId *openapi_types.UUID `json:"certificationId,omitempty"`
+	DutyTypeId      openapi_types.UUID  `json:"dutyTypeId"`
+	DutyTypeName    string              `json:"dutyTypeName"`
+
+	// EventId Event the staff member is also assigned to (event_overlap)
+	EventId   *openapi_types.UUID `json:"eventId,omitempty"`
+	EventName *string             `json:"eventName,omitempty"`
+
+	// Message Human readable description of the conflict
+	Message string `json:"message"`
+
+	// OtherShiftId Other shift involved (double_booked and insufficient_rest)
+	OtherShiftId *openapi_types.UUID `json:"otherShiftId,omitempty"`
+
+	// PositionName Position of the event the staff member is assigned to (event_overlap)
+	PositionName   *string            `json:"positionName,omitempty"`
+	ShiftEndDate   time.Time          `json:"shiftEndDate"`
+	ShiftId        openapi_types.UUID `json:"shiftId"`
+	ShiftStartDate time.Time          `json:"shiftStartDate"`
+
+	// StaffMemberId Staff member concerned (all types except understaffed)
+	StaffMemberId   *openapi_types.UUID `json:"staffMemberId,omitempty"`
+	StaffMemberName *string             `json:"staffMemberName,omitempty"`
+
+	// Type Kind of roster conflict. double_booked is a staff member on two overlapping shifts (including time off);
+	// event_overlap a staff member on a shift while assigned to a required staff position of an event;
+	// insufficient_rest a staff member starting a shift before the rest time of their previous shift is over;
+	// missing_certification a staff member on a shift without a valid certificate its duty type requires;
+	// time_off a staff member on a shift during their approved time off;
+	// understaffed a shift with fewer staff members than it needs.
+	Type DutyConflictType `json:"type"`
+}
+
+// DutyConflictReport defines model for DutyConflictReport.
+type DutyConflictReport struct {
+	From openapi_types.Date `json:"from"`
+
+	// Items Conflicts ordered by shift start
+	Items []DutyConflict     `json:"items"`
+	To    openapi_types.Date `json:"to"`
+}
+
+// DutyConflictType Kind of roster conflict. double_booked is a staff member on two overlapping shifts (including time off);
+// event_overlap a staff member on a shift while assigned to a required staff position of an event;
+// insufficient_rest a staff member starting a shift before the rest time of their previous shift is over;
+// missing_certification a staff member on a shift without a valid certificate its duty type requires;
+// time_off a staff member on a shift during their approved time off;
+// understaffed a shift with fewer staff members than it needs.
+type DutyConflictType string
+
+// DutyRotation defines model for DutyRotation.
+type DutyRotation struct {
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	CreatedAt time.Time          `json:"createdAt"`
+
+	// DaysOfWeek Days of the week shifts are generated on, 0 being Sunday; empty for every day
+	DaysOfWeek []int `json:"daysOfWeek"`
+
+	// DutyTypeId Duty type of the shifts the rotation generates
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+
+	// EndTime Camp local time the shifts end (HH:MM); shifts ending at or before their start time end the next day
+	EndTime string `json:"endTime"`
+
+	// Id Unique identifier for the rotation
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the rotation, e.g. Weeknight watch
+	Name string `json:"name"`
+
+	// StaffCount Staff members needed on each shift
+	StaffCount int `json:"staffCount"`
+
+	// StaffMemberIds Staff members the shifts are distributed among; empty for all staff members of the camp
+	StaffMemberIds []openapi_types.UUID `json:"staffMemberIds"`
+
+	// StartTime Camp local time the shifts start (HH:MM)
+	StartTime string `json:"startTime"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// DutyRotationCreationRequest defines model for DutyRotationCreationRequest.
+type DutyRotationCreationRequest struct {
+	// DaysOfWeek Days of the week shifts are generated on, 0 being Sunday; every day when omitted
+	DaysOfWeek *[]int             `json:"daysOfWeek,omitempty"`
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+
+	// EndTime Camp local time the shifts end (HH:MM); shifts ending at or before their start time end the next day
+	EndTime string `json:"endTime"`
+	Name    string `json:"name"`
+
+	// StaffCount Staff members needed on each shift, 1 when omitted
+	StaffCount *int `json:"staffCount,omitempty"`
+
+	// StaffMemberIds Staff members the shifts are distributed among; all staff members of the camp when omitted
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
+
+	// StartTime Camp local time the shifts start (HH:MM)
+	StartTime string `json:"startTime"`
+}
+
+// DutyRotationGenerationRequest defines model for DutyRotationGenerationRequest.
+type DutyRotationGenerationRequest struct {
+	// AssignStaff Whether to assign staff members to the generated shifts following the assignment rules
+	AssignStaff *bool `json:"assignStaff,omitempty"`
+
+	// From First day to generate shifts on
+	From openapi_types.Date `json:"from"`
+
+	// To Last day to generate shifts on, at most 92 days after the first
+	To openapi_types.Date `json:"to"`
+}
+
+// DutyRotationUpdateRequest defines model for DutyRotationUpdateRequest.
+type DutyRotationUpdateRequest struct {
+	// DaysOfWeek Days of the week shifts are generated on, 0 being Sunday; every day when omitted
+	DaysOfWeek *[]int             `json:"daysOfWeek,omitempty"`
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+
+	// EndTime Camp local time the shifts end (HH:MM); shifts ending at or before their start time end the next day
+	EndTime string `json:"endTime"`
+	Name    string `json:"name"`
+
+	// StaffCount Staff members needed on each shift, 1 when omitted
+	StaffCount *int `json:"staffCount,omitempty"`
+
+	// StaffMemberIds Staff members the shifts are distributed among; all staff members of the camp when omitted
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
+
+	// StartTime Camp local time the shifts start (HH:MM)
+	StartTime string `json:"startTime"`
+}
+
+// DutyRotationsListResponse defines model for DutyRotationsListResponse.
+type DutyRotationsListResponse struct {
+	Items []DutyRotation `json:"items"`
+}
+
+// DutyShift defines model for DutyShift.
+type DutyShift struct {
+	// CampId Camp ID
+	CampId     openapi_types.UUID `json:"campId"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+	EndDate    time.Time          `json:"endDate"`
+
+	// Id Unique identifier for the shift
+	Id    openapi_types.UUID `json:"id"`
+	Notes *string            `json:"notes,omitempty"`
+
+	// RotationId Rotation the shift was generated from, if any
+	RotationId *openapi_types.UUID `json:"rotationId,omitempty"`
+
+	// StaffCount Staff members needed on the shift
+	StaffCount int `json:"staffCount"`
+
+	// StaffMemberIds Staff members assigned to the shift
+	StaffMemberIds []openapi_types.UUID `json:"staffMemberIds"`
+	StartDate      time.Time            `json:"startDate"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// DutyShiftAssignmentRequest defines model for DutyShiftAssignmentRequest.
+type DutyShiftAssignmentRequest struct {
+	// DutyTypeId Only fill shifts of this duty type
+	DutyTypeId *openapi_types.UUID `json:"dutyTypeId,omitempty"`
+
+	// From First day of the shifts to fill
+	From openapi_types.Date `json:"from"`
+
+	// To Last day of the shifts to fill, at most 92 days after the first
+	To openapi_types.Date `json:"to"`
+}
+
+// DutyShiftAssignmentResult defines model for DutyShiftAssignmentResult.
+type DutyShiftAssignmentResult struct {
+	// Assigned Number of staff assignments made
+	Assigned int `json:"assigned"`
+
+	// OpenSlots Number of positions left open because no staff member was available
+	OpenSlots int `json:"openSlots"`
+
+	// Shifts Shifts that were created or filled
+	Shifts []DutyShift `json:"shifts"`
+}
+
+// DutyShiftCreationRequest defines model for DutyShiftCreationRequest.
+type DutyShiftCreationRequest struct {
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+	EndDate    time.Time          `json:"endDate"`
+	Notes      *string            `json:"notes,omitempty"`
+
+	// StaffCount Staff members needed on the shift; the number of assigned staff members, or 1, when omitted
+	StaffCount *int `json:"staffCount,omitempty"`
+
+	// StaffMemberIds Staff members assigned to the shift
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
+	StartDate      time.Time             `json:"startDate"`
+}
+
+// DutyShiftUpdateRequest defines model for DutyShiftUpdateRequest.
+type DutyShiftUpdateRequest struct {
+	DutyTypeId openapi_types.UUID `json:"dutyTypeId"`
+	EndDate    time.Time          `json:"endDate"`
+	Notes      *string            `json:"notes,omitempty"`
+
+	// StaffCount Staff members needed on the shift; the number of assigned staff members, or 1, when omitted
+	StaffCount *int `json:"staffCount,omitempty"`
+
+	// StaffMemberIds Staff members assigned to the shift
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
+	StartDate      time.Time             `json:"startDate"`
+}
+
+// DutyShiftsListResponse defines model for DutyShiftsListResponse.
+type DutyShiftsListResponse struct {
+	Items []DutyShift `json:"items"`
+}
+
+// DutyType defines model for DutyType.
+type DutyType struct {
+	// CampId Camp ID
+	CampId      openapi_types.UUID `json:"campId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+
+	// Id Unique identifier for the duty type
+	Id openapi_types.UUID `json:"id"`
+
+	// IsTimeOff Whether shifts of this type are time off, such as days off. Staff members on time off are not available
+	// for other duties or events, and time off shifts are never reported as understaffed.
+	IsTimeOff bool `json:"isTimeOff"`
+
+	// MinRestHours Hours a staff member must rest after a shift of this type before their next duty
+	MinRestHours int `json:"minRestHours"`
+
+	// Name Name of the duty, e.g. Night watch
+	Name string `json:"name"`
+
+	// RequiredCertificationIds Certifications every staff member on a shift of this type must hold
+	RequiredCertificationIds []openapi_types.UUID `json:"requiredCertificationIds"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// DutyTypeCreationRequest defines model for DutyTypeCreationRequest.
+type DutyTypeCreationRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// IsTimeOff Whether shifts of this type are time off, such as days off
+	IsTimeOff *bool `json:"isTimeOff,omitempty"`
+
+	// MinRestHours Hours a staff member must rest after a shift of this type before their next duty
+	MinRestHours *int   `json:"minRestHours,omitempty"`
+	Name         string `json:"name"`
+
+	// RequiredCertificationIds Certifications every staff member on a shift of this type must hold
+	RequiredCertificationIds *[]openapi_types.UUID `json:"requiredCertificationIds,omitempty"`
+}
+
+// DutyTypeUpdateRequest defines model for DutyTypeUpdateRequest.
+type DutyTypeUpdateRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// IsTimeOff Whether shifts of this type are time off, such as days off
+	IsTimeOff *bool `json:"isTimeOff,omitempty"`
+
+	// MinRestHours Hours a staff member must rest after a shift of this type before their next duty
+	MinRestHours *int   `json:"minRestHours,omitempty"`
+	Name         string `json:"name"`
+
+	// RequiredCertificationIds Certifications every staff member on a shift of this type must hold
+	RequiredCertificationIds *[]openapi_types.UUID `json:"requiredCertificationIds,omitempty"`
+}
+
+// DutyTypesListResponse defines model for DutyTypesListResponse.
+type DutyTypesListResponse struct {
+	Items []DutyType `json:"items"`
+}
+
+// EligibilityReport defines model for EligibilityReport.
+type EligibilityReport struct {
+	// Events Events with at least one ineligible camper, ordered by start
+	Events []EventEligibility `json:"events"`
+
+	// EventsChecked Events of activities with eligibility rules
+	EventsChecked int                `json:"eventsChecked"`
+	From          openapi_types.Date `json:"from"`
+
+	// IneligibleCount Ineligible campers summed over the events
+	IneligibleCount int                `json:"ineligibleCount"`
+	To              openapi_types.Date `json:"to"`
+}
+
+// EntityCreationRequestMeta defines model for EntityCreationRequestMeta.
+type EntityCreationRequestMeta struct {
+	// Description Description of the entity
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the entity
+	Name string `json:"name"`
+}
+
+// EntityMeta defines model for EntityMeta.
+type EntityMeta struct {
+	// CampId The unique identifier of the camp this entity belongs to
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CreatedAt The date and time the entity was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description The description of the entity
+	Description *string `json:"description,omitempty"`
+
+	// Id The unique identifier of the entity
+	Id openapi_types.UUID `json:"id"`
+
+	// Name The name of the entity
+	Name string `json:"name"`
+
+	// TenantId The unique identifier of the tenant this entity belongs to
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// UpdatedAt The date and time the entity was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// EquipmentCheckout defines model for EquipmentCheckout.
+type EquipmentCheckout struct {
+	CampId       openapi_types.UUID `json:"campId"`
+	CheckedOutAt time.Time          `json:"checkedOutAt"`
+
+	// CheckedOutBy User who checked the units out
+	CheckedOutBy      *openapi_types.UUID `json:"checkedOutBy,omitempty"`
+	CheckedOutByEmail *string             `json:"checkedOutByEmail,omitempty"`
+	CreatedAt         time.Time           `json:"createdAt"`
+
+	// DueAt When the units are expected back
+	DueAt           *time.Time         `json:"dueAt,omitempty"`
+	EquipmentItemId openapi_types.UUID `json:"equipmentItemId"`
+
+	// EventId Event the units are used for
+	EventId  *openapi_types.UUID `json:"eventId,omitempty"`
+	Id       openapi_types.UUID  `json:"id"`
+	Notes    *string             `json:"notes,omitempty"`
+	Quantity int                 `json:"quantity"`
+
+	// ReturnCondition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+	ReturnCondition *EquipmentCondition `json:"returnCondition,omitempty"`
+
+	// ReturnedAt When the units were returned; not set while they are checked out
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+
+	// ReturnedBy User who checked the units in
+	ReturnedBy      *openapi_types.UUID `json:"returnedBy,omitempty"`
+	ReturnedByEmail *string             `json:"returnedByEmail,omitempty"`
+
+	// StaffMemberId Staff member responsible for the units
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+	TenantId      openapi_types.UUID  `json:"tenantId"`
+	UpdatedAt     time.Time           `json:"updatedAt"`
+}
+
+// EquipmentCheckoutRequest defines model for EquipmentCheckoutRequest.
+type EquipmentCheckoutRequest struct {
+	DueAt   *time.Time          `json:"dueAt,omitempty"`
+	EventId *openapi_types.UUID `json:"eventId,omitempty"`
+	Notes   *string             `json:"notes,omitempty"`
+
+	// Quantity Units to check out; at most the available units
+	Quantity      int                 `json:"quantity"`
+	StaffMemberId *openapi_types.UUID `json:"staffMemberId,omitempty"`
+}
+
+// EquipmentCheckoutsListResponse defines model for EquipmentCheckoutsListResponse.
+type EquipmentCheckoutsListResponse struct {
+	Items []EquipmentCheckout `json:"items"`
+}
+
+// EquipmentCondition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+type EquipmentCondition string
+
+// EquipmentConflictsReport defines model for EquipmentConflictsReport.
+type EquipmentConflictsReport struct {
+	// EventsChecked Events of activities that need equipment
+	EventsChecked int                `json:"eventsChecked"`
+	From          openapi_types.Date `json:"from"`
+
+	// Shortages Spans where concurrent events need more units than are available, ordered by start
+	Shortages []EquipmentShortage `json:"shortages"`
+	To        openapi_types.Date  `json:"to"`
+}
+
+// EquipmentItem defines model for EquipmentItem.
+type EquipmentItem struct {
+	// AvailableQuantity Units that can be checked out now; none while the item needs repair or is retired
+	AvailableQuantity int `json:"availableQuantity"`
+
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// Category Free-form grouping such as waterfront or archery
+	Category *string `json:"category,omitempty"`
+
+	// CheckedOutQuantity Units currently checked out and not returned
+	CheckedOutQuantity int `json:"checkedOutQuantity"`
+
+	// Condition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+	Condition   EquipmentCondition `json:"condition"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+
+	// HomeLocationId Location the units are stored at
+	HomeLocationId *openapi_types.UUID `json:"homeLocationId,omitempty"`
+
+	// Id Unique identifier for the equipment item
+	Id    openapi_types.UUID `json:"id"`
+	Name  string             `json:"name"`
+	Notes *string            `json:"notes,omitempty"`
+
+	// Quantity Units the camp owns
+	Quantity int `json:"quantity"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// EquipmentItemCreationRequest defines model for EquipmentItemCreationRequest.
+type EquipmentItemCreationRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Condition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+	Condition      *EquipmentCondition `json:"condition,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	HomeLocationId *openapi_types.UUID `json:"homeLocationId,omitempty"`
+	Name           string              `json:"name"`
+	Notes          *string             `json:"notes,omitempty"`
+	Quantity       int                 `json:"quantity"`
+}
+
+// EquipmentItemUpdateRequest defines model for EquipmentItemUpdateRequest.
+type EquipmentItemUpdateRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Condition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+	Condition      EquipmentCondition  `json:"condition"`
+	Description    *string             `json:"description,omitempty"`
+	HomeLocationId *openapi_types.UUID `json:"homeLocationId,omitempty"`
+	Name           string              `json:"name"`
+	Notes          *string             `json:"notes,omitempty"`
+
+	// Quantity Cannot be below the units currently checked out
+	Quantity int `json:"quantity"`
+}
+
+// EquipmentItemsListResponse defines model for EquipmentItemsListResponse.
+type EquipmentItemsListResponse struct {
+	Items []EquipmentItem `json:"items"`
+}
+
+// EquipmentReturnRequest defines model for EquipmentReturnRequest.
+type EquipmentReturnRequest struct {
+	// Condition State of an equipment item; items that need repair or are retired cannot be checked out or used by events
+	Condition *EquipmentCondition `json:"condition,omitempty"`
+
+	// Notes Replaces the notes of the checkout when given
+	Notes *string `json:"notes,omitempty"`
+}
+
+// EquipmentShortage defines model for EquipmentShortage.
+type EquipmentShortage struct {
+	// AvailableQuantity Usable units, without those checked out for other purposes during the span
+	AvailableQuantity int `json:"availableQuantity"`
+
+	// EndDate End of the span the concurrent events overlap
+	EndDate         time.Time          `json:"endDate"`
+	EquipmentItemId openapi_types.UUID `json:"equipmentItemId"`
+	EquipmentName   string             `json:"equipmentName"`
+
+	// EventIds Concurrent events needing the item
+	EventIds []openapi_types.UUID `json:"eventIds"`
+
+	// Message Human readable description of the shortage
+	Message string `json:"message"`
+
+	// RequiredQuantity Units the concurrent events need together
+	RequiredQuantity int `json:"requiredQuantity"`
+
+	// StartDate Start of the span the concurrent events overlap
+	StartDate time.Time `json:"startDate"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	Meta EntityMeta `json:"meta"`
+	Spec EventSpec  `json:"spec"`
+}
+
+// EventCreationRequest defines model for EventCreationRequest.
+type EventCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec EventSpec                 `json:"spec"`
+}
+
+// EventEligibility defines model for EventEligibility.
+type EventEligibility struct {
+	// ActivityId Activity whose eligibility rules apply; no camper is ineligible for events without one
+	ActivityId *openapi_types.UUID `json:"activityId,omitempty"`
+
+	// CamperCount Enrolled campers of the targeted groups, without the excluded campers
+	CamperCount int                `json:"camperCount"`
+	EndDate     time.Time          `json:"endDate"`
+	EventId     openapi_types.UUID `json:"eventId"`
+	EventName   string             `json:"eventName"`
+
+	// IneligibleCampers Campers who do not meet the activity's eligibility rules, by name
+	IneligibleCampers []IneligibleCamper `json:"ineligibleCampers"`
+	StartDate         time.Time          `json:"startDate"`
+}
+
+// EventRequiredStaffPosition defines model for EventRequiredStaffPosition.
+type EventRequiredStaffPosition struct {
+	// AssignedStaffId ID of the staff member assigned to this position; they must have completed their onboarding
+	AssignedStaffId *openapi_types.UUID `json:"assignedStaffId,omitempty"`
+
+	// PositionName Name of the position required for this event
+	PositionName string `json:"positionName"`
+
+	// RequiredCertificationId ID of the certification required for this position
+	RequiredCertificationId *openapi_types.UUID `json:"requiredCertificationId,omitempty"`
+}
+
+// EventSpec defines model for EventSpec.
+type EventSpec struct {
+	ActivityId *openapi_types.UUID `json:"activityId,omitempty"`
+
+	// Capacity Optional maximum capacity for the event
+	Capacity *int                `json:"capacity,omitempty"`
+	ColorId  *openapi_types.UUID `json:"colorId,omitempty"`
+	EndDate  time.Time           `json:"endDate"`
+
+	// ExcludeCamperIds IDs of campers to exclude from assigned groups
+	ExcludeCamperIds *[]openapi_types.UUID `json:"excludeCamperIds,omitempty"`
+
+	// ExcludeStaffIds IDs of staff members to exclude from assigned groups
+	ExcludeStaffIds *[]openapi_types.UUID `json:"excludeStaffIds,omitempty"`
+
+	// GroupIds IDs of groups assigned to this event
+	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+
+	// IsRecurrenceParent True for the first event in a recurring series
+	IsRecurrenceParent *bool `json:"isRecurrenceParent,omitempty"`
+
+	// LocationId Location of the event; it cannot be out of service for maintenance during the event
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+	ProgramId  *openapi_types.UUID `json:"programId,omitempty"`
+
+	// RecurrenceId Links events in a recurring series together
+	RecurrenceId   *openapi_types.UUID           `json:"recurrenceId,omitempty"`
+	RecurrenceRule *RecurrenceRule               `json:"recurrenceRule,omitempty"`
+	RequiredStaff  *[]EventRequiredStaffPosition `json:"requiredStaff,omitempty"`
+	StartDate      time.Time                     `json:"startDate"`
+}
+
+// EventUpdateRequest defines model for EventUpdateRequest.
+type EventUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec EventSpec                 `json:"spec"`
+}
+
+// EventsListResponse defines model for EventsListResponse.
+type EventsListResponse struct {
+	Items []Event `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Gender Gender of the camper or staff member
+type Gender string
+
+// Group defines model for Group.
+type Group struct {
+	Meta EntityMeta `json:"meta"`
+	Spec GroupSpec  `json:"spec"`
+}
+
+// GroupCreationRequest defines model for GroupCreationRequest.
+type GroupCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec GroupSpec                 `json:"spec"`
+}
+
+// GroupSpec defines model for GroupSpec.
+type GroupSpec struct {
+	// CamperIds Manually selected camper IDs (cannot be used with groupIds).
+	// For rule-based groups this is read-only and lists the campers currently matching the membership rules.
+	CamperIds *[]openapi_types.UUID `json:"camperIds,omitempty"`
+
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// GroupIds Child group IDs for creating nested groups (cannot be used with camperIds, staffIds or membershipRules)
+	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+
+	// HousingRoomId Optional housing room assignment for this group
+	HousingRoomId *openapi_types.UUID `json:"housingRoomId,omitempty"`
+
+	// MembershipRules Filter expressions in the list filter syntax ("field operator value") that campers must all match
+	// to be members of the group. Membership is re-evaluated whenever campers, enrollments or sessions change.
+	// Supported fields are name, gender, birthday, sessionId, housingGroupId, enrollmentStatus and
+	// ageAtSessionStart (age in whole years on the first day of the group's session, or of the camper's
+	// session when the group has none) and skillLevel.<skillTrackId> (position of the highest level of the
+	// skill track the camper passed, starting at 1, or 0 when none). When the group has a session, only campers
+	// actively enrolled in it are considered. Membership is also re-evaluated when skill assessments change.
+	// Cannot be used with groupIds; camperIds sent for a rule-based group are ignored.
+	MembershipRules *[]string `json:"membershipRules,omitempty"`
+
+	// SessionId Optional session this group belongs to
+	SessionId *openapi_types.UUID `json:"sessionId,omitempty"`
+
+	// StaffIds Manually selected staff IDs (cannot be used with groupIds)
+	StaffIds *[]openapi_types.UUID `json:"staffIds,omitempty"`
+}
+
+// GroupUpdateRequest defines model for GroupUpdateRequest.
+type GroupUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec GroupSpec                 `json:"spec"`
+}
+
+// GroupsListResponse defines model for GroupsListResponse.
+type GroupsListResponse struct {
+	Items []Group `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// Guardian defines model for Guardian.
+type Guardian struct {
+	Meta EntityMeta   `json:"meta"`
+	Spec GuardianSpec `json:"spec"`
+}
+
+// GuardianCreationRequest defines model for GuardianCreationRequest.
+type GuardianCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec GuardianSpec              `json:"spec"`
+}
+
+// GuardianNotificationStatus Whether the guardians of the campers involved have been told about the incident
+type GuardianNotificationStatus string
+
+// GuardianPhone defines model for GuardianPhone.
+type GuardianPhone struct {
+	Number string            `json:"number"`
+	Type   GuardianPhoneType `json:"type"`
+}
+
+// GuardianPhoneType defines model for GuardianPhone.Type.
+type GuardianPhoneType string
+
+// GuardianSpec defines model for GuardianSpec.
+type GuardianSpec struct {
+	// Address Postal address of the guardian
+	Address *string `json:"address,omitempty"`
+
+	// AuthorizedPickups Additional people the guardian authorizes to pick up the linked campers
+	AuthorizedPickups *[]AuthorizedPickup `json:"authorizedPickups,omitempty"`
+
+	// CamperIds IDs of the campers this guardian is linked to (siblings share guardians)
+	CamperIds *[]openapi_types.UUID `json:"camperIds,omitempty"`
+
+	// CanPickUp Whether the guardian is allowed to pick up the linked campers
+	CanPickUp *bool `json:"canPickUp,omitempty"`
+
+	// CustodyNotes Custody arrangements or restrictions staff must be aware of
+	CustodyNotes *string              `json:"custodyNotes,omitempty"`
+	Email        *openapi_types.Email `json:"email,omitempty"`
+
+	// EmergencyPriority Order in which emergency contacts are called (1 is called first)
+	EmergencyPriority *int `json:"emergencyPriority,omitempty"`
+
+	// IsEmergencyContact Whether this guardian should be contacted in an emergency
+	IsEmergencyContact *bool `json:"isEmergencyContact,omitempty"`
+
+	// Phones Phone numbers the guardian can be reached at
+	Phones *[]GuardianPhone `json:"phones,omitempty"`
+
+	// Relationship Relationship of the guardian to the campers (e.g. mother, father, grandparent, legal guardian)
+	Relationship string `json:"relationship"`
+}
+
+// GuardianUpdateRequest defines model for GuardianUpdateRequest.
+type GuardianUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec GuardianSpec              `json:"spec"`
+}
+
+// GuardiansListResponse defines model for GuardiansListResponse.
+type GuardiansListResponse struct {
+	Items []Guardian `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// HousingAssignment defines model for HousingAssignment.
+type HousingAssignment struct {
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty"`
+
+	// AcceptedBy ID of the user who accepted the proposal
+	AcceptedBy *openapi_types.UUID `json:"acceptedBy,omitempty"`
+
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CreatedAt Timestamp when the proposal was generated
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy ID of the user who generated the proposal
+	CreatedBy *openapi_types.UUID      `json:"createdBy,omitempty"`
+	Groups    []HousingAssignmentGroup `json:"groups"`
+
+	// Id Unique identifier for the housing assignment proposal
+	Id               openapi_types.UUID     `json:"id"`
+	KeepExisting     bool                   `json:"keepExisting"`
+	MaxAgeSpanMonths *int                   `json:"maxAgeSpanMonths,omitempty"`
+	Score            HousingAssignmentScore `json:"score"`
+	SeparateGenders  bool                   `json:"separateGenders"`
+	SessionId        openapi_types.UUID     `json:"sessionId"`
+
+	// Status State of a housing assignment proposal. Accepting a proposal supersedes the other open proposals of the session.
+	Status HousingAssignmentStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// Unassigned Campers the proposal could not place
+	Unassigned []HousingAssignmentUnassigned `json:"unassigned"`
+
+	// UpdatedAt Timestamp when the proposal was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// HousingAssignmentGroup defines model for HousingAssignmentGroup.
+type HousingAssignmentGroup struct {
+	// Beds Number of beds in the housing room
+	Beds int `json:"beds"`
+
+	// CamperIds IDs of the campers placed in the group
+	CamperIds []openapi_types.UUID `json:"camperIds"`
+
+	// Gender Gender the group houses (omitted while the group is empty or genders are not separated)
+	Gender *string `json:"gender,omitempty"`
+
+	// GroupId ID of the housing group
+	GroupId       openapi_types.UUID `json:"groupId"`
+	GroupName     string             `json:"groupName"`
+	HousingRoomId openapi_types.UUID `json:"housingRoomId"`
+
+	// StaffCount Number of staff counselors in the group, each taking a bed
+	StaffCount int `json:"staffCount"`
+}
+
+// HousingAssignmentRequest defines model for HousingAssignmentRequest.
+type HousingAssignmentRequest struct {
+	// KeepExisting Keep campers that already have a housing group in the session where they are
+	KeepExisting *bool `json:"keepExisting,omitempty"`
+
+	// MaxAgeSpanMonths Largest age difference in months allowed between campers of one housing group (no limit when omitted)
+	MaxAgeSpanMonths *int `json:"maxAgeSpanMonths,omitempty"`
+
+	// SeparateGenders Only house campers of the same gender together, and with counselors of that gender
+	SeparateGenders *bool `json:"separateGenders,omitempty"`
+
+	// SessionId Session whose enrolled campers are placed into its housing groups
+	SessionId openapi_types.UUID `json:"sessionId"`
+}
+
+// HousingAssignmentScore defines model for HousingAssignmentScore.
+type HousingAssignmentScore struct {
+	// CampersWithRequestSatisfied Number of campers who got at least one of their requested bunkmates
+	CampersWithRequestSatisfied int `json:"campersWithRequestSatisfied"`
+
+	// CampersWithRequests Number of campers who made at least one bunk request
+	CampersWithRequests int `json:"campersWithRequests"`
+
+	// RequestsSatisfied Number of bunk requests whose two campers share a housing group
+	RequestsSatisfied int `json:"requestsSatisfied"`
+
+	// RequestsTotal Number of bunk requests between campers of the session
+	RequestsTotal int `json:"requestsTotal"`
+
+	// Satisfaction Percentage of campers with requests who got at least one requested bunkmate (100 when nobody made a request)
+	Satisfaction float64 `json:"satisfaction"`
+}
+
+// HousingAssignmentStatus State of a housing assignment proposal. Accepting a proposal supersedes the other open proposals of the session.
+type HousingAssignmentStatus string
+
+// HousingAssignmentUnassigned defines model for HousingAssignmentUnassigned.
+type HousingAssignmentUnassigned struct {
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// Reason Why the camper could not be placed
+	Reason string `json:"reason"`
+}
+
+// HousingAssignmentsListResponse defines model for HousingAssignmentsListResponse.
+type HousingAssignmentsListResponse struct {
+	Items []HousingAssignment `json:"items"`
+}
+
+// HousingNightOccupancy defines model for HousingNightOccupancy.
+type HousingNightOccupancy struct {
+	// Date Day the night starts on
+	Date openapi_types.Date `json:"date"`
+
+	// Rooms Rooms ordered by name
+	Rooms []HousingRoomOccupancy `json:"rooms"`
+}
+
+// HousingOccupancyReport defines model for HousingOccupancyReport.
+type HousingOccupancyReport struct {
+	From   openapi_types.Date      `json:"from"`
+	Nights []HousingNightOccupancy `json:"nights"`
+	To     openapi_types.Date      `json:"to"`
+}
+
+// HousingRoom defines model for HousingRoom.
+type HousingRoom struct {
+	Meta EntityMeta      `json:"meta"`
+	Spec HousingRoomSpec `json:"spec"`
+}
+
+// HousingRoomCreationRequest defines model for HousingRoomCreationRequest.
+type HousingRoomCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec HousingRoomSpec           `json:"spec"`
+}
+
+// HousingRoomGenderPolicy Who may sleep in the room on the same night. single_gender allows either gender as long as everyone in the room
+// shares it; male and female fix the gender of the room.
+type HousingRoomGenderPolicy string
+
+// HousingRoomOccupancy defines model for HousingRoomOccupancy.
+type HousingRoomOccupancy struct {
+	// Available Beds still free for the night
+	Available int `json:"available"`
+
+	// Beds Beds of the room
+	Beds            int                `json:"beds"`
+	CamperCount     int                `json:"camperCount"`
+	HousingRoomId   openapi_types.UUID `json:"housingRoomId"`
+	HousingRoomName string             `json:"housingRoomName"`
+
+	// Occupied Beds assigned for the night
+	Occupied   int `json:"occupied"`
+	StaffCount int `json:"staffCount"`
+}
+
+// HousingRoomSpec defines model for HousingRoomSpec.
+type HousingRoomSpec struct {
+	// AreaId ID of the physical area where this housing room is located
+	AreaId *openapi_types.UUID `json:"areaId,omitempty"`
+
+	// Bathroom Type of bathroom for this housing room
+	Bathroom *HousingRoomSpecBathroom `json:"bathroom,omitempty"`
+
+	// Beds Number of beds in this housing room
+	Beds int `json:"beds"`
+
+	// GenderPolicy Who may sleep in the room on the same night. single_gender allows either gender as long as everyone in the room
+	// shares it; male and female fix the gender of the room.
+	GenderPolicy *HousingRoomGenderPolicy `json:"genderPolicy,omitempty"`
+}
+
+// HousingRoomSpecBathroom Type of bathroom for this housing room
+type HousingRoomSpecBathroom string
+
+// HousingRoomUpdateRequest defines model for HousingRoomUpdateRequest.
+type HousingRoomUpdateRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec HousingRoomSpec           `json:"spec"`
+}
+
+// HousingRoomsListResponse defines model for HousingRoomsListResponse.
+type HousingRoomsListResponse struct {
+	Items []HousingRoom `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// ImportEntityType Type of entity being imported
+type ImportEntityType string
+
+// ImportJob defines model for ImportJob.
+type ImportJob struct {
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CreatedAt Timestamp when the job was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EntityType Type of entity being imported
+	EntityType ImportEntityType `json:"entityType"`
+
+	// ErrorCount Number of rows that failed to import
+	ErrorCount int `json:"errorCount"`
+
+	// FilePath Path to the uploaded CSV file
+	FilePath string `json:"filePath"`
+
+	// Id Unique identifier for the import job
+	Id openapi_types.UUID `json:"id"`
+
+	// Mode Import mode - create only creates new entities, upsert creates or updates existing entities
+	Mode ImportMode `json:"mode"`
+
+	// ProcessedRows Number of rows processed so far
+	ProcessedRows int `json:"processedRows"`
+
+	// Status Status of an import job
+	Status ImportJobStatus `json:"status"`
+
+	// SuccessCount Number of successfully imported rows
+	SuccessCount int `json:"successCount"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// TotalRows Total number of rows in the CSV
+	TotalRows int `json:"totalRows"`
+
+	// UpdatedAt Timestamp when the job was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// ValidationErrors List of validation errors
+	ValidationErrors *[]ValidationError `json:"validationErrors,omitempty"`
+}
+
+// ImportJobStatus Status of an import job
+type ImportJobStatus string
+
+// ImportJobsListResponse defines model for ImportJobsListResponse.
+type ImportJobsListResponse struct {
+	Items []ImportJob `json:"items"`
+
+	// Limit Maximum number of items returned
+	Limit int `json:"limit"`
+
+	// Offset Number of items skipped
+	Offset int `json:"offset"`
+
+	// Total Total number of import jobs
+	Total int `json:"total"`
+}
+
+// ImportMode Import mode - create only creates new entities, upsert creates or updates existing entities
+type ImportMode string
+
+// Incident defines model for Incident.
+type Incident struct {
+	Meta EntityMeta   `json:"meta"`
+	Spec IncidentSpec `json:"spec"`
+}
+
+// IncidentCreationRequest defines model for IncidentCreationRequest.
+type IncidentCreationRequest struct {
+	Meta EntityCreationRequestMeta `json:"meta"`
+	Spec IncidentMutationSpec      `json:"spec"`
+}
+
+// IncidentFollowUpTask defines model for IncidentFollowUpTask.
+type IncidentFollowUpTask struct {
+	// AssigneeId ID of the staff member responsible for the task
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+
+	// Completed Whether the task is done
+	Completed *bool `json:"completed,omitempty"`
+
+	// CompletedAt Timestamp when the task was completed (set automatically when completed)
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Description What needs to be done
+	Description string `json:"description"`
+
+	// DueDate Date the task should be done by
+	DueDate *openapi_types.Date `json:"dueDate,omitempty"`
+}
+
+// IncidentMutationSpec defines model for IncidentMutationSpec.
+type IncidentMutationSpec struct {
+	// ActionsTaken First aid and other actions taken in response
+	ActionsTaken *string `json:"actionsTaken,omitempty"`
+
+	// ActivityId ID of the activity during which the incident happened (taken from the event when omitted)
+	ActivityId *openapi_types.UUID `json:"activityId,omitempty"`
+
+	// CamperIds IDs of the campers involved
+	CamperIds *[]openapi_types.UUID `json:"camperIds,omitempty"`
+
+	// EventId ID of the event during which the incident happened
+	EventId       *openapi_types.UUID     `json:"eventId,omitempty"`
+	FollowUpTasks *[]IncidentFollowUpTask `json:"followUpTasks,omitempty"`
+
+	// GuardianNotificationNotes Who was notified and how
+	GuardianNotificationNotes *string `json:"guardianNotificationNotes,omitempty"`
+
+	// GuardianNotificationStatus Whether the guardians of the campers involved have been told about the incident
+	GuardianNotificationStatus *GuardianNotificationStatus `json:"guardianNotificationStatus,omitempty"`
+
+	// GuardiansNotifiedAt When the guardians were notified
+	GuardiansNotifiedAt *time.Time `json:"guardiansNotifiedAt,omitempty"`
+
+	// LocationId ID of the location where the incident happened
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+
+	// Narrative What happened
+	Narrative string `json:"narrative"`
+
+	// OccurredAt When the incident happened
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// Severity How serious the incident was
+	Severity IncidentSeverity `json:"severity"`
+
+	// StaffMemberIds IDs of the staff members involved or witnessing
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
+
+	// Type Kind of incident being reported
+	Type IncidentType `json:"type"`
+}
+
+// IncidentReport defines model for IncidentReport.
+type IncidentReport struct {
+	Buckets []IncidentReportBucket `json:"buckets"`
+	From    *openapi_types.Date    `json:"from,omitempty"`
+
+	// GroupBy How incidents are grouped in an incident report
+	GroupBy  IncidentReportGrouping `json:"groupBy"`
+	Severity IncidentSeverityCounts `json:"severity"`
+	To       *openapi_types.Date    `json:"to,omitempty"`
+
+	// Total Number of incidents in the period
+	Total int `json:"total"`
+}
+
+// IncidentReportBucket defines model for IncidentReportBucket.
+type IncidentReportBucket struct {
+	// Key Location or activity ID, or the first day of the week (empty when incidents have no location or activity)
+	Key string `json:"key"`
+
+	// Label Location or activity name, or the first day of the week
+	Label    string                 `json:"label"`
+	Severity IncidentSeverityCounts `json:"severity"`
+
+	// Total Number of incidents in the bucket
+	Total int `json:"total"`
+}
+
+// IncidentReportGrouping How incidents are grouped in an incident report
+type IncidentReportGrouping string
+
+// IncidentReviewRequest defines model for IncidentReviewRequest.
+type IncidentReviewRequest struct {
+	// Comment Reviewer comment, e.g. what needs to change before approval
+	Comment *string `json:"comment,omitempty"`
+
+	// Status Review decision
+	Status IncidentReviewRequestStatus `json:"status"`
+}
+
+// IncidentReviewRequestStatus Review decision
+type IncidentReviewRequestStatus string
+
+// IncidentSeverity How serious the incident was
+type IncidentSeverity string
+
+// IncidentSeverityCounts defines model for IncidentSeverityCounts.
+type IncidentSeverityCounts struct {
+	Critical int `json:"critical"`
+	Minor    int `json:"minor"`
+	Moderate int `json:"moderate"`
+	Serious  int `json:"serious"`
+}
+
+// IncidentSpec defines model for IncidentSpec.
+type IncidentSpec struct {
+	// ActionsTaken First aid and other actions taken in response
+	ActionsTaken *string `json:"actionsTaken,omitempty"`
+
+	// ActivityId ID of the activity during which the incident happened (taken from the event when omitted)
+	ActivityId *openapi_types.UUID `json:"activityId,omitempty"`
+
+	// CamperIds IDs of the campers involved
+	CamperIds *[]openapi_types.UUID `json:"camperIds,omitempty"`
+
+	// EventId ID of the event during which the incident happened
+	EventId       *openapi_types.UUID     `json:"eventId,omitempty"`
+	FollowUpTasks *[]IncidentFollowUpTask `json:"followUpTasks,omitempty"`
+
+	// GuardianNotificationNotes Who was notified and how
+	GuardianNotificationNotes *string `json:"guardianNotificationNotes,omitempty"`
+
+	// GuardianNotificationStatus Whether the guardians of the campers involved have been told about the incident
+	GuardianNotificationStatus GuardianNotificationStatus `json:"guardianNotificationStatus"`
+
+	// GuardiansNotifiedAt When the guardians were notified
+	GuardiansNotifiedAt *time.Time `json:"guardiansNotifiedAt,omitempty"`
+
+	// LocationId ID of the location where the incident happened
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+
+	// Narrative What happened
+	Narrative string `json:"narrative"`
+
+	// OccurredAt When the incident happened
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// ReportedBy ID of the user who filed the report
+	ReportedBy *openapi_types.UUID `json:"reportedBy,omitempty"`
+
+	// ReportedByEmail Email of the user who filed the report
+	ReportedByEmail *string `json:"reportedByEmail,omitempty"`
+
+	// ReviewComment Comment left with the last review
+	ReviewComment *string `json:"reviewComment,omitempty"`
+
+	// ReviewedAt Timestamp of the last review
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+
+	// ReviewedBy ID of the user who last reviewed the report
+	ReviewedBy *openapi_types.UUID `json:"reviewedBy,omitempty"`
+
+	// ReviewedByEmail Email of the user who last reviewed the report
+	ReviewedByEmail *string `json:"reviewedByEmail,omitempty"`
+
+	// Severity How serious the incident was
+	Severity IncidentSeverity `json:"severity"`
+
+	// StaffMemberIds IDs of the staff members involved or witnessing
+	StaffMemberIds *[]openapi_types.UUID `json:"staffMemberIds,omitempty"`
 
-	// GroupIds IDs of the groups this camper belongs to
-	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+	// Status Review state of an incident report
+	Status IncidentStatus `json:"status"`
 
-	// HousingGroupId ID of the housing group this camper belongs to (auto-populated from groupIds)
-	HousingGroupId *openapi_types.UUID `json:"housingGroupId,omitempty"`
+	// SubmittedAt Timestamp when the report was last submitted for review
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
 
-	// SessionId ID of the camp session this camper is registered in
-	SessionId openapi_types.UUID `json:"sessionId"`
+	// Type Kind of incident being reported
+	Type IncidentType `json:"type"`
 }
 
-// CamperUpdateRequest defines model for CamperUpdateRequest.
-type CamperUpdateRequest struct {
+// IncidentStatus Review state of an incident report
+type IncidentStatus string
+
+// IncidentType Kind of incident being reported
+type IncidentType string
+
+// IncidentUpdateRequest defines model for IncidentUpdateRequest.
+type IncidentUpdateRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CamperMutationSpec        `json:"spec"`
+	Spec IncidentMutationSpec      `json:"spec"`
 }
 
-// CampersListResponse defines model for CampersListResponse.
-type CampersListResponse struct {
-	Items []Camper `json:"items"`
+// IncidentsListResponse defines model for IncidentsListResponse.
+type IncidentsListResponse struct {
+	Items []Incident `json:"items"`
 
 	// Limit Number of items per page
 	Limit int `json:"limit"`
@@ -686,10 +3448,17 @@ type CampersListResponse struct {
 	Total int `json:"total"`
 }
 
-// CampsListResponse defines model for CampsListResponse.
-type CampsListResponse struct {
-	Items []Camp `json:"items"`
+// IneligibleCamper defines model for IneligibleCamper.
+type IneligibleCamper struct {
+	CamperId   openapi_types.UUID `json:"camperId"`
+	CamperName string             `json:"camperName"`
+
+	// Reasons Human readable eligibility rules the camper does not meet
+	Reasons []string `json:"reasons"`
+}
 
+// ListResponseBase defines model for ListResponseBase.
+type ListResponseBase struct {
 	// Limit Number of items per page
 	Limit int `json:"limit"`
 
@@ -703,30 +3472,36 @@ type CampsListResponse struct {
 	Total int `json:"total"`
 }
 
-// Certification defines model for Certification.
-type Certification struct {
-	Meta EntityMeta        `json:"meta"`
-	Spec CertificationSpec `json:"spec"`
+// Location defines model for Location.
+type Location struct {
+	Meta EntityMeta   `json:"meta"`
+	Spec LocationSpec `json:"spec"`
 }
 
-// CertificationCreationRequest defines model for CertificationCreationRequest.
-type CertificationCreationRequest struct {
+// LocationCreationRequest defines model for LocationCreationRequest.
+type LocationCreationRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CertificationSpec         `json:"spec"`
+	Spec LocationSpec              `json:"spec"`
 }
 
-// CertificationSpec defines model for CertificationSpec.
-type CertificationSpec = map[string]interface{}
+// LocationSpec defines model for LocationSpec.
+type LocationSpec struct {
+	// AreaId ID of the physical area where this location is situated
+	AreaId    *openapi_types.UUID `json:"areaId,omitempty"`
+	Capacity  *int                `json:"capacity,omitempty"`
+	Equipment *[]string           `json:"equipment,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
+}
 
-// CertificationUpdateRequest defines model for CertificationUpdateRequest.
-type CertificationUpdateRequest struct {
+// LocationUpdateRequest defines model for LocationUpdateRequest.
+type LocationUpdateRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec CertificationSpec         `json:"spec"`
+	Spec LocationSpec              `json:"spec"`
 }
 
-// CertificationsListResponse defines model for CertificationsListResponse.
-type CertificationsListResponse struct {
-	Items []Certification `json:"items"`
+// LocationsListResponse defines model for LocationsListResponse.
+type LocationsListResponse struct {
+	Items []Location `json:"items"`
 
 	// Limit Number of items per page
 	Limit int `json:"limit"`
@@ -741,202 +3516,369 @@ type CertificationsListResponse struct {
 	Total int `json:"total"`
 }
 
-// Color defines model for Color.
-type Color struct {
-	Meta EntityMeta `json:"meta"`
-	Spec ColorSpec  `json:"spec"`
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	// Email User's email address
+	Email openapi_types.Email `json:"email"`
+
+	// Password User's password
+	Password string `json:"password"`
 }
 
-// ColorCreationRequest defines model for ColorCreationRequest.
-type ColorCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec ColorSpec                 `json:"spec"`
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	// Token Authentication token (placeholder for future implementation)
+	Token string `json:"token"`
+	User  User   `json:"user"`
 }
 
-// ColorSpec defines model for ColorSpec.
-type ColorSpec struct {
-	// Default Whether this is the default color for events
-	Default *bool `json:"default,omitempty"`
+// MaintenanceTicket defines model for MaintenanceTicket.
+type MaintenanceTicket struct {
+	// AssigneeId Staff member handling the ticket
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
 
-	// HexValue Hex color value (e.g., "#FF5733")
-	HexValue string `json:"hexValue"`
+	// CampId Camp ID
+	CampId      openapi_types.UUID `json:"campId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+
+	// EntityId ID of the location, housing room or area needing maintenance
+	EntityId openapi_types.UUID `json:"entityId"`
+
+	// EntityType Kind of facility a maintenance ticket is about
+	EntityType MaintenanceTicketEntityType `json:"entityType"`
+
+	// Id Unique identifier for the maintenance ticket
+	Id openapi_types.UUID `json:"id"`
+
+	// OutOfServiceFrom Start of the period the facility cannot be used; not out of service when omitted
+	OutOfServiceFrom *time.Time `json:"outOfServiceFrom,omitempty"`
+
+	// OutOfServiceUntil End of the period the facility cannot be used; until the ticket is resolved when omitted
+	OutOfServiceUntil *time.Time `json:"outOfServiceUntil,omitempty"`
+
+	// Priority How soon a maintenance ticket needs to be handled
+	Priority MaintenanceTicketPriority `json:"priority"`
+
+	// ReportedBy User who reported the problem
+	ReportedBy      *openapi_types.UUID `json:"reportedBy,omitempty"`
+	ReportedByEmail *string             `json:"reportedByEmail,omitempty"`
+
+	// ResolvedAt When the ticket was resolved or closed
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+
+	// Status Progress of a maintenance ticket; resolved and closed tickets no longer take their facility out of service
+	Status MaintenanceTicketStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// Title Short summary of the problem, e.g. "Cabin door does not lock"
+	Title     string    `json:"title"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// ColorUpdateRequest defines model for ColorUpdateRequest.
-type ColorUpdateRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec ColorSpec                 `json:"spec"`
+// MaintenanceTicketCreationRequest defines model for MaintenanceTicketCreationRequest.
+type MaintenanceTicketCreationRequest struct {
+	AssigneeId  *openapi_types.UUID `json:"assigneeId,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	EntityId    openapi_types.UUID  `json:"entityId"`
+
+	// EntityType Kind of facility a maintenance ticket is about
+	EntityType        MaintenanceTicketEntityType `json:"entityType"`
+	OutOfServiceFrom  *time.Time                  `json:"outOfServiceFrom,omitempty"`
+	OutOfServiceUntil *time.Time                  `json:"outOfServiceUntil,omitempty"`
+
+	// Priority How soon a maintenance ticket needs to be handled
+	Priority *MaintenanceTicketPriority `json:"priority,omitempty"`
+	Title    string                     `json:"title"`
 }
 
-// ColorsListResponse defines model for ColorsListResponse.
-type ColorsListResponse struct {
-	Items []Color `json:"items"`
+// MaintenanceTicketEntityType Kind of facility a maintenance ticket is about
+type MaintenanceTicketEntityType string
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+// MaintenanceTicketPriority How soon a maintenance ticket needs to be handled
+type MaintenanceTicketPriority string
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+// MaintenanceTicketStatus Progress of a maintenance ticket; resolved and closed tickets no longer take their facility out of service
+type MaintenanceTicketStatus string
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+// MaintenanceTicketUpdateRequest defines model for MaintenanceTicketUpdateRequest.
+type MaintenanceTicketUpdateRequest struct {
+	AssigneeId        *openapi_types.UUID `json:"assigneeId,omitempty"`
+	Description       *string             `json:"description,omitempty"`
+	OutOfServiceFrom  *time.Time          `json:"outOfServiceFrom,omitempty"`
+	OutOfServiceUntil *time.Time          `json:"outOfServiceUntil,omitempty"`
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
+	// Priority How soon a maintenance ticket needs to be handled
+	Priority MaintenanceTicketPriority `json:"priority"`
+
+	// Status Progress of a maintenance ticket; resolved and closed tickets no longer take their facility out of service
+	Status MaintenanceTicketStatus `json:"status"`
+	Title  string                  `json:"title"`
 }
 
-// EntityCreationRequestMeta defines model for EntityCreationRequestMeta.
-type EntityCreationRequestMeta struct {
-	// Description Description of the entity
-	Description *string `json:"description,omitempty"`
+// MaintenanceTicketsListResponse defines model for MaintenanceTicketsListResponse.
+type MaintenanceTicketsListResponse struct {
+	Items []MaintenanceTicket `json:"items"`
+}
 
-	// Name Name of the entity
-	Name string `json:"name"`
+// MarCamperReport defines model for MarCamperReport.
+type MarCamperReport struct {
+	CamperId   openapi_types.UUID `json:"camperId"`
+	CamperName string             `json:"camperName"`
+	Doses      []DueDose          `json:"doses"`
+	Summary    MarSummary         `json:"summary"`
 }
 
-// EntityMeta defines model for EntityMeta.
-type EntityMeta struct {
-	// CampId The unique identifier of the camp this entity belongs to
-	CampId openapi_types.UUID `json:"campId"`
+// MarReport defines model for MarReport.
+type MarReport struct {
+	// CamperId Camper the report was generated for
+	CamperId *openapi_types.UUID `json:"camperId,omitempty"`
+	Campers  []MarCamperReport   `json:"campers"`
+	Date     openapi_types.Date  `json:"date"`
 
-	// CreatedAt The date and time the entity was created
-	CreatedAt time.Time `json:"createdAt"`
+	// SessionId Session the report was generated for
+	SessionId *openapi_types.UUID `json:"sessionId,omitempty"`
+	Summary   MarSummary          `json:"summary"`
+}
 
-	// Description The description of the entity
-	Description *string `json:"description,omitempty"`
+// MarSummary defines model for MarSummary.
+type MarSummary struct {
+	Given  int `json:"given"`
+	Missed int `json:"missed"`
 
-	// Id The unique identifier of the entity
-	Id openapi_types.UUID `json:"id"`
+	// Overdue Number of pending doses that are overdue
+	Overdue int `json:"overdue"`
+	Pending int `json:"pending"`
+	Refused int `json:"refused"`
+}
 
-	// Name The name of the entity
-	Name string `json:"name"`
+// MealHeadcount defines model for MealHeadcount.
+type MealHeadcount struct {
+	// Allergens People at the meal per allergen
+	Allergens []AllergenCount `json:"allergens"`
 
-	// TenantId The unique identifier of the tenant this entity belongs to
-	TenantId openapi_types.UUID `json:"tenantId"`
+	// Campers Number of campers at the meal
+	Campers int `json:"campers"`
 
-	// UpdatedAt The date and time the entity was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
-}
+	// Diets Meals to prepare per diet
+	Diets          []DietCount        `json:"diets"`
+	EndTime        string             `json:"endTime"`
+	MealPeriodId   openapi_types.UUID `json:"mealPeriodId"`
+	MealPeriodName string             `json:"mealPeriodName"`
 
-// Event defines model for Event.
-type Event struct {
-	Meta EntityMeta `json:"meta"`
-	Spec EventSpec  `json:"spec"`
+	// MenuId Menu planned for the meal, if any
+	MenuId *openapi_types.UUID `json:"menuId,omitempty"`
+
+	// Staff Number of staff members at the meal
+	Staff     int    `json:"staff"`
+	StartTime string `json:"startTime"`
+
+	// Total Number of meals to prepare
+	Total int `json:"total"`
+
+	// Warnings Campers at the meal allergic to a dish on the menu
+	Warnings []AllergenWarning `json:"warnings"`
 }
 
-// EventCreationRequest defines model for EventCreationRequest.
-type EventCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec EventSpec                 `json:"spec"`
+// MealHeadcountBasis How campers were counted. attendance counts the campers on site at the meal according to the day's
+// check-ins and check-outs; enrollment counts the campers enrolled in a session covering the day and is
+// used for days without attendance records, such as upcoming days.
+type MealHeadcountBasis string
+
+// MealHeadcountReport defines model for MealHeadcountReport.
+type MealHeadcountReport struct {
+	// Basis How campers were counted. attendance counts the campers on site at the meal according to the day's
+	// check-ins and check-outs; enrollment counts the campers enrolled in a session covering the day and is
+	// used for days without attendance records, such as upcoming days.
+	Basis MealHeadcountBasis `json:"basis"`
+	Date  openapi_types.Date `json:"date"`
+
+	// Meals Headcounts per meal period in the order of the day
+	Meals []MealHeadcount `json:"meals"`
 }
 
-// EventRequiredStaffPosition defines model for EventRequiredStaffPosition.
-type EventRequiredStaffPosition struct {
-	// AssignedStaffId ID of the staff member assigned to this position
-	AssignedStaffId *openapi_types.UUID `json:"assignedStaffId,omitempty"`
+// MealPeriod defines model for MealPeriod.
+type MealPeriod struct {
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	CreatedAt time.Time          `json:"createdAt"`
 
-	// PositionName Name of the position required for this event
-	PositionName string `json:"positionName"`
+	// EndTime Time the meal ends every day (HH:MM)
+	EndTime string `json:"endTime"`
 
-	// RequiredCertificationId ID of the certification required for this position
-	RequiredCertificationId *openapi_types.UUID `json:"requiredCertificationId,omitempty"`
+	// Id Unique identifier for the meal period
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the meal, e.g. Breakfast
+	Name string `json:"name"`
+
+	// Position Display order of the meal within the day
+	Position int `json:"position"`
+
+	// StartTime Time the meal starts every day (HH:MM)
+	StartTime string `json:"startTime"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
 }
 
-// EventSpec defines model for EventSpec.
-type EventSpec struct {
-	ActivityId *openapi_types.UUID `json:"activityId,omitempty"`
+// MealPeriodCreationRequest defines model for MealPeriodCreationRequest.
+type MealPeriodCreationRequest struct {
+	// EndTime Time the meal ends every day (HH:MM)
+	EndTime string `json:"endTime"`
+	Name    string `json:"name"`
 
-	// Capacity Optional maximum capacity for the event
-	Capacity *int                `json:"capacity,omitempty"`
-	ColorId  *openapi_types.UUID `json:"colorId,omitempty"`
-	EndDate  time.Time           `json:"endDate"`
+	// Position Display order of the meal within the day
+	Position *int `json:"position,omitempty"`
 
-	// ExcludeCamperIds IDs of campers to exclude from assigned groups
-	ExcludeCamperIds *[]openapi_types.UUID `json:"excludeCamperIds,omitempty"`
+	// StartTime Time the meal starts every day (HH:MM)
+	StartTime string `json:"startTime"`
+}
 
-	// ExcludeStaffIds IDs of staff members to exclude from assigned groups
-	ExcludeStaffIds *[]openapi_types.UUID `json:"excludeStaffIds,omitempty"`
+// MealPeriodUpdateRequest defines model for MealPeriodUpdateRequest.
+type MealPeriodUpdateRequest struct {
+	// EndTime Time the meal ends every day (HH:MM)
+	EndTime string `json:"endTime"`
+	Name    string `json:"name"`
 
-	// GroupIds IDs of groups assigned to this event
-	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+	// Position Display order of the meal within the day
+	Position *int `json:"position,omitempty"`
 
-	// IsRecurrenceParent True for the first event in a recurring series
-	IsRecurrenceParent *bool               `json:"isRecurrenceParent,omitempty"`
-	LocationId         *openapi_types.UUID `json:"locationId,omitempty"`
-	ProgramId          *openapi_types.UUID `json:"programId,omitempty"`
+	// StartTime Time the meal starts every day (HH:MM)
+	StartTime string `json:"startTime"`
+}
 
-	// RecurrenceId Links events in a recurring series together
-	RecurrenceId   *openapi_types.UUID           `json:"recurrenceId,omitempty"`
-	RecurrenceRule *RecurrenceRule               `json:"recurrenceRule,omitempty"`
-	RequiredStaff  *[]EventRequiredStaffPosition `json:"requiredStaff,omitempty"`
-	StartDate      time.Time                     `json:"startDate"`
+// MealPeriodsListResponse defines model for MealPeriodsListResponse.
+type MealPeriodsListResponse struct {
+	Items []MealPeriod `json:"items"`
 }
 
-// EventUpdateRequest defines model for EventUpdateRequest.
-type EventUpdateRequest struct {
+// Medication defines model for Medication.
+type Medication struct {
+	Meta EntityMeta     `json:"meta"`
+	Spec MedicationSpec `json:"spec"`
+}
+
+// MedicationCreationRequest defines model for MedicationCreationRequest.
+type MedicationCreationRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec EventSpec                 `json:"spec"`
+	Spec MedicationSpec            `json:"spec"`
 }
 
-// EventsListResponse defines model for EventsListResponse.
-type EventsListResponse struct {
-	Items []Event `json:"items"`
+// MedicationDose defines model for MedicationDose.
+type MedicationDose struct {
+	// AdministeredAt When the dose was given or refused
+	AdministeredAt *time.Time `json:"administeredAt,omitempty"`
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+	// CamperId ID of the camper
+	CamperId openapi_types.UUID `json:"camperId"`
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+	// CreatedAt Timestamp when the record was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
-}
+	// Id Unique identifier for the dose record
+	Id openapi_types.UUID `json:"id"`
 
-// Gender Gender of the camper or staff member
-type Gender string
+	// Initials Initials of the health staff member who recorded the dose
+	Initials string `json:"initials"`
 
-// Group defines model for Group.
-type Group struct {
-	Meta EntityMeta `json:"meta"`
-	Spec GroupSpec  `json:"spec"`
+	// MedicationId ID of the medication
+	MedicationId openapi_types.UUID `json:"medicationId"`
+
+	// Notes Notes about the dose (e.g. reason for refusal)
+	Notes *string `json:"notes,omitempty"`
+
+	// RecordedAt Timestamp when the dose was recorded
+	RecordedAt time.Time `json:"recordedAt"`
+
+	// RecordedBy ID of the user who recorded the dose
+	RecordedBy *openapi_types.UUID `json:"recordedBy,omitempty"`
+
+	// ScheduledFor Scheduled time of the dose (omitted for as-needed doses)
+	ScheduledFor *time.Time `json:"scheduledFor,omitempty"`
+
+	// Status Outcome recorded for a medication dose
+	Status MedicationDoseStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId openapi_types.UUID `json:"tenantId"`
+
+	// UpdatedAt Timestamp when the record was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GroupCreationRequest defines model for GroupCreationRequest.
-type GroupCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec GroupSpec                 `json:"spec"`
+// MedicationDoseRequest defines model for MedicationDoseRequest.
+type MedicationDoseRequest struct {
+	// AdministeredAt When the dose was given or refused (defaults to now)
+	AdministeredAt *time.Time `json:"administeredAt,omitempty"`
+
+	// Initials Initials of the health staff member recording the dose
+	Initials string `json:"initials"`
+
+	// MedicationId ID of the medication
+	MedicationId openapi_types.UUID `json:"medicationId"`
+
+	// Notes Notes about the dose (e.g. reason for refusal)
+	Notes *string `json:"notes,omitempty"`
+
+	// ScheduledFor Scheduled time of the dose being recorded (omit for as-needed doses)
+	ScheduledFor *time.Time `json:"scheduledFor,omitempty"`
+
+	// Status Outcome recorded for a medication dose
+	Status MedicationDoseStatus `json:"status"`
 }
 
-// GroupSpec defines model for GroupSpec.
-type GroupSpec struct {
-	// CamperIds Manually selected camper IDs (cannot be used with groupIds)
-	CamperIds *[]openapi_types.UUID `json:"camperIds,omitempty"`
+// MedicationDoseStatus Outcome recorded for a medication dose
+type MedicationDoseStatus string
 
-	// GroupIds Child group IDs for creating nested groups (cannot be used with camperIds or staffIds)
-	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
+// MedicationSpec defines model for MedicationSpec.
+type MedicationSpec struct {
+	// AsNeeded Whether the medication is only given as needed (PRN), in which case no doses are scheduled
+	AsNeeded *bool `json:"asNeeded,omitempty"`
 
-	// HousingRoomId Optional housing room assignment for this group
-	HousingRoomId *openapi_types.UUID `json:"housingRoomId,omitempty"`
+	// CamperId ID of the camper the medication is prescribed to
+	CamperId openapi_types.UUID `json:"camperId"`
+
+	// DaysOfWeek Days of the week doses are due. If empty or not provided, doses are due every day.
+	DaysOfWeek *[]MedicationSpecDaysOfWeek `json:"daysOfWeek,omitempty"`
+
+	// Dosage Amount given per dose
+	Dosage string `json:"dosage"`
+
+	// EndDate Last day doses are due (defaults to every day of camp)
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
+
+	// Instructions Administration instructions (e.g. take with food)
+	Instructions *string `json:"instructions,omitempty"`
+
+	// Route How the medication is taken (oral, inhaled, topical, injection, ...)
+	Route *string `json:"route,omitempty"`
 
-	// SessionId Optional session this group belongs to
-	SessionId *openapi_types.UUID `json:"sessionId,omitempty"`
+	// ScheduleTimes Times of day a dose is due (24-hour format HH:MM, camp local time)
+	ScheduleTimes *[]string `json:"scheduleTimes,omitempty"`
 
-	// StaffIds Manually selected staff IDs (cannot be used with groupIds)
-	StaffIds *[]openapi_types.UUID `json:"staffIds,omitempty"`
+	// StartDate First day doses are due (defaults to every day of camp)
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
 }
 
-// GroupUpdateRequest defines model for GroupUpdateRequest.
-type GroupUpdateRequest struct {
+// MedicationSpecDaysOfWeek defines model for MedicationSpec.DaysOfWeek.
+type MedicationSpecDaysOfWeek string
+
+// MedicationUpdateRequest defines model for MedicationUpdateRequest.
+type MedicationUpdateRequest struct {
 	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec GroupSpec                 `json:"spec"`
+	Spec MedicationSpec            `json:"spec"`
 }
 
-// GroupsListResponse defines model for GroupsListResponse.
-type GroupsListResponse struct {
-	Items []Group `json:"items"`
+// MedicationsListResponse defines model for MedicationsListResponse.
+type MedicationsListResponse struct {
+	Items []Medication `json:"items"`
 
 	// Limit Number of items per page
 	Limit int `json:"limit"`
@@ -951,197 +3893,308 @@ type GroupsListResponse struct {
 	Total int `json:"total"`
 }
 
-// HousingRoom defines model for HousingRoom.
-type HousingRoom struct {
-	Meta EntityMeta      `json:"meta"`
-	Spec HousingRoomSpec `json:"spec"`
-}
+// Menu defines model for Menu.
+type Menu struct {
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	CreatedAt time.Time          `json:"createdAt"`
 
-// HousingRoomCreationRequest defines model for HousingRoomCreationRequest.
-type HousingRoomCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec HousingRoomSpec           `json:"spec"`
-}
+	// Date Day the menu is served
+	Date openapi_types.Date `json:"date"`
 
-// HousingRoomSpec defines model for HousingRoomSpec.
-type HousingRoomSpec struct {
-	// AreaId ID of the physical area where this housing room is located
-	AreaId *openapi_types.UUID `json:"areaId,omitempty"`
+	// Id Unique identifier for the menu
+	Id    openapi_types.UUID `json:"id"`
+	Items []MenuItem         `json:"items"`
 
-	// Bathroom Type of bathroom for this housing room
-	Bathroom *HousingRoomSpecBathroom `json:"bathroom,omitempty"`
+	// MealPeriodId Meal period the menu is served at
+	MealPeriodId openapi_types.UUID `json:"mealPeriodId"`
 
-	// Beds Number of beds in this housing room
-	Beds int `json:"beds"`
+	// Notes Notes for the kitchen
+	Notes *string `json:"notes,omitempty"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
 }
 
-// HousingRoomSpecBathroom Type of bathroom for this housing room
-type HousingRoomSpecBathroom string
+// MenuCreationRequest defines model for MenuCreationRequest.
+type MenuCreationRequest struct {
+	// Date Day the menu is served
+	Date  openapi_types.Date `json:"date"`
+	Items []MenuItem         `json:"items"`
 
-// HousingRoomUpdateRequest defines model for HousingRoomUpdateRequest.
-type HousingRoomUpdateRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec HousingRoomSpec           `json:"spec"`
+	// MealPeriodId Meal period the menu is served at
+	MealPeriodId openapi_types.UUID `json:"mealPeriodId"`
+	Notes        *string            `json:"notes,omitempty"`
 }
 
-// HousingRoomsListResponse defines model for HousingRoomsListResponse.
-type HousingRoomsListResponse struct {
-	Items []HousingRoom `json:"items"`
+// MenuItem defines model for MenuItem.
+type MenuItem struct {
+	// Allergens Allergens the dish contains
+	Allergens   *[]Allergen `json:"allergens,omitempty"`
+	Description *string     `json:"description,omitempty"`
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// Name Name of the dish
+	Name string `json:"name"`
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+	// SuitableFor Diets the dish is suitable for
+	SuitableFor *[]DietaryRestriction `json:"suitableFor,omitempty"`
+}
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+// MenuUpdateRequest defines model for MenuUpdateRequest.
+type MenuUpdateRequest struct {
+	Items []MenuItem `json:"items"`
+	Notes *string    `json:"notes,omitempty"`
+}
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
+// MenusListResponse defines model for MenusListResponse.
+type MenusListResponse struct {
+	Items []Menu `json:"items"`
 }
 
-// ImportEntityType Type of entity being imported
-type ImportEntityType string
+// Note defines model for Note.
+type Note struct {
+	// AuthorEmail Email of the user who wrote the note
+	AuthorEmail *string `json:"authorEmail,omitempty"`
+
+	// AuthorId User who wrote the note
+	AuthorId *openapi_types.UUID `json:"authorId,omitempty"`
+
+	// Body The observation itself
+	Body string `json:"body"`
 
-// ImportJob defines model for ImportJob.
-type ImportJob struct {
 	// CampId Camp ID
 	CampId openapi_types.UUID `json:"campId"`
 
-	// CreatedAt Timestamp when the job was created
-	CreatedAt time.Time `json:"createdAt"`
-
-	// EntityType Type of entity being imported
-	EntityType ImportEntityType `json:"entityType"`
+	// Category What a note is about
+	Category  NoteCategory `json:"category"`
+	CreatedAt time.Time    `json:"createdAt"`
 
-	// ErrorCount Number of rows that failed to import
-	ErrorCount int `json:"errorCount"`
+	// EntityId ID of the camper or staff member the note is about
+	EntityId openapi_types.UUID `json:"entityId"`
 
-	// FilePath Path to the uploaded CSV file
-	FilePath string `json:"filePath"`
+	// EntityType Kind of person a note is about
+	EntityType NoteEntityType `json:"entityType"`
 
-	// Id Unique identifier for the import job
+	// Id Unique identifier for the note
 	Id openapi_types.UUID `json:"id"`
 
-	// Mode Import mode - create only creates new entities, upsert creates or updates existing entities
-	Mode ImportMode `json:"mode"`
+	// Pinned Pinned notes are listed first
+	Pinned bool `json:"pinned"`
 
-	// ProcessedRows Number of rows processed so far
-	ProcessedRows int `json:"processedRows"`
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
 
-	// Status Status of an import job
-	Status ImportJobStatus `json:"status"`
+	// Visibility Who can read a note. all_staff notes are visible to everyone with access to the camp, admins notes to
+	// admins and program admins, and health notes to health staff and admins. Authors always see their own notes.
+	Visibility NoteVisibility `json:"visibility"`
+}
 
-	// SuccessCount Number of successfully imported rows
-	SuccessCount int `json:"successCount"`
+// NoteCategory What a note is about
+type NoteCategory string
 
-	// TenantId Tenant ID
-	TenantId openapi_types.UUID `json:"tenantId"`
+// NoteCreationRequest defines model for NoteCreationRequest.
+type NoteCreationRequest struct {
+	Body string `json:"body"`
 
-	// TotalRows Total number of rows in the CSV
-	TotalRows int `json:"totalRows"`
+	// Category What a note is about
+	Category NoteCategory `json:"category"`
 
-	// UpdatedAt Timestamp when the job was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// EntityId ID of the camper or staff member the note is about
+	EntityId openapi_types.UUID `json:"entityId"`
 
-	// ValidationErrors List of validation errors
-	ValidationErrors *[]ValidationError `json:"validationErrors,omitempty"`
+	// EntityType Kind of person a note is about
+	EntityType NoteEntityType `json:"entityType"`
+	Pinned     *bool          `json:"pinned,omitempty"`
+
+	// Visibility Who can read a note. all_staff notes are visible to everyone with access to the camp, admins notes to
+	// admins and program admins, and health notes to health staff and admins. Authors always see their own notes.
+	Visibility *NoteVisibility `json:"visibility,omitempty"`
 }
 
-// ImportJobStatus Status of an import job
-type ImportJobStatus string
+// NoteEntityType Kind of person a note is about
+type NoteEntityType string
 
-// ImportJobsListResponse defines model for ImportJobsListResponse.
-type ImportJobsListResponse struct {
-	Items []ImportJob `json:"items"`
+// NoteUpdateRequest defines model for NoteUpdateRequest.
+type NoteUpdateRequest struct {
+	Body string `json:"body"`
 
-	// Limit Maximum number of items returned
-	Limit int `json:"limit"`
+	// Category What a note is about
+	Category NoteCategory `json:"category"`
+	Pinned   bool         `json:"pinned"`
 
-	// Offset Number of items skipped
-	Offset int `json:"offset"`
+	// Visibility Who can read a note. all_staff notes are visible to everyone with access to the camp, admins notes to
+	// admins and program admins, and health notes to health staff and admins. Authors always see their own notes.
+	Visibility NoteVisibility `json:"visibility"`
+}
 
-	// Total Total number of import jobs
-	Total int `json:"total"`
+// NoteVisibility Who can read a note. all_staff notes are visible to everyone with access to the camp, admins notes to
+// admins and program admins, and health notes to health staff and admins. Authors always see their own notes.
+type NoteVisibility string
+
+// NotesListResponse defines model for NotesListResponse.
+type NotesListResponse struct {
+	Items []Note `json:"items"`
 }
 
-// ImportMode Import mode - create only creates new entities, upsert creates or updates existing entities
-type ImportMode string
+// OnSiteCount defines model for OnSiteCount.
+type OnSiteCount struct {
+	// Id ID of the group or housing room
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
 
-// ListResponseBase defines model for ListResponseBase.
-type ListResponseBase struct {
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// OnSite Number of campers of the group or housing room currently on site
+	OnSite int `json:"onSite"`
+}
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+// OnSiteReport defines model for OnSiteReport.
+type OnSiteReport struct {
+	// CamperIds IDs of the campers currently on site
+	CamperIds []openapi_types.UUID `json:"camperIds"`
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+	// CheckedOut Number of campers who were checked in during the day and have since left
+	CheckedOut int                `json:"checkedOut"`
+	Date       openapi_types.Date `json:"date"`
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
+	// Groups On-site counts per group, only groups with campers on site are listed
+	Groups []OnSiteCount `json:"groups"`
+
+	// HousingRooms On-site counts per housing room, only rooms with campers on site are listed
+	HousingRooms []OnSiteCount `json:"housingRooms"`
+
+	// OnSite Number of campers checked in and not yet checked out
+	OnSite int `json:"onSite"`
 }
 
-// Location defines model for Location.
-type Location struct {
-	Meta EntityMeta   `json:"meta"`
-	Spec LocationSpec `json:"spec"`
+// OnboardingItem defines model for OnboardingItem.
+type OnboardingItem struct {
+	Description *string `json:"description,omitempty"`
+
+	// DueOn Last day the item should be completed on
+	DueOn *openapi_types.Date `json:"dueOn,omitempty"`
+
+	// Id Identifier of the checklist item, kept when the template is updated
+	Id openapi_types.UUID `json:"id"`
+
+	// RequiresAttachment Whether completing the item requires a document attached to the staff member, e.g. the signed contract
+	RequiresAttachment bool `json:"requiresAttachment"`
+
+	// Title What the staff member has to do, e.g. Sign contract
+	Title string `json:"title"`
 }
 
-// LocationCreationRequest defines model for LocationCreationRequest.
-type LocationCreationRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec LocationSpec              `json:"spec"`
+// OnboardingItemInput defines model for OnboardingItemInput.
+type OnboardingItemInput struct {
+	Description *string `json:"description,omitempty"`
+
+	// DueOn Last day the item should be completed on
+	DueOn *openapi_types.Date `json:"dueOn,omitempty"`
+
+	// Id Identifier of an existing item of the template to keep its completions; new items get one assigned
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// RequiresAttachment Whether completing the item requires a document attached to the staff member
+	RequiresAttachment *bool `json:"requiresAttachment,omitempty"`
+
+	// Title What the staff member has to do, e.g. Sign contract
+	Title string `json:"title"`
 }
 
-// LocationSpec defines model for LocationSpec.
-type LocationSpec struct {
-	// AreaId ID of the physical area where this location is situated
-	AreaId    *openapi_types.UUID `json:"areaId,omitempty"`
-	Capacity  *int                `json:"capacity,omitempty"`
-	Equipment *[]string           `json:"equipment,omitempty"`
-	Notes     *string             `json:"notes,omitempty"`
+// OnboardingReport defines model for OnboardingReport.
+type OnboardingReport struct {
+	GeneratedAt   time.Time `json:"generatedAt"`
+	NotReadyCount int       `json:"notReadyCount"`
+	ReadyCount    int       `json:"readyCount"`
+
+	// StaffMembers Onboarding progress of every staff member, those not ready first, then by name
+	StaffMembers []StaffOnboarding `json:"staffMembers"`
 }
 
-// LocationUpdateRequest defines model for LocationUpdateRequest.
-type LocationUpdateRequest struct {
-	Meta EntityCreationRequestMeta `json:"meta"`
-	Spec LocationSpec              `json:"spec"`
+// OnboardingTemplate defines model for OnboardingTemplate.
+type OnboardingTemplate struct {
+	// CampId Camp ID
+	CampId      openapi_types.UUID `json:"campId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+
+	// Id Unique identifier for the onboarding template
+	Id openapi_types.UUID `json:"id"`
+
+	// IsDefault Whether the template applies to staff members without a template of their own; a camp has at most one
+	IsDefault bool `json:"isDefault"`
+
+	// Items Checklist items staff members have to complete before they can work
+	Items []OnboardingItem `json:"items"`
+
+	// Name Name of the template, e.g. Counselor onboarding
+	Name string `json:"name"`
+
+	// RequiredCertificationIds Certifications staff members must hold, unexpired, before they can work
+	RequiredCertificationIds []openapi_types.UUID `json:"requiredCertificationIds"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
 }
 
-// LocationsListResponse defines model for LocationsListResponse.
-type LocationsListResponse struct {
-	Items []Location `json:"items"`
+// OnboardingTemplateCreationRequest defines model for OnboardingTemplateCreationRequest.
+type OnboardingTemplateCreationRequest struct {
+	Description *string `json:"description,omitempty"`
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// IsDefault Whether the template applies to staff members without a template of their own; replaces the camp's current default
+	IsDefault *bool `json:"isDefault,omitempty"`
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+	// Items Checklist items staff members have to complete before they can work
+	Items *[]OnboardingItemInput `json:"items,omitempty"`
+	Name  string                 `json:"name"`
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+	// RequiredCertificationIds Certifications staff members must hold, unexpired, before they can work
+	RequiredCertificationIds *[]openapi_types.UUID `json:"requiredCertificationIds,omitempty"`
+}
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
+// OnboardingTemplateUpdateRequest defines model for OnboardingTemplateUpdateRequest.
+type OnboardingTemplateUpdateRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// IsDefault Whether the template applies to staff members without a template of their own; replaces the camp's current default
+	IsDefault *bool `json:"isDefault,omitempty"`
+
+	// Items Checklist items staff members have to complete before they can work
+	Items *[]OnboardingItemInput `json:"items,omitempty"`
+	Name  string                 `json:"name"`
+
+	// RequiredCertificationIds Certifications staff members must hold, unexpired, before they can work
+	RequiredCertificationIds *[]openapi_types.UUID `json:"requiredCertificationIds,omitempty"`
 }
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	// Email User's email address
-	Email openapi_types.Email `json:"email"`
+// OnboardingTemplatesListResponse defines model for OnboardingTemplatesListResponse.
+type OnboardingTemplatesListResponse struct {
+	Items []OnboardingTemplate `json:"items"`
+}
 
-	// Password User's password
-	Password string `json:"password"`
+// OutOfServicePeriod defines model for OutOfServicePeriod.
+type OutOfServicePeriod struct {
+	EntityId openapi_types.UUID `json:"entityId"`
+
+	// EntityName Name of the location, housing room or area
+	EntityName string `json:"entityName"`
+
+	// EntityType Kind of facility a maintenance ticket is about
+	EntityType MaintenanceTicketEntityType `json:"entityType"`
+	From       time.Time                   `json:"from"`
+
+	// TicketId Maintenance ticket taking the facility out of service
+	TicketId openapi_types.UUID `json:"ticketId"`
+
+	// Until Omitted while the facility is out of service until the ticket is resolved
+	Until *time.Time `json:"until,omitempty"`
 }
 
-// LoginResponse defines model for LoginResponse.
-type LoginResponse struct {
-	// Token Authentication token (placeholder for future implementation)
-	Token string `json:"token"`
-	User  User   `json:"user"`
+// OutOfServicePeriodsListResponse defines model for OutOfServicePeriodsListResponse.
+type OutOfServicePeriodsListResponse struct {
+	// Items Periods ordered by start. A period of an area covers its locations and housing rooms as well.
+	Items []OutOfServicePeriod `json:"items"`
 }
 
 // Program defines model for Program.
@@ -1175,23 +4228,184 @@ type ProgramUpdateRequest struct {
 	Spec ProgramSpec               `json:"spec"`
 }
 
-// ProgramsListResponse defines model for ProgramsListResponse.
-type ProgramsListResponse struct {
-	Items []Program `json:"items"`
+// ProgramsListResponse defines model for ProgramsListResponse.
+type ProgramsListResponse struct {
+	Items []Program `json:"items"`
+
+	// Limit Number of items per page
+	Limit int `json:"limit"`
+
+	// Next Next offset value to use for the next page, or null if no more pages available
+	Next *int `json:"next"`
+
+	// Offset Current offset (starting position)
+	Offset int `json:"offset"`
+
+	// Total Total count of all items across all pages
+	Total int `json:"total"`
+}
+
+// RatioComplianceDay defines model for RatioComplianceDay.
+type RatioComplianceDay struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Violations Violations of the day ordered by start
+	Violations []RatioViolation `json:"violations"`
+}
+
+// RatioComplianceReport defines model for RatioComplianceReport.
+type RatioComplianceReport struct {
+	// Days Days with at least one violation, in order
+	Days []RatioComplianceDay `json:"days"`
+
+	// EventsChecked Events with campers covered by a policy
+	EventsChecked int                `json:"eventsChecked"`
+	From          openapi_types.Date `json:"from"`
+
+	// NightsChecked Nights of housing groups with campers covered by a policy
+	NightsChecked  int                `json:"nightsChecked"`
+	To             openapi_types.Date `json:"to"`
+	ViolationCount int                `json:"violationCount"`
+}
+
+// RatioPoliciesListResponse defines model for RatioPoliciesListResponse.
+type RatioPoliciesListResponse struct {
+	Items []RatioPolicy `json:"items"`
+}
+
+// RatioPolicy defines model for RatioPolicy.
+type RatioPolicy struct {
+	// ActivityIds Activities whose events the policy covers
+	ActivityIds []openapi_types.UUID `json:"activityIds"`
+
+	// AppliesToEvents Whether the policy is checked for events
+	AppliesToEvents bool `json:"appliesToEvents"`
+
+	// AppliesToOvernight Whether the policy is checked for housing groups at night
+	AppliesToOvernight bool `json:"appliesToOvernight"`
+
+	// CampId Camp ID
+	CampId openapi_types.UUID `json:"campId"`
+
+	// CampersPerStaff Most campers a single staff member may supervise, e.g. 6 for a 1:6 ratio
+	CampersPerStaff int       `json:"campersPerStaff"`
+	CreatedAt       time.Time `json:"createdAt"`
+	Description     *string   `json:"description,omitempty"`
+
+	// Id Unique identifier for the ratio policy
+	Id openapi_types.UUID `json:"id"`
+
+	// MaxAge Oldest age in years the policy covers; no upper bound when omitted
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge Youngest age in years the policy covers; no lower bound when omitted
+	MinAge *int `json:"minAge,omitempty"`
+
+	// Name Name of the policy, e.g. Under 8s
+	Name string `json:"name"`
+
+	// ProgramIds Programs whose events the policy covers. A policy without activities or programs covers all events.
+	ProgramIds []openapi_types.UUID `json:"programIds"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// RatioPolicyCreationRequest defines model for RatioPolicyCreationRequest.
+type RatioPolicyCreationRequest struct {
+	// ActivityIds Activities whose events the policy covers
+	ActivityIds *[]openapi_types.UUID `json:"activityIds,omitempty"`
+
+	// AppliesToEvents Whether the policy is checked for events; defaults to true
+	AppliesToEvents *bool `json:"appliesToEvents,omitempty"`
+
+	// AppliesToOvernight Whether the policy is checked for housing groups at night; defaults to true
+	AppliesToOvernight *bool `json:"appliesToOvernight,omitempty"`
+
+	// CampersPerStaff Most campers a single staff member may supervise
+	CampersPerStaff int     `json:"campersPerStaff"`
+	Description     *string `json:"description,omitempty"`
+
+	// MaxAge Oldest age in years the policy covers; no upper bound when omitted
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge Youngest age in years the policy covers; no lower bound when omitted
+	MinAge *int   `json:"minAge,omitempty"`
+	Name   string `json:"name"`
+
+	// ProgramIds Programs whose events the policy covers. Without activities or programs all events are covered.
+	ProgramIds *[]openapi_types.UUID `json:"programIds,omitempty"`
+}
+
+// RatioPolicyUpdateRequest defines model for RatioPolicyUpdateRequest.
+type RatioPolicyUpdateRequest struct {
+	// ActivityIds Activities whose events the policy covers
+	ActivityIds *[]openapi_types.UUID `json:"activityIds,omitempty"`
+
+	// AppliesToEvents Whether the policy is checked for events; defaults to true
+	AppliesToEvents *bool `json:"appliesToEvents,omitempty"`
+
+	// AppliesToOvernight Whether the policy is checked for housing groups at night; defaults to true
+	AppliesToOvernight *bool `json:"appliesToOvernight,omitempty"`
+
+	// CampersPerStaff Most campers a single staff member may supervise
+	CampersPerStaff int     `json:"campersPerStaff"`
+	Description     *string `json:"description,omitempty"`
+
+	// MaxAge Oldest age in years the policy covers; no upper bound when omitted
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// MinAge Youngest age in years the policy covers; no lower bound when omitted
+	MinAge *int   `json:"minAge,omitempty"`
+	Name   string `json:"name"`
+
+	// ProgramIds Programs whose events the policy covers. Without activities or programs all events are covered.
+	ProgramIds *[]openapi_types.UUID `json:"programIds,omitempty"`
+}
+
+// RatioViolation defines model for RatioViolation.
+type RatioViolation struct {
+	// CamperCount Campers covered by a policy
+	CamperCount int `json:"camperCount"`
+
+	// EndDate End of the event or of the night
+	EndDate time.Time `json:"endDate"`
+
+	// EventId Event short of staff (event)
+	EventId   *openapi_types.UUID `json:"eventId,omitempty"`
+	EventName *string             `json:"eventName,omitempty"`
+
+	// GroupId Housing group short of staff (overnight)
+	GroupId   *openapi_types.UUID `json:"groupId,omitempty"`
+	GroupName *string             `json:"groupName,omitempty"`
+
+	// Message Human readable description of the violation
+	Message string `json:"message"`
+
+	// PolicyIds Policies that apply to the campers
+	PolicyIds []openapi_types.UUID `json:"policyIds"`
 
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+	// RequiredStaff Staff members the policies require
+	RequiredStaff int `json:"requiredStaff"`
 
-	// Next Next offset value to use for the next page, or null if no more pages available
-	Next *int `json:"next"`
+	// StaffCount Staff members supervising them
+	StaffCount int `json:"staffCount"`
 
-	// Offset Current offset (starting position)
-	Offset int `json:"offset"`
+	// StartDate Start of the event or of the night
+	StartDate time.Time `json:"startDate"`
 
-	// Total Total count of all items across all pages
-	Total int `json:"total"`
+	// Type Where the ratio is not met:
+	// - event: an event has fewer staff members than its campers need
+	// - overnight: a housing group has fewer staff members than its campers need at night
+	Type RatioViolationType `json:"type"`
 }
 
+// RatioViolationType Where the ratio is not met:
+// - event: an event has fewer staff members than its campers need
+// - overnight: a housing group has fewer staff members than its campers need at night
+type RatioViolationType string
+
 // RecurrenceRule defines model for RecurrenceRule.
 type RecurrenceRule struct {
 	// DaysOfWeek Days of week for weekly recurrence (0=Sunday, 6=Saturday)
@@ -1257,6 +4471,30 @@ type RolesListResponse struct {
 	Total int `json:"total"`
 }
 
+// ScheduleItem defines model for ScheduleItem.
+type ScheduleItem struct {
+	EndDate time.Time `json:"endDate"`
+
+	// Id ID of the event, duty shift or time-off request
+	Id openapi_types.UUID `json:"id"`
+
+	// LocationId Location of the event
+	LocationId *openapi_types.UUID `json:"locationId,omitempty"`
+
+	// Name Name of the event or duty type; "Time off" for time off
+	Name string `json:"name"`
+
+	// PositionName Required staff position of the event the staff member is assigned to
+	PositionName *string   `json:"positionName,omitempty"`
+	StartDate    time.Time `json:"startDate"`
+
+	// Type What a schedule item is - an event the staff member takes part in, a duty shift or approved time off
+	Type ScheduleItemType `json:"type"`
+}
+
+// ScheduleItemType What a schedule item is - an event the staff member takes part in, a duty shift or approved time off
+type ScheduleItemType string
+
 // ScopeType The scope level for an access rule
 type ScopeType string
 
@@ -1274,6 +4512,8 @@ type SessionCreationRequest struct {
 
 // SessionSpec defines model for SessionSpec.
 type SessionSpec struct {
+	// Capacity Maximum number of campers enrolled in the session (unlimited when omitted)
+	Capacity  *int               `json:"capacity,omitempty"`
 	EndDate   openapi_types.Date `json:"endDate"`
 	StartDate openapi_types.Date `json:"startDate"`
 }
@@ -1313,6 +4553,154 @@ type SignupRequest struct {
 	TenantId string `json:"tenantId"`
 }
 
+// SkillAssessment A staff member's assessment of a camper at a level of a skill track
+type SkillAssessment struct {
+	AssessedOn openapi_types.Date `json:"assessedOn"`
+	CampId     openapi_types.UUID `json:"campId"`
+	CamperId   openapi_types.UUID `json:"camperId"`
+	CreatedAt  time.Time          `json:"createdAt"`
+
+	// EvaluatorId Staff member who assessed the camper; absent once the staff member is deleted
+	EvaluatorId *openapi_types.UUID `json:"evaluatorId,omitempty"`
+	Id          openapi_types.UUID  `json:"id"`
+	LevelId     openapi_types.UUID  `json:"levelId"`
+
+	// LevelName Name of the level when the assessment was recorded
+	LevelName string  `json:"levelName"`
+	Notes     *string `json:"notes,omitempty"`
+
+	// Passed Whether the camper passed the level; failed attempts are kept in the history
+	Passed bool `json:"passed"`
+
+	// RecordedBy User who recorded the assessment
+	RecordedBy      *openapi_types.UUID `json:"recordedBy,omitempty"`
+	RecordedByEmail *string             `json:"recordedByEmail,omitempty"`
+	SkillTrackId    openapi_types.UUID  `json:"skillTrackId"`
+	TenantId        openapi_types.UUID  `json:"tenantId"`
+	UpdatedAt       time.Time           `json:"updatedAt"`
+}
+
+// SkillAssessmentCreationRequest defines model for SkillAssessmentCreationRequest.
+type SkillAssessmentCreationRequest struct {
+	AssessedOn openapi_types.Date `json:"assessedOn"`
+
+	// EvaluatorId Staff member who assessed the camper; defaults to the staff member linked to the current user
+	EvaluatorId  *openapi_types.UUID `json:"evaluatorId,omitempty"`
+	LevelId      openapi_types.UUID  `json:"levelId"`
+	Notes        *string             `json:"notes,omitempty"`
+	Passed       bool                `json:"passed"`
+	SkillTrackId openapi_types.UUID  `json:"skillTrackId"`
+}
+
+// SkillLevel A level of a skill track; levels are ordered from lowest to highest
+type SkillLevel struct {
+	// BadgeName Badge awarded to campers who pass the level; no badge when not set
+	BadgeName *string `json:"badgeName,omitempty"`
+
+	// Description What a camper must show to pass the level
+	Description *string `json:"description,omitempty"`
+
+	// Id Stable ID of the level; omit it to add a new level, keep it to rename or move an existing one
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Name string              `json:"name"`
+}
+
+// SkillTrack defines model for SkillTrack.
+type SkillTrack struct {
+	CampId      openapi_types.UUID `json:"campId"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// Levels Levels from lowest to highest
+	Levels    []SkillLevel       `json:"levels"`
+	Name      string             `json:"name"`
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// SkillTrackCreationRequest defines model for SkillTrackCreationRequest.
+type SkillTrackCreationRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Levels Levels from lowest to highest
+	Levels []SkillLevel `json:"levels"`
+	Name   string       `json:"name"`
+}
+
+// SkillTrackUpdateRequest defines model for SkillTrackUpdateRequest.
+type SkillTrackUpdateRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Levels Levels from lowest to highest
+	Levels []SkillLevel `json:"levels"`
+	Name   string       `json:"name"`
+}
+
+// SkillTracksListResponse defines model for SkillTracksListResponse.
+type SkillTracksListResponse struct {
+	Items []SkillTrack `json:"items"`
+}
+
+// StaffAvailability defines model for StaffAvailability.
+type StaffAvailability struct {
+	StaffMemberId openapi_types.UUID `json:"staffMemberId"`
+
+	// UnavailableWindows Weekly times the staff member cannot work; duty shifts are not auto-assigned to them then
+	UnavailableWindows []UnavailableWindow `json:"unavailableWindows"`
+}
+
+// StaffAvailabilityUpdateRequest defines model for StaffAvailabilityUpdateRequest.
+type StaffAvailabilityUpdateRequest struct {
+	// UnavailableWindows Replaces the weekly times the staff member cannot work
+	UnavailableWindows []UnavailableWindow `json:"unavailableWindows"`
+}
+
+// StaffCertification defines model for StaffCertification.
+type StaffCertification struct {
+	// CertificateNumber Number printed on the certificate
+	CertificateNumber *string `json:"certificateNumber,omitempty"`
+
+	// CertificationId ID of the certification held
+	CertificationId openapi_types.UUID `json:"certificationId"`
+
+	// ExpiresOn Last day the certificate is valid; certificates without an expiry date never expire
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+
+	// IssuedOn Day the certificate was issued
+	IssuedOn *openapi_types.Date `json:"issuedOn,omitempty"`
+
+	// VerifiedAt When the certificate was verified
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+
+	// VerifiedBy ID of the admin who verified the certificate
+	VerifiedBy *openapi_types.UUID `json:"verifiedBy,omitempty"`
+
+	// VerifiedByEmail Email of the admin who verified the certificate
+	VerifiedByEmail *string `json:"verifiedByEmail,omitempty"`
+}
+
+// StaffCertificationInput defines model for StaffCertificationInput.
+type StaffCertificationInput struct {
+	// CertificateNumber Number printed on the certificate
+	CertificateNumber *string `json:"certificateNumber,omitempty"`
+
+	// CertificationId ID of the certification held
+	CertificationId openapi_types.UUID `json:"certificationId"`
+
+	// ExpiresOn Last day the certificate is valid; certificates without an expiry date never expire
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+
+	// IssuedOn Day the certificate was issued
+	IssuedOn *openapi_types.Date `json:"issuedOn,omitempty"`
+}
+
+// StaffCertificationVerificationRequest defines model for StaffCertificationVerificationRequest.
+type StaffCertificationVerificationRequest struct {
+	// CertificationId ID of the certification of the staff member to mark as verified
+	CertificationId openapi_types.UUID `json:"certificationId"`
+}
+
 // StaffMember defines model for StaffMember.
 type StaffMember struct {
 	Meta EntityMeta      `json:"meta"`
@@ -1327,31 +4715,63 @@ type StaffMemberCreationRequest struct {
 
 // StaffMemberMutationSpec defines model for StaffMemberMutationSpec.
 type StaffMemberMutationSpec struct {
+	// Allergies Food allergens the person is allergic to, checked against menus
+	Allergies *Allergies `json:"allergies,omitempty"`
+
 	// Birthday Date of birth of the camper or staff member
 	Birthday Birthday `json:"birthday"`
 
 	// CertificationIds IDs of certifications this staff member holds
 	CertificationIds *[]openapi_types.UUID `json:"certificationIds,omitempty"`
 
+	// Certifications Certifications this staff member holds with their certificate details; replaces certificationIds when provided
+	Certifications *[]StaffCertificationInput `json:"certifications,omitempty"`
+
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// DietaryRestrictions Diets the person follows, used for meal headcounts
+	DietaryRestrictions *DietaryRestrictions `json:"dietaryRestrictions,omitempty"`
+
 	// Gender Gender of the camper or staff member
 	Gender Gender `json:"gender"`
 
 	// GroupIds IDs of the groups this staff member belongs to (max one housing group allowed)
 	GroupIds *[]openapi_types.UUID `json:"groupIds,omitempty"`
-	Phone    *string               `json:"phone,omitempty"`
+
+	// OnboardingTemplateId ID of the onboarding template this staff member follows; the camp's default template applies when absent
+	OnboardingTemplateId *openapi_types.UUID `json:"onboardingTemplateId,omitempty"`
+	Phone                *string             `json:"phone,omitempty"`
 
 	// RoleId ID of the role this staff member has
 	RoleId openapi_types.UUID `json:"roleId"`
+
+	// UserId ID of the user account linked to this staff member, letting them sign in to see their own schedule, groups and campers
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
 }
 
 // StaffMemberSpec defines model for StaffMemberSpec.
 type StaffMemberSpec struct {
+	// Allergies Food allergens the person is allergic to, checked against menus
+	Allergies *Allergies `json:"allergies,omitempty"`
+
 	// Birthday Date of birth of the camper or staff member
 	Birthday Birthday `json:"birthday"`
 
-	// CertificationIds IDs of certifications this staff member holds
+	// CertificationIds IDs of certifications this staff member holds a certificate of that has not expired
 	CertificationIds *[]openapi_types.UUID `json:"certificationIds,omitempty"`
 
+	// Certifications Certifications this staff member holds with their certificate details
+	Certifications *[]StaffCertification `json:"certifications,omitempty"`
+
+	// CustomFields Values of the camp's custom fields, keyed by field key. Numbers are JSON numbers, dates are
+	// ISO 8601 dates (YYYY-MM-DD), booleans are JSON booleans and text and enum values are strings.
+	CustomFields *CustomFieldValues `json:"customFields,omitempty"`
+
+	// DietaryRestrictions Diets the person follows, used for meal headcounts
+	DietaryRestrictions *DietaryRestrictions `json:"dietaryRestrictions,omitempty"`
+
 	// Gender Gender of the camper or staff member
 	Gender Gender `json:"gender"`
 
@@ -1360,10 +4780,16 @@ type StaffMemberSpec struct {
 
 	// HousingGroupId ID of the housing group this staff member belongs to (auto-populated from groupIds)
 	HousingGroupId *openapi_types.UUID `json:"housingGroupId,omitempty"`
-	Phone          *string             `json:"phone,omitempty"`
+
+	// OnboardingTemplateId ID of the onboarding template this staff member follows; the camp's default template applies when absent
+	OnboardingTemplateId *openapi_types.UUID `json:"onboardingTemplateId,omitempty"`
+	Phone                *string             `json:"phone,omitempty"`
 
 	// RoleId ID of the role this staff member has
 	RoleId openapi_types.UUID `json:"roleId"`
+
+	// UserId ID of the user account linked to this staff member, letting them sign in to see their own schedule, groups and campers
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
 }
 
 // StaffMemberUpdateRequest defines model for StaffMemberUpdateRequest.
@@ -1389,6 +4815,81 @@ type StaffMembersListResponse struct {
 	Total int `json:"total"`
 }
 
+// StaffOnboarding defines model for StaffOnboarding.
+type StaffOnboarding struct {
+	Certifications []StaffOnboardingCertification `json:"certifications"`
+
+	// CompletedCount Number of checklist items and required certifications done
+	CompletedCount int                   `json:"completedCount"`
+	Items          []StaffOnboardingItem `json:"items"`
+
+	// OverdueCount Number of checklist items past their due day
+	OverdueCount int `json:"overdueCount"`
+
+	// Ready Whether the staff member completed every checklist item and holds every required certification. Staff members
+	// who are not ready cannot be assigned to events.
+	Ready           bool               `json:"ready"`
+	StaffMemberId   openapi_types.UUID `json:"staffMemberId"`
+	StaffMemberName string             `json:"staffMemberName"`
+
+	// TemplateId Onboarding template the staff member follows - their own or the camp's default; absent when there is none
+	TemplateId   *openapi_types.UUID `json:"templateId,omitempty"`
+	TemplateName *string             `json:"templateName,omitempty"`
+
+	// TotalCount Number of checklist items and required certifications
+	TotalCount int `json:"totalCount"`
+}
+
+// StaffOnboardingCertification defines model for StaffOnboardingCertification.
+type StaffOnboardingCertification struct {
+	CertificationId   openapi_types.UUID `json:"certificationId"`
+	CertificationName string             `json:"certificationName"`
+
+	// ExpiresOn Last day the staff member's certificate is valid
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+
+	// Valid Whether the staff member holds the certification and it has not expired
+	Valid bool `json:"valid"`
+}
+
+// StaffOnboardingItem defines model for StaffOnboardingItem.
+type StaffOnboardingItem struct {
+	// AttachmentId Document attached to the staff member that completed the item
+	AttachmentId *openapi_types.UUID `json:"attachmentId,omitempty"`
+	Completed    bool                `json:"completed"`
+	CompletedAt  *time.Time          `json:"completedAt,omitempty"`
+
+	// CompletedBy ID of the user who marked the item completed
+	CompletedBy      *openapi_types.UUID `json:"completedBy,omitempty"`
+	CompletedByEmail *string             `json:"completedByEmail,omitempty"`
+	Description      *string             `json:"description,omitempty"`
+	DueOn            *openapi_types.Date `json:"dueOn,omitempty"`
+	ItemId           openapi_types.UUID  `json:"itemId"`
+	Notes            *string             `json:"notes,omitempty"`
+
+	// Overdue Whether the item is not completed and its due day has passed
+	Overdue            bool   `json:"overdue"`
+	RequiresAttachment bool   `json:"requiresAttachment"`
+	Title              string `json:"title"`
+}
+
+// StaffOnboardingItemCompletionRequest defines model for StaffOnboardingItemCompletionRequest.
+type StaffOnboardingItemCompletionRequest struct {
+	// AttachmentId Document attached to the staff member completing the item; required when the item requires an attachment
+	AttachmentId *openapi_types.UUID `json:"attachmentId,omitempty"`
+	Notes        *string             `json:"notes,omitempty"`
+}
+
+// StaffSchedule defines model for StaffSchedule.
+type StaffSchedule struct {
+	From openapi_types.Date `json:"from"`
+
+	// Items Events, duty shifts and approved time off overlapping the days, ordered by start
+	Items         []ScheduleItem     `json:"items"`
+	StaffMemberId openapi_types.UUID `json:"staffMemberId"`
+	To            openapi_types.Date `json:"to"`
+}
+
 // Tenant defines model for Tenant.
 type Tenant struct {
 	// Id Unique identifier for the tenant
@@ -1465,6 +4966,219 @@ type TimeBlocksListResponse struct {
 	Total int `json:"total"`
 }
 
+// TimeClockRequest defines model for TimeClockRequest.
+type TimeClockRequest struct {
+	// At When the staff member clocked in or out; now when omitted
+	At    *time.Time `json:"at,omitempty"`
+	Notes *string    `json:"notes,omitempty"`
+}
+
+// TimeEntriesListResponse defines model for TimeEntriesListResponse.
+type TimeEntriesListResponse struct {
+	// Items Entries ordered by clock-in time
+	Items []TimeEntry `json:"items"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	ClockInAt time.Time          `json:"clockInAt"`
+
+	// ClockOutAt Missing while the staff member is still clocked in
+	ClockOutAt *time.Time `json:"clockOutAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+
+	// Hours Hours worked, rounded to the minute; missing while the staff member is still clocked in
+	Hours *float64 `json:"hours,omitempty"`
+
+	// Id Unique identifier for the time entry
+	Id            openapi_types.UUID `json:"id"`
+	Notes         *string            `json:"notes,omitempty"`
+	ReviewComment *string            `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time         `json:"reviewedAt,omitempty"`
+
+	// ReviewedBy User who last approved or rejected the entry
+	ReviewedBy      *openapi_types.UUID `json:"reviewedBy,omitempty"`
+	ReviewedByEmail *string             `json:"reviewedByEmail,omitempty"`
+	StaffMemberId   openapi_types.UUID  `json:"staffMemberId"`
+
+	// Status Review state of a time entry
+	Status TimeEntryStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// TimeEntryCreationRequest defines model for TimeEntryCreationRequest.
+type TimeEntryCreationRequest struct {
+	ClockInAt time.Time `json:"clockInAt"`
+
+	// ClockOutAt Leave out while the staff member is still clocked in
+	ClockOutAt    *time.Time         `json:"clockOutAt,omitempty"`
+	Notes         *string            `json:"notes,omitempty"`
+	StaffMemberId openapi_types.UUID `json:"staffMemberId"`
+}
+
+// TimeEntryReviewRequest defines model for TimeEntryReviewRequest.
+type TimeEntryReviewRequest struct {
+	// Comment Reviewer comment; required when rejecting
+	Comment *string `json:"comment,omitempty"`
+
+	// Status Review state of a time entry
+	Status TimeEntryStatus `json:"status"`
+}
+
+// TimeEntryStatus Review state of a time entry
+type TimeEntryStatus string
+
+// TimeEntryUpdateRequest defines model for TimeEntryUpdateRequest.
+type TimeEntryUpdateRequest struct {
+	ClockInAt time.Time `json:"clockInAt"`
+
+	// ClockOutAt Leave out while the staff member is still clocked in
+	ClockOutAt    *time.Time         `json:"clockOutAt,omitempty"`
+	Notes         *string            `json:"notes,omitempty"`
+	StaffMemberId openapi_types.UUID `json:"staffMemberId"`
+}
+
+// TimeOffRequest defines model for TimeOffRequest.
+type TimeOffRequest struct {
+	// CampId Camp ID
+	CampId    openapi_types.UUID `json:"campId"`
+	CreatedAt time.Time          `json:"createdAt"`
+	EndDate   time.Time          `json:"endDate"`
+
+	// Id Unique identifier for the time-off request
+	Id            openapi_types.UUID `json:"id"`
+	Reason        *string            `json:"reason,omitempty"`
+	ReviewComment *string            `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time         `json:"reviewedAt,omitempty"`
+
+	// ReviewedBy User who last approved or rejected the request
+	ReviewedBy      *openapi_types.UUID `json:"reviewedBy,omitempty"`
+	ReviewedByEmail *string             `json:"reviewedByEmail,omitempty"`
+	StaffMemberId   openapi_types.UUID  `json:"staffMemberId"`
+	StartDate       time.Time           `json:"startDate"`
+
+	// Status Review state of a time-off request. Staff members are not auto-assigned to duty shifts during approved time off.
+	Status TimeOffRequestStatus `json:"status"`
+
+	// TenantId Tenant ID
+	TenantId  openapi_types.UUID `json:"tenantId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// TimeOffRequestCreationRequest defines model for TimeOffRequestCreationRequest.
+type TimeOffRequestCreationRequest struct {
+	EndDate   time.Time `json:"endDate"`
+	Reason    *string   `json:"reason,omitempty"`
+	StartDate time.Time `json:"startDate"`
+}
+
+// TimeOffRequestReviewRequest defines model for TimeOffRequestReviewRequest.
+type TimeOffRequestReviewRequest struct {
+	// Comment Reviewer comment; required when rejecting
+	Comment *string `json:"comment,omitempty"`
+
+	// Status Review state of a time-off request. Staff members are not auto-assigned to duty shifts during approved time off.
+	Status TimeOffRequestStatus `json:"status"`
+}
+
+// TimeOffRequestStatus Review state of a time-off request. Staff members are not auto-assigned to duty shifts during approved time off.
+type TimeOffRequestStatus string
+
+// TimeOffRequestsListResponse defines model for TimeOffRequestsListResponse.
+type TimeOffRequestsListResponse struct {
+	Items []TimeOffRequest `json:"items"`
+}
+
+// Timeline defines model for Timeline.
+type Timeline struct {
+	// Items Timeline entries, most recent first
+	Items []TimelineEntry `json:"items"`
+}
+
+// TimelineEntry defines model for TimelineEntry.
+type TimelineEntry struct {
+	// ActorEmail Email of the user who recorded the event, when known
+	ActorEmail *string `json:"actorEmail,omitempty"`
+
+	// Details Longer text such as the note body or attendance notes
+	Details *string `json:"details,omitempty"`
+	Note    *Note   `json:"note,omitempty"`
+
+	// OccurredAt When the event happened
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// ReferenceId ID of the note, attendance record, incident or group the entry comes from
+	ReferenceId openapi_types.UUID `json:"referenceId"`
+
+	// Summary One-line description of the event
+	Summary string `json:"summary"`
+
+	// Type Kind of event shown on a person's timeline
+	Type TimelineEntryType `json:"type"`
+}
+
+// TimelineEntryType Kind of event shown on a person's timeline
+type TimelineEntryType string
+
+// TimesheetReport defines model for TimesheetReport.
+type TimesheetReport struct {
+	From openapi_types.Date `json:"from"`
+
+	// Items Weekly totals ordered by staff member name and week
+	Items []TimesheetWeek `json:"items"`
+
+	// OvertimeThresholdHours Weekly hours beyond which hours count as overtime
+	OvertimeThresholdHours float64            `json:"overtimeThresholdHours"`
+	To                     openapi_types.Date `json:"to"`
+}
+
+// TimesheetWeek defines model for TimesheetWeek.
+type TimesheetWeek struct {
+	// ApprovedHours Hours of the week's approved entries
+	ApprovedHours float64 `json:"approvedHours"`
+
+	// Hours Hours of the week's clocked out entries that were not rejected
+	Hours float64 `json:"hours"`
+
+	// OpenEntries Entries without a clock-out
+	OpenEntries int `json:"openEntries"`
+
+	// OvertimeHours Approved hours beyond the overtime threshold
+	OvertimeHours float64 `json:"overtimeHours"`
+
+	// PendingEntries Entries still waiting for approval
+	PendingEntries int `json:"pendingEntries"`
+
+	// RegularHours Approved hours up to the overtime threshold. Approved hours of the week's days before the pay period use up the threshold first.
+	RegularHours float64 `json:"regularHours"`
+
+	// ScheduledHours Hours of the events and duty shifts (other than time off) the staff member is assigned to, for comparison
+	ScheduledHours  float64            `json:"scheduledHours"`
+	StaffMemberId   openapi_types.UUID `json:"staffMemberId"`
+	StaffMemberName string             `json:"staffMemberName"`
+
+	// WeekStart Monday of the week
+	WeekStart openapi_types.Date `json:"weekStart"`
+}
+
+// UnavailableWindow defines model for UnavailableWindow.
+type UnavailableWindow struct {
+	// DaysOfWeek Days of the week the window recurs on, 0 being Sunday; empty or missing for every day
+	DaysOfWeek *[]int `json:"daysOfWeek,omitempty"`
+
+	// EndTime Camp local time the window ends (HH:MM); windows ending at or before their start time end the next day
+	EndTime string  `json:"endTime"`
+	Notes   *string `json:"notes,omitempty"`
+
+	// StartTime Camp local time the window starts (HH:MM)
+	StartTime string `json:"startTime"`
+}
+
 // User defines model for User.
 type User struct {
 	// AccessRules Array of access rules defining user's permissions
@@ -1498,6 +5212,12 @@ type ActivitiesFilterBy = []string
 // ActivitiesSortBy defines model for ActivitiesSortBy.
 type ActivitiesSortBy string
 
+// ApplicationsFilterBy defines model for ApplicationsFilterBy.
+type ApplicationsFilterBy = []string
+
+// ApplicationsSortBy defines model for ApplicationsSortBy.
+type ApplicationsSortBy string
+
 // AreasFilterBy defines model for AreasFilterBy.
 type AreasFilterBy = []string
 
@@ -1508,7 +5228,7 @@ type AreasSortBy string
 type CampersFilterBy = []string
 
 // CampersSortBy defines model for CampersSortBy.
-type CampersSortBy string
+type CampersSortBy = string
 
 // CampsFilterBy defines model for CampsFilterBy.
 type CampsFilterBy = []string
@@ -1538,7 +5258,13 @@ type EventsSortBy string
 type GroupsFilterBy = []string
 
 // GroupsSortBy defines model for GroupsSortBy.
-type GroupsSortBy string
+type GroupsSortBy = string
+
+// GuardiansFilterBy defines model for GuardiansFilterBy.
+type GuardiansFilterBy = []string
+
+// GuardiansSortBy defines model for GuardiansSortBy.
+type GuardiansSortBy string
 
 // HousingRoomsFilterBy defines model for HousingRoomsFilterBy.
 type HousingRoomsFilterBy = []string
@@ -1546,12 +5272,24 @@ type HousingRoomsFilterBy = []string
 // HousingRoomsSortBy defines model for HousingRoomsSortBy.
 type HousingRoomsSortBy string
 
+// IncidentsFilterBy defines model for IncidentsFilterBy.
+type IncidentsFilterBy = []string
+
+// IncidentsSortBy defines model for IncidentsSortBy.
+type IncidentsSortBy string
+
 // LocationsFilterBy defines model for LocationsFilterBy.
 type LocationsFilterBy = []string
 
 // LocationsSortBy defines model for LocationsSortBy.
 type LocationsSortBy string
 
+// MedicationsFilterBy defines model for MedicationsFilterBy.
+type MedicationsFilterBy = []string
+
+// MedicationsSortBy defines model for MedicationsSortBy.
+type MedicationsSortBy string
+
 // ProgramsFilterBy defines model for ProgramsFilterBy.
 type ProgramsFilterBy = []string
 
@@ -1574,7 +5312,7 @@ type SessionsSortBy string
 type StaffMembersFilterBy = []string
 
 // StaffMembersSortBy defines model for StaffMembersSortBy.
-type StaffMembersSortBy string
+type StaffMembersSortBy = string
 
 // TimeBlocksFilterBy defines model for TimeBlocksFilterBy.
 type TimeBlocksFilterBy = []string
@@ -1582,30 +5320,210 @@ type TimeBlocksFilterBy = []string
 // TimeBlocksSortBy defines model for TimeBlocksSortBy.
 type TimeBlocksSortBy string
 
+// AssessmentId defines model for assessment_id.
+type AssessmentId = openapi_types.UUID
+
+// AttachmentEntityIdFilter defines model for attachment_entity_id_filter.
+type AttachmentEntityIdFilter = openapi_types.UUID
+
+// AttachmentEntityTypeFilter defines model for attachment_entity_type_filter.
+type AttachmentEntityTypeFilter = AttachmentEntityType
+
+// AttendanceCamperId defines model for attendance_camper_id.
+type AttendanceCamperId = openapi_types.UUID
+
+// AttendanceDate defines model for attendance_date.
+type AttendanceDate = openapi_types.Date
+
+// BedHousingRoomIdFilter defines model for bed_housing_room_id_filter.
+type BedHousingRoomIdFilter = openapi_types.UUID
+
+// BunkRequestCamperId defines model for bunk_request_camper_id.
+type BunkRequestCamperId = openapi_types.UUID
+
 // CampId defines model for camp_id.
 type CampId = openapi_types.UUID
 
+// CheckoutId defines model for checkout_id.
+type CheckoutId = openapi_types.UUID
+
+// ComplianceBefore defines model for compliance_before.
+type ComplianceBefore = openapi_types.Date
+
+// CustomFieldEntityTypeFilter defines model for custom_field_entity_type_filter.
+type CustomFieldEntityTypeFilter = CustomFieldEntityType
+
 // DeleteScope defines model for delete_scope.
 type DeleteScope string
 
+// DuplicatesMinScore defines model for duplicates_min_score.
+type DuplicatesMinScore = int
+
+// DutyFrom defines model for duty_from.
+type DutyFrom = openapi_types.Date
+
+// DutyStaffMemberId defines model for duty_staff_member_id.
+type DutyStaffMemberId = openapi_types.UUID
+
+// DutyTo defines model for duty_to.
+type DutyTo = openapi_types.Date
+
+// DutyTypeIdFilter defines model for duty_type_id_filter.
+type DutyTypeIdFilter = openapi_types.UUID
+
+// EligibilityFrom defines model for eligibility_from.
+type EligibilityFrom = openapi_types.Date
+
+// EligibilityTo defines model for eligibility_to.
+type EligibilityTo = openapi_types.Date
+
+// EquipmentCategoryFilter defines model for equipment_category_filter.
+type EquipmentCategoryFilter = string
+
+// EquipmentConditionFilter defines model for equipment_condition_filter.
+type EquipmentConditionFilter = EquipmentCondition
+
+// EquipmentFrom defines model for equipment_from.
+type EquipmentFrom = openapi_types.Date
+
+// EquipmentHomeLocationIdFilter defines model for equipment_home_location_id_filter.
+type EquipmentHomeLocationIdFilter = openapi_types.UUID
+
+// EquipmentOpenFilter defines model for equipment_open_filter.
+type EquipmentOpenFilter = bool
+
+// EquipmentTo defines model for equipment_to.
+type EquipmentTo = openapi_types.Date
+
 // Force defines model for force.
 type Force = bool
 
+// HousingSessionId defines model for housing_session_id.
+type HousingSessionId = openapi_types.UUID
+
 // Id defines model for id.
 type Id = string
 
+// IncidentReportFrom defines model for incident_report_from.
+type IncidentReportFrom = openapi_types.Date
+
+// IncidentReportGroupBy defines model for incident_report_group_by.
+type IncidentReportGroupBy = IncidentReportGrouping
+
+// IncidentReportTo defines model for incident_report_to.
+type IncidentReportTo = openapi_types.Date
+
 // Limit defines model for limit.
 type Limit = int
 
+// MaintenanceAssigneeIdFilter defines model for maintenance_assignee_id_filter.
+type MaintenanceAssigneeIdFilter = openapi_types.UUID
+
+// MaintenanceEntityIdFilter defines model for maintenance_entity_id_filter.
+type MaintenanceEntityIdFilter = openapi_types.UUID
+
+// MaintenanceEntityTypeFilter defines model for maintenance_entity_type_filter.
+type MaintenanceEntityTypeFilter = MaintenanceTicketEntityType
+
+// MaintenancePriorityFilter defines model for maintenance_priority_filter.
+type MaintenancePriorityFilter = MaintenanceTicketPriority
+
+// MaintenanceStatusFilter defines model for maintenance_status_filter.
+type MaintenanceStatusFilter = MaintenanceTicketStatus
+
+// MarCamperId defines model for mar_camper_id.
+type MarCamperId = openapi_types.UUID
+
+// MarDate defines model for mar_date.
+type MarDate = openapi_types.Date
+
+// MarSessionId defines model for mar_session_id.
+type MarSessionId = openapi_types.UUID
+
+// MenuFrom defines model for menu_from.
+type MenuFrom = openapi_types.Date
+
+// MenuTo defines model for menu_to.
+type MenuTo = openapi_types.Date
+
+// NoteEntityIdFilter defines model for note_entity_id_filter.
+type NoteEntityIdFilter = openapi_types.UUID
+
+// NoteEntityTypeFilter defines model for note_entity_type_filter.
+type NoteEntityTypeFilter = NoteEntityType
+
+// OccupancyFrom defines model for occupancy_from.
+type OccupancyFrom = openapi_types.Date
+
+// OccupancyTo defines model for occupancy_to.
+type OccupancyTo = openapi_types.Date
+
 // Offset defines model for offset.
 type Offset = int
 
+// OnboardingItemId defines model for onboarding_item_id.
+type OnboardingItemId = openapi_types.UUID
+
+// OnboardingReadyFilter defines model for onboarding_ready_filter.
+type OnboardingReadyFilter = bool
+
+// OutOfServiceFrom defines model for out_of_service_from.
+type OutOfServiceFrom = time.Time
+
+// OutOfServiceTo defines model for out_of_service_to.
+type OutOfServiceTo = time.Time
+
+// OvertimeThreshold defines model for overtime_threshold.
+type OvertimeThreshold = float64
+
+// PayPeriodFrom defines model for pay_period_from.
+type PayPeriodFrom = openapi_types.Date
+
+// PayPeriodTo defines model for pay_period_to.
+type PayPeriodTo = openapi_types.Date
+
+// RatioFrom defines model for ratio_from.
+type RatioFrom = openapi_types.Date
+
+// RatioTo defines model for ratio_to.
+type RatioTo = openapi_types.Date
+
+// ScheduleFrom defines model for schedule_from.
+type ScheduleFrom = openapi_types.Date
+
+// ScheduleTo defines model for schedule_to.
+type ScheduleTo = openapi_types.Date
+
 // Search defines model for search.
 type Search = string
 
 // SortOrder defines model for sortOrder.
 type SortOrder string
 
+// TimeEntryFrom defines model for time_entry_from.
+type TimeEntryFrom = openapi_types.Date
+
+// TimeEntryStaffMemberId defines model for time_entry_staff_member_id.
+type TimeEntryStaffMemberId = openapi_types.UUID
+
+// TimeEntryStatusFilter defines model for time_entry_status_filter.
+type TimeEntryStatusFilter = TimeEntryStatus
+
+// TimeEntryTo defines model for time_entry_to.
+type TimeEntryTo = openapi_types.Date
+
+// TimeOffStaffMemberId defines model for time_off_staff_member_id.
+type TimeOffStaffMemberId = openapi_types.UUID
+
+// TimeOffStatusFilter defines model for time_off_status_filter.
+type TimeOffStatusFilter = TimeOffRequestStatus
+
+// TimelineFrom defines model for timeline_from.
+type TimelineFrom = time.Time
+
+// TimelineTo defines model for timeline_to.
+type TimelineTo = time.Time
+
 // UpdateScope defines model for update_scope.
 type UpdateScope string
 
@@ -1671,12 +5589,52 @@ type ListActivitiesParamsSortBy string
 // ListActivitiesParamsSortOrder defines parameters for ListActivities.
 type ListActivitiesParamsSortOrder string
 
-// DeleteActivityByIdParams defines parameters for DeleteActivityById.
-type DeleteActivityByIdParams struct {
-	// Force Force deletion and cascade to dependent resources (events)
-	Force *Force `form:"force,omitempty" json:"force,omitempty"`
+// DeleteActivityByIdParams defines parameters for DeleteActivityById.
+type DeleteActivityByIdParams struct {
+	// Force Force deletion and cascade to dependent resources (events)
+	Force *Force `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetEligibilityReportParams defines parameters for GetEligibilityReport.
+type GetEligibilityReportParams struct {
+	// From First day to check; today when omitted
+	From *EligibilityFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day to check; a week after the first day when omitted
+	To *EligibilityTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListApplicationsParams defines parameters for ListApplications.
+type ListApplicationsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before starting to return results
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Search Search term to filter items by name, title, or other text fields
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// FilterBy Filter results by parameters. Format: field operator value
+	// Operators: == (equals), != (not equals), <= (less/equal), >= (greater/equal),
+	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
+	// Dates in ISO 8601 format. Text filters are case-insensitive.
+	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	FilterBy *ApplicationsFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
+
+	// SortBy Field name to sort by
+	SortBy *ListApplicationsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Sort direction
+	SortOrder *ListApplicationsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
+// ListApplicationsParamsSortBy defines parameters for ListApplications.
+type ListApplicationsParamsSortBy string
+
+// ListApplicationsParamsSortOrder defines parameters for ListApplications.
+type ListApplicationsParamsSortOrder string
+
 // ListAreasParams defines parameters for ListAreas.
 type ListAreasParams struct {
 	// Limit Maximum number of items to return per page
@@ -1708,6 +5666,68 @@ type ListAreasParamsSortBy string
 // ListAreasParamsSortOrder defines parameters for ListAreas.
 type ListAreasParamsSortOrder string
 
+// ListAttachmentsParams defines parameters for ListAttachments.
+type ListAttachmentsParams struct {
+	// EntityType Only include attachments of this kind of record
+	EntityType *AttachmentEntityTypeFilter `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Only include attachments of this record
+	EntityId *AttachmentEntityIdFilter `form:"entityId,omitempty" json:"entityId,omitempty"`
+}
+
+// UploadAttachmentMultipartBody defines parameters for UploadAttachment.
+type UploadAttachmentMultipartBody struct {
+	// Category What the attached document is. Photos must be images.
+	Category    AttachmentCategory `json:"category"`
+	Description *string            `json:"description,omitempty"`
+	EntityId    openapi_types.UUID `json:"entityId"`
+
+	// EntityType Kind of record an attachment belongs to
+	EntityType AttachmentEntityType `json:"entityType"`
+
+	// File PDF or image file to attach
+	File openapi_types.File `json:"file"`
+}
+
+// ListAttendanceRecordsParams defines parameters for ListAttendanceRecords.
+type ListAttendanceRecordsParams struct {
+	// Date Camp local day (defaults to today)
+	Date *AttendanceDate `form:"date,omitempty" json:"date,omitempty"`
+
+	// CamperId Only include records of this camper
+	CamperId *AttendanceCamperId `form:"camperId,omitempty" json:"camperId,omitempty"`
+}
+
+// GetOnSiteReportParams defines parameters for GetOnSiteReport.
+type GetOnSiteReportParams struct {
+	// Date Camp local day (defaults to today)
+	Date *AttendanceDate `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListBedAssignmentsParams defines parameters for ListBedAssignments.
+type ListBedAssignmentsParams struct {
+	// SessionId Only include entries of this session
+	SessionId *HousingSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+
+	// HousingRoomId Only include beds, or assignments to beds, of this housing room
+	HousingRoomId *BedHousingRoomIdFilter `form:"housingRoomId,omitempty" json:"housingRoomId,omitempty"`
+}
+
+// ListBedsParams defines parameters for ListBeds.
+type ListBedsParams struct {
+	// HousingRoomId Only include beds, or assignments to beds, of this housing room
+	HousingRoomId *BedHousingRoomIdFilter `form:"housingRoomId,omitempty" json:"housingRoomId,omitempty"`
+}
+
+// ListBunkRequestsParams defines parameters for ListBunkRequests.
+type ListBunkRequestsParams struct {
+	// SessionId Only include entries of this session
+	SessionId *HousingSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+
+	// CamperId Only include requests made by or naming this camper
+	CamperId *BunkRequestCamperId `form:"camperId,omitempty" json:"camperId,omitempty"`
+}
+
 // ListCampersParams defines parameters for ListCampers.
 type ListCampersParams struct {
 	// Limit Maximum number of items to return per page
@@ -1724,21 +5744,34 @@ type ListCampersParams struct {
 	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
 	// Dates in ISO 8601 format. Text filters are case-insensitive.
 	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	// Custom fields are filtered as customFields.<key> using the operators valid for the field type.
 	FilterBy *CampersFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
 
-	// SortBy Field name to sort by
-	SortBy *ListCampersParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortBy Field name to sort by, or customFields.<key> to sort by a custom field
+	SortBy *CampersSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 
 	// SortOrder Sort direction
 	SortOrder *ListCampersParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
-// ListCampersParamsSortBy defines parameters for ListCampers.
-type ListCampersParamsSortBy string
-
 // ListCampersParamsSortOrder defines parameters for ListCampers.
 type ListCampersParamsSortOrder string
 
+// ListCamperDuplicatesParams defines parameters for ListCamperDuplicates.
+type ListCamperDuplicatesParams struct {
+	// MinScore Only include pairs scoring at least this much (defaults to 70)
+	MinScore *DuplicatesMinScore `form:"minScore,omitempty" json:"minScore,omitempty"`
+}
+
+// GetCamperTimelineParams defines parameters for GetCamperTimeline.
+type GetCamperTimelineParams struct {
+	// From Only include events at or after this time
+	From *TimelineFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events before this time
+	To *TimelineTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListCertificationsParams defines parameters for ListCertifications.
 type ListCertificationsParams struct {
 	// Limit Maximum number of items to return per page
@@ -1770,6 +5803,12 @@ type ListCertificationsParamsSortBy string
 // ListCertificationsParamsSortOrder defines parameters for ListCertifications.
 type ListCertificationsParamsSortOrder string
 
+// GetCertificationComplianceParams defines parameters for GetCertificationCompliance.
+type GetCertificationComplianceParams struct {
+	// Before Report certificates that expire before this day (camp local date, defaults to 30 days from today)
+	Before *ComplianceBefore `form:"before,omitempty" json:"before,omitempty"`
+}
+
 // ListColorsParams defines parameters for ListColors.
 type ListColorsParams struct {
 	// Limit Maximum number of items to return per page
@@ -1801,6 +5840,63 @@ type ListColorsParamsSortBy string
 // ListColorsParamsSortOrder defines parameters for ListColors.
 type ListColorsParamsSortOrder string
 
+// ListCustomFieldsParams defines parameters for ListCustomFields.
+type ListCustomFieldsParams struct {
+	// EntityType Only include custom fields for this kind of record
+	EntityType *CustomFieldEntityTypeFilter `form:"entityType,omitempty" json:"entityType,omitempty"`
+}
+
+// ListDutyShiftsParams defines parameters for ListDutyShifts.
+type ListDutyShiftsParams struct {
+	// From Only include shifts ending on or after this day; today when omitted for conflicts
+	From *DutyFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include shifts starting on or before this day; a week after the first day when omitted for conflicts
+	To *DutyTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// StaffMemberId Only include shifts the staff member is assigned to
+	StaffMemberId *DutyStaffMemberId `form:"staffMemberId,omitempty" json:"staffMemberId,omitempty"`
+
+	// DutyTypeId Only include shifts of this duty type
+	DutyTypeId *DutyTypeIdFilter `form:"dutyTypeId,omitempty" json:"dutyTypeId,omitempty"`
+}
+
+// GetDutyShiftConflictsParams defines parameters for GetDutyShiftConflicts.
+type GetDutyShiftConflictsParams struct {
+	// From Only include shifts ending on or after this day; today when omitted for conflicts
+	From *DutyFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include shifts starting on or before this day; a week after the first day when omitted for conflicts
+	To *DutyTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListEquipmentItemsParams defines parameters for ListEquipmentItems.
+type ListEquipmentItemsParams struct {
+	// Category Only include equipment items of this category
+	Category *EquipmentCategoryFilter `form:"category,omitempty" json:"category,omitempty"`
+
+	// HomeLocationId Only include equipment items stored at this location
+	HomeLocationId *EquipmentHomeLocationIdFilter `form:"homeLocationId,omitempty" json:"homeLocationId,omitempty"`
+
+	// Condition Only include equipment items in this condition
+	Condition *EquipmentConditionFilter `form:"condition,omitempty" json:"condition,omitempty"`
+}
+
+// GetEquipmentConflictsParams defines parameters for GetEquipmentConflicts.
+type GetEquipmentConflictsParams struct {
+	// From First day to check; today when omitted
+	From *EquipmentFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day to check; a week after the first day when omitted
+	To *EquipmentTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListEquipmentCheckoutsParams defines parameters for ListEquipmentCheckouts.
+type ListEquipmentCheckoutsParams struct {
+	// Open Only include checkouts that have not been returned
+	Open *EquipmentOpenFilter `form:"open,omitempty" json:"open,omitempty"`
+}
+
 // ListEventsParams defines parameters for ListEvents.
 type ListEventsParams struct {
 	// Limit Maximum number of items to return per page
@@ -1866,21 +5962,68 @@ type ListGroupsParams struct {
 	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
 	// Dates in ISO 8601 format. Text filters are case-insensitive.
 	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	// Custom fields are filtered as customFields.<key> using the operators valid for the field type.
 	FilterBy *GroupsFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
 
-	// SortBy Field name to sort by
-	SortBy *ListGroupsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortBy Field name to sort by, or customFields.<key> to sort by a custom field
+	SortBy *GroupsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 
 	// SortOrder Sort direction
 	SortOrder *ListGroupsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
-// ListGroupsParamsSortBy defines parameters for ListGroups.
-type ListGroupsParamsSortBy string
-
 // ListGroupsParamsSortOrder defines parameters for ListGroups.
 type ListGroupsParamsSortOrder string
 
+// ListGuardiansParams defines parameters for ListGuardians.
+type ListGuardiansParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before starting to return results
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Search Search term to filter items by name, title, or other text fields
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// FilterBy Filter results by parameters. Format: field operator value
+	// Operators: == (equals), != (not equals), <= (less/equal), >= (greater/equal),
+	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
+	// Dates in ISO 8601 format. Text filters are case-insensitive.
+	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	FilterBy *GuardiansFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
+
+	// SortBy Field name to sort by
+	SortBy *ListGuardiansParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Sort direction
+	SortOrder *ListGuardiansParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ListGuardiansParamsSortBy defines parameters for ListGuardians.
+type ListGuardiansParamsSortBy string
+
+// ListGuardiansParamsSortOrder defines parameters for ListGuardians.
+type ListGuardiansParamsSortOrder string
+
+// ListHousingAssignmentsParams defines parameters for ListHousingAssignments.
+type ListHousingAssignmentsParams struct {
+	// SessionId Only include entries of this session
+	SessionId *HousingSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+}
+
+// GetHousingOccupancyParams defines parameters for GetHousingOccupancy.
+type GetHousingOccupancyParams struct {
+	// SessionId Only include entries of this session
+	SessionId *HousingSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+
+	// From First night of the report; the session's start, or today, when omitted
+	From *OccupancyFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last night of the report; the night before the session's end, or six days after from, when omitted
+	To *OccupancyTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListHousingRoomsParams defines parameters for ListHousingRooms.
 type ListHousingRoomsParams struct {
 	// Limit Maximum number of items to return per page
@@ -1939,6 +6082,49 @@ type ValidateImportMultipartBody struct {
 	File openapi_types.File `json:"file"`
 }
 
+// ListIncidentsParams defines parameters for ListIncidents.
+type ListIncidentsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before starting to return results
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Search Search term to filter items by name, title, or other text fields
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// FilterBy Filter results by parameters. Format: field operator value
+	// Operators: == (equals), != (not equals), <= (less/equal), >= (greater/equal),
+	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
+	// Dates in ISO 8601 format. Text filters are case-insensitive.
+	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	FilterBy *IncidentsFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
+
+	// SortBy Field name to sort by
+	SortBy *ListIncidentsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Sort direction
+	SortOrder *ListIncidentsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ListIncidentsParamsSortBy defines parameters for ListIncidents.
+type ListIncidentsParamsSortBy string
+
+// ListIncidentsParamsSortOrder defines parameters for ListIncidents.
+type ListIncidentsParamsSortOrder string
+
+// GetIncidentReportParams defines parameters for GetIncidentReport.
+type GetIncidentReportParams struct {
+	// GroupBy How incidents are grouped in the report
+	GroupBy IncidentReportGroupBy `form:"groupBy" json:"groupBy"`
+
+	// From First day of the reporting period (camp local date, inclusive)
+	From *IncidentReportFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the reporting period (camp local date, inclusive)
+	To *IncidentReportTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListLocationsParams defines parameters for ListLocations.
 type ListLocationsParams struct {
 	// Limit Maximum number of items to return per page
@@ -1970,6 +6156,127 @@ type ListLocationsParamsSortBy string
 // ListLocationsParamsSortOrder defines parameters for ListLocations.
 type ListLocationsParamsSortOrder string
 
+// ListMaintenanceTicketsParams defines parameters for ListMaintenanceTickets.
+type ListMaintenanceTicketsParams struct {
+	// EntityType Only include tickets about this kind of facility
+	EntityType *MaintenanceEntityTypeFilter `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Only include tickets about this location, housing room or area
+	EntityId *MaintenanceEntityIdFilter `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// Status Only include tickets with this status
+	Status *MaintenanceStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+
+	// Priority Only include tickets with this priority
+	Priority *MaintenancePriorityFilter `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// AssigneeId Only include tickets assigned to this staff member
+	AssigneeId *MaintenanceAssigneeIdFilter `form:"assigneeId,omitempty" json:"assigneeId,omitempty"`
+}
+
+// ListDueDosesParams defines parameters for ListDueDoses.
+type ListDueDosesParams struct {
+	// Date Day of the medication administration record (camp local date)
+	Date MarDate `form:"date" json:"date"`
+
+	// CamperId Only include doses of this camper
+	CamperId *MarCamperId `form:"camperId,omitempty" json:"camperId,omitempty"`
+
+	// SessionId Only include doses of campers enrolled in this session
+	SessionId *MarSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+}
+
+// GetMarReportParams defines parameters for GetMarReport.
+type GetMarReportParams struct {
+	// Date Day of the medication administration record (camp local date)
+	Date MarDate `form:"date" json:"date"`
+
+	// CamperId Only include doses of this camper
+	CamperId *MarCamperId `form:"camperId,omitempty" json:"camperId,omitempty"`
+
+	// SessionId Only include doses of campers enrolled in this session
+	SessionId *MarSessionId `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+}
+
+// GetMyScheduleParams defines parameters for GetMySchedule.
+type GetMyScheduleParams struct {
+	// From First day of the schedule; today when omitted
+	From *ScheduleFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the schedule; a week after the first day when omitted
+	To *ScheduleTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetMealHeadcountsParams defines parameters for GetMealHeadcounts.
+type GetMealHeadcountsParams struct {
+	// Date Camp local day (defaults to today)
+	Date *AttendanceDate `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListMedicationsParams defines parameters for ListMedications.
+type ListMedicationsParams struct {
+	// Limit Maximum number of items to return per page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip before starting to return results
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Search Search term to filter items by name, title, or other text fields
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// FilterBy Filter results by parameters. Format: field operator value
+	// Operators: == (equals), != (not equals), <= (less/equal), >= (greater/equal),
+	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
+	// Dates in ISO 8601 format. Text filters are case-insensitive.
+	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	FilterBy *MedicationsFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
+
+	// SortBy Field name to sort by
+	SortBy *ListMedicationsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Sort direction
+	SortOrder *ListMedicationsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ListMedicationsParamsSortBy defines parameters for ListMedications.
+type ListMedicationsParamsSortBy string
+
+// ListMedicationsParamsSortOrder defines parameters for ListMedications.
+type ListMedicationsParamsSortOrder string
+
+// ListMenusParams defines parameters for ListMenus.
+type ListMenusParams struct {
+	// From Only include menus served on or after this day
+	From *MenuFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include menus served on or before this day
+	To *MenuTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListNotesParams defines parameters for ListNotes.
+type ListNotesParams struct {
+	// EntityType Only include notes about this kind of person
+	EntityType *NoteEntityTypeFilter `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Only include notes about this camper or staff member
+	EntityId *NoteEntityIdFilter `form:"entityId,omitempty" json:"entityId,omitempty"`
+}
+
+// GetOnboardingReportParams defines parameters for GetOnboardingReport.
+type GetOnboardingReportParams struct {
+	// Ready Only include staff members who are (true) or are not (false) ready to work
+	Ready *OnboardingReadyFilter `form:"ready,omitempty" json:"ready,omitempty"`
+}
+
+// ListOutOfServicePeriodsParams defines parameters for ListOutOfServicePeriods.
+type ListOutOfServicePeriodsParams struct {
+	// From Only include periods ending after this instant; now when omitted
+	From *OutOfServiceFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include periods starting before this instant
+	To *OutOfServiceTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListProgramsParams defines parameters for ListPrograms.
 type ListProgramsParams struct {
 	// Limit Maximum number of items to return per page
@@ -2007,6 +6314,15 @@ type DeleteProgramByIdParams struct {
 	Force *Force `form:"force,omitempty" json:"force,omitempty"`
 }
 
+// GetRatioComplianceParams defines parameters for GetRatioCompliance.
+type GetRatioComplianceParams struct {
+	// From First day to check; today when omitted
+	From *RatioFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day to check; a week after the first day when omitted
+	To *RatioTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListRolesParams defines parameters for ListRoles.
 type ListRolesParams struct {
 	// Limit Maximum number of items to return per page
@@ -2085,21 +6401,28 @@ type ListStaffMembersParams struct {
 	// =@ (contains), !@ (not contains), =^ (starts with), =~ (ends with)
 	// Dates in ISO 8601 format. Text filters are case-insensitive.
 	// Note: Text operators (=@, !@, =^, =~) only work with text fields.
+	// Custom fields are filtered as customFields.<key> using the operators valid for the field type.
 	FilterBy *StaffMembersFilterBy `form:"filterBy,omitempty" json:"filterBy,omitempty"`
 
-	// SortBy Field name to sort by
-	SortBy *ListStaffMembersParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	// SortBy Field name to sort by, or customFields.<key> to sort by a custom field
+	SortBy *StaffMembersSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 
 	// SortOrder Sort direction
 	SortOrder *ListStaffMembersParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
-// ListStaffMembersParamsSortBy defines parameters for ListStaffMembers.
-type ListStaffMembersParamsSortBy string
-
 // ListStaffMembersParamsSortOrder defines parameters for ListStaffMembers.
 type ListStaffMembersParamsSortOrder string
 
+// GetStaffMemberTimelineParams defines parameters for GetStaffMemberTimeline.
+type GetStaffMemberTimelineParams struct {
+	// From Only include events at or after this time
+	From *TimelineFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include events before this time
+	To *TimelineTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListTimeBlocksParams defines parameters for ListTimeBlocks.
 type ListTimeBlocksParams struct {
 	// Limit Maximum number of items to return per page
@@ -2131,6 +6454,60 @@ type ListTimeBlocksParamsSortBy string
 // ListTimeBlocksParamsSortOrder defines parameters for ListTimeBlocks.
 type ListTimeBlocksParamsSortOrder string
 
+// ListTimeEntriesParams defines parameters for ListTimeEntries.
+type ListTimeEntriesParams struct {
+	// StaffMemberId Only include the staff member's entries
+	StaffMemberId *TimeEntryStaffMemberId `form:"staffMemberId,omitempty" json:"staffMemberId,omitempty"`
+
+	// From Only include entries clocked in on or after this day
+	From *TimeEntryFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include entries clocked in on or before this day
+	To *TimeEntryTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// Status Only include entries in this review state
+	Status *TimeEntryStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListTimeOffRequestsParams defines parameters for ListTimeOffRequests.
+type ListTimeOffRequestsParams struct {
+	// StaffMemberId Only include the staff member's requests
+	StaffMemberId *TimeOffStaffMemberId `form:"staffMemberId,omitempty" json:"staffMemberId,omitempty"`
+
+	// Status Only include requests in this review state
+	Status *TimeOffStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetTimesheetsParams defines parameters for GetTimesheets.
+type GetTimesheetsParams struct {
+	// From First day of the pay period
+	From PayPeriodFrom `form:"from" json:"from"`
+
+	// To Last day of the pay period
+	To PayPeriodTo `form:"to" json:"to"`
+
+	// StaffMemberId Only include the staff member's entries
+	StaffMemberId *TimeEntryStaffMemberId `form:"staffMemberId,omitempty" json:"staffMemberId,omitempty"`
+
+	// OvertimeThreshold Weekly hours beyond which hours count as overtime; 40 when omitted
+	OvertimeThreshold *OvertimeThreshold `form:"overtimeThreshold,omitempty" json:"overtimeThreshold,omitempty"`
+}
+
+// ExportTimesheetsParams defines parameters for ExportTimesheets.
+type ExportTimesheetsParams struct {
+	// From First day of the pay period
+	From PayPeriodFrom `form:"from" json:"from"`
+
+	// To Last day of the pay period
+	To PayPeriodTo `form:"to" json:"to"`
+
+	// StaffMemberId Only include the staff member's entries
+	StaffMemberId *TimeEntryStaffMemberId `form:"staffMemberId,omitempty" json:"staffMemberId,omitempty"`
+
+	// OvertimeThreshold Weekly hours beyond which hours count as overtime; 40 when omitted
+	OvertimeThreshold *OvertimeThreshold `form:"overtimeThreshold,omitempty" json:"overtimeThreshold,omitempty"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
@@ -2146,30 +6523,120 @@ type CreateActivityJSONRequestBody = ActivityCreationRequest
 // UpdateActivityByIdJSONRequestBody defines body for UpdateActivityById for application/json ContentType.
 type UpdateActivityByIdJSONRequestBody = ActivityUpdateRequest
 
+// CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
+type CreateApplicationJSONRequestBody = ApplicationCreationRequest
+
+// UpdateApplicationByIdJSONRequestBody defines body for UpdateApplicationById for application/json ContentType.
+type UpdateApplicationByIdJSONRequestBody = ApplicationUpdateRequest
+
+// TransitionApplicationJSONRequestBody defines body for TransitionApplication for application/json ContentType.
+type TransitionApplicationJSONRequestBody = ApplicationTransitionRequest
+
 // CreateAreaJSONRequestBody defines body for CreateArea for application/json ContentType.
 type CreateAreaJSONRequestBody = AreaCreationRequest
 
 // UpdateAreaByIdJSONRequestBody defines body for UpdateAreaById for application/json ContentType.
 type UpdateAreaByIdJSONRequestBody = AreaUpdateRequest
 
+// UploadAttachmentMultipartRequestBody defines body for UploadAttachment for multipart/form-data ContentType.
+type UploadAttachmentMultipartRequestBody UploadAttachmentMultipartBody
+
+// CheckInCamperJSONRequestBody defines body for CheckInCamper for application/json ContentType.
+type CheckInCamperJSONRequestBody = AttendanceRequest
+
+// CheckOutCamperJSONRequestBody defines body for CheckOutCamper for application/json ContentType.
+type CheckOutCamperJSONRequestBody = AttendanceRequest
+
+// CreateBedAssignmentJSONRequestBody defines body for CreateBedAssignment for application/json ContentType.
+type CreateBedAssignmentJSONRequestBody = BedAssignmentCreationRequest
+
+// UpdateBedAssignmentByIdJSONRequestBody defines body for UpdateBedAssignmentById for application/json ContentType.
+type UpdateBedAssignmentByIdJSONRequestBody = BedAssignmentUpdateRequest
+
+// CreateBedJSONRequestBody defines body for CreateBed for application/json ContentType.
+type CreateBedJSONRequestBody = BedCreationRequest
+
+// UpdateBedByIdJSONRequestBody defines body for UpdateBedById for application/json ContentType.
+type UpdateBedByIdJSONRequestBody = BedUpdateRequest
+
+// CreateBunkRequestJSONRequestBody defines body for CreateBunkRequest for application/json ContentType.
+type CreateBunkRequestJSONRequestBody = BunkRequestCreationRequest
+
 // CreateCamperJSONRequestBody defines body for CreateCamper for application/json ContentType.
 type CreateCamperJSONRequestBody = CamperCreationRequest
 
 // UpdateCamperByIdJSONRequestBody defines body for UpdateCamperById for application/json ContentType.
 type UpdateCamperByIdJSONRequestBody = CamperUpdateRequest
 
+// CreateCamperEnrollmentJSONRequestBody defines body for CreateCamperEnrollment for application/json ContentType.
+type CreateCamperEnrollmentJSONRequestBody = CamperEnrollmentRequest
+
+// UpdateCamperEnrollmentJSONRequestBody defines body for UpdateCamperEnrollment for application/json ContentType.
+type UpdateCamperEnrollmentJSONRequestBody = CamperEnrollmentRequest
+
+// MergeCamperJSONRequestBody defines body for MergeCamper for application/json ContentType.
+type MergeCamperJSONRequestBody = CamperMergeRequest
+
+// CreateSkillAssessmentJSONRequestBody defines body for CreateSkillAssessment for application/json ContentType.
+type CreateSkillAssessmentJSONRequestBody = SkillAssessmentCreationRequest
+
 // CreateCertificationJSONRequestBody defines body for CreateCertification for application/json ContentType.
 type CreateCertificationJSONRequestBody = CertificationCreationRequest
 
 // UpdateCertificationByIdJSONRequestBody defines body for UpdateCertificationById for application/json ContentType.
 type UpdateCertificationByIdJSONRequestBody = CertificationUpdateRequest
 
+// CloneCampJSONRequestBody defines body for CloneCamp for application/json ContentType.
+type CloneCampJSONRequestBody = CampCloneRequest
+
 // CreateColorJSONRequestBody defines body for CreateColor for application/json ContentType.
 type CreateColorJSONRequestBody = ColorCreationRequest
 
 // UpdateColorByIdJSONRequestBody defines body for UpdateColorById for application/json ContentType.
 type UpdateColorByIdJSONRequestBody = ColorUpdateRequest
 
+// CreateCustomFieldJSONRequestBody defines body for CreateCustomField for application/json ContentType.
+type CreateCustomFieldJSONRequestBody = CustomFieldDefinitionCreationRequest
+
+// UpdateCustomFieldByIdJSONRequestBody defines body for UpdateCustomFieldById for application/json ContentType.
+type UpdateCustomFieldByIdJSONRequestBody = CustomFieldDefinitionUpdateRequest
+
+// CreateDutyRotationJSONRequestBody defines body for CreateDutyRotation for application/json ContentType.
+type CreateDutyRotationJSONRequestBody = DutyRotationCreationRequest
+
+// UpdateDutyRotationByIdJSONRequestBody defines body for UpdateDutyRotationById for application/json ContentType.
+type UpdateDutyRotationByIdJSONRequestBody = DutyRotationUpdateRequest
+
+// GenerateDutyRotationShiftsJSONRequestBody defines body for GenerateDutyRotationShifts for application/json ContentType.
+type GenerateDutyRotationShiftsJSONRequestBody = DutyRotationGenerationRequest
+
+// CreateDutyShiftJSONRequestBody defines body for CreateDutyShift for application/json ContentType.
+type CreateDutyShiftJSONRequestBody = DutyShiftCreationRequest
+
+// AutoAssignDutyShiftsJSONRequestBody defines body for AutoAssignDutyShifts for application/json ContentType.
+type AutoAssignDutyShiftsJSONRequestBody = DutyShiftAssignmentRequest
+
+// UpdateDutyShiftByIdJSONRequestBody defines body for UpdateDutyShiftById for application/json ContentType.
+type UpdateDutyShiftByIdJSONRequestBody = DutyShiftUpdateRequest
+
+// CreateDutyTypeJSONRequestBody defines body for CreateDutyType for application/json ContentType.
+type CreateDutyTypeJSONRequestBody = DutyTypeCreationRequest
+
+// UpdateDutyTypeByIdJSONRequestBody defines body for UpdateDutyTypeById for application/json ContentType.
+type UpdateDutyTypeByIdJSONRequestBody = DutyTypeUpdateRequest
+
+// CreateEquipmentItemJSONRequestBody defines body for CreateEquipmentItem for application/json ContentType.
+type CreateEquipmentItemJSONRequestBody = EquipmentItemCreationRequest
+
+// UpdateEquipmentItemByIdJSONRequestBody defines body for UpdateEquipmentItemById for application/json ContentType.
+type UpdateEquipmentItemByIdJSONRequestBody = EquipmentItemUpdateRequest
+
+// CheckOutEquipmentJSONRequestBody defines body for CheckOutEquipment for application/json ContentType.
+type CheckOutEquipmentJSONRequestBody = EquipmentCheckoutRequest
+
+// ReturnEquipmentJSONRequestBody defines body for ReturnEquipment for application/json ContentType.
+type ReturnEquipmentJSONRequestBody = EquipmentReturnRequest
+
 // CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
 type CreateEventJSONRequestBody = EventCreationRequest
 
@@ -2182,6 +6649,15 @@ type CreateGroupJSONRequestBody = GroupCreationRequest
 // UpdateGroupByIdJSONRequestBody defines body for UpdateGroupById for application/json ContentType.
 type UpdateGroupByIdJSONRequestBody = GroupUpdateRequest
 
+// CreateGuardianJSONRequestBody defines body for CreateGuardian for application/json ContentType.
+type CreateGuardianJSONRequestBody = GuardianCreationRequest
+
+// UpdateGuardianByIdJSONRequestBody defines body for UpdateGuardianById for application/json ContentType.
+type UpdateGuardianByIdJSONRequestBody = GuardianUpdateRequest
+
+// CreateHousingAssignmentJSONRequestBody defines body for CreateHousingAssignment for application/json ContentType.
+type CreateHousingAssignmentJSONRequestBody = HousingAssignmentRequest
+
 // CreateHousingRoomJSONRequestBody defines body for CreateHousingRoom for application/json ContentType.
 type CreateHousingRoomJSONRequestBody = HousingRoomCreationRequest
 
@@ -2194,18 +6670,81 @@ type StartImportMultipartRequestBody StartImportMultipartBody
 // ValidateImportMultipartRequestBody defines body for ValidateImport for multipart/form-data ContentType.
 type ValidateImportMultipartRequestBody ValidateImportMultipartBody
 
+// CreateIncidentJSONRequestBody defines body for CreateIncident for application/json ContentType.
+type CreateIncidentJSONRequestBody = IncidentCreationRequest
+
+// UpdateIncidentByIdJSONRequestBody defines body for UpdateIncidentById for application/json ContentType.
+type UpdateIncidentByIdJSONRequestBody = IncidentUpdateRequest
+
+// ReviewIncidentJSONRequestBody defines body for ReviewIncident for application/json ContentType.
+type ReviewIncidentJSONRequestBody = IncidentReviewRequest
+
 // CreateLocationJSONRequestBody defines body for CreateLocation for application/json ContentType.
 type CreateLocationJSONRequestBody = LocationCreationRequest
 
 // UpdateLocationByIdJSONRequestBody defines body for UpdateLocationById for application/json ContentType.
 type UpdateLocationByIdJSONRequestBody = LocationUpdateRequest
 
+// CreateMaintenanceTicketJSONRequestBody defines body for CreateMaintenanceTicket for application/json ContentType.
+type CreateMaintenanceTicketJSONRequestBody = MaintenanceTicketCreationRequest
+
+// UpdateMaintenanceTicketByIdJSONRequestBody defines body for UpdateMaintenanceTicketById for application/json ContentType.
+type UpdateMaintenanceTicketByIdJSONRequestBody = MaintenanceTicketUpdateRequest
+
+// RecordMedicationDoseJSONRequestBody defines body for RecordMedicationDose for application/json ContentType.
+type RecordMedicationDoseJSONRequestBody = MedicationDoseRequest
+
+// UpdateMedicationDoseJSONRequestBody defines body for UpdateMedicationDose for application/json ContentType.
+type UpdateMedicationDoseJSONRequestBody = MedicationDoseRequest
+
+// UpdateMyAvailabilityJSONRequestBody defines body for UpdateMyAvailability for application/json ContentType.
+type UpdateMyAvailabilityJSONRequestBody = StaffAvailabilityUpdateRequest
+
+// CreateMyTimeOffRequestJSONRequestBody defines body for CreateMyTimeOffRequest for application/json ContentType.
+type CreateMyTimeOffRequestJSONRequestBody = TimeOffRequestCreationRequest
+
+// CreateMealPeriodJSONRequestBody defines body for CreateMealPeriod for application/json ContentType.
+type CreateMealPeriodJSONRequestBody = MealPeriodCreationRequest
+
+// UpdateMealPeriodByIdJSONRequestBody defines body for UpdateMealPeriodById for application/json ContentType.
+type UpdateMealPeriodByIdJSONRequestBody = MealPeriodUpdateRequest
+
+// CreateMedicationJSONRequestBody defines body for CreateMedication for application/json ContentType.
+type CreateMedicationJSONRequestBody = MedicationCreationRequest
+
+// UpdateMedicationByIdJSONRequestBody defines body for UpdateMedicationById for application/json ContentType.
+type UpdateMedicationByIdJSONRequestBody = MedicationUpdateRequest
+
+// CreateMenuJSONRequestBody defines body for CreateMenu for application/json ContentType.
+type CreateMenuJSONRequestBody = MenuCreationRequest
+
+// UpdateMenuByIdJSONRequestBody defines body for UpdateMenuById for application/json ContentType.
+type UpdateMenuByIdJSONRequestBody = MenuUpdateRequest
+
+// CreateNoteJSONRequestBody defines body for CreateNote for application/json ContentType.
+type CreateNoteJSONRequestBody = NoteCreationRequest
+
+// UpdateNoteByIdJSONRequestBody defines body for UpdateNoteById for application/json ContentType.
+type UpdateNoteByIdJSONRequestBody = NoteUpdateRequest
+
+// CreateOnboardingTemplateJSONRequestBody defines body for CreateOnboardingTemplate for application/json ContentType.
+type CreateOnboardingTemplateJSONRequestBody = OnboardingTemplateCreationRequest
+
+// UpdateOnboardingTemplateByIdJSONRequestBody defines body for UpdateOnboardingTemplateById for application/json ContentType.
+type UpdateOnboardingTemplateByIdJSONRequestBody = OnboardingTemplateUpdateRequest
+
 // CreateProgramJSONRequestBody defines body for CreateProgram for application/json ContentType.
 type CreateProgramJSONRequestBody = ProgramCreationRequest
 
 // UpdateProgramByIdJSONRequestBody defines body for UpdateProgramById for application/json ContentType.
 type UpdateProgramByIdJSONRequestBody = ProgramUpdateRequest
 
+// CreateRatioPolicyJSONRequestBody defines body for CreateRatioPolicy for application/json ContentType.
+type CreateRatioPolicyJSONRequestBody = RatioPolicyCreationRequest
+
+// UpdateRatioPolicyByIdJSONRequestBody defines body for UpdateRatioPolicyById for application/json ContentType.
+type UpdateRatioPolicyByIdJSONRequestBody = RatioPolicyUpdateRequest
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = RoleCreationRequest
 
@@ -2218,17 +6757,47 @@ type CreateSessionJSONRequestBody = SessionCreationRequest
 // UpdateSessionByIdJSONRequestBody defines body for UpdateSessionById for application/json ContentType.
 type UpdateSessionByIdJSONRequestBody = SessionUpdateRequest
 
+// CreateSkillTrackJSONRequestBody defines body for CreateSkillTrack for application/json ContentType.
+type CreateSkillTrackJSONRequestBody = SkillTrackCreationRequest
+
+// UpdateSkillTrackByIdJSONRequestBody defines body for UpdateSkillTrackById for application/json ContentType.
+type UpdateSkillTrackByIdJSONRequestBody = SkillTrackUpdateRequest
+
 // CreateStaffMemberJSONRequestBody defines body for CreateStaffMember for application/json ContentType.
 type CreateStaffMemberJSONRequestBody = StaffMemberCreationRequest
 
 // UpdateStaffMemberByIdJSONRequestBody defines body for UpdateStaffMemberById for application/json ContentType.
 type UpdateStaffMemberByIdJSONRequestBody = StaffMemberUpdateRequest
 
+// VerifyStaffMemberCertificationJSONRequestBody defines body for VerifyStaffMemberCertification for application/json ContentType.
+type VerifyStaffMemberCertificationJSONRequestBody = StaffCertificationVerificationRequest
+
+// ClockInStaffMemberJSONRequestBody defines body for ClockInStaffMember for application/json ContentType.
+type ClockInStaffMemberJSONRequestBody = TimeClockRequest
+
+// ClockOutStaffMemberJSONRequestBody defines body for ClockOutStaffMember for application/json ContentType.
+type ClockOutStaffMemberJSONRequestBody = TimeClockRequest
+
+// CompleteStaffMemberOnboardingItemJSONRequestBody defines body for CompleteStaffMemberOnboardingItem for application/json ContentType.
+type CompleteStaffMemberOnboardingItemJSONRequestBody = StaffOnboardingItemCompletionRequest
+
 // CreateTimeBlockJSONRequestBody defines body for CreateTimeBlock for application/json ContentType.
 type CreateTimeBlockJSONRequestBody = TimeBlockCreationRequest
 
 // UpdateTimeBlockByIdJSONRequestBody defines body for UpdateTimeBlockById for application/json ContentType.
 type UpdateTimeBlockByIdJSONRequestBody = TimeBlockUpdateRequest
 
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = TimeEntryCreationRequest
+
+// UpdateTimeEntryByIdJSONRequestBody defines body for UpdateTimeEntryById for application/json ContentType.
+type UpdateTimeEntryByIdJSONRequestBody = TimeEntryUpdateRequest
+
+// ReviewTimeEntryJSONRequestBody defines body for ReviewTimeEntry for application/json ContentType.
+type ReviewTimeEntryJSONRequestBody = TimeEntryReviewRequest
+
+// ReviewTimeOffRequestJSONRequestBody defines body for ReviewTimeOffRequest for application/json ContentType.
+type ReviewTimeOffRequestJSONRequestBody = TimeOffRequestReviewRequest
+
 // UpdateCampByIdJSONRequestBody defines body for UpdateCampById for application/json ContentType.
 type UpdateCampByIdJSONRequestBody = CampUpdateRequest
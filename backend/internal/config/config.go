@@ -9,12 +9,14 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Logging  LoggingConfig
-	JWT      JWTConfig
-	CORS     CORSConfig
-	Cleanup  CleanupConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Logging     LoggingConfig
+	JWT         JWTConfig
+	CORS        CORSConfig
+	Cleanup     CleanupConfig
+	Storage     StorageConfig
+	Attachments AttachmentsConfig
 }
 
 // ServerConfig holds HTTP server configuration
@@ -62,6 +64,18 @@ type CleanupConfig struct {
 	FailedRetentionDays  int
 }
 
+// StorageConfig holds file storage configuration
+type StorageConfig struct {
+	Backend  string
+	LocalDir string
+}
+
+// AttachmentsConfig holds attachment upload and download configuration
+type AttachmentsConfig struct {
+	MaxSizeBytes   int64
+	DownloadURLTTL time.Duration
+}
+
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
 	viper.SetDefault("SERVER_HOST", "0.0.0.0")
@@ -96,6 +110,14 @@ func Load() (*Config, error) {
 	viper.SetDefault("CLEANUP_SUCCESS_RETENTION_DAYS", 30)
 	viper.SetDefault("CLEANUP_FAILED_RETENTION_DAYS", 90)
 
+	// File storage defaults - only the local filesystem backend is available for now
+	viper.SetDefault("STORAGE_BACKEND", "local")
+	viper.SetDefault("STORAGE_LOCAL_DIR", "./uploads/attachments")
+
+	// Attachment defaults
+	viper.SetDefault("ATTACHMENT_MAX_SIZE_MB", 10)
+	viper.SetDefault("ATTACHMENT_DOWNLOAD_URL_TTL", "5m")
+
 	// Automatically read from environment variables
 	viper.AutomaticEnv()
 
@@ -125,6 +147,16 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid CLEANUP_POLL_INTERVAL: %w", err)
 	}
 
+	downloadURLTTL, err := time.ParseDuration(viper.GetString("ATTACHMENT_DOWNLOAD_URL_TTL"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ATTACHMENT_DOWNLOAD_URL_TTL: %w", err)
+	}
+
+	attachmentMaxSizeMB := viper.GetInt64("ATTACHMENT_MAX_SIZE_MB")
+	if attachmentMaxSizeMB <= 0 {
+		return nil, fmt.Errorf("ATTACHMENT_MAX_SIZE_MB must be positive")
+	}
+
 	config := &Config{
 		Server: ServerConfig{
 			Host:         viper.GetString("SERVER_HOST"),
@@ -159,6 +191,14 @@ func Load() (*Config, error) {
 			SuccessRetentionDays: viper.GetInt("CLEANUP_SUCCESS_RETENTION_DAYS"),
 			FailedRetentionDays:  viper.GetInt("CLEANUP_FAILED_RETENTION_DAYS"),
 		},
+		Storage: StorageConfig{
+			Backend:  viper.GetString("STORAGE_BACKEND"),
+			LocalDir: viper.GetString("STORAGE_LOCAL_DIR"),
+		},
+		Attachments: AttachmentsConfig{
+			MaxSizeBytes:   attachmentMaxSizeMB << 20,
+			DownloadURLTTL: downloadURLTTL,
+		},
 	}
 
 	return config, nil
@@ -179,6 +179,41 @@ func (d *Database) createMigrationsTable() error {
 // DropAllTables drops all tables (use with caution - for testing only)
 func (d *Database) DropAllTables() error {
 	tables := []string{
+		"camp_clone_jobs",
+		"camper_badges",
+		"skill_assessments",
+		"skill_tracks",
+		"equipment_checkouts",
+		"equipment_items",
+		"maintenance_tickets",
+		"bed_assignments",
+		"beds",
+		"onboarding_completions",
+		"onboarding_templates",
+		"time_off_requests",
+		"time_entries",
+		"ratio_policies",
+		"duty_shifts",
+		"duty_rotations",
+		"duty_types",
+		"menus",
+		"meal_periods",
+		"notes",
+		"group_membership_changes",
+		"custom_field_definitions",
+		"attachments",
+		"camper_merges",
+		"housing_assignments",
+		"bunk_requests",
+		"attendance_records",
+		"incidents",
+		"medication_doses",
+		"medications",
+		"application_transitions",
+		"applications",
+		"camper_enrollments",
+		"guardian_campers",
+		"guardians",
 		"housing_rooms",
 		"locations",
 		"roles",
@@ -1302,7 +1337,8 @@ func (d *Database) SeedData() error {
 	fmt.Println("  Email: viewer@adventurecamps.com | Password: password123 | Scope: tenant (Adventure Camps) | Role: viewer")
 	fmt.Println("\nMulti-Access:")
 	fmt.Println("  Email: multicamp@democamp.com | Password: password123 | Scope: camp (Summer Camp 2025) | Role: admin")
-	fmt.Println("==========================\n")
+	fmt.Println("==========================")
+	fmt.Println()
 
 	return nil
 }
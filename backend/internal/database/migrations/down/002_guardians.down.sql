@@ -0,0 +1,6 @@
+-- Migration: 002_guardians (DOWN)
+-- Description: Rolls back guardians and the guardian_campers junction table
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS guardian_campers CASCADE;
+DROP TABLE IF EXISTS guardians CASCADE;
@@ -0,0 +1,5 @@
+-- Migration: 003_camper_enrollments (DOWN)
+-- Description: Rolls back the camper_enrollments table
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS camper_enrollments CASCADE;
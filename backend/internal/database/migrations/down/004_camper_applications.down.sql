@@ -0,0 +1,9 @@
+-- Migration: 004_camper_applications (DOWN)
+-- Description: Rolls back camper applications, their transitions and session capacity
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS application_transitions CASCADE;
+DROP TABLE IF EXISTS applications CASCADE;
+
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS check_session_capacity;
+ALTER TABLE sessions DROP COLUMN IF EXISTS capacity;
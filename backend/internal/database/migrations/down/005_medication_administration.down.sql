@@ -0,0 +1,13 @@
+-- Migration: 005_medication_administration (DOWN)
+-- Description: Rolls back camper medications, the medication administration record and the health role
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS medication_doses CASCADE;
+DROP TABLE IF EXISTS medications CASCADE;
+
+DELETE FROM access_rules WHERE role = 'health';
+
+ALTER TABLE access_rules DROP CONSTRAINT IF EXISTS check_access_rule_role;
+ALTER TABLE access_rules ADD CONSTRAINT check_access_rule_role CHECK (role IN ('admin', 'program-admin', 'viewer'));
+
+COMMENT ON COLUMN access_rules.role IS 'Role at this scope: admin, program-admin, viewer';
@@ -0,0 +1,5 @@
+-- Migration: 006_incident_reports (DOWN)
+-- Description: Rolls back incident and injury reports
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS incidents CASCADE;
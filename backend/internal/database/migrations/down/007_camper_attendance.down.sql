@@ -0,0 +1,5 @@
+-- Migration: 007_camper_attendance (DOWN)
+-- Description: Rolls back daily camper sign-in and sign-out records
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS attendance_records CASCADE;
@@ -0,0 +1,6 @@
+-- Migration: 008_housing_assignment (DOWN)
+-- Description: Rolls back bunkmate requests and housing assignment proposals
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS housing_assignments CASCADE;
+DROP TABLE IF EXISTS bunk_requests CASCADE;
@@ -0,0 +1,8 @@
+-- Migration: 009_rule_based_groups (DOWN)
+-- Description: Rolls back group membership rules
+-- Created: 2026-10-19
+
+DROP INDEX IF EXISTS idx_groups_rule_based;
+
+ALTER TABLE groups DROP CONSTRAINT IF EXISTS check_group_membership_rules;
+ALTER TABLE groups DROP COLUMN IF EXISTS membership_rules;
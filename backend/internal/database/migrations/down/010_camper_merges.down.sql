@@ -0,0 +1,5 @@
+-- Migration: 010_camper_merges (DOWN)
+-- Description: Rolls back the camper merge audit trail
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS camper_merges CASCADE;
@@ -0,0 +1,5 @@
+-- Migration: 011_attachments (DOWN)
+-- Description: Rolls back document and photo attachments
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS attachments CASCADE;
@@ -0,0 +1,12 @@
+-- Migration: 012_custom_fields (DOWN)
+-- Description: Rolls back custom field definitions and values
+-- Created: 2026-10-19
+
+ALTER TABLE groups DROP CONSTRAINT IF EXISTS check_group_custom_fields;
+ALTER TABLE groups DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_custom_fields;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS custom_fields;
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_custom_fields;
+ALTER TABLE campers DROP COLUMN IF EXISTS custom_fields;
+
+DROP TABLE IF EXISTS custom_field_definitions CASCADE;
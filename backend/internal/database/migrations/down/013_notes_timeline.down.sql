@@ -0,0 +1,10 @@
+-- Migration: 013_notes_timeline (DOWN)
+-- Description: Rolls back notes and the group membership history
+-- Created: 2026-10-19
+
+DROP TRIGGER IF EXISTS log_group_staff_members_membership_change ON group_staff_members;
+DROP TRIGGER IF EXISTS log_group_campers_membership_change ON group_campers;
+DROP FUNCTION IF EXISTS log_group_membership_change();
+
+DROP TABLE IF EXISTS group_membership_changes CASCADE;
+DROP TABLE IF EXISTS notes CASCADE;
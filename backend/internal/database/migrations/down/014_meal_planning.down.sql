@@ -0,0 +1,15 @@
+-- Migration: 014_meal_planning (DOWN)
+-- Description: Rolls back meal periods, menus and dietary profiles
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS menus CASCADE;
+DROP TABLE IF EXISTS meal_periods CASCADE;
+
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_allergies;
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_dietary_restrictions;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS allergies;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS dietary_restrictions;
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_allergies;
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_dietary_restrictions;
+ALTER TABLE campers DROP COLUMN IF EXISTS allergies;
+ALTER TABLE campers DROP COLUMN IF EXISTS dietary_restrictions;
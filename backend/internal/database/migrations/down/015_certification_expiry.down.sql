@@ -0,0 +1,13 @@
+-- Migration: 015_certification_expiry (DOWN)
+-- Description: Rolls back certificate details and verification of staff member certifications
+-- Created: 2026-10-19
+
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS verified_at;
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS verified_by_email;
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS verified_by;
+
+DROP INDEX IF EXISTS idx_staff_member_certifications_expires_on;
+ALTER TABLE staff_member_certifications DROP CONSTRAINT IF EXISTS check_staff_member_certification_dates;
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS certificate_number;
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS expires_on;
+ALTER TABLE staff_member_certifications DROP COLUMN IF EXISTS issued_on;
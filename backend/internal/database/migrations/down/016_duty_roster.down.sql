@@ -0,0 +1,7 @@
+-- Migration: 016_duty_roster (DOWN)
+-- Description: Rolls back duty types, rotation templates and duty shifts
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS duty_shifts CASCADE;
+DROP TABLE IF EXISTS duty_rotations CASCADE;
+DROP TABLE IF EXISTS duty_types CASCADE;
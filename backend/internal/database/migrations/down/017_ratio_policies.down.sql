@@ -0,0 +1,5 @@
+-- Migration: 017_ratio_policies (DOWN)
+-- Description: Rolls back supervision ratio policies
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS ratio_policies CASCADE;
@@ -0,0 +1,5 @@
+-- Migration: 018_timesheets (DOWN)
+-- Description: Rolls back staff time entries
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS time_entries CASCADE;
@@ -0,0 +1,17 @@
+-- Migration: 019_staff_self_service (DOWN)
+-- Description: Rolls back staff member user links, the staff role, weekly availability and time-off requests
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS time_off_requests CASCADE;
+
+DROP INDEX IF EXISTS idx_staff_members_camp_user;
+DROP INDEX IF EXISTS idx_staff_members_user_id;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS unavailable_windows;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS user_id;
+
+DELETE FROM access_rules WHERE role = 'staff';
+
+ALTER TABLE access_rules DROP CONSTRAINT IF EXISTS check_access_rule_role;
+ALTER TABLE access_rules ADD CONSTRAINT check_access_rule_role CHECK (role IN ('admin', 'program-admin', 'viewer', 'health'));
+
+COMMENT ON COLUMN access_rules.role IS 'Role at this scope: admin, program-admin, viewer, health';
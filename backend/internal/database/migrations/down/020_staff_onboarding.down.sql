@@ -0,0 +1,10 @@
+-- Migration: 020_staff_onboarding (DOWN)
+-- Description: Rolls back onboarding templates and per-staff onboarding progress
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS onboarding_completions CASCADE;
+
+DROP INDEX IF EXISTS idx_staff_members_onboarding_template_id;
+ALTER TABLE staff_members DROP COLUMN IF EXISTS onboarding_template_id;
+
+DROP TABLE IF EXISTS onboarding_templates CASCADE;
@@ -0,0 +1,8 @@
+-- Migration: 021_bed_assignments (DOWN)
+-- Description: Rolls back beds, bed assignments and room gender policies
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS bed_assignments CASCADE;
+DROP TABLE IF EXISTS beds CASCADE;
+
+ALTER TABLE housing_rooms DROP COLUMN IF EXISTS gender_policy;
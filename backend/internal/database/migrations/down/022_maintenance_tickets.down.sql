@@ -0,0 +1,10 @@
+-- Migration: 022_maintenance_tickets (DOWN)
+-- Description: Rolls back maintenance tickets and their photos
+-- Created: 2026-10-19
+
+DELETE FROM attachments WHERE entity_type = 'maintenance_ticket';
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS check_attachment_entity_type;
+ALTER TABLE attachments ADD CONSTRAINT check_attachment_entity_type
+    CHECK (entity_type IN ('camper', 'staff_member', 'certification', 'incident'));
+
+DROP TABLE IF EXISTS maintenance_tickets CASCADE;
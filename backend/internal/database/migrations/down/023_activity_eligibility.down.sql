@@ -0,0 +1,6 @@
+-- Migration: 023_activity_eligibility (DOWN)
+-- Description: Rolls back activity eligibility rules
+-- Created: 2026-10-19
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS check_activity_eligibility;
+ALTER TABLE activities DROP COLUMN IF EXISTS eligibility;
@@ -0,0 +1,9 @@
+-- Migration: 024_equipment_inventory (DOWN)
+-- Description: Rolls back equipment items, their check-out history and equipment needs of activities
+-- Created: 2026-10-19
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS check_activity_equipment_needs;
+ALTER TABLE activities DROP COLUMN IF EXISTS equipment_needs;
+
+DROP TABLE IF EXISTS equipment_checkouts CASCADE;
+DROP TABLE IF EXISTS equipment_items CASCADE;
@@ -0,0 +1,7 @@
+-- Migration: 025_skill_progression (DOWN)
+-- Description: Rolls back skill tracks, camper skill assessments and camper badges
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS camper_badges CASCADE;
+DROP TABLE IF EXISTS skill_assessments CASCADE;
+DROP TABLE IF EXISTS skill_tracks CASCADE;
@@ -0,0 +1,5 @@
+-- Migration: 026_camp_clone_jobs (DOWN)
+-- Description: Rolls back camp clone jobs
+-- Created: 2026-10-19
+
+DROP TABLE IF EXISTS camp_clone_jobs CASCADE;
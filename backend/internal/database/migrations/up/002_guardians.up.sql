@@ -0,0 +1,70 @@
+-- Migration: 002_guardians
+-- Description: Creates guardians with emergency contact and authorized pickup data, and the guardian_campers junction table
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- GUARDIANS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS guardians (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    
+    -- Spec fields
+    relationship VARCHAR(100) NOT NULL,
+    phones JSONB,
+    email VARCHAR(255),
+    address TEXT,
+    is_emergency_contact BOOLEAN NOT NULL DEFAULT false,
+    emergency_priority INTEGER,
+    custody_notes TEXT,
+    can_pick_up BOOLEAN NOT NULL DEFAULT false,
+    authorized_pickups JSONB,
+    
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_guardian_emergency_priority CHECK (emergency_priority IS NULL OR emergency_priority >= 1)
+);
+
+-- Indexes for guardians
+CREATE INDEX IF NOT EXISTS idx_guardians_tenant_id ON guardians(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_guardians_camp_id ON guardians(camp_id);
+CREATE INDEX IF NOT EXISTS idx_guardians_tenant_id_camp_id ON guardians(tenant_id, camp_id);
+CREATE INDEX IF NOT EXISTS idx_guardians_deleted_at ON guardians(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_guardians_name ON guardians(name);
+
+-- Trigger for guardians
+DROP TRIGGER IF EXISTS update_guardians_updated_at ON guardians;
+CREATE TRIGGER update_guardians_updated_at
+    BEFORE UPDATE ON guardians
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- GUARDIAN_CAMPERS JUNCTION TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS guardian_campers (
+    guardian_id UUID NOT NULL REFERENCES guardians(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    PRIMARY KEY (guardian_id, camper_id)
+);
+
+-- Indexes for guardian_campers
+CREATE INDEX IF NOT EXISTS idx_guardian_campers_guardian_id ON guardian_campers(guardian_id);
+CREATE INDEX IF NOT EXISTS idx_guardian_campers_camper_id ON guardian_campers(camper_id);
+
+COMMENT ON TABLE guardians IS 'Parents and guardians of campers, shared between siblings';
+COMMENT ON TABLE guardian_campers IS 'Junction table linking guardians to campers (many-to-many)';
+
+COMMENT ON COLUMN guardians.relationship IS 'Relationship to the campers (e.g. mother, father, legal guardian)';
+COMMENT ON COLUMN guardians.phones IS 'JSON array of phone numbers with type (mobile, home, work, other)';
+COMMENT ON COLUMN guardians.emergency_priority IS 'Order in which emergency contacts are called (1 is called first)';
+COMMENT ON COLUMN guardians.custody_notes IS 'Custody arrangements or restrictions staff must be aware of';
+COMMENT ON COLUMN guardians.can_pick_up IS 'Whether the guardian is allowed to pick up the linked campers';
+COMMENT ON COLUMN guardians.authorized_pickups IS 'JSON array of additional people authorized to pick up, with photo ID notes';
@@ -0,0 +1,66 @@
+-- Migration: 003_camper_enrollments
+-- Description: Creates camper_enrollments so a camper can attend multiple sessions, backfilled from campers.session_id
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- CAMPER_ENROLLMENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS camper_enrollments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL DEFAULT 'enrolled',
+    start_date DATE,
+    end_date DATE,
+    housing_group_id UUID REFERENCES groups(id) ON DELETE SET NULL,
+    notes TEXT,
+    
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_camper_enrollment_status CHECK (status IN ('enrolled', 'cancelled', 'completed')),
+    CONSTRAINT check_camper_enrollment_dates CHECK (start_date IS NULL OR end_date IS NULL OR end_date >= start_date)
+);
+
+-- Indexes for camper_enrollments
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_tenant_id ON camper_enrollments(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_camp_id ON camper_enrollments(camp_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_tenant_id_camp_id ON camper_enrollments(tenant_id, camp_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_camper_id ON camper_enrollments(camper_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_session_id ON camper_enrollments(session_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_housing_group_id ON camper_enrollments(housing_group_id);
+CREATE INDEX IF NOT EXISTS idx_camper_enrollments_deleted_at ON camper_enrollments(deleted_at);
+
+-- A camper can only be enrolled once per session
+CREATE UNIQUE INDEX IF NOT EXISTS idx_camper_enrollments_camper_session
+    ON camper_enrollments(camper_id, session_id)
+    WHERE deleted_at IS NULL;
+
+-- Trigger for camper_enrollments
+DROP TRIGGER IF EXISTS update_camper_enrollments_updated_at ON camper_enrollments;
+CREATE TRIGGER update_camper_enrollments_updated_at
+    BEFORE UPDATE ON camper_enrollments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- BACKFILL FROM EXISTING CAMPERS
+-- ============================================================================
+INSERT INTO camper_enrollments (tenant_id, camp_id, camper_id, session_id, status, housing_group_id, created_at, updated_at)
+SELECT c.tenant_id, c.camp_id, c.id, c.session_id, 'enrolled', c.housing_group_id, c.created_at, c.updated_at
+FROM campers c
+WHERE c.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM camper_enrollments e
+    WHERE e.camper_id = c.id AND e.session_id = c.session_id AND e.deleted_at IS NULL
+  );
+
+COMMENT ON TABLE camper_enrollments IS 'Sessions a camper attends; a camper record is shared across all of its sessions';
+
+COMMENT ON COLUMN camper_enrollments.status IS 'Enrollment status: enrolled, cancelled or completed';
+COMMENT ON COLUMN camper_enrollments.start_date IS 'First day attended when the camper joins part of the session (NULL means session start)';
+COMMENT ON COLUMN camper_enrollments.end_date IS 'Last day attended when the camper leaves early (NULL means session end)';
+COMMENT ON COLUMN camper_enrollments.housing_group_id IS 'Housing group for this session, which may differ between sessions';
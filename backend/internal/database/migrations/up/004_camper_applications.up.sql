@@ -0,0 +1,88 @@
+-- Migration: 004_camper_applications
+-- Description: Adds session capacity, camper applications and their status transition audit trail
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- SESSION CAPACITY
+-- ============================================================================
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS capacity INTEGER;
+
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS check_session_capacity;
+ALTER TABLE sessions ADD CONSTRAINT check_session_capacity CHECK (capacity IS NULL OR capacity >= 1);
+
+COMMENT ON COLUMN sessions.capacity IS 'Maximum number of campers enrolled in the session (NULL means unlimited)';
+
+-- ============================================================================
+-- APPLICATIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS applications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    
+    -- Spec fields
+    birthday DATE NOT NULL,
+    gender VARCHAR(50) NOT NULL,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    camper_id UUID REFERENCES campers(id) ON DELETE SET NULL,
+    guardian_ids JSONB,
+    notes TEXT,
+    status VARCHAR(50) NOT NULL DEFAULT 'draft',
+    waitlist_position INTEGER,
+    submitted_at TIMESTAMP,
+    
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_application_status CHECK (status IN ('draft', 'submitted', 'accepted', 'waitlisted', 'enrolled', 'cancelled', 'withdrawn')),
+    CONSTRAINT check_application_waitlist_position CHECK (waitlist_position IS NULL OR waitlist_position >= 1)
+);
+
+-- Indexes for applications
+CREATE INDEX IF NOT EXISTS idx_applications_tenant_id ON applications(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_applications_camp_id ON applications(camp_id);
+CREATE INDEX IF NOT EXISTS idx_applications_tenant_id_camp_id ON applications(tenant_id, camp_id);
+CREATE INDEX IF NOT EXISTS idx_applications_deleted_at ON applications(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_applications_name ON applications(name);
+CREATE INDEX IF NOT EXISTS idx_applications_session_id ON applications(session_id);
+CREATE INDEX IF NOT EXISTS idx_applications_camper_id ON applications(camper_id);
+CREATE INDEX IF NOT EXISTS idx_applications_session_status ON applications(session_id, status, waitlist_position);
+
+-- Trigger for applications
+DROP TRIGGER IF EXISTS update_applications_updated_at ON applications;
+CREATE TRIGGER update_applications_updated_at
+    BEFORE UPDATE ON applications
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- APPLICATION_TRANSITIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS application_transitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    reason TEXT,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_by_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for application_transitions
+CREATE INDEX IF NOT EXISTS idx_application_transitions_tenant_id ON application_transitions(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_application_transitions_camp_id ON application_transitions(camp_id);
+CREATE INDEX IF NOT EXISTS idx_application_transitions_application_id ON application_transitions(application_id);
+
+COMMENT ON TABLE applications IS 'Camper applications moving through the registration workflow before becoming campers';
+COMMENT ON TABLE application_transitions IS 'Audit trail of application status changes (append-only)';
+
+COMMENT ON COLUMN applications.camper_id IS 'Existing camper applying for another session, or the camper created on acceptance';
+COMMENT ON COLUMN applications.guardian_ids IS 'JSON array of guardian IDs linked to the camper on acceptance';
+COMMENT ON COLUMN applications.waitlist_position IS 'Position on the session waitlist (1 is next in line), only set while waitlisted';
+COMMENT ON COLUMN application_transitions.changed_by_email IS 'Email of the user at the time of the change, kept if the user is deleted';
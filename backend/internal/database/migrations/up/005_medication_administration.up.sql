@@ -0,0 +1,104 @@
+-- Migration: 005_medication_administration
+-- Description: Adds camper medications, the medication administration record (MAR) and the health role
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- HEALTH ROLE
+-- ============================================================================
+ALTER TABLE access_rules DROP CONSTRAINT IF EXISTS check_access_rule_role;
+ALTER TABLE access_rules ADD CONSTRAINT check_access_rule_role CHECK (role IN ('admin', 'program-admin', 'viewer', 'health'));
+
+COMMENT ON COLUMN access_rules.role IS 'Role at this scope: admin, program-admin, viewer, health';
+
+-- ============================================================================
+-- MEDICATIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS medications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    
+    -- Spec fields
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    dosage VARCHAR(100) NOT NULL,
+    route VARCHAR(50),
+    instructions TEXT,
+    schedule_times JSONB,
+    days_of_week JSONB,
+    start_date DATE,
+    end_date DATE,
+    as_needed BOOLEAN NOT NULL DEFAULT FALSE,
+    
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_medication_dates CHECK (start_date IS NULL OR end_date IS NULL OR end_date >= start_date)
+);
+
+-- Indexes for medications
+CREATE INDEX IF NOT EXISTS idx_medications_tenant_id ON medications(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_medications_camp_id ON medications(camp_id);
+CREATE INDEX IF NOT EXISTS idx_medications_tenant_id_camp_id ON medications(tenant_id, camp_id);
+CREATE INDEX IF NOT EXISTS idx_medications_deleted_at ON medications(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_medications_name ON medications(name);
+CREATE INDEX IF NOT EXISTS idx_medications_camper_id ON medications(camper_id);
+
+-- Trigger for medications
+DROP TRIGGER IF EXISTS update_medications_updated_at ON medications;
+CREATE TRIGGER update_medications_updated_at
+    BEFORE UPDATE ON medications
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- MEDICATION_DOSES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS medication_doses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    medication_id UUID NOT NULL REFERENCES medications(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    scheduled_for TIMESTAMP,
+    status VARCHAR(50) NOT NULL,
+    administered_at TIMESTAMP,
+    initials VARCHAR(10) NOT NULL,
+    notes TEXT,
+    recorded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_medication_dose_status CHECK (status IN ('given', 'refused', 'missed'))
+);
+
+-- Indexes for medication_doses
+CREATE INDEX IF NOT EXISTS idx_medication_doses_tenant_id ON medication_doses(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_medication_doses_camp_id ON medication_doses(camp_id);
+CREATE INDEX IF NOT EXISTS idx_medication_doses_medication_id ON medication_doses(medication_id);
+CREATE INDEX IF NOT EXISTS idx_medication_doses_camper_id ON medication_doses(camper_id);
+CREATE INDEX IF NOT EXISTS idx_medication_doses_administered_at ON medication_doses(administered_at);
+
+-- A scheduled dose is recorded at most once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_medication_doses_medication_scheduled
+    ON medication_doses(medication_id, scheduled_for)
+    WHERE scheduled_for IS NOT NULL;
+
+-- Trigger for medication_doses
+DROP TRIGGER IF EXISTS update_medication_doses_updated_at ON medication_doses;
+CREATE TRIGGER update_medication_doses_updated_at
+    BEFORE UPDATE ON medication_doses
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE medications IS 'Medications on a camper''s medication list, with the schedule due doses are generated from';
+COMMENT ON TABLE medication_doses IS 'Medication administration record: doses given, refused or missed';
+
+COMMENT ON COLUMN medications.schedule_times IS 'JSON array of daily dose times in HH:MM format, in the camp timezone';
+COMMENT ON COLUMN medications.days_of_week IS 'JSON array of weekdays the medication is given (empty means every day)';
+COMMENT ON COLUMN medications.as_needed IS 'As-needed (PRN) medications have no schedule and are only recorded when given';
+COMMENT ON COLUMN medication_doses.scheduled_for IS 'Scheduled time of the dose (UTC), NULL for as-needed doses';
+COMMENT ON COLUMN medication_doses.initials IS 'Initials of the health staff member who recorded the dose';
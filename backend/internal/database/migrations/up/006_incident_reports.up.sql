@@ -0,0 +1,77 @@
+-- Migration: 006_incident_reports
+-- Description: Adds incident and injury reports with review state and follow-up tasks
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- INCIDENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS incidents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    
+    -- Spec fields
+    occurred_at TIMESTAMP NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    severity VARCHAR(50) NOT NULL,
+    camper_ids JSONB,
+    staff_member_ids JSONB,
+    location_id UUID REFERENCES locations(id) ON DELETE SET NULL,
+    event_id UUID REFERENCES events(id) ON DELETE SET NULL,
+    activity_id UUID REFERENCES activities(id) ON DELETE SET NULL,
+    narrative TEXT NOT NULL,
+    actions_taken TEXT,
+    guardian_notification_status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    guardians_notified_at TIMESTAMP,
+    guardian_notification_notes TEXT,
+    follow_up_tasks JSONB,
+    
+    -- Review fields
+    status VARCHAR(50) NOT NULL DEFAULT 'draft',
+    reported_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reported_by_email VARCHAR(255),
+    submitted_at TIMESTAMP,
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_by_email VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    review_comment TEXT,
+    
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_incident_type CHECK (type IN ('injury', 'illness', 'behavioral', 'property_damage', 'near_miss', 'other')),
+    CONSTRAINT check_incident_severity CHECK (severity IN ('minor', 'moderate', 'serious', 'critical')),
+    CONSTRAINT check_incident_status CHECK (status IN ('draft', 'submitted', 'returned', 'approved')),
+    CONSTRAINT check_incident_guardian_notification CHECK (guardian_notification_status IN ('not_required', 'pending', 'notified'))
+);
+
+-- Indexes for incidents
+CREATE INDEX IF NOT EXISTS idx_incidents_tenant_id ON incidents(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_camp_id ON incidents(camp_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_tenant_id_camp_id ON incidents(tenant_id, camp_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_deleted_at ON incidents(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_incidents_name ON incidents(name);
+CREATE INDEX IF NOT EXISTS idx_incidents_occurred_at ON incidents(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_incidents_location_id ON incidents(location_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_event_id ON incidents(event_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_activity_id ON incidents(activity_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_status ON incidents(status);
+
+-- Trigger for incidents
+DROP TRIGGER IF EXISTS update_incidents_updated_at ON incidents;
+CREATE TRIGGER update_incidents_updated_at
+    BEFORE UPDATE ON incidents
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE incidents IS 'Incident and injury reports with their review state';
+
+COMMENT ON COLUMN incidents.camper_ids IS 'JSON array of IDs of the campers involved';
+COMMENT ON COLUMN incidents.staff_member_ids IS 'JSON array of IDs of the staff members involved or witnessing';
+COMMENT ON COLUMN incidents.activity_id IS 'Activity during which the incident happened, defaults to the activity of the event';
+COMMENT ON COLUMN incidents.follow_up_tasks IS 'JSON array of follow-up tasks: description, assigneeId, dueDate, completed, completedAt';
+COMMENT ON COLUMN incidents.status IS 'Review state: draft -> submitted -> approved, or returned for changes';
+COMMENT ON COLUMN incidents.reported_by_email IS 'Email of the reporting user at the time of filing, kept if the user is deleted';
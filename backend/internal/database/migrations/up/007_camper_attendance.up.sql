@@ -0,0 +1,47 @@
+-- Migration: 007_camper_attendance
+-- Description: Adds daily camper sign-in and sign-out records
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- ATTENDANCE_RECORDS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS attendance_records (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    method VARCHAR(50) NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    staff_member_id UUID REFERENCES staff_members(id) ON DELETE SET NULL,
+    person_name VARCHAR(255),
+    guardian_id UUID REFERENCES guardians(id) ON DELETE SET NULL,
+    notes TEXT,
+    recorded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_attendance_type CHECK (type IN ('check_in', 'check_out')),
+    CONSTRAINT check_attendance_method CHECK (method IN ('in_person', 'carpool', 'bus', 'walk', 'other'))
+);
+
+-- Indexes for attendance_records
+CREATE INDEX IF NOT EXISTS idx_attendance_records_tenant_id ON attendance_records(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_attendance_records_camp_id ON attendance_records(camp_id);
+CREATE INDEX IF NOT EXISTS idx_attendance_records_camper_id ON attendance_records(camper_id);
+CREATE INDEX IF NOT EXISTS idx_attendance_records_date ON attendance_records(date);
+CREATE INDEX IF NOT EXISTS idx_attendance_records_camp_id_date ON attendance_records(camp_id, date);
+
+-- Trigger for attendance_records
+DROP TRIGGER IF EXISTS update_attendance_records_updated_at ON attendance_records;
+CREATE TRIGGER update_attendance_records_updated_at
+    BEFORE UPDATE ON attendance_records
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE attendance_records IS 'Daily camper sign-ins and sign-outs; the latest record of a day tells whether a camper is on site';
+
+COMMENT ON COLUMN attendance_records.date IS 'Camp local day of occurred_at';
+COMMENT ON COLUMN attendance_records.person_name IS 'Person who dropped off or picked up the camper, verified against the authorized pickup list on check-out';
+COMMENT ON COLUMN attendance_records.guardian_id IS 'Guardian whose authorized pickup list the pickup person is on';
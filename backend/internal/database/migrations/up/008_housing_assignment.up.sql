@@ -0,0 +1,82 @@
+-- Migration: 008_housing_assignment
+-- Description: Adds bunkmate requests and automatic housing assignment proposals
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- BUNK_REQUESTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS bunk_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    requested_camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_bunk_request_not_self CHECK (camper_id <> requested_camper_id)
+);
+
+-- Indexes for bunk_requests
+CREATE INDEX IF NOT EXISTS idx_bunk_requests_tenant_id ON bunk_requests(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_bunk_requests_camp_id ON bunk_requests(camp_id);
+CREATE INDEX IF NOT EXISTS idx_bunk_requests_session_id ON bunk_requests(session_id);
+CREATE INDEX IF NOT EXISTS idx_bunk_requests_camper_id ON bunk_requests(camper_id);
+CREATE INDEX IF NOT EXISTS idx_bunk_requests_requested_camper_id ON bunk_requests(requested_camper_id);
+
+-- A camper requests a bunkmate at most once per session
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bunk_requests_session_camper_requested
+    ON bunk_requests(session_id, camper_id, requested_camper_id);
+
+-- Trigger for bunk_requests
+DROP TRIGGER IF EXISTS update_bunk_requests_updated_at ON bunk_requests;
+CREATE TRIGGER update_bunk_requests_updated_at
+    BEFORE UPDATE ON bunk_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- HOUSING_ASSIGNMENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS housing_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL DEFAULT 'proposed',
+    max_age_span_months INTEGER,
+    separate_genders BOOLEAN NOT NULL DEFAULT TRUE,
+    keep_existing BOOLEAN NOT NULL DEFAULT TRUE,
+    groups JSONB,
+    unassigned JSONB,
+    score JSONB,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    accepted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    accepted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_housing_assignment_status CHECK (status IN ('proposed', 'accepted', 'superseded')),
+    CONSTRAINT check_housing_assignment_age_span CHECK (max_age_span_months IS NULL OR max_age_span_months >= 0)
+);
+
+-- Indexes for housing_assignments
+CREATE INDEX IF NOT EXISTS idx_housing_assignments_tenant_id ON housing_assignments(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_housing_assignments_camp_id ON housing_assignments(camp_id);
+CREATE INDEX IF NOT EXISTS idx_housing_assignments_session_id ON housing_assignments(session_id);
+
+-- Trigger for housing_assignments
+DROP TRIGGER IF EXISTS update_housing_assignments_updated_at ON housing_assignments;
+CREATE TRIGGER update_housing_assignments_updated_at
+    BEFORE UPDATE ON housing_assignments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE bunk_requests IS 'Bunkmate requests; two requests naming each other in a session are mutual';
+COMMENT ON TABLE housing_assignments IS 'Generated proposals placing the campers of a session into its housing groups';
+
+COMMENT ON COLUMN housing_assignments.groups IS 'JSON array of housing groups with beds, staff count, gender and the IDs of the campers placed in them';
+COMMENT ON COLUMN housing_assignments.unassigned IS 'JSON array of campers that could not be placed, with the reason';
+COMMENT ON COLUMN housing_assignments.score IS 'JSON object with the bunk request satisfaction of the proposal';
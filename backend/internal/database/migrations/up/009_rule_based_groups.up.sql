@@ -0,0 +1,17 @@
+-- Migration: 009_rule_based_groups
+-- Description: Adds membership rules to groups so camper membership can be derived from filter expressions
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- GROUP MEMBERSHIP RULES
+-- ============================================================================
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS membership_rules JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE groups DROP CONSTRAINT IF EXISTS check_group_membership_rules;
+ALTER TABLE groups ADD CONSTRAINT check_group_membership_rules CHECK (jsonb_typeof(membership_rules) = 'array');
+
+-- Rule-based groups are looked up whenever campers change
+CREATE INDEX IF NOT EXISTS idx_groups_rule_based ON groups(camp_id)
+    WHERE jsonb_array_length(membership_rules) > 0 AND deleted_at IS NULL;
+
+COMMENT ON COLUMN groups.membership_rules IS 'Filter expressions campers must all match to be members; camper members of rule-based groups are maintained in group_campers';
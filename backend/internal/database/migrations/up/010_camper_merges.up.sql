@@ -0,0 +1,33 @@
+-- Migration: 010_camper_merges
+-- Description: Adds the audit trail of duplicate campers merged into a surviving camper
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- CAMPER_MERGES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS camper_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    survivor_camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    duplicate_camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    duplicate_name VARCHAR(255) NOT NULL,
+    duplicate_birthday DATE NOT NULL,
+    duplicate_snapshot JSONB,
+    moved JSONB NOT NULL,
+    merged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    merged_by_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_camper_merge_not_self CHECK (survivor_camper_id <> duplicate_camper_id)
+);
+
+-- Indexes for camper_merges
+CREATE INDEX IF NOT EXISTS idx_camper_merges_tenant_id ON camper_merges(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_camper_merges_camp_id ON camper_merges(camp_id);
+CREATE INDEX IF NOT EXISTS idx_camper_merges_survivor_camper_id ON camper_merges(survivor_camper_id);
+CREATE INDEX IF NOT EXISTS idx_camper_merges_created_at ON camper_merges(created_at);
+
+COMMENT ON TABLE camper_merges IS 'Audit trail of duplicate campers merged into a surviving camper; the duplicate is soft deleted';
+COMMENT ON COLUMN camper_merges.duplicate_snapshot IS 'The duplicate camper as it was right before the merge';
+COMMENT ON COLUMN camper_merges.moved IS 'Number of references moved to the survivor per kind (groups, enrollments, attendance records, ...)';
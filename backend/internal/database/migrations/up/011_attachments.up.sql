@@ -0,0 +1,42 @@
+-- Migration: 011_attachments
+-- Description: Adds document and photo attachments linked to campers, staff members, certifications and incidents
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- ATTACHMENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum VARCHAR(64) NOT NULL,
+    storage_key VARCHAR(500) NOT NULL,
+    scan_status VARCHAR(50) NOT NULL DEFAULT 'not_scanned',
+    description TEXT,
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    uploaded_by_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_attachment_entity_type CHECK (entity_type IN ('camper', 'staff_member', 'certification', 'incident')),
+    CONSTRAINT check_attachment_category CHECK (category IN ('waiver', 'medical_form', 'photo', 'certificate', 'other')),
+    CONSTRAINT check_attachment_scan_status CHECK (scan_status IN ('not_scanned', 'clean')),
+    CONSTRAINT check_attachment_size CHECK (size > 0),
+    CONSTRAINT unique_attachment_storage_key UNIQUE (storage_key)
+);
+
+-- Indexes for attachments
+CREATE INDEX IF NOT EXISTS idx_attachments_tenant_id ON attachments(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_camp_id ON attachments(camp_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_entity ON attachments(entity_type, entity_id);
+
+COMMENT ON TABLE attachments IS 'Metadata of uploaded documents and photos; the contents live in the configured storage backend';
+COMMENT ON COLUMN attachments.entity_id IS 'ID of the camper, staff member, certification or incident, depending on entity_type';
+COMMENT ON COLUMN attachments.content_type IS 'Content type detected from the file contents on upload';
+COMMENT ON COLUMN attachments.storage_key IS 'Key of the file contents in the storage backend';
+COMMENT ON COLUMN attachments.scan_status IS 'Result of the virus scan run on upload; infected files are never stored';
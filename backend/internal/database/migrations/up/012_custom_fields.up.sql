@@ -0,0 +1,60 @@
+-- Migration: 012_custom_fields
+-- Description: Adds per-camp custom field definitions and custom field values on campers, staff members and groups
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- CUSTOM FIELD DEFINITIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS custom_field_definitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    key VARCHAR(63) NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    options JSONB,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0,
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_custom_field_entity_type CHECK (entity_type IN ('camper', 'staff_member', 'group')),
+    CONSTRAINT check_custom_field_type CHECK (type IN ('text', 'number', 'date', 'enum', 'boolean')),
+    CONSTRAINT check_custom_field_key CHECK (key ~ '^[a-zA-Z][a-zA-Z0-9_]*$'),
+    CONSTRAINT unique_custom_field_key UNIQUE (camp_id, entity_type, key)
+);
+
+-- Indexes for custom field definitions
+CREATE INDEX IF NOT EXISTS idx_custom_field_definitions_tenant_id ON custom_field_definitions(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_custom_field_definitions_camp_id ON custom_field_definitions(camp_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_custom_field_definitions_updated_at ON custom_field_definitions;
+CREATE TRIGGER update_custom_field_definitions_updated_at
+    BEFORE UPDATE ON custom_field_definitions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE custom_field_definitions IS 'Admin-defined fields campers, staff members or groups of a camp carry in addition to their built-in fields';
+COMMENT ON COLUMN custom_field_definitions.key IS 'Key the value is stored under in the custom_fields column of the entity table';
+COMMENT ON COLUMN custom_field_definitions.options IS 'Allowed values of enum fields';
+
+-- ============================================================================
+-- CUSTOM FIELD VALUES
+-- ============================================================================
+ALTER TABLE campers ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_custom_fields;
+ALTER TABLE campers ADD CONSTRAINT check_camper_custom_fields CHECK (jsonb_typeof(custom_fields) = 'object');
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_custom_fields;
+ALTER TABLE staff_members ADD CONSTRAINT check_staff_member_custom_fields CHECK (jsonb_typeof(custom_fields) = 'object');
+ALTER TABLE groups DROP CONSTRAINT IF EXISTS check_group_custom_fields;
+ALTER TABLE groups ADD CONSTRAINT check_group_custom_fields CHECK (jsonb_typeof(custom_fields) = 'object');
+
+COMMENT ON COLUMN campers.custom_fields IS 'Values of the camp''s camper custom fields keyed by custom_field_definitions.key';
+COMMENT ON COLUMN staff_members.custom_fields IS 'Values of the camp''s staff member custom fields keyed by custom_field_definitions.key';
+COMMENT ON COLUMN groups.custom_fields IS 'Values of the camp''s group custom fields keyed by custom_field_definitions.key';
@@ -0,0 +1,122 @@
+-- Migration: 013_notes_timeline
+-- Description: Adds camper and staff member notes and a group membership history for person timelines
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- NOTES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    category VARCHAR(50) NOT NULL,
+    visibility VARCHAR(50) NOT NULL DEFAULT 'all_staff',
+    body TEXT NOT NULL,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    author_id UUID,
+    author_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    
+    CONSTRAINT check_note_entity_type CHECK (entity_type IN ('camper', 'staff_member')),
+    CONSTRAINT check_note_category CHECK (category IN ('observation', 'behavior', 'health', 'achievement', 'family', 'other')),
+    CONSTRAINT check_note_visibility CHECK (visibility IN ('all_staff', 'admins', 'health'))
+);
+
+-- Indexes for notes
+CREATE INDEX IF NOT EXISTS idx_notes_tenant_id ON notes(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_notes_camp_id ON notes(camp_id);
+CREATE INDEX IF NOT EXISTS idx_notes_entity ON notes(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_notes_deleted_at ON notes(deleted_at);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_notes_updated_at ON notes;
+CREATE TRIGGER update_notes_updated_at
+    BEFORE UPDATE ON notes
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE notes IS 'Observations staff log about campers and staff members';
+COMMENT ON COLUMN notes.visibility IS 'all_staff: everyone with camp access, admins: admins and program admins, health: health staff and admins';
+COMMENT ON COLUMN notes.pinned IS 'Pinned notes are listed before all other notes of the person';
+
+-- ============================================================================
+-- GROUP MEMBERSHIP CHANGES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS group_membership_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    member_type VARCHAR(50) NOT NULL,
+    member_id UUID NOT NULL,
+    change VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_group_membership_member_type CHECK (member_type IN ('camper', 'staff_member')),
+    CONSTRAINT check_group_membership_change CHECK (change IN ('added', 'removed'))
+);
+
+-- Indexes for group membership changes
+CREATE INDEX IF NOT EXISTS idx_group_membership_changes_camp_id ON group_membership_changes(camp_id);
+CREATE INDEX IF NOT EXISTS idx_group_membership_changes_member ON group_membership_changes(member_type, member_id);
+CREATE INDEX IF NOT EXISTS idx_group_membership_changes_group_id ON group_membership_changes(group_id);
+
+COMMENT ON TABLE group_membership_changes IS 'History of campers and staff members joining and leaving groups, written by triggers on the group junction tables';
+COMMENT ON COLUMN group_membership_changes.created_at IS 'Transaction time of the change; a removal and re-add in the same transaction cancel out';
+
+-- Function logging membership changes of group_campers and group_staff_members
+CREATE OR REPLACE FUNCTION log_group_membership_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_membership RECORD;
+    v_group_tenant_id UUID;
+    v_group_camp_id UUID;
+    v_member_type VARCHAR(50);
+    v_member_id UUID;
+    v_change VARCHAR(20);
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        v_membership := OLD;
+        v_change := 'removed';
+    ELSE
+        v_membership := NEW;
+        v_change := 'added';
+    END IF;
+
+    IF TG_TABLE_NAME = 'group_campers' THEN
+        v_member_type := 'camper';
+        v_member_id := v_membership.camper_id;
+    ELSE
+        v_member_type := 'staff_member';
+        v_member_id := v_membership.staff_member_id;
+    END IF;
+
+    SELECT tenant_id, camp_id INTO v_group_tenant_id, v_group_camp_id FROM groups WHERE id = v_membership.group_id;
+
+    -- The group itself is being deleted; its history goes with it
+    IF v_group_tenant_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO group_membership_changes (tenant_id, camp_id, group_id, member_type, member_id, change)
+    VALUES (v_group_tenant_id, v_group_camp_id, v_membership.group_id, v_member_type, v_member_id, v_change);
+
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS log_group_campers_membership_change ON group_campers;
+CREATE TRIGGER log_group_campers_membership_change
+    AFTER INSERT OR DELETE ON group_campers
+    FOR EACH ROW
+    EXECUTE FUNCTION log_group_membership_change();
+
+DROP TRIGGER IF EXISTS log_group_staff_members_membership_change ON group_staff_members;
+CREATE TRIGGER log_group_staff_members_membership_change
+    AFTER INSERT OR DELETE ON group_staff_members
+    FOR EACH ROW
+    EXECUTE FUNCTION log_group_membership_change();
@@ -0,0 +1,91 @@
+-- Migration: 014_meal_planning
+-- Description: Adds dietary profiles of campers and staff members, meal periods and menus for meal headcounts
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- DIETARY PROFILES
+-- ============================================================================
+ALTER TABLE campers ADD COLUMN IF NOT EXISTS dietary_restrictions JSONB;
+ALTER TABLE campers ADD COLUMN IF NOT EXISTS allergies JSONB;
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS dietary_restrictions JSONB;
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS allergies JSONB;
+
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_dietary_restrictions;
+ALTER TABLE campers ADD CONSTRAINT check_camper_dietary_restrictions CHECK (jsonb_typeof(dietary_restrictions) = 'array');
+ALTER TABLE campers DROP CONSTRAINT IF EXISTS check_camper_allergies;
+ALTER TABLE campers ADD CONSTRAINT check_camper_allergies CHECK (jsonb_typeof(allergies) = 'array');
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_dietary_restrictions;
+ALTER TABLE staff_members ADD CONSTRAINT check_staff_member_dietary_restrictions CHECK (jsonb_typeof(dietary_restrictions) = 'array');
+ALTER TABLE staff_members DROP CONSTRAINT IF EXISTS check_staff_member_allergies;
+ALTER TABLE staff_members ADD CONSTRAINT check_staff_member_allergies CHECK (jsonb_typeof(allergies) = 'array');
+
+COMMENT ON COLUMN campers.dietary_restrictions IS 'Diets the camper follows, e.g. ["vegetarian", "gluten_free"]';
+COMMENT ON COLUMN campers.allergies IS 'Food allergens the camper is allergic to, checked against menus';
+COMMENT ON COLUMN staff_members.dietary_restrictions IS 'Diets the staff member follows, e.g. ["vegan"]';
+COMMENT ON COLUMN staff_members.allergies IS 'Food allergens the staff member is allergic to';
+
+-- ============================================================================
+-- MEAL PERIODS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS meal_periods (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_meal_period_times CHECK (end_time > start_time)
+);
+
+-- Indexes for meal_periods
+CREATE INDEX IF NOT EXISTS idx_meal_periods_tenant_id ON meal_periods(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_meal_periods_camp_id ON meal_periods(camp_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_meal_periods_updated_at ON meal_periods;
+CREATE TRIGGER update_meal_periods_updated_at
+    BEFORE UPDATE ON meal_periods
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE meal_periods IS 'Meals served every day of the camp, e.g. breakfast from 07:30 to 08:30';
+COMMENT ON COLUMN meal_periods.start_time IS 'Camp local time the meal starts (HH:MM)';
+COMMENT ON COLUMN meal_periods.end_time IS 'Camp local time the meal ends (HH:MM)';
+
+-- ============================================================================
+-- MENUS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS menus (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    meal_period_id UUID NOT NULL REFERENCES meal_periods(id) ON DELETE CASCADE,
+    items JSONB NOT NULL DEFAULT '[]'::jsonb,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT unique_menu_date_meal_period UNIQUE (camp_id, date, meal_period_id),
+    CONSTRAINT check_menu_items CHECK (jsonb_typeof(items) = 'array')
+);
+
+-- Indexes for menus
+CREATE INDEX IF NOT EXISTS idx_menus_tenant_id ON menus(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_menus_camp_id ON menus(camp_id);
+CREATE INDEX IF NOT EXISTS idx_menus_meal_period_id ON menus(meal_period_id);
+CREATE INDEX IF NOT EXISTS idx_menus_date ON menus(date);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_menus_updated_at ON menus;
+CREATE TRIGGER update_menus_updated_at
+    BEFORE UPDATE ON menus
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE menus IS 'Dishes served at a meal period on a day';
+COMMENT ON COLUMN menus.items IS 'Dishes with their allergens and the diets they suit, e.g. [{"name": "Pasta", "allergens": ["wheat"], "suitableFor": ["vegetarian"]}]';
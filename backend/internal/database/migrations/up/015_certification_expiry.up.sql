@@ -0,0 +1,31 @@
+-- Migration: 015_certification_expiry
+-- Description: Adds issue and expiry dates, certificate numbers and verification to staff member certifications
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- CERTIFICATE DETAILS
+-- ============================================================================
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS issued_on DATE;
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS expires_on DATE;
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS certificate_number VARCHAR(255);
+
+ALTER TABLE staff_member_certifications DROP CONSTRAINT IF EXISTS check_staff_member_certification_dates;
+ALTER TABLE staff_member_certifications ADD CONSTRAINT check_staff_member_certification_dates CHECK (expires_on IS NULL OR issued_on IS NULL OR expires_on >= issued_on);
+
+-- Index for finding expired and expiring certificates
+CREATE INDEX IF NOT EXISTS idx_staff_member_certifications_expires_on ON staff_member_certifications(expires_on);
+
+COMMENT ON COLUMN staff_member_certifications.issued_on IS 'Day the certificate was issued';
+COMMENT ON COLUMN staff_member_certifications.expires_on IS 'Last day the certificate is valid; NULL when it does not expire';
+COMMENT ON COLUMN staff_member_certifications.certificate_number IS 'Number printed on the certificate';
+
+-- ============================================================================
+-- VERIFICATION
+-- ============================================================================
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS verified_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS verified_by_email VARCHAR(255);
+ALTER TABLE staff_member_certifications ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP;
+
+COMMENT ON COLUMN staff_member_certifications.verified_by IS 'Admin who checked the certificate document';
+COMMENT ON COLUMN staff_member_certifications.verified_by_email IS 'Email of the verifying admin, kept when the user is deleted';
+COMMENT ON COLUMN staff_member_certifications.verified_at IS 'When the certificate was verified; cleared when its details change';
@@ -0,0 +1,119 @@
+-- Migration: 016_duty_roster
+-- Description: Adds duty types, rotation templates and duty shifts for the staff duty roster
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- DUTY TYPES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS duty_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    is_time_off BOOLEAN NOT NULL DEFAULT FALSE,
+    min_rest_hours INTEGER NOT NULL DEFAULT 0,
+    required_certification_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_duty_type_min_rest_hours CHECK (min_rest_hours BETWEEN 0 AND 48),
+    CONSTRAINT check_duty_type_required_certification_ids CHECK (jsonb_typeof(required_certification_ids) = 'array')
+);
+
+-- Indexes for duty_types
+CREATE INDEX IF NOT EXISTS idx_duty_types_tenant_id ON duty_types(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_duty_types_camp_id ON duty_types(camp_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_duty_types_updated_at ON duty_types;
+CREATE TRIGGER update_duty_types_updated_at
+    BEFORE UPDATE ON duty_types
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE duty_types IS 'Kinds of staff duties that are not events, e.g. night watch, lunch duty or days off';
+COMMENT ON COLUMN duty_types.is_time_off IS 'Shifts of this type are time off: staff members on them are unavailable and they are never understaffed';
+COMMENT ON COLUMN duty_types.min_rest_hours IS 'Hours a staff member must rest after a shift of this type before their next duty';
+COMMENT ON COLUMN duty_types.required_certification_ids IS 'Certifications every staff member on a shift of this type must hold';
+
+-- ============================================================================
+-- DUTY ROTATIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS duty_rotations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    duty_type_id UUID NOT NULL REFERENCES duty_types(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL,
+    days_of_week JSONB NOT NULL DEFAULT '[]'::jsonb,
+    staff_count INTEGER NOT NULL DEFAULT 1,
+    staff_member_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_duty_rotation_staff_count CHECK (staff_count >= 1),
+    CONSTRAINT check_duty_rotation_days_of_week CHECK (jsonb_typeof(days_of_week) = 'array'),
+    CONSTRAINT check_duty_rotation_staff_member_ids CHECK (jsonb_typeof(staff_member_ids) = 'array')
+);
+
+-- Indexes for duty_rotations
+CREATE INDEX IF NOT EXISTS idx_duty_rotations_tenant_id ON duty_rotations(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_duty_rotations_camp_id ON duty_rotations(camp_id);
+CREATE INDEX IF NOT EXISTS idx_duty_rotations_duty_type_id ON duty_rotations(duty_type_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_duty_rotations_updated_at ON duty_rotations;
+CREATE TRIGGER update_duty_rotations_updated_at
+    BEFORE UPDATE ON duty_rotations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE duty_rotations IS 'Templates generating shifts of a duty type at the same camp local time on given weekdays';
+COMMENT ON COLUMN duty_rotations.end_time IS 'Camp local time the shifts end (HH:MM); at or before start_time means the next day';
+COMMENT ON COLUMN duty_rotations.days_of_week IS 'Weekdays shifts are generated on, 0 being Sunday; empty for every day';
+COMMENT ON COLUMN duty_rotations.staff_member_ids IS 'Staff members the shifts are distributed among; empty for all staff members';
+
+-- ============================================================================
+-- DUTY SHIFTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS duty_shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    duty_type_id UUID NOT NULL REFERENCES duty_types(id) ON DELETE CASCADE,
+    rotation_id UUID REFERENCES duty_rotations(id) ON DELETE SET NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    staff_count INTEGER NOT NULL DEFAULT 1,
+    staff_member_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_duty_shift_dates CHECK (end_date > start_date),
+    CONSTRAINT check_duty_shift_staff_count CHECK (staff_count >= 1),
+    CONSTRAINT check_duty_shift_staff_member_ids CHECK (jsonb_typeof(staff_member_ids) = 'array')
+);
+
+-- Indexes for duty_shifts
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_tenant_id ON duty_shifts(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_camp_id ON duty_shifts(camp_id);
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_duty_type_id ON duty_shifts(duty_type_id);
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_rotation_id ON duty_shifts(rotation_id);
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_start_date ON duty_shifts(start_date);
+CREATE INDEX IF NOT EXISTS idx_duty_shifts_end_date ON duty_shifts(end_date);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_duty_shifts_updated_at ON duty_shifts;
+CREATE TRIGGER update_duty_shifts_updated_at
+    BEFORE UPDATE ON duty_shifts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE duty_shifts IS 'Staff members on a duty from one instant to another; conflicts with events and other shifts are reported, not rejected';
+COMMENT ON COLUMN duty_shifts.rotation_id IS 'Rotation the shift was generated from, if any';
+COMMENT ON COLUMN duty_shifts.staff_count IS 'Staff members needed on the shift';
+COMMENT ON COLUMN duty_shifts.staff_member_ids IS 'Staff members assigned to the shift';
@@ -0,0 +1,52 @@
+-- Migration: 017_ratio_policies
+-- Description: Adds supervision ratio policies keyed by camper age band and activity or program
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- RATIO POLICIES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS ratio_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    min_age INTEGER,
+    max_age INTEGER,
+    campers_per_staff INTEGER NOT NULL,
+    applies_to_events BOOLEAN NOT NULL DEFAULT TRUE,
+    applies_to_overnight BOOLEAN NOT NULL DEFAULT TRUE,
+    activity_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    program_ids JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_ratio_policy_ages CHECK (
+        (min_age IS NULL OR min_age >= 0) AND
+        (max_age IS NULL OR max_age >= 0) AND
+        (min_age IS NULL OR max_age IS NULL OR max_age >= min_age)
+    ),
+    CONSTRAINT check_ratio_policy_campers_per_staff CHECK (campers_per_staff >= 1),
+    CONSTRAINT check_ratio_policy_applies CHECK (applies_to_events OR applies_to_overnight),
+    CONSTRAINT check_ratio_policy_activity_ids CHECK (jsonb_typeof(activity_ids) = 'array'),
+    CONSTRAINT check_ratio_policy_program_ids CHECK (jsonb_typeof(program_ids) = 'array')
+);
+
+-- Indexes for ratio_policies
+CREATE INDEX IF NOT EXISTS idx_ratio_policies_tenant_id ON ratio_policies(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_ratio_policies_camp_id ON ratio_policies(camp_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_ratio_policies_updated_at ON ratio_policies;
+CREATE TRIGGER update_ratio_policies_updated_at
+    BEFORE UPDATE ON ratio_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE ratio_policies IS 'Licensing supervision ratios: the most campers of an age band a single staff member may supervise';
+COMMENT ON COLUMN ratio_policies.min_age IS 'Youngest age in years covered; no lower bound when NULL';
+COMMENT ON COLUMN ratio_policies.max_age IS 'Oldest age in years covered; no upper bound when NULL';
+COMMENT ON COLUMN ratio_policies.campers_per_staff IS 'Most campers per staff member, e.g. 6 for a 1:6 ratio';
+COMMENT ON COLUMN ratio_policies.applies_to_overnight IS 'Checked for housing groups between the end and the start of the camp day';
+COMMENT ON COLUMN ratio_policies.activity_ids IS 'Activities whose events are covered; with program_ids empty too, all events are covered';
+COMMENT ON COLUMN ratio_policies.program_ids IS 'Programs whose events are covered';
@@ -0,0 +1,47 @@
+-- Migration: 018_timesheets
+-- Description: Adds staff time entries with clock-in, clock-out and supervisor approval
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- TIME ENTRIES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS time_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    staff_member_id UUID NOT NULL REFERENCES staff_members(id) ON DELETE CASCADE,
+    clock_in_at TIMESTAMP NOT NULL,
+    clock_out_at TIMESTAMP,
+    notes TEXT,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_by_email VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    review_comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_time_entry_status CHECK (status IN ('pending', 'approved', 'rejected')),
+    CONSTRAINT check_time_entry_clock_out CHECK (clock_out_at IS NULL OR clock_out_at > clock_in_at),
+    CONSTRAINT check_time_entry_approved_closed CHECK (status <> 'approved' OR clock_out_at IS NOT NULL)
+);
+
+-- Indexes for time_entries
+CREATE INDEX IF NOT EXISTS idx_time_entries_tenant_id ON time_entries(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_time_entries_camp_id ON time_entries(camp_id);
+CREATE INDEX IF NOT EXISTS idx_time_entries_staff_member_id ON time_entries(staff_member_id);
+CREATE INDEX IF NOT EXISTS idx_time_entries_clock_in_at ON time_entries(clock_in_at);
+
+-- A staff member can only be clocked in once at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_open_staff_member ON time_entries(staff_member_id) WHERE clock_out_at IS NULL;
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_time_entries_updated_at ON time_entries;
+CREATE TRIGGER update_time_entries_updated_at
+    BEFORE UPDATE ON time_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE time_entries IS 'Hours staff members worked, recorded by clocking in and out, for payroll';
+COMMENT ON COLUMN time_entries.clock_out_at IS 'NULL while the staff member is still clocked in';
+COMMENT ON COLUMN time_entries.status IS 'Review state: pending, approved or rejected; approved entries are locked';
@@ -0,0 +1,64 @@
+-- Migration: 019_staff_self_service
+-- Description: Links staff members to user accounts, adds the staff role, weekly availability and time-off requests
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- STAFF ROLE
+-- ============================================================================
+ALTER TABLE access_rules DROP CONSTRAINT IF EXISTS check_access_rule_role;
+ALTER TABLE access_rules ADD CONSTRAINT check_access_rule_role CHECK (role IN ('admin', 'program-admin', 'viewer', 'health', 'staff'));
+
+COMMENT ON COLUMN access_rules.role IS 'Role at this scope: admin, program-admin, viewer, health, staff';
+
+-- ============================================================================
+-- STAFF MEMBER USER LINK AND AVAILABILITY
+-- ============================================================================
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS user_id UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS unavailable_windows JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_staff_members_user_id ON staff_members(user_id);
+
+-- A user account is linked to at most one staff member per camp
+CREATE UNIQUE INDEX IF NOT EXISTS idx_staff_members_camp_user ON staff_members(camp_id, user_id) WHERE user_id IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN staff_members.user_id IS 'User account the staff member signs in with to see their own schedule, groups and campers';
+COMMENT ON COLUMN staff_members.unavailable_windows IS 'Weekly times the staff member cannot work: [{daysOfWeek, startTime, endTime, notes}]';
+
+-- ============================================================================
+-- TIME OFF REQUESTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS time_off_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    staff_member_id UUID NOT NULL REFERENCES staff_members(id) ON DELETE CASCADE,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    reason TEXT,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_by_email VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    review_comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    
+    CONSTRAINT check_time_off_request_status CHECK (status IN ('pending', 'approved', 'rejected')),
+    CONSTRAINT check_time_off_request_dates CHECK (end_date > start_date)
+);
+
+-- Indexes for time_off_requests
+CREATE INDEX IF NOT EXISTS idx_time_off_requests_tenant_id ON time_off_requests(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_time_off_requests_camp_id ON time_off_requests(camp_id);
+CREATE INDEX IF NOT EXISTS idx_time_off_requests_staff_member_id ON time_off_requests(staff_member_id);
+CREATE INDEX IF NOT EXISTS idx_time_off_requests_start_date ON time_off_requests(start_date);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_time_off_requests_updated_at ON time_off_requests;
+CREATE TRIGGER update_time_off_requests_updated_at
+    BEFORE UPDATE ON time_off_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE time_off_requests IS 'Time off staff members ask for; approved time off keeps them off auto-assigned duty shifts';
+COMMENT ON COLUMN time_off_requests.status IS 'Review state: pending, approved or rejected';
@@ -0,0 +1,75 @@
+-- Migration: 020_staff_onboarding
+-- Description: Adds onboarding templates with checklist items and required certifications, and per-staff progress
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- ONBOARDING TEMPLATES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS onboarding_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    items JSONB NOT NULL DEFAULT '[]',
+    required_certification_ids JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for onboarding_templates
+CREATE INDEX IF NOT EXISTS idx_onboarding_templates_tenant_id ON onboarding_templates(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_onboarding_templates_camp_id ON onboarding_templates(camp_id);
+
+-- A camp has at most one default template
+CREATE UNIQUE INDEX IF NOT EXISTS idx_onboarding_templates_camp_default ON onboarding_templates(camp_id) WHERE is_default;
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_onboarding_templates_updated_at ON onboarding_templates;
+CREATE TRIGGER update_onboarding_templates_updated_at
+    BEFORE UPDATE ON onboarding_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE onboarding_templates IS 'Checklists and certifications staff members must complete before they can be assigned to events';
+COMMENT ON COLUMN onboarding_templates.is_default IS 'Whether the template applies to staff members without a template of their own';
+COMMENT ON COLUMN onboarding_templates.items IS 'Checklist items: [{id, title, description, dueOn, requiresAttachment}]';
+COMMENT ON COLUMN onboarding_templates.required_certification_ids IS 'Certifications staff members must hold, unexpired';
+
+-- ============================================================================
+-- STAFF MEMBER ONBOARDING TEMPLATE
+-- ============================================================================
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS onboarding_template_id UUID REFERENCES onboarding_templates(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_staff_members_onboarding_template_id ON staff_members(onboarding_template_id);
+
+COMMENT ON COLUMN staff_members.onboarding_template_id IS 'Onboarding template the staff member follows; NULL falls back to the camp default';
+
+-- ============================================================================
+-- ONBOARDING COMPLETIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS onboarding_completions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    staff_member_id UUID NOT NULL REFERENCES staff_members(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL,
+    attachment_id UUID REFERENCES attachments(id) ON DELETE CASCADE,
+    notes TEXT,
+    completed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    completed_by_email VARCHAR(255),
+    completed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for onboarding_completions
+CREATE INDEX IF NOT EXISTS idx_onboarding_completions_tenant_id ON onboarding_completions(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_onboarding_completions_camp_id ON onboarding_completions(camp_id);
+CREATE INDEX IF NOT EXISTS idx_onboarding_completions_staff_member_id ON onboarding_completions(staff_member_id);
+
+-- A checklist item is completed at most once per staff member
+CREATE UNIQUE INDEX IF NOT EXISTS idx_onboarding_completions_staff_member_item ON onboarding_completions(staff_member_id, item_id);
+
+COMMENT ON TABLE onboarding_completions IS 'Onboarding checklist items staff members completed';
+COMMENT ON COLUMN onboarding_completions.item_id IS 'ID of the checklist item in the items of the staff member''s onboarding template';
+COMMENT ON COLUMN onboarding_completions.attachment_id IS 'Document completing the item; deleting it reopens the item';
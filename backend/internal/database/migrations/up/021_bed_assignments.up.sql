@@ -0,0 +1,82 @@
+-- Migration: 021_bed_assignments
+-- Description: Adds beds per housing room, per-session bed assignments for campers and staff, and room gender policies
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- HOUSING ROOM GENDER POLICY
+-- ============================================================================
+ALTER TABLE housing_rooms ADD COLUMN IF NOT EXISTS gender_policy VARCHAR(20) NOT NULL DEFAULT 'mixed'
+    CHECK (gender_policy IN ('mixed', 'single_gender', 'male', 'female'));
+
+COMMENT ON COLUMN housing_rooms.gender_policy IS 'Who may share the room on a night: mixed, single_gender (any, but one per night), male or female';
+
+-- ============================================================================
+-- BEDS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS beds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    housing_room_id UUID NOT NULL REFERENCES housing_rooms(id) ON DELETE CASCADE,
+    label VARCHAR(50) NOT NULL,
+    bunk_position VARCHAR(20) NOT NULL DEFAULT 'none' CHECK (bunk_position IN ('none', 'top', 'bottom')),
+    accessible BOOLEAN NOT NULL DEFAULT FALSE,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for beds
+CREATE INDEX IF NOT EXISTS idx_beds_tenant_id ON beds(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_beds_camp_id ON beds(camp_id);
+CREATE INDEX IF NOT EXISTS idx_beds_housing_room_id ON beds(housing_room_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_beds_updated_at ON beds;
+CREATE TRIGGER update_beds_updated_at
+    BEFORE UPDATE ON beds
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE beds IS 'Individual beds of housing rooms; a room has at most as many as its number of beds';
+COMMENT ON COLUMN beds.bunk_position IS 'Position in a bunk: none for single beds, top or bottom';
+
+-- ============================================================================
+-- BED ASSIGNMENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS bed_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    bed_id UUID NOT NULL REFERENCES beds(id) ON DELETE CASCADE,
+    camper_id UUID REFERENCES campers(id) ON DELETE CASCADE,
+    staff_member_id UUID REFERENCES staff_members(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((camper_id IS NULL) <> (staff_member_id IS NULL)),
+    CHECK (end_date > start_date)
+);
+
+-- Indexes for bed_assignments
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_tenant_id ON bed_assignments(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_camp_id ON bed_assignments(camp_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_session_id ON bed_assignments(session_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_bed_id ON bed_assignments(bed_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_camper_id ON bed_assignments(camper_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_staff_member_id ON bed_assignments(staff_member_id);
+CREATE INDEX IF NOT EXISTS idx_bed_assignments_dates ON bed_assignments(camp_id, start_date, end_date);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_bed_assignments_updated_at ON bed_assignments;
+CREATE TRIGGER update_bed_assignments_updated_at
+    BEFORE UPDATE ON bed_assignments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE bed_assignments IS 'Campers and staff members sleeping in a bed during a session';
+COMMENT ON COLUMN bed_assignments.start_date IS 'First night in the bed';
+COMMENT ON COLUMN bed_assignments.end_date IS 'Day the bed is left; the night before it is the last one';
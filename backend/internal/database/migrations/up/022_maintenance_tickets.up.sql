@@ -0,0 +1,60 @@
+-- Migration: 022_maintenance_tickets
+-- Description: Adds maintenance tickets for locations, housing rooms and areas with out-of-service periods and photos
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- MAINTENANCE TICKETS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS maintenance_tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    priority VARCHAR(20) NOT NULL DEFAULT 'medium',
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    assignee_id UUID REFERENCES staff_members(id) ON DELETE SET NULL,
+    out_of_service_from TIMESTAMP,
+    out_of_service_until TIMESTAMP,
+    reported_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reported_by_email VARCHAR(255),
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_maintenance_ticket_entity_type CHECK (entity_type IN ('location', 'housing_room', 'area')),
+    CONSTRAINT check_maintenance_ticket_priority CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    CONSTRAINT check_maintenance_ticket_status CHECK (status IN ('open', 'in_progress', 'resolved', 'closed')),
+    CONSTRAINT check_maintenance_ticket_out_of_service CHECK (
+        out_of_service_until IS NULL OR (out_of_service_from IS NOT NULL AND out_of_service_until > out_of_service_from)
+    )
+);
+
+-- Indexes for maintenance_tickets
+CREATE INDEX IF NOT EXISTS idx_maintenance_tickets_tenant_id ON maintenance_tickets(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_tickets_camp_id ON maintenance_tickets(camp_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_tickets_entity ON maintenance_tickets(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_tickets_assignee_id ON maintenance_tickets(assignee_id);
+CREATE INDEX IF NOT EXISTS idx_maintenance_tickets_out_of_service ON maintenance_tickets(camp_id, out_of_service_from)
+    WHERE out_of_service_from IS NOT NULL AND status NOT IN ('resolved', 'closed');
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_maintenance_tickets_updated_at ON maintenance_tickets;
+CREATE TRIGGER update_maintenance_tickets_updated_at
+    BEFORE UPDATE ON maintenance_tickets
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE maintenance_tickets IS 'Problems with locations, housing rooms and areas that need fixing';
+COMMENT ON COLUMN maintenance_tickets.entity_id IS 'ID of the location, housing room or area, depending on entity_type';
+COMMENT ON COLUMN maintenance_tickets.out_of_service_from IS 'Start of the period the facility cannot be scheduled; NULL keeps it in service';
+COMMENT ON COLUMN maintenance_tickets.out_of_service_until IS 'End of the out-of-service period; NULL until the ticket is resolved or closed';
+
+-- ============================================================================
+-- ATTACHMENTS: PHOTOS OF MAINTENANCE TICKETS
+-- ============================================================================
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS check_attachment_entity_type;
+ALTER TABLE attachments ADD CONSTRAINT check_attachment_entity_type
+    CHECK (entity_type IN ('camper', 'staff_member', 'certification', 'incident', 'maintenance_ticket'));
@@ -0,0 +1,13 @@
+-- Migration: 023_activity_eligibility
+-- Description: Adds eligibility rules (age range, genders, prerequisite activities, skill levels) to activities
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- ACTIVITY ELIGIBILITY
+-- ============================================================================
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS eligibility JSONB;
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS check_activity_eligibility;
+ALTER TABLE activities ADD CONSTRAINT check_activity_eligibility CHECK (jsonb_typeof(eligibility) = 'object');
+
+COMMENT ON COLUMN activities.eligibility IS 'Which campers may take part, e.g. {"minAge": 10, "genders": ["female"], "prerequisiteActivityIds": [...], "requiredSkills": [{"customFieldId": "...", "minimumLevel": "Level 3"}]}';
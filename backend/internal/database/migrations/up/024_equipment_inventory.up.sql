@@ -0,0 +1,99 @@
+-- Migration: 024_equipment_inventory
+-- Description: Adds equipment items with quantities and condition, their check-out history and equipment needs of activities
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- EQUIPMENT ITEMS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS equipment_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    category VARCHAR(100),
+    quantity INTEGER NOT NULL DEFAULT 0,
+    home_location_id UUID REFERENCES locations(id) ON DELETE SET NULL,
+    condition VARCHAR(20) NOT NULL DEFAULT 'good',
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_equipment_item_quantity CHECK (quantity >= 0),
+    CONSTRAINT check_equipment_item_condition CHECK (condition IN ('good', 'fair', 'needs_repair', 'retired'))
+);
+
+-- Indexes for equipment_items
+CREATE INDEX IF NOT EXISTS idx_equipment_items_tenant_id ON equipment_items(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_equipment_items_camp_id ON equipment_items(camp_id);
+CREATE INDEX IF NOT EXISTS idx_equipment_items_home_location_id ON equipment_items(home_location_id);
+CREATE INDEX IF NOT EXISTS idx_equipment_items_category ON equipment_items(camp_id, category);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_equipment_items_updated_at ON equipment_items;
+CREATE TRIGGER update_equipment_items_updated_at
+    BEFORE UPDATE ON equipment_items
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE equipment_items IS 'Kinds of equipment a camp owns a number of units of, e.g. youth life jackets';
+COMMENT ON COLUMN equipment_items.quantity IS 'Number of units owned, including those checked out';
+COMMENT ON COLUMN equipment_items.condition IS 'Only units in good or fair condition can be checked out and used by events';
+
+-- ============================================================================
+-- EQUIPMENT CHECKOUTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS equipment_checkouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    equipment_item_id UUID NOT NULL REFERENCES equipment_items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    staff_member_id UUID REFERENCES staff_members(id) ON DELETE SET NULL,
+    event_id UUID REFERENCES events(id) ON DELETE SET NULL,
+    checked_out_at TIMESTAMP NOT NULL,
+    due_at TIMESTAMP,
+    returned_at TIMESTAMP,
+    return_condition VARCHAR(20),
+    notes TEXT,
+    checked_out_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    checked_out_by_email VARCHAR(255),
+    returned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    returned_by_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_equipment_checkout_quantity CHECK (quantity >= 1),
+    CONSTRAINT check_equipment_checkout_due CHECK (due_at IS NULL OR due_at > checked_out_at),
+    CONSTRAINT check_equipment_checkout_return_condition CHECK (
+        return_condition IS NULL OR return_condition IN ('good', 'fair', 'needs_repair', 'retired')
+    )
+);
+
+-- Indexes for equipment_checkouts
+CREATE INDEX IF NOT EXISTS idx_equipment_checkouts_tenant_id ON equipment_checkouts(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_equipment_checkouts_camp_id ON equipment_checkouts(camp_id);
+CREATE INDEX IF NOT EXISTS idx_equipment_checkouts_equipment_item_id ON equipment_checkouts(equipment_item_id, checked_out_at);
+CREATE INDEX IF NOT EXISTS idx_equipment_checkouts_open ON equipment_checkouts(camp_id, equipment_item_id)
+    WHERE returned_at IS NULL;
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_equipment_checkouts_updated_at ON equipment_checkouts;
+CREATE TRIGGER update_equipment_checkouts_updated_at
+    BEFORE UPDATE ON equipment_checkouts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE equipment_checkouts IS 'Units of equipment items taken out of storage, open until they are returned';
+COMMENT ON COLUMN equipment_checkouts.event_id IS 'Event the units are used by; units checked out without an event are unavailable to events';
+COMMENT ON COLUMN equipment_checkouts.return_condition IS 'Condition reported on return; it becomes the condition of the equipment item';
+
+-- ============================================================================
+-- ACTIVITY EQUIPMENT NEEDS
+-- ============================================================================
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS equipment_needs JSONB;
+
+ALTER TABLE activities DROP CONSTRAINT IF EXISTS check_activity_equipment_needs;
+ALTER TABLE activities ADD CONSTRAINT check_activity_equipment_needs CHECK (jsonb_typeof(equipment_needs) = 'array');
+
+COMMENT ON COLUMN activities.equipment_needs IS 'Units of equipment each participant needs, e.g. [{"equipmentItemId": "...", "quantityPerParticipant": 1}]';
@@ -0,0 +1,99 @@
+-- Migration: 025_skill_progression
+-- Description: Adds skill tracks with ordered levels, camper skill assessments and the badges awarded for passed levels
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- SKILL TRACKS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS skill_tracks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    levels JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_skill_track_levels CHECK (jsonb_typeof(levels) = 'array')
+);
+
+-- Indexes for skill_tracks
+CREATE INDEX IF NOT EXISTS idx_skill_tracks_tenant_id ON skill_tracks(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_skill_tracks_camp_id ON skill_tracks(camp_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_skill_tracks_updated_at ON skill_tracks;
+CREATE TRIGGER update_skill_tracks_updated_at
+    BEFORE UPDATE ON skill_tracks
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE skill_tracks IS 'Skills campers progress through in ordered levels, e.g. swimming or archery';
+COMMENT ON COLUMN skill_tracks.levels IS 'Levels from lowest to highest, e.g. [{"id": "...", "name": "Beginner", "badgeName": "Tadpole"}]';
+
+-- ============================================================================
+-- SKILL ASSESSMENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS skill_assessments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    skill_track_id UUID NOT NULL REFERENCES skill_tracks(id) ON DELETE CASCADE,
+    level_id UUID NOT NULL,
+    level_name VARCHAR(255) NOT NULL,
+    passed BOOLEAN NOT NULL,
+    assessed_on DATE NOT NULL,
+    evaluator_id UUID REFERENCES staff_members(id) ON DELETE SET NULL,
+    notes TEXT,
+    recorded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    recorded_by_email VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Indexes for skill_assessments
+CREATE INDEX IF NOT EXISTS idx_skill_assessments_tenant_id ON skill_assessments(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_skill_assessments_camp_id ON skill_assessments(camp_id);
+CREATE INDEX IF NOT EXISTS idx_skill_assessments_camper_id ON skill_assessments(camper_id, assessed_on);
+CREATE INDEX IF NOT EXISTS idx_skill_assessments_skill_track_id ON skill_assessments(skill_track_id, level_id);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_skill_assessments_updated_at ON skill_assessments;
+CREATE TRIGGER update_skill_assessments_updated_at
+    BEFORE UPDATE ON skill_assessments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE skill_assessments IS 'Assessments of campers at levels of skill tracks, including failed attempts';
+COMMENT ON COLUMN skill_assessments.level_id IS 'ID of a level in the levels of the skill track; assessed levels cannot be removed from the track';
+COMMENT ON COLUMN skill_assessments.level_name IS 'Name of the level when the assessment was recorded';
+COMMENT ON COLUMN skill_assessments.evaluator_id IS 'Staff member who assessed the camper';
+
+-- ============================================================================
+-- CAMPER BADGES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS camper_badges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    camper_id UUID NOT NULL REFERENCES campers(id) ON DELETE CASCADE,
+    skill_track_id UUID NOT NULL REFERENCES skill_tracks(id) ON DELETE CASCADE,
+    level_id UUID NOT NULL,
+    assessment_id UUID NOT NULL REFERENCES skill_assessments(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    awarded_on DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_camper_badge UNIQUE (camper_id, skill_track_id, level_id)
+);
+
+-- Indexes for camper_badges
+CREATE INDEX IF NOT EXISTS idx_camper_badges_tenant_id ON camper_badges(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_camper_badges_camp_id ON camper_badges(camp_id);
+CREATE INDEX IF NOT EXISTS idx_camper_badges_camper_id ON camper_badges(camper_id);
+CREATE INDEX IF NOT EXISTS idx_camper_badges_assessment_id ON camper_badges(assessment_id);
+
+COMMENT ON TABLE camper_badges IS 'Badges campers were awarded for passing levels of skill tracks, once per level';
+COMMENT ON COLUMN camper_badges.assessment_id IS 'First passed assessment at the level; deleting it moves the badge to the next passed one';
@@ -0,0 +1,46 @@
+-- Migration: 026_camp_clone_jobs
+-- Description: Adds camp clone jobs that copy a camp's configuration into a new camp for another season
+-- Created: 2026-10-19
+
+-- ============================================================================
+-- CAMP CLONE JOBS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS camp_clone_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    camp_id UUID NOT NULL REFERENCES camps(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    copy_events BOOLEAN NOT NULL DEFAULT false,
+    new_camp_id UUID REFERENCES camps(id) ON DELETE SET NULL,
+    copied JSONB,
+    error TEXT,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    requested_by_email VARCHAR(255),
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_camp_clone_job_status CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    CONSTRAINT check_camp_clone_job_dates CHECK (end_date >= start_date)
+);
+
+-- Indexes for camp_clone_jobs
+CREATE INDEX IF NOT EXISTS idx_camp_clone_jobs_tenant_id ON camp_clone_jobs(tenant_id);
+CREATE INDEX IF NOT EXISTS idx_camp_clone_jobs_camp_id ON camp_clone_jobs(camp_id);
+CREATE INDEX IF NOT EXISTS idx_camp_clone_jobs_status ON camp_clone_jobs(status);
+
+-- Trigger for updated_at
+DROP TRIGGER IF EXISTS update_camp_clone_jobs_updated_at ON camp_clone_jobs;
+CREATE TRIGGER update_camp_clone_jobs_updated_at
+    BEFORE UPDATE ON camp_clone_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE camp_clone_jobs IS 'Background copies of a camp''s configuration into a new camp with new dates, e.g. for the next season';
+COMMENT ON COLUMN camp_clone_jobs.copy_events IS 'Whether events are copied too, shifted by the days between the start dates of the two camps';
+COMMENT ON COLUMN camp_clone_jobs.copied IS 'Number of records copied per kind, e.g. {"areas": 3, "programs": 5, "events": 120}';
@@ -11,7 +11,7 @@ import (
 type AccessRule struct {
 	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"-"`
 	UserID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_access_rules_user_id" json:"-"`
-	Role      string     `gorm:"type:varchar(50);not null" json:"role"`                           // admin, program-admin, viewer
+	Role      string     `gorm:"type:varchar(50);not null" json:"role"`                           // admin, program-admin, viewer, health, staff
 	ScopeType string     `gorm:"type:varchar(20);not null" json:"scopeType"`                      // system, tenant, camp
 	ScopeID   *uuid.UUID `gorm:"type:uuid;index:idx_access_rules_scope" json:"scopeId,omitempty"` // null for system scope
 
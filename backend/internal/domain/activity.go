@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +27,8 @@ type Activity struct {
 	TimeBlockID       *uuid.UUID     `gorm:"type:uuid;index:idx_activities_time_block_id" json:"timeBlockId,omitempty"`
 	RequiredStaff     json.RawMessage `gorm:"type:jsonb" json:"requiredStaff,omitempty"`
 	ActivityConflicts json.RawMessage `gorm:"type:jsonb" json:"activityConflicts,omitempty"`
+	Eligibility       *ActivityEligibility `gorm:"type:jsonb" json:"eligibility,omitempty"`
+	EquipmentNeeds    EquipmentNeeds `gorm:"type:jsonb" json:"equipmentNeeds,omitempty"`
 	CreatedAt         time.Time      `gorm:"autoCreateTime" json:"createdAt"`
 	UpdatedAt         time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
 	DeletedAt         gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
@@ -75,6 +80,9 @@ func (a *Activity) ToAPI() api.Activity {
 		}
 	}
 
+	spec.Eligibility = a.Eligibility.ToAPI()
+	spec.EquipmentNeeds = a.EquipmentNeeds.ToAPI()
+
 	return api.Activity{
 		Meta: api.EntityMeta{
 			Id:          a.ID,
@@ -89,3 +97,188 @@ func (a *Activity) ToAPI() api.Activity {
 	}
 }
 
+
+// SkillRequirement is a minimum level campers must have reached in a skill recorded in a camper custom field
+type SkillRequirement struct {
+	CustomFieldID uuid.UUID `json:"customFieldId"`
+	MinimumLevel  string    `json:"minimumLevel"`
+}
+
+// SkillLevelRequirement is a level of a skill track campers must have passed, or a higher one
+type SkillLevelRequirement struct {
+	SkillTrackID   uuid.UUID `json:"skillTrackId"`
+	MinimumLevelID uuid.UUID `json:"minimumLevelId"`
+}
+
+// ActivityEligibility restricts which campers may take part in an activity. Every rule that is set must be met.
+type ActivityEligibility struct {
+	MinAge                  *int                    `json:"minAge,omitempty"`
+	MaxAge                  *int                    `json:"maxAge,omitempty"`
+	Genders                 []string                `json:"genders,omitempty"`
+	PrerequisiteActivityIDs []uuid.UUID             `json:"prerequisiteActivityIds,omitempty"`
+	RequiredSkills          []SkillRequirement      `json:"requiredSkills,omitempty"`
+	RequiredSkillLevels     []SkillLevelRequirement `json:"requiredSkillLevels,omitempty"`
+}
+
+// ParseActivityEligibility converts API eligibility rules, returning nil when no rule is set
+func ParseActivityEligibility(req *api.ActivityEligibility) (*ActivityEligibility, error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	e := &ActivityEligibility{
+		MinAge: req.MinAge,
+		MaxAge: req.MaxAge,
+	}
+	if (e.MinAge != nil && *e.MinAge < 0) || (e.MaxAge != nil && *e.MaxAge < 0) {
+		return nil, fmt.Errorf("ages must not be negative")
+	}
+	if e.MinAge != nil && e.MaxAge != nil && *e.MaxAge < *e.MinAge {
+		return nil, fmt.Errorf("maximum age must not be below minimum age")
+	}
+
+	if req.Genders != nil {
+		for _, gender := range *req.Genders {
+			gender = strings.TrimSpace(gender)
+			if gender == "" {
+				return nil, fmt.Errorf("genders must not be empty")
+			}
+			e.Genders = append(e.Genders, gender)
+		}
+	}
+
+	if req.PrerequisiteActivityIds != nil {
+		seen := make(map[uuid.UUID]bool)
+		for _, id := range *req.PrerequisiteActivityIds {
+			if !seen[id] {
+				seen[id] = true
+				e.PrerequisiteActivityIDs = append(e.PrerequisiteActivityIDs, id)
+			}
+		}
+	}
+
+	if req.RequiredSkills != nil {
+		seen := make(map[uuid.UUID]bool)
+		for _, skill := range *req.RequiredSkills {
+			if seen[skill.CustomFieldId] {
+				return nil, fmt.Errorf("skill %s is required more than once", skill.CustomFieldId)
+			}
+			seen[skill.CustomFieldId] = true
+			e.RequiredSkills = append(e.RequiredSkills, SkillRequirement{
+				CustomFieldID: skill.CustomFieldId,
+				MinimumLevel:  strings.TrimSpace(skill.MinimumLevel),
+			})
+		}
+	}
+
+	if req.RequiredSkillLevels != nil {
+		seen := make(map[uuid.UUID]bool)
+		for _, skill := range *req.RequiredSkillLevels {
+			if seen[skill.SkillTrackId] {
+				return nil, fmt.Errorf("skill track %s is required more than once", skill.SkillTrackId)
+			}
+			seen[skill.SkillTrackId] = true
+			e.RequiredSkillLevels = append(e.RequiredSkillLevels, SkillLevelRequirement{
+				SkillTrackID:   skill.SkillTrackId,
+				MinimumLevelID: skill.MinimumLevelId,
+			})
+		}
+	}
+
+	if e.IsEmpty() {
+		return nil, nil
+	}
+	return e, nil
+}
+
+// Scan implements the sql.Scanner interface for ActivityEligibility
+func (e *ActivityEligibility) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan activity eligibility: unexpected type %T", value)
+	}
+	return json.Unmarshal(bytes, e)
+}
+
+// Value implements the driver.Valuer interface for ActivityEligibility
+func (e ActivityEligibility) Value() (driver.Value, error) {
+	return json.Marshal(e)
+}
+
+// IsEmpty reports whether no eligibility rule is set
+func (e *ActivityEligibility) IsEmpty() bool {
+	return e == nil || (e.MinAge == nil && e.MaxAge == nil && len(e.Genders) == 0 && len(e.PrerequisiteActivityIDs) == 0 && len(e.RequiredSkills) == 0 && len(e.RequiredSkillLevels) == 0)
+}
+
+// ToAPI converts the eligibility rules to their API representation, omitting them when empty
+func (e *ActivityEligibility) ToAPI() *api.ActivityEligibility {
+	if e.IsEmpty() {
+		return nil
+	}
+
+	eligibility := &api.ActivityEligibility{
+		MinAge: e.MinAge,
+		MaxAge: e.MaxAge,
+	}
+	if len(e.Genders) > 0 {
+		genders := e.Genders
+		eligibility.Genders = &genders
+	}
+	if len(e.PrerequisiteActivityIDs) > 0 {
+		ids := e.PrerequisiteActivityIDs
+		eligibility.PrerequisiteActivityIds = &ids
+	}
+	if len(e.RequiredSkills) > 0 {
+		skills := make([]api.ActivitySkillRequirement, len(e.RequiredSkills))
+		for i, skill := range e.RequiredSkills {
+			skills[i] = api.ActivitySkillRequirement{
+				CustomFieldId: skill.CustomFieldID,
+				MinimumLevel:  skill.MinimumLevel,
+			}
+		}
+		eligibility.RequiredSkills = &skills
+	}
+	if len(e.RequiredSkillLevels) > 0 {
+		levels := make([]api.ActivitySkillLevelRequirement, len(e.RequiredSkillLevels))
+		for i, level := range e.RequiredSkillLevels {
+			levels[i] = api.ActivitySkillLevelRequirement{
+				SkillTrackId:   level.SkillTrackID,
+				MinimumLevelId: level.MinimumLevelID,
+			}
+		}
+		eligibility.RequiredSkillLevels = &levels
+	}
+	return eligibility
+}
+
+// CheckCamper returns the age and gender rules the camper does not meet on the day
+func (e *ActivityEligibility) CheckCamper(camper *Camper, day time.Time) []string {
+	var reasons []string
+	age := AgeOn(camper.Birthday, day)
+	if e.MinAge != nil && age < *e.MinAge {
+		reasons = append(reasons, fmt.Sprintf("is %d years old; the minimum age is %d", age, *e.MinAge))
+	}
+	if e.MaxAge != nil && age > *e.MaxAge {
+		reasons = append(reasons, fmt.Sprintf("is %d years old; the maximum age is %d", age, *e.MaxAge))
+	}
+	if len(e.Genders) > 0 && !e.AdmitsGender(camper.Gender) {
+		reasons = append(reasons, fmt.Sprintf("is %s; the activity is for %s", camper.Gender, strings.Join(e.Genders, ", ")))
+	}
+	return reasons
+}
+
+// AdmitsGender reports whether campers of the gender may take part, ignoring case
+func (e *ActivityEligibility) AdmitsGender(gender string) bool {
+	if len(e.Genders) == 0 {
+		return true
+	}
+	for _, allowed := range e.Genders {
+		if strings.EqualFold(allowed, gender) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// ApplicationStatus represents the stage of a camper application in the registration workflow
+type ApplicationStatus string
+
+const (
+	ApplicationStatusDraft      ApplicationStatus = "draft"
+	ApplicationStatusSubmitted  ApplicationStatus = "submitted"
+	ApplicationStatusAccepted   ApplicationStatus = "accepted"
+	ApplicationStatusWaitlisted ApplicationStatus = "waitlisted"
+	ApplicationStatusEnrolled   ApplicationStatus = "enrolled"
+	ApplicationStatusCancelled  ApplicationStatus = "cancelled"
+	ApplicationStatusWithdrawn  ApplicationStatus = "withdrawn"
+)
+
+// ErrApplicationStatusChanged is returned when an application changed status while it was being moved
+var ErrApplicationStatusChanged = errors.New("application status changed concurrently")
+
+// applicationTransitions lists the statuses each status can move to
+var applicationTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationStatusDraft:      {ApplicationStatusSubmitted, ApplicationStatusWithdrawn},
+	ApplicationStatusSubmitted:  {ApplicationStatusAccepted, ApplicationStatusWaitlisted, ApplicationStatusCancelled, ApplicationStatusWithdrawn},
+	ApplicationStatusWaitlisted: {ApplicationStatusAccepted, ApplicationStatusCancelled, ApplicationStatusWithdrawn},
+	ApplicationStatusAccepted:   {ApplicationStatusEnrolled, ApplicationStatusCancelled, ApplicationStatusWithdrawn},
+	ApplicationStatusEnrolled:   {ApplicationStatusCancelled, ApplicationStatusWithdrawn},
+}
+
+// CanTransitionTo reports whether the status can move to the target status
+func (s ApplicationStatus) CanTransitionTo(target ApplicationStatus) bool {
+	for _, allowed := range applicationTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCamper reports whether applications in this status hold a place in the session
+func (s ApplicationStatus) HasCamper() bool {
+	return s == ApplicationStatusAccepted || s == ApplicationStatusEnrolled
+}
+
+// IsEditable reports whether the application details can still be changed
+func (s ApplicationStatus) IsEditable() bool {
+	return s == ApplicationStatusDraft || s == ApplicationStatusSubmitted || s == ApplicationStatusWaitlisted
+}
+
+// Application represents a request for a child to attend a camp session
+type Application struct {
+	ID               uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID         uuid.UUID         `gorm:"type:uuid;not null;index:idx_applications_tenant_id" json:"tenantId"`
+	CampID           uuid.UUID         `gorm:"type:uuid;not null;index:idx_applications_camp_id" json:"campId"`
+	Name             string            `gorm:"type:varchar(255);not null" json:"name"`
+	Description      string            `gorm:"type:text" json:"description,omitempty"`
+	Birthday         time.Time         `gorm:"type:date;not null" json:"birthday"`
+	Gender           string            `gorm:"type:varchar(50);not null" json:"gender"`
+	SessionID        uuid.UUID         `gorm:"type:uuid;not null;index:idx_applications_session_id" json:"sessionId"`
+	CamperID         *uuid.UUID        `gorm:"type:uuid;index:idx_applications_camper_id" json:"camperId,omitempty"`
+	GuardianIDs      []uuid.UUID       `gorm:"type:jsonb;serializer:json" json:"guardianIds,omitempty"`
+	Notes            string            `gorm:"type:text" json:"notes,omitempty"`
+	Status           ApplicationStatus `gorm:"type:varchar(50);not null;default:draft" json:"status"`
+	WaitlistPosition *int              `gorm:"type:integer" json:"waitlistPosition,omitempty"`
+	SubmittedAt      *time.Time        `json:"submittedAt,omitempty"`
+	CreatedAt        time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt        time.Time         `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt        gorm.DeletedAt    `gorm:"index" json:"deletedAt,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Application) TableName() string {
+	return "applications"
+}
+
+// BeforeCreate sets the UUID before creating an application
+func (a *Application) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain Application to an API Application representation
+func (a *Application) ToAPI() api.Application {
+	spec := api.ApplicationSpec{
+		Birthday:         api.Birthday{Time: a.Birthday},
+		Gender:           api.Gender(a.Gender),
+		SessionId:        a.SessionID,
+		CamperId:         a.CamperID,
+		Notes:            utils.StringToPtr(a.Notes),
+		Status:           api.ApplicationStatus(a.Status),
+		WaitlistPosition: a.WaitlistPosition,
+		SubmittedAt:      a.SubmittedAt,
+	}
+
+	if len(a.GuardianIDs) > 0 {
+		guardianIDs := a.GuardianIDs
+		spec.GuardianIds = &guardianIDs
+	}
+
+	return api.Application{
+		Meta: api.EntityMeta{
+			Id:          a.ID,
+			TenantId:    a.TenantID,
+			CampId:      a.CampID,
+			Name:        a.Name,
+			Description: utils.StringToPtr(a.Description),
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   a.UpdatedAt,
+		},
+		Spec: spec,
+	}
+}
+
+// ApplicationTransition records a status change of an application for auditing
+type ApplicationTransition struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID          `gorm:"type:uuid;not null;index:idx_application_transitions_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID          `gorm:"type:uuid;not null;index:idx_application_transitions_camp_id" json:"campId"`
+	ApplicationID  uuid.UUID          `gorm:"type:uuid;not null;index:idx_application_transitions_application_id" json:"applicationId"`
+	FromStatus     *ApplicationStatus `gorm:"type:varchar(50)" json:"fromStatus,omitempty"`
+	ToStatus       ApplicationStatus  `gorm:"type:varchar(50);not null" json:"toStatus"`
+	Reason         string             `gorm:"type:text" json:"reason,omitempty"`
+	ChangedBy      *uuid.UUID         `gorm:"type:uuid" json:"changedBy,omitempty"`
+	ChangedByEmail string             `gorm:"type:varchar(255)" json:"changedByEmail,omitempty"`
+	CreatedAt      time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName overrides the default table name
+func (ApplicationTransition) TableName() string {
+	return "application_transitions"
+}
+
+// BeforeCreate sets the UUID before creating a transition
+func (t *ApplicationTransition) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain ApplicationTransition to an API ApplicationTransition representation
+func (t *ApplicationTransition) ToAPI() api.ApplicationTransition {
+	transition := api.ApplicationTransition{
+		Id:             t.ID,
+		ApplicationId:  t.ApplicationID,
+		ToStatus:       api.ApplicationStatus(t.ToStatus),
+		Reason:         utils.StringToPtr(t.Reason),
+		ChangedBy:      t.ChangedBy,
+		ChangedByEmail: utils.StringToPtr(t.ChangedByEmail),
+		CreatedAt:      t.CreatedAt,
+	}
+
+	if t.FromStatus != nil {
+		fromStatus := api.ApplicationStatus(*t.FromStatus)
+		transition.FromStatus = &fromStatus
+	}
+
+	return transition
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// AttachmentEntityType represents the kind of record an attachment belongs to
+type AttachmentEntityType string
+
+const (
+	AttachmentEntityTypeCamper        AttachmentEntityType = "camper"
+	AttachmentEntityTypeStaffMember   AttachmentEntityType = "staff_member"
+	AttachmentEntityTypeCertification AttachmentEntityType = "certification"
+	AttachmentEntityTypeIncident      AttachmentEntityType = "incident"
+	AttachmentEntityTypeMaintenance   AttachmentEntityType = "maintenance_ticket"
+)
+
+// AttachmentCategory represents what an attached document is
+type AttachmentCategory string
+
+const (
+	AttachmentCategoryWaiver      AttachmentCategory = "waiver"
+	AttachmentCategoryMedicalForm AttachmentCategory = "medical_form"
+	AttachmentCategoryPhoto       AttachmentCategory = "photo"
+	AttachmentCategoryCertificate AttachmentCategory = "certificate"
+	AttachmentCategoryOther       AttachmentCategory = "other"
+)
+
+// AttachmentScanStatus represents the result of the virus scan run on upload
+type AttachmentScanStatus string
+
+const (
+	AttachmentScanStatusNotScanned AttachmentScanStatus = "not_scanned"
+	AttachmentScanStatusClean      AttachmentScanStatus = "clean"
+)
+
+// Attachment represents a file such as a signed waiver or profile photo linked to a camp record.
+// The contents live in the configured storage backend under StorageKey.
+type Attachment struct {
+	ID              uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID        uuid.UUID            `gorm:"type:uuid;not null;index:idx_attachments_tenant_id" json:"tenantId"`
+	CampID          uuid.UUID            `gorm:"type:uuid;not null;index:idx_attachments_camp_id" json:"campId"`
+	EntityType      AttachmentEntityType `gorm:"type:varchar(50);not null" json:"entityType"`
+	EntityID        uuid.UUID            `gorm:"type:uuid;not null" json:"entityId"`
+	Category        AttachmentCategory   `gorm:"type:varchar(50);not null" json:"category"`
+	FileName        string               `gorm:"type:varchar(255);not null" json:"fileName"`
+	ContentType     string               `gorm:"type:varchar(100);not null" json:"contentType"`
+	Size            int64                `gorm:"not null" json:"size"`
+	Checksum        string               `gorm:"type:varchar(64);not null" json:"checksum"`
+	StorageKey      string               `gorm:"type:varchar(500);not null" json:"-"`
+	ScanStatus      AttachmentScanStatus `gorm:"type:varchar(50);not null;default:not_scanned" json:"scanStatus"`
+	Description     string               `gorm:"type:text" json:"description,omitempty"`
+	UploadedBy      *uuid.UUID           `gorm:"type:uuid" json:"uploadedBy,omitempty"`
+	UploadedByEmail string               `gorm:"type:varchar(255)" json:"uploadedByEmail,omitempty"`
+	CreatedAt       time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName overrides the default table name
+func (Attachment) TableName() string {
+	return "attachments"
+}
+
+// BeforeCreate sets the UUID before creating an attachment
+func (a *Attachment) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain Attachment to an API Attachment representation
+func (a *Attachment) ToAPI() api.Attachment {
+	return api.Attachment{
+		Id:              a.ID,
+		TenantId:        a.TenantID,
+		CampId:          a.CampID,
+		EntityType:      api.AttachmentEntityType(a.EntityType),
+		EntityId:        a.EntityID,
+		Category:        api.AttachmentCategory(a.Category),
+		FileName:        a.FileName,
+		ContentType:     a.ContentType,
+		Size:            a.Size,
+		Checksum:        a.Checksum,
+		ScanStatus:      api.AttachmentScanStatus(a.ScanStatus),
+		Description:     utils.StringToPtr(a.Description),
+		UploadedBy:      a.UploadedBy,
+		UploadedByEmail: utils.StringToPtr(a.UploadedByEmail),
+		CreatedAt:       a.CreatedAt,
+	}
+}
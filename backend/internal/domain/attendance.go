@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// AttendanceType represents whether a camper arrived at or left camp
+type AttendanceType string
+
+const (
+	AttendanceTypeCheckIn  AttendanceType = "check_in"
+	AttendanceTypeCheckOut AttendanceType = "check_out"
+)
+
+// AttendanceMethod represents how a camper arrived at or left camp
+type AttendanceMethod string
+
+const (
+	AttendanceMethodInPerson AttendanceMethod = "in_person"
+	AttendanceMethodCarpool  AttendanceMethod = "carpool"
+	AttendanceMethodBus      AttendanceMethod = "bus"
+	AttendanceMethodWalk     AttendanceMethod = "walk"
+	AttendanceMethodOther    AttendanceMethod = "other"
+)
+
+// IsValid reports whether the method is one of the known attendance methods
+func (m AttendanceMethod) IsValid() bool {
+	switch m {
+	case AttendanceMethodInPerson, AttendanceMethodCarpool, AttendanceMethodBus, AttendanceMethodWalk, AttendanceMethodOther:
+		return true
+	}
+	return false
+}
+
+// RequiresPickupPerson reports whether a check-out with this method hands the camper
+// over to a person who must be on the authorized pickup list
+func (m AttendanceMethod) RequiresPickupPerson() bool {
+	return m == AttendanceMethodInPerson || m == AttendanceMethodCarpool
+}
+
+// AttendanceRecord represents a daily sign-in or sign-out of a camper
+type AttendanceRecord struct {
+	ID            uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID      uuid.UUID        `gorm:"type:uuid;not null;index:idx_attendance_records_tenant_id" json:"tenantId"`
+	CampID        uuid.UUID        `gorm:"type:uuid;not null;index:idx_attendance_records_camp_id" json:"campId"`
+	CamperID      uuid.UUID        `gorm:"type:uuid;not null;index:idx_attendance_records_camper_id" json:"camperId"`
+	Date          time.Time        `gorm:"type:date;not null;index:idx_attendance_records_date" json:"date"`
+	Type          AttendanceType   `gorm:"type:varchar(50);not null" json:"type"`
+	Method        AttendanceMethod `gorm:"type:varchar(50);not null" json:"method"`
+	OccurredAt    time.Time        `gorm:"not null" json:"occurredAt"`
+	StaffMemberID *uuid.UUID       `gorm:"type:uuid" json:"staffMemberId,omitempty"`
+	PersonName    string           `gorm:"type:varchar(255)" json:"personName,omitempty"`
+	GuardianID    *uuid.UUID       `gorm:"type:uuid" json:"guardianId,omitempty"`
+	Notes         string           `gorm:"type:text" json:"notes,omitempty"`
+	RecordedBy    *uuid.UUID       `gorm:"type:uuid" json:"recordedBy,omitempty"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt     time.Time        `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (AttendanceRecord) TableName() string {
+	return "attendance_records"
+}
+
+// BeforeCreate sets the UUID before creating an attendance record
+func (a *AttendanceRecord) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain AttendanceRecord to an API AttendanceRecord representation
+func (a *AttendanceRecord) ToAPI() api.AttendanceRecord {
+	return api.AttendanceRecord{
+		Id:            a.ID,
+		TenantId:      a.TenantID,
+		CampId:        a.CampID,
+		CamperId:      a.CamperID,
+		Date:          openapi_types.Date{Time: a.Date},
+		Type:          api.AttendanceType(a.Type),
+		Method:        api.AttendanceMethod(a.Method),
+		OccurredAt:    a.OccurredAt,
+		StaffMemberId: a.StaffMemberID,
+		PersonName:    utils.StringToPtr(a.PersonName),
+		GuardianId:    a.GuardianID,
+		Notes:         utils.StringToPtr(a.Notes),
+		RecordedBy:    a.RecordedBy,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MaxTimeOffDays is the longest a single time-off request can last
+const MaxTimeOffDays = 31
+
+// UnavailableWindow represents a weekly recurring time a staff member cannot work
+type UnavailableWindow struct {
+	DaysOfWeek []int  `json:"daysOfWeek"`
+	StartTime  string `json:"startTime"` // Format: HH:MM
+	EndTime    string `json:"endTime"`   // Format: HH:MM
+	Notes      string `json:"notes,omitempty"`
+}
+
+// ParseUnavailableWindows converts API windows to domain windows, validating their weekdays and times
+func ParseUnavailableWindows(inputs []api.UnavailableWindow) ([]UnavailableWindow, error) {
+	windows := make([]UnavailableWindow, 0, len(inputs))
+	for _, input := range inputs {
+		window := UnavailableWindow{
+			DaysOfWeek: []int{},
+			StartTime:  input.StartTime,
+			EndTime:    input.EndTime,
+			Notes:      utils.PtrToString(input.Notes),
+		}
+		if input.DaysOfWeek != nil {
+			window.DaysOfWeek = *input.DaysOfWeek
+		}
+		if err := window.Validate(); err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// ToAPI converts the domain UnavailableWindow to an API UnavailableWindow representation
+func (w *UnavailableWindow) ToAPI() api.UnavailableWindow {
+	days := w.DaysOfWeek
+	if days == nil {
+		days = []int{}
+	}
+
+	return api.UnavailableWindow{
+		DaysOfWeek: &days,
+		StartTime:  w.StartTime,
+		EndTime:    w.EndTime,
+		Notes:      utils.StringToPtr(w.Notes),
+	}
+}
+
+// Validate checks the window's times and weekdays
+func (w *UnavailableWindow) Validate() error {
+	if _, err := time.Parse("15:04", w.StartTime); err != nil {
+		return fmt.Errorf("start time must be in HH:MM format")
+	}
+	if _, err := time.Parse("15:04", w.EndTime); err != nil {
+		return fmt.Errorf("end time must be in HH:MM format")
+	}
+	for _, day := range w.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("days of the week must be between 0 (Sunday) and 6 (Saturday)")
+		}
+	}
+	return nil
+}
+
+// Overlaps reports whether the window, on any of its days, overlaps the period [start, end) in the
+// given time zone. Windows ending at or before their start time end the next day.
+func (w *UnavailableWindow) Overlaps(start, end time.Time, loc *time.Location) bool {
+	from, _ := time.Parse("15:04", w.StartTime)
+	to, _ := time.Parse("15:04", w.EndTime)
+
+	// Start a day early so a window running past midnight into the period is found
+	first := start.In(loc).AddDate(0, 0, -1)
+	last := end.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !w.recursOn(day.Weekday()) {
+			continue
+		}
+		windowStart := time.Date(day.Year(), day.Month(), day.Day(), from.Hour(), from.Minute(), 0, 0, loc)
+		windowEnd := time.Date(day.Year(), day.Month(), day.Day(), to.Hour(), to.Minute(), 0, 0, loc)
+		if !windowEnd.After(windowStart) {
+			windowEnd = windowEnd.AddDate(0, 0, 1)
+		}
+		if windowStart.Before(end) && start.Before(windowEnd) {
+			return true
+		}
+	}
+	return false
+}
+
+// recursOn reports whether the window recurs on the weekday
+func (w *UnavailableWindow) recursOn(weekday time.Weekday) bool {
+	if len(w.DaysOfWeek) == 0 {
+		return true
+	}
+	for _, day := range w.DaysOfWeek {
+		if time.Weekday(day) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// TimeOffRequestStatus represents the review state of a time-off request
+type TimeOffRequestStatus string
+
+const (
+	TimeOffRequestStatusPending  TimeOffRequestStatus = "pending"
+	TimeOffRequestStatusApproved TimeOffRequestStatus = "approved"
+	TimeOffRequestStatusRejected TimeOffRequestStatus = "rejected"
+)
+
+// IsValid reports whether the status is one of the known review states
+func (s TimeOffRequestStatus) IsValid() bool {
+	switch s {
+	case TimeOffRequestStatusPending, TimeOffRequestStatusApproved, TimeOffRequestStatusRejected:
+		return true
+	}
+	return false
+}
+
+// TimeOffRequest represents a staff member asking for time off
+type TimeOffRequest struct {
+	ID              uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID        uuid.UUID            `gorm:"type:uuid;not null;index:idx_time_off_requests_tenant_id" json:"tenantId"`
+	CampID          uuid.UUID            `gorm:"type:uuid;not null;index:idx_time_off_requests_camp_id" json:"campId"`
+	StaffMemberID   uuid.UUID            `gorm:"type:uuid;not null;index:idx_time_off_requests_staff_member_id" json:"staffMemberId"`
+	StartDate       time.Time            `gorm:"type:timestamp;not null;index:idx_time_off_requests_start_date" json:"startDate"`
+	EndDate         time.Time            `gorm:"type:timestamp;not null" json:"endDate"`
+	Reason          string               `gorm:"type:text" json:"reason,omitempty"`
+	Status          TimeOffRequestStatus `gorm:"type:varchar(50);not null;default:pending" json:"status"`
+	ReviewedBy      *uuid.UUID           `gorm:"type:uuid" json:"reviewedBy,omitempty"`
+	ReviewedByEmail string               `gorm:"type:varchar(255)" json:"reviewedByEmail,omitempty"`
+	ReviewedAt      *time.Time           `json:"reviewedAt,omitempty"`
+	ReviewComment   string               `gorm:"type:text" json:"reviewComment,omitempty"`
+	CreatedAt       time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time            `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (TimeOffRequest) TableName() string {
+	return "time_off_requests"
+}
+
+// BeforeCreate sets the UUID before creating a time-off request
+func (t *TimeOffRequest) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain TimeOffRequest to an API TimeOffRequest representation
+func (t *TimeOffRequest) ToAPI() api.TimeOffRequest {
+	return api.TimeOffRequest{
+		Id:              t.ID,
+		TenantId:        t.TenantID,
+		CampId:          t.CampID,
+		StaffMemberId:   t.StaffMemberID,
+		StartDate:       t.StartDate,
+		EndDate:         t.EndDate,
+		Reason:          utils.StringToPtr(t.Reason),
+		Status:          api.TimeOffRequestStatus(t.Status),
+		ReviewedBy:      t.ReviewedBy,
+		ReviewedByEmail: utils.StringToPtr(t.ReviewedByEmail),
+		ReviewedAt:      t.ReviewedAt,
+		ReviewComment:   utils.StringToPtr(t.ReviewComment),
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+// Validate checks that the request ends after it starts and does not last too long
+func (t *TimeOffRequest) Validate() error {
+	if !t.EndDate.After(t.StartDate) {
+		return fmt.Errorf("end date must be after start date")
+	}
+	if t.EndDate.Sub(t.StartDate) > MaxTimeOffDays*24*time.Hour {
+		return fmt.Errorf("time off can be requested for at most %d days at once", MaxTimeOffDays)
+	}
+	return nil
+}
+
+// Overlaps reports whether the time off overlaps the period [start, end)
+func (t *TimeOffRequest) Overlaps(start, end time.Time) bool {
+	return t.StartDate.Before(end) && start.Before(t.EndDate)
+}
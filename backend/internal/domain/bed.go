@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// BunkPosition represents the position of a bed in a bunk
+type BunkPosition string
+
+const (
+	BunkPositionNone   BunkPosition = "none"
+	BunkPositionTop    BunkPosition = "top"
+	BunkPositionBottom BunkPosition = "bottom"
+)
+
+// IsValid checks if the bunk position is valid
+func (p BunkPosition) IsValid() bool {
+	switch p {
+	case BunkPositionNone, BunkPositionTop, BunkPositionBottom:
+		return true
+	}
+	return false
+}
+
+// Bed represents a single bed within a housing room
+type Bed struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID      uuid.UUID    `gorm:"type:uuid;not null;index:idx_beds_tenant_id" json:"tenantId"`
+	CampID        uuid.UUID    `gorm:"type:uuid;not null;index:idx_beds_camp_id" json:"campId"`
+	HousingRoomID uuid.UUID    `gorm:"type:uuid;not null;index:idx_beds_housing_room_id" json:"housingRoomId"`
+	Label         string       `gorm:"type:varchar(50);not null" json:"label"`
+	BunkPosition  BunkPosition `gorm:"type:varchar(20);not null;default:'none'" json:"bunkPosition"`
+	Accessible    bool         `gorm:"not null;default:false" json:"accessible"`
+	Notes         string       `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt     time.Time    `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt     time.Time    `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (Bed) TableName() string {
+	return "beds"
+}
+
+// BeforeCreate sets the UUID before creating a bed
+func (b *Bed) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain Bed to an API Bed representation
+func (b *Bed) ToAPI() api.Bed {
+	return api.Bed{
+		Id:            b.ID,
+		TenantId:      b.TenantID,
+		CampId:        b.CampID,
+		HousingRoomId: b.HousingRoomID,
+		Label:         b.Label,
+		BunkPosition:  api.BedBunkPosition(b.BunkPosition),
+		Accessible:    b.Accessible,
+		Notes:         utils.StringToPtr(b.Notes),
+		CreatedAt:     b.CreatedAt,
+		UpdatedAt:     b.UpdatedAt,
+	}
+}
+
+// Validate checks that the bed has a label and a known bunk position
+func (b *Bed) Validate() error {
+	if strings.TrimSpace(b.Label) == "" {
+		return fmt.Errorf("label is required")
+	}
+	if !b.BunkPosition.IsValid() {
+		return fmt.Errorf("invalid bunk position: %s", b.BunkPosition)
+	}
+	return nil
+}
+
+// BedAssignment represents a camper or staff member sleeping in a bed from one night to another during a session
+type BedAssignment struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID      uuid.UUID  `gorm:"type:uuid;not null;index:idx_bed_assignments_tenant_id" json:"tenantId"`
+	CampID        uuid.UUID  `gorm:"type:uuid;not null;index:idx_bed_assignments_camp_id" json:"campId"`
+	SessionID     uuid.UUID  `gorm:"type:uuid;not null;index:idx_bed_assignments_session_id" json:"sessionId"`
+	BedID         uuid.UUID  `gorm:"type:uuid;not null;index:idx_bed_assignments_bed_id" json:"bedId"`
+	CamperID      *uuid.UUID `gorm:"type:uuid;index:idx_bed_assignments_camper_id" json:"camperId,omitempty"`
+	StaffMemberID *uuid.UUID `gorm:"type:uuid;index:idx_bed_assignments_staff_member_id" json:"staffMemberId,omitempty"`
+	// StartDate is the first night in the bed and EndDate the day it is left
+	StartDate time.Time `gorm:"type:date;not null" json:"startDate"`
+	EndDate   time.Time `gorm:"type:date;not null" json:"endDate"`
+	Notes     string    `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+
+	// Relations
+	Bed *Bed `gorm:"foreignKey:BedID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName overrides the default table name
+func (BedAssignment) TableName() string {
+	return "bed_assignments"
+}
+
+// BeforeCreate sets the UUID before creating a bed assignment
+func (a *BedAssignment) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain BedAssignment to an API BedAssignment representation.
+// The Bed relation must be loaded to fill in the housing room.
+func (a *BedAssignment) ToAPI() api.BedAssignment {
+	assignment := api.BedAssignment{
+		Id:            a.ID,
+		TenantId:      a.TenantID,
+		CampId:        a.CampID,
+		SessionId:     a.SessionID,
+		BedId:         a.BedID,
+		CamperId:      a.CamperID,
+		StaffMemberId: a.StaffMemberID,
+		StartDate:     openapi_types.Date{Time: a.StartDate},
+		EndDate:       openapi_types.Date{Time: a.EndDate},
+		Notes:         utils.StringToPtr(a.Notes),
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+	if a.Bed != nil {
+		assignment.HousingRoomId = a.Bed.HousingRoomID
+	}
+	return assignment
+}
+
+// Validate checks that the assignment has exactly one occupant and at least one night
+func (a *BedAssignment) Validate() error {
+	if (a.CamperID == nil) == (a.StaffMemberID == nil) {
+		return fmt.Errorf("exactly one of camperId and staffMemberId is required")
+	}
+	if !a.EndDate.After(a.StartDate) {
+		return fmt.Errorf("endDate must be after startDate")
+	}
+	return nil
+}
+
+// Overlaps reports whether the assignment shares a night with the nights from start until end
+func (a *BedAssignment) Overlaps(start, end time.Time) bool {
+	return a.StartDate.Before(end) && start.Before(a.EndDate)
+}
+
+// CoversNight reports whether the occupant sleeps in the bed on the night starting on the day
+func (a *BedAssignment) CoversNight(day time.Time) bool {
+	return !day.Before(a.StartDate) && day.Before(a.EndDate)
+}
+
+// SameOccupant reports whether both assignments are for the same camper or staff member
+func (a *BedAssignment) SameOccupant(other *BedAssignment) bool {
+	if a.CamperID != nil && other.CamperID != nil {
+		return *a.CamperID == *other.CamperID
+	}
+	if a.StaffMemberID != nil && other.StaffMemberID != nil {
+		return *a.StaffMemberID == *other.StaffMemberID
+	}
+	return false
+}
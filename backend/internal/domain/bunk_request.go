@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// BunkRequest represents a camper's request to share a housing room with another camper in a session
+type BunkRequest struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID          uuid.UUID `gorm:"type:uuid;not null;index:idx_bunk_requests_tenant_id" json:"tenantId"`
+	CampID            uuid.UUID `gorm:"type:uuid;not null;index:idx_bunk_requests_camp_id" json:"campId"`
+	SessionID         uuid.UUID `gorm:"type:uuid;not null;index:idx_bunk_requests_session_id" json:"sessionId"`
+	CamperID          uuid.UUID `gorm:"type:uuid;not null;index:idx_bunk_requests_camper_id" json:"camperId"`
+	RequestedCamperID uuid.UUID `gorm:"type:uuid;not null;index:idx_bunk_requests_requested_camper_id" json:"requestedCamperId"`
+	Notes             string    `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+
+	// Mutual is set by the service when the requested camper asked for this camper too
+	Mutual bool `gorm:"-" json:"mutual"`
+}
+
+// TableName overrides the default table name
+func (BunkRequest) TableName() string {
+	return "bunk_requests"
+}
+
+// BeforeCreate sets the UUID before creating a bunk request
+func (b *BunkRequest) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain BunkRequest to an API BunkRequest representation
+func (b *BunkRequest) ToAPI() api.BunkRequest {
+	return api.BunkRequest{
+		Id:                b.ID,
+		TenantId:          b.TenantID,
+		CampId:            b.CampID,
+		SessionId:         b.SessionID,
+		CamperId:          b.CamperID,
+		RequestedCamperId: b.RequestedCamperID,
+		Notes:             utils.StringToPtr(b.Notes),
+		Mutual:            b.Mutual,
+		CreatedAt:         b.CreatedAt,
+		UpdatedAt:         b.UpdatedAt,
+	}
+}
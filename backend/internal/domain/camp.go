@@ -51,6 +51,18 @@ func (c *Camp) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// TimeLocation returns the camp's time zone, falling back to UTC when it is unset or unknown
+func (c *Camp) TimeLocation() *time.Location {
+	if c.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // ToAPI converts the domain Camp to an API Camp representation
 func (c *Camp) ToAPI() api.Camp {
 	var address *struct {
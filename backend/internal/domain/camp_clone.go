@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CampCloneJobStatus represents the status of a camp clone job
+type CampCloneJobStatus string
+
+const (
+	CampCloneJobStatusPending   CampCloneJobStatus = "pending"
+	CampCloneJobStatusRunning   CampCloneJobStatus = "running"
+	CampCloneJobStatusCompleted CampCloneJobStatus = "completed"
+	CampCloneJobStatusFailed    CampCloneJobStatus = "failed"
+)
+
+// CampCloneCounts records how many records of each kind a clone copied into the new camp
+type CampCloneCounts struct {
+	Areas          int `json:"areas"`
+	Locations      int `json:"locations"`
+	HousingRooms   int `json:"housingRooms"`
+	Beds           int `json:"beds"`
+	Colors         int `json:"colors"`
+	TimeBlocks     int `json:"timeBlocks"`
+	Roles          int `json:"roles"`
+	Certifications int `json:"certifications"`
+	Groups         int `json:"groups"`
+	Programs       int `json:"programs"`
+	SkillTracks    int `json:"skillTracks"`
+	Activities     int `json:"activities"`
+	Events         int `json:"events"`
+
+	// DroppedSkillRequirements counts activity requirements on camper custom fields, which are not copied
+	DroppedSkillRequirements int `json:"droppedSkillRequirements"`
+}
+
+// CampCloneJob represents a background copy of a camp's configuration into a new camp for another season
+type CampCloneJob struct {
+	ID               uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID         uuid.UUID          `gorm:"type:uuid;not null;index:idx_camp_clone_jobs_tenant_id" json:"tenantId"`
+	CampID           uuid.UUID          `gorm:"type:uuid;not null;index:idx_camp_clone_jobs_camp_id" json:"campId"`
+	Status           CampCloneJobStatus `gorm:"type:varchar(20);not null;default:pending;index:idx_camp_clone_jobs_status" json:"status"`
+	Name             string             `gorm:"type:varchar(255);not null" json:"name"`
+	Description      *string            `gorm:"type:text" json:"description,omitempty"`
+	StartDate        time.Time          `gorm:"type:date;not null" json:"startDate"`
+	EndDate          time.Time          `gorm:"type:date;not null" json:"endDate"`
+	CopyEvents       bool               `gorm:"not null;default:false" json:"copyEvents"`
+	NewCampID        *uuid.UUID         `gorm:"type:uuid" json:"newCampId,omitempty"`
+	Copied           *CampCloneCounts   `gorm:"type:jsonb;serializer:json" json:"copied,omitempty"`
+	Error            string             `gorm:"type:text" json:"error,omitempty"`
+	RequestedBy      *uuid.UUID         `gorm:"type:uuid" json:"requestedBy,omitempty"`
+	RequestedByEmail string             `gorm:"type:varchar(255)" json:"requestedByEmail,omitempty"`
+	StartedAt        *time.Time         `gorm:"type:timestamp" json:"startedAt,omitempty"`
+	CompletedAt      *time.Time         `gorm:"type:timestamp" json:"completedAt,omitempty"`
+	CreatedAt        time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt        time.Time          `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (CampCloneJob) TableName() string {
+	return "camp_clone_jobs"
+}
+
+// BeforeCreate sets the UUID before creating a camp clone job
+func (j *CampCloneJob) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain CampCloneJob to an API CampCloneJob representation
+func (j *CampCloneJob) ToAPI() api.CampCloneJob {
+	job := api.CampCloneJob{
+		Id:               j.ID,
+		TenantId:         j.TenantID,
+		CampId:           j.CampID,
+		Status:           api.CampCloneJobStatus(j.Status),
+		Name:             j.Name,
+		Description:      j.Description,
+		StartDate:        openapi_types.Date{Time: j.StartDate},
+		EndDate:          openapi_types.Date{Time: j.EndDate},
+		CopyEvents:       j.CopyEvents,
+		NewCampId:        j.NewCampID,
+		Error:            utils.StringToPtr(j.Error),
+		RequestedBy:      j.RequestedBy,
+		RequestedByEmail: utils.StringToPtr(j.RequestedByEmail),
+		StartedAt:        j.StartedAt,
+		CompletedAt:      j.CompletedAt,
+		CreatedAt:        j.CreatedAt,
+		UpdatedAt:        j.UpdatedAt,
+	}
+	if j.Copied != nil {
+		job.Copied = &api.CampCloneCounts{
+			Areas:          j.Copied.Areas,
+			Locations:      j.Copied.Locations,
+			HousingRooms:   j.Copied.HousingRooms,
+			Beds:           j.Copied.Beds,
+			Colors:         j.Copied.Colors,
+			TimeBlocks:     j.Copied.TimeBlocks,
+			Roles:          j.Copied.Roles,
+			Certifications: j.Copied.Certifications,
+			Groups:         j.Copied.Groups,
+			Programs:       j.Copied.Programs,
+			SkillTracks:    j.Copied.SkillTracks,
+			Activities:     j.Copied.Activities,
+			Events:         j.Copied.Events,
+
+			DroppedSkillRequirements: j.Copied.DroppedSkillRequirements,
+		}
+	}
+	return job
+}
+
+// Validate checks that the new camp has a name and ends on or after it starts
+func (j *CampCloneJob) Validate() error {
+	if strings.TrimSpace(j.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if j.EndDate.Before(j.StartDate) {
+		return fmt.Errorf("end date must be after start date")
+	}
+	return nil
+}
+
+// CloneIDs maps the IDs of records of a camp to the IDs of their copies in a cloned camp
+type CloneIDs map[uuid.UUID]uuid.UUID
+
+// Add gives a record a new ID and returns it
+func (m CloneIDs) Add(id uuid.UUID) uuid.UUID {
+	copyID := uuid.New()
+	m[id] = copyID
+	return copyID
+}
+
+// Ptr returns the ID of the copy of a record, or nil when there is no record or it was not copied
+func (m CloneIDs) Ptr(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	copyID, ok := m[*id]
+	if !ok {
+		return nil
+	}
+	return &copyID
+}
+
+// List returns the IDs of the copies of records, dropping records that were not copied
+func (m CloneIDs) List(ids []uuid.UUID) []uuid.UUID {
+	var copyIDs []uuid.UUID
+	for _, id := range ids {
+		if copyID, ok := m[id]; ok {
+			copyIDs = append(copyIDs, copyID)
+		}
+	}
+	return copyIDs
+}
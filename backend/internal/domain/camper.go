@@ -11,21 +11,27 @@ import (
 
 // Camper represents a camper registered for a camp session
 type Camper struct {
-	ID             uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	TenantID       uuid.UUID      `gorm:"type:uuid;not null;index:idx_campers_tenant_id" json:"tenantId"`
-	CampID         uuid.UUID      `gorm:"type:uuid;not null;index:idx_campers_camp_id" json:"campId"`
-	Name           string         `gorm:"type:varchar(255);not null" json:"name"`
-	Description    string         `gorm:"type:text" json:"description,omitempty"`
-	Birthday       time.Time      `gorm:"type:date;not null" json:"birthday"`
-	Gender         string         `gorm:"type:varchar(50);not null" json:"gender"`
-	SessionID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_campers_session_id" json:"sessionId"`
-	HousingGroupID *uuid.UUID     `gorm:"type:uuid;index:idx_campers_housing_group_id" json:"housingGroupId,omitempty"`
-	CreatedAt      time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt      time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt      gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+	ID                  uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID            uuid.UUID            `gorm:"type:uuid;not null;index:idx_campers_tenant_id" json:"tenantId"`
+	CampID              uuid.UUID            `gorm:"type:uuid;not null;index:idx_campers_camp_id" json:"campId"`
+	Name                string               `gorm:"type:varchar(255);not null" json:"name"`
+	Description         string               `gorm:"type:text" json:"description,omitempty"`
+	Birthday            time.Time            `gorm:"type:date;not null" json:"birthday"`
+	Gender              string               `gorm:"type:varchar(50);not null" json:"gender"`
+	SessionID           uuid.UUID            `gorm:"type:uuid;not null;index:idx_campers_session_id" json:"sessionId"`
+	HousingGroupID      *uuid.UUID           `gorm:"type:uuid;index:idx_campers_housing_group_id" json:"housingGroupId,omitempty"`
+	CustomFields        CustomFieldValues    `gorm:"type:jsonb;not null;default:'{}'" json:"customFields,omitempty"`
+	DietaryRestrictions []DietaryRestriction `gorm:"type:jsonb;serializer:json" json:"dietaryRestrictions,omitempty"`
+	Allergies           []Allergen           `gorm:"type:jsonb;serializer:json" json:"allergies,omitempty"`
+	CreatedAt           time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt           time.Time            `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt           gorm.DeletedAt       `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relationships (for preloading junction table data)
-	GroupCampers []GroupCamper `gorm:"foreignKey:CamperID" json:"-"`
+	GroupCampers []GroupCamper      `gorm:"foreignKey:CamperID" json:"-"`
+	Enrollments  []CamperEnrollment `gorm:"foreignKey:CamperID" json:"-"`
+	// SkillAssessments are only preloaded where skill levels are evaluated
+	SkillAssessments []SkillAssessment `gorm:"foreignKey:CamperID" json:"-"`
 }
 
 // GroupCamper represents the junction table between groups and campers
@@ -61,6 +67,14 @@ func (c *Camper) ToAPI() api.Camper {
 		groupIDs = append(groupIDs, gc.GroupID)
 	}
 
+	// Extract session IDs from active enrollments
+	sessionIDs := []uuid.UUID{}
+	for _, enrollment := range c.Enrollments {
+		if enrollment.IsActive() {
+			sessionIDs = append(sessionIDs, enrollment.SessionID)
+		}
+	}
+
 	return api.Camper{
 		Meta: api.EntityMeta{
 			Id:          c.ID,
@@ -72,11 +86,15 @@ func (c *Camper) ToAPI() api.Camper {
 			UpdatedAt:   c.UpdatedAt,
 		},
 		Spec: api.CamperSpec{
-			Birthday:       api.Birthday{Time: c.Birthday},
-			Gender:         api.Gender(c.Gender),
-			SessionId:      c.SessionID,
-			HousingGroupId: c.HousingGroupID,
-			GroupIds:       &groupIDs,
+			Birthday:            api.Birthday{Time: c.Birthday},
+			Gender:              api.Gender(c.Gender),
+			SessionId:           c.SessionID,
+			SessionIds:          &sessionIDs,
+			HousingGroupId:      c.HousingGroupID,
+			GroupIds:            &groupIDs,
+			CustomFields:        c.CustomFields.ToAPI(),
+			DietaryRestrictions: DietaryRestrictionsToAPI(c.DietaryRestrictions),
+			Allergies:           AllergensToAPI(c.Allergies),
 		},
 	}
 }
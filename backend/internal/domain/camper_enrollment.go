@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// EnrollmentStatus represents the status of a camper's enrollment in a session
+type EnrollmentStatus string
+
+const (
+	EnrollmentStatusEnrolled  EnrollmentStatus = "enrolled"
+	EnrollmentStatusCancelled EnrollmentStatus = "cancelled"
+	EnrollmentStatusCompleted EnrollmentStatus = "completed"
+)
+
+// IsValid reports whether the status is one of the known enrollment statuses
+func (s EnrollmentStatus) IsValid() bool {
+	switch s {
+	case EnrollmentStatusEnrolled, EnrollmentStatusCancelled, EnrollmentStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// CamperEnrollment links a camper to a session they attend
+type CamperEnrollment struct {
+	ID             uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID        `gorm:"type:uuid;not null;index:idx_camper_enrollments_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID        `gorm:"type:uuid;not null;index:idx_camper_enrollments_camp_id" json:"campId"`
+	CamperID       uuid.UUID        `gorm:"type:uuid;not null;index:idx_camper_enrollments_camper_id" json:"camperId"`
+	SessionID      uuid.UUID        `gorm:"type:uuid;not null;index:idx_camper_enrollments_session_id" json:"sessionId"`
+	Status         EnrollmentStatus `gorm:"type:varchar(50);not null;default:enrolled" json:"status"`
+	StartDate      *time.Time       `gorm:"type:date" json:"startDate,omitempty"`
+	EndDate        *time.Time       `gorm:"type:date" json:"endDate,omitempty"`
+	HousingGroupID *uuid.UUID       `gorm:"type:uuid;index:idx_camper_enrollments_housing_group_id" json:"housingGroupId,omitempty"`
+	Notes          string           `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt      time.Time        `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time        `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt      gorm.DeletedAt   `gorm:"index" json:"deletedAt,omitempty"`
+}
+
+// TableName overrides the default table name
+func (CamperEnrollment) TableName() string {
+	return "camper_enrollments"
+}
+
+// BeforeCreate sets the UUID before creating an enrollment
+func (e *CamperEnrollment) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the enrollment still counts towards the session
+func (e *CamperEnrollment) IsActive() bool {
+	return e.Status != EnrollmentStatusCancelled
+}
+
+// ToAPI converts the domain CamperEnrollment to an API CamperEnrollment representation
+func (e *CamperEnrollment) ToAPI() api.CamperEnrollment {
+	enrollment := api.CamperEnrollment{
+		Id:             e.ID,
+		TenantId:       e.TenantID,
+		CampId:         e.CampID,
+		CamperId:       e.CamperID,
+		SessionId:      e.SessionID,
+		Status:         api.CamperEnrollmentStatus(e.Status),
+		HousingGroupId: e.HousingGroupID,
+		Notes:          utils.StringToPtr(e.Notes),
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+
+	if e.StartDate != nil {
+		enrollment.StartDate = &openapi_types.Date{Time: *e.StartDate}
+	}
+	if e.EndDate != nil {
+		enrollment.EndDate = &openapi_types.Date{Time: *e.EndDate}
+	}
+
+	return enrollment
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CamperMergeCounts records how many references of each kind a merge moved to the survivor
+type CamperMergeCounts struct {
+	Groups            int `json:"groups"`
+	Guardians         int `json:"guardians"`
+	Enrollments       int `json:"enrollments"`
+	Applications      int `json:"applications"`
+	Medications       int `json:"medications"`
+	MedicationDoses   int `json:"medicationDoses"`
+	AttendanceRecords int `json:"attendanceRecords"`
+	BunkRequests      int `json:"bunkRequests"`
+	Events            int `json:"events"`
+	Incidents         int `json:"incidents"`
+	Notes             int `json:"notes"`
+	Attachments       int `json:"attachments"`
+	SkillAssessments  int `json:"skillAssessments"`
+	Badges            int `json:"badges"`
+}
+
+// CamperMerge is the audit record of a duplicate camper merged into a surviving camper
+type CamperMerge struct {
+	ID                uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID          uuid.UUID         `gorm:"type:uuid;not null;index:idx_camper_merges_tenant_id" json:"tenantId"`
+	CampID            uuid.UUID         `gorm:"type:uuid;not null;index:idx_camper_merges_camp_id" json:"campId"`
+	SurvivorCamperID  uuid.UUID         `gorm:"type:uuid;not null;index:idx_camper_merges_survivor_camper_id" json:"survivorCamperId"`
+	DuplicateCamperID uuid.UUID         `gorm:"type:uuid;not null" json:"duplicateCamperId"`
+	DuplicateName     string            `gorm:"type:varchar(255);not null" json:"duplicateName"`
+	DuplicateBirthday time.Time         `gorm:"type:date;not null" json:"duplicateBirthday"`
+	DuplicateSnapshot json.RawMessage   `gorm:"type:jsonb" json:"duplicateSnapshot,omitempty"`
+	Moved             CamperMergeCounts `gorm:"type:jsonb;serializer:json" json:"moved"`
+	MergedBy          *uuid.UUID        `gorm:"type:uuid" json:"mergedBy,omitempty"`
+	MergedByEmail     string            `gorm:"type:varchar(255)" json:"mergedByEmail,omitempty"`
+	CreatedAt         time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName overrides the default table name
+func (CamperMerge) TableName() string {
+	return "camper_merges"
+}
+
+// BeforeCreate sets the UUID before creating a camper merge
+func (m *CamperMerge) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain CamperMerge to an API CamperMerge representation
+func (m *CamperMerge) ToAPI() api.CamperMerge {
+	return api.CamperMerge{
+		Id:                m.ID,
+		TenantId:          m.TenantID,
+		CampId:            m.CampID,
+		SurvivorCamperId:  m.SurvivorCamperID,
+		DuplicateCamperId: m.DuplicateCamperID,
+		DuplicateName:     m.DuplicateName,
+		DuplicateBirthday: openapi_types.Date{Time: m.DuplicateBirthday},
+		Moved: api.CamperMergeCounts{
+			Groups:            m.Moved.Groups,
+			Guardians:         m.Moved.Guardians,
+			Enrollments:       m.Moved.Enrollments,
+			Applications:      m.Moved.Applications,
+			Medications:       m.Moved.Medications,
+			MedicationDoses:   m.Moved.MedicationDoses,
+			AttendanceRecords: m.Moved.AttendanceRecords,
+			BunkRequests:      m.Moved.BunkRequests,
+			Events:            m.Moved.Events,
+			Incidents:         m.Moved.Incidents,
+			Notes:             m.Moved.Notes,
+			Attachments:       m.Moved.Attachments,
+			SkillAssessments:  m.Moved.SkillAssessments,
+			Badges:            m.Moved.Badges,
+		},
+		MergedBy:      m.MergedBy,
+		MergedByEmail: utils.StringToPtr(m.MergedByEmail),
+		CreatedAt:     m.CreatedAt,
+	}
+}
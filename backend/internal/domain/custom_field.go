@@ -0,0 +1,328 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CustomFieldEntityType represents the kind of record a custom field applies to
+type CustomFieldEntityType string
+
+const (
+	CustomFieldEntityTypeCamper      CustomFieldEntityType = "camper"
+	CustomFieldEntityTypeStaffMember CustomFieldEntityType = "staff_member"
+	CustomFieldEntityTypeGroup       CustomFieldEntityType = "group"
+)
+
+// CustomFieldType represents the type of values a custom field accepts
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText    CustomFieldType = "text"
+	CustomFieldTypeNumber  CustomFieldType = "number"
+	CustomFieldTypeDate    CustomFieldType = "date"
+	CustomFieldTypeEnum    CustomFieldType = "enum"
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+)
+
+// CustomFieldsPrefix is the prefix used to address custom fields in filterBy and sortBy, e.g. customFields.tshirtSize
+const CustomFieldsPrefix = "customFields."
+
+// customFieldDateLayout is the format custom date values are stored in
+const customFieldDateLayout = "2006-01-02"
+
+// customFieldKeyPattern restricts keys to identifiers so they can be safely used in JSONB path expressions
+var customFieldKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,62}$`)
+
+// CustomFieldDefinition represents an admin-defined field that campers, staff members or groups
+// of a camp carry in addition to their built-in fields. Values are stored in the custom_fields
+// column of the entity table keyed by Key.
+type CustomFieldDefinition struct {
+	ID          uuid.UUID             `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID    uuid.UUID             `gorm:"type:uuid;not null;index:idx_custom_field_definitions_tenant_id" json:"tenantId"`
+	CampID      uuid.UUID             `gorm:"type:uuid;not null;index:idx_custom_field_definitions_camp_id" json:"campId"`
+	EntityType  CustomFieldEntityType `gorm:"type:varchar(50);not null" json:"entityType"`
+	Key         string                `gorm:"type:varchar(63);not null" json:"key"`
+	Label       string                `gorm:"type:varchar(255);not null" json:"label"`
+	Type        CustomFieldType       `gorm:"type:varchar(50);not null" json:"type"`
+	Options     []string              `gorm:"type:jsonb;serializer:json" json:"options,omitempty"`
+	Required    bool                  `gorm:"not null;default:false" json:"required"`
+	Position    int                   `gorm:"not null;default:0" json:"position"`
+	Description string                `gorm:"type:text" json:"description,omitempty"`
+	CreatedAt   time.Time             `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt   time.Time             `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (CustomFieldDefinition) TableName() string {
+	return "custom_field_definitions"
+}
+
+// BeforeCreate sets the UUID before creating a custom field definition
+func (d *CustomFieldDefinition) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain CustomFieldDefinition to an API CustomFieldDefinition representation
+func (d *CustomFieldDefinition) ToAPI() api.CustomFieldDefinition {
+	var options *[]string
+	if len(d.Options) > 0 {
+		options = &d.Options
+	}
+
+	return api.CustomFieldDefinition{
+		Id:          d.ID,
+		TenantId:    d.TenantID,
+		CampId:      d.CampID,
+		EntityType:  api.CustomFieldEntityType(d.EntityType),
+		Key:         d.Key,
+		Label:       d.Label,
+		Type:        api.CustomFieldType(d.Type),
+		Options:     options,
+		Required:    d.Required,
+		Position:    d.Position,
+		Description: utils.StringToPtr(d.Description),
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+// Validate checks that the definition is well-formed
+func (d *CustomFieldDefinition) Validate() error {
+	switch d.EntityType {
+	case CustomFieldEntityTypeCamper, CustomFieldEntityTypeStaffMember, CustomFieldEntityTypeGroup:
+	default:
+		return fmt.Errorf("invalid entity type '%s'", d.EntityType)
+	}
+
+	if !customFieldKeyPattern.MatchString(d.Key) {
+		return fmt.Errorf("invalid key '%s': must start with a letter and contain only letters, digits and underscores", d.Key)
+	}
+
+	if strings.TrimSpace(d.Label) == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	switch d.Type {
+	case CustomFieldTypeEnum:
+		if len(d.Options) == 0 {
+			return fmt.Errorf("enum fields need at least one option")
+		}
+		seen := make(map[string]bool, len(d.Options))
+		for _, option := range d.Options {
+			if strings.TrimSpace(option) == "" {
+				return fmt.Errorf("enum options cannot be empty")
+			}
+			if seen[option] {
+				return fmt.Errorf("duplicate enum option '%s'", option)
+			}
+			seen[option] = true
+		}
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeBoolean:
+		if len(d.Options) > 0 {
+			return fmt.Errorf("options are only allowed for enum fields")
+		}
+	default:
+		return fmt.Errorf("invalid field type '%s'", d.Type)
+	}
+
+	return nil
+}
+
+// FilterFieldType returns the filter field type used when filtering on this custom field
+func (d *CustomFieldDefinition) FilterFieldType() FieldType {
+	switch d.Type {
+	case CustomFieldTypeNumber:
+		return FieldTypeNumber
+	case CustomFieldTypeDate:
+		return FieldTypeDate
+	case CustomFieldTypeBoolean:
+		return FieldTypeBoolean
+	default:
+		return FieldTypeText
+	}
+}
+
+// NormalizeValue checks a JSON-decoded value against the field type and returns it in its stored form
+func (d *CustomFieldDefinition) NormalizeValue(value interface{}) (interface{}, error) {
+	switch d.Type {
+	case CustomFieldTypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case json.Number:
+			return d.ParseValue(v.String())
+		}
+		return nil, fmt.Errorf("custom field '%s' must be a number", d.Key)
+	case CustomFieldTypeBoolean:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+		return nil, fmt.Errorf("custom field '%s' must be a boolean", d.Key)
+	default:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("custom field '%s' must be a string", d.Key)
+		}
+		return d.ParseValue(v)
+	}
+}
+
+// ParseValue parses a raw string such as a CSV cell into the stored form of the field type
+func (d *CustomFieldDefinition) ParseValue(raw string) (interface{}, error) {
+	raw = strings.TrimSpace(raw)
+
+	switch d.Type {
+	case CustomFieldTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("custom field '%s' must be a number", d.Key)
+		}
+		return number, nil
+	case CustomFieldTypeDate:
+		date, err := time.Parse(customFieldDateLayout, raw)
+		if err != nil {
+			return nil, fmt.Errorf("custom field '%s' must be a date in YYYY-MM-DD format", d.Key)
+		}
+		return date.Format(customFieldDateLayout), nil
+	case CustomFieldTypeEnum:
+		for _, option := range d.Options {
+			if option == raw {
+				return raw, nil
+			}
+		}
+		return nil, fmt.Errorf("custom field '%s' must be one of: %s", d.Key, strings.Join(d.Options, ", "))
+	case CustomFieldTypeBoolean:
+		switch strings.ToLower(raw) {
+		case "true", "yes", "1":
+			return true, nil
+		case "false", "no", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("custom field '%s' must be true or false", d.Key)
+	default:
+		return raw, nil
+	}
+}
+
+// IsLevelScale reports whether the field orders its values into skill levels: enum options from lowest
+// to highest, or numbers
+func (d *CustomFieldDefinition) IsLevelScale() bool {
+	return d.Type == CustomFieldTypeEnum || d.Type == CustomFieldTypeNumber
+}
+
+// LevelRank returns the rank of a stored value on the field's level scale, reporting false when the
+// value is missing or not on the scale
+func (d *CustomFieldDefinition) LevelRank(value interface{}) (float64, bool) {
+	switch d.Type {
+	case CustomFieldTypeEnum:
+		if v, ok := value.(string); ok {
+			for i, option := range d.Options {
+				if option == v {
+					return float64(i), true
+				}
+			}
+		}
+	case CustomFieldTypeNumber:
+		if v, ok := value.(float64); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// CustomFieldValues holds the custom field values of a camper, staff member or group keyed by field key
+type CustomFieldValues map[string]interface{}
+
+// Scan implements the sql.Scanner interface for CustomFieldValues
+func (v *CustomFieldValues) Scan(value interface{}) error {
+	if value == nil {
+		*v = CustomFieldValues{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan custom field values: unexpected type %T", value)
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// Value implements the driver.Valuer interface for CustomFieldValues
+func (v CustomFieldValues) Value() (driver.Value, error) {
+	if v == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(v)
+}
+
+// ToAPI converts the values to their API representation, omitting them when empty
+func (v CustomFieldValues) ToAPI() *api.CustomFieldValues {
+	if len(v) == 0 {
+		return nil
+	}
+	values := api.CustomFieldValues(v)
+	return &values
+}
+
+// NormalizeCustomFieldValues validates values against the definitions of an entity type and
+// returns them in their stored form. Null and empty values are dropped, unknown keys are
+// rejected and every required field must have a value.
+func NormalizeCustomFieldValues(definitions []CustomFieldDefinition, values map[string]interface{}) (CustomFieldValues, error) {
+	byKey := make(map[string]*CustomFieldDefinition, len(definitions))
+	for i := range definitions {
+		byKey[definitions[i].Key] = &definitions[i]
+	}
+
+	// Check keys in a stable order so the reported error does not change between requests
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := CustomFieldValues{}
+	for _, key := range keys {
+		definition, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown custom field '%s'", key)
+		}
+
+		value := values[key]
+		if value == nil {
+			continue
+		}
+		if s, ok := value.(string); ok && strings.TrimSpace(s) == "" {
+			continue
+		}
+
+		normalizedValue, err := definition.NormalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[key] = normalizedValue
+	}
+
+	for _, definition := range definitions {
+		if _, ok := normalized[definition.Key]; definition.Required && !ok {
+			return nil, fmt.Errorf("custom field '%s' is required", definition.Key)
+		}
+	}
+
+	return normalized, nil
+}
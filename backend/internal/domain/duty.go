@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MaxDutyRestHours is the longest rest a duty type can require after its shifts
+const MaxDutyRestHours = 48
+
+// DutyType represents a kind of staff duty that is not an event, e.g. night watch or a day off
+type DutyType struct {
+	ID                       uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID                 uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_types_tenant_id" json:"tenantId"`
+	CampID                   uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_types_camp_id" json:"campId"`
+	Name                     string      `gorm:"type:varchar(255);not null" json:"name"`
+	Description              string      `gorm:"type:text" json:"description,omitempty"`
+	IsTimeOff                bool        `gorm:"not null;default:false" json:"isTimeOff"`
+	MinRestHours             int         `gorm:"not null;default:0" json:"minRestHours"`
+	RequiredCertificationIDs []uuid.UUID `gorm:"type:jsonb;serializer:json" json:"requiredCertificationIds"`
+	CreatedAt                time.Time   `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt                time.Time   `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (DutyType) TableName() string {
+	return "duty_types"
+}
+
+// BeforeCreate sets the UUID before creating a duty type
+func (d *DutyType) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain DutyType to an API DutyType representation
+func (d *DutyType) ToAPI() api.DutyType {
+	certificationIDs := d.RequiredCertificationIDs
+	if certificationIDs == nil {
+		certificationIDs = []uuid.UUID{}
+	}
+
+	return api.DutyType{
+		Id:                       d.ID,
+		TenantId:                 d.TenantID,
+		CampId:                   d.CampID,
+		Name:                     d.Name,
+		Description:              utils.StringToPtr(d.Description),
+		IsTimeOff:                d.IsTimeOff,
+		MinRestHours:             d.MinRestHours,
+		RequiredCertificationIds: certificationIDs,
+		CreatedAt:                d.CreatedAt,
+		UpdatedAt:                d.UpdatedAt,
+	}
+}
+
+// Validate checks that the duty type has a name and a rest time within bounds
+func (d *DutyType) Validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if d.MinRestHours < 0 || d.MinRestHours > MaxDutyRestHours {
+		return fmt.Errorf("minimum rest must be between 0 and %d hours", MaxDutyRestHours)
+	}
+	return nil
+}
+
+// MinRest returns the rest a staff member needs after a shift of this type
+func (d *DutyType) MinRest() time.Duration {
+	return time.Duration(d.MinRestHours) * time.Hour
+}
+
+// DutyRotation represents a template generating shifts of a duty type at the same time on given weekdays
+type DutyRotation struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_rotations_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_rotations_camp_id" json:"campId"`
+	DutyTypeID     uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_rotations_duty_type_id" json:"dutyTypeId"`
+	Name           string      `gorm:"type:varchar(255);not null" json:"name"`
+	StartTime      string      `gorm:"type:varchar(5);not null" json:"startTime"` // Format: HH:MM
+	EndTime        string      `gorm:"type:varchar(5);not null" json:"endTime"`   // Format: HH:MM
+	DaysOfWeek     []int       `gorm:"type:jsonb;serializer:json" json:"daysOfWeek"`
+	StaffCount     int         `gorm:"not null;default:1" json:"staffCount"`
+	StaffMemberIDs []uuid.UUID `gorm:"type:jsonb;serializer:json" json:"staffMemberIds"`
+	CreatedAt      time.Time   `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time   `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (DutyRotation) TableName() string {
+	return "duty_rotations"
+}
+
+// BeforeCreate sets the UUID before creating a duty rotation
+func (d *DutyRotation) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain DutyRotation to an API DutyRotation representation
+func (d *DutyRotation) ToAPI() api.DutyRotation {
+	days := d.DaysOfWeek
+	if days == nil {
+		days = []int{}
+	}
+	staffMemberIDs := d.StaffMemberIDs
+	if staffMemberIDs == nil {
+		staffMemberIDs = []uuid.UUID{}
+	}
+
+	return api.DutyRotation{
+		Id:             d.ID,
+		TenantId:       d.TenantID,
+		CampId:         d.CampID,
+		DutyTypeId:     d.DutyTypeID,
+		Name:           d.Name,
+		StartTime:      d.StartTime,
+		EndTime:        d.EndTime,
+		DaysOfWeek:     days,
+		StaffCount:     d.StaffCount,
+		StaffMemberIds: staffMemberIDs,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+// Validate checks the rotation's name, times, weekdays and staff count
+func (d *DutyRotation) Validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if _, err := time.Parse("15:04", d.StartTime); err != nil {
+		return fmt.Errorf("start time must be in HH:MM format")
+	}
+	if _, err := time.Parse("15:04", d.EndTime); err != nil {
+		return fmt.Errorf("end time must be in HH:MM format")
+	}
+	for _, day := range d.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("days of the week must be between 0 (Sunday) and 6 (Saturday)")
+		}
+	}
+	if d.StaffCount < 1 {
+		return fmt.Errorf("staff count must be at least 1")
+	}
+	return nil
+}
+
+// RunsOn reports whether the rotation generates a shift on the weekday
+func (d *DutyRotation) RunsOn(weekday time.Weekday) bool {
+	if len(d.DaysOfWeek) == 0 {
+		return true
+	}
+	for _, day := range d.DaysOfWeek {
+		if time.Weekday(day) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// ShiftWindow returns the instants a shift of the rotation starting on a day in the given time zone
+// starts and ends. Shifts ending at or before their start time end the next day.
+func (d *DutyRotation) ShiftWindow(day time.Time, loc *time.Location) (time.Time, time.Time) {
+	at := func(clock string, offset int) time.Time {
+		t, _ := time.Parse("15:04", clock)
+		return time.Date(day.Year(), day.Month(), day.Day()+offset, t.Hour(), t.Minute(), 0, 0, loc)
+	}
+
+	start, end := at(d.StartTime, 0), at(d.EndTime, 0)
+	if !end.After(start) {
+		end = at(d.EndTime, 1)
+	}
+	return start, end
+}
+
+// InPool reports whether a staff member can be assigned to the rotation's shifts
+func (d *DutyRotation) InPool(staffMemberID uuid.UUID) bool {
+	if len(d.StaffMemberIDs) == 0 {
+		return true
+	}
+	for _, id := range d.StaffMemberIDs {
+		if id == staffMemberID {
+			return true
+		}
+	}
+	return false
+}
+
+// DutyShift represents staff members on a duty from one instant to another
+type DutyShift struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_shifts_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_shifts_camp_id" json:"campId"`
+	DutyTypeID     uuid.UUID   `gorm:"type:uuid;not null;index:idx_duty_shifts_duty_type_id" json:"dutyTypeId"`
+	RotationID     *uuid.UUID  `gorm:"type:uuid;index:idx_duty_shifts_rotation_id" json:"rotationId,omitempty"`
+	StartDate      time.Time   `gorm:"type:timestamp;not null;index:idx_duty_shifts_start_date" json:"startDate"`
+	EndDate        time.Time   `gorm:"type:timestamp;not null;index:idx_duty_shifts_end_date" json:"endDate"`
+	StaffCount     int         `gorm:"not null;default:1" json:"staffCount"`
+	StaffMemberIDs []uuid.UUID `gorm:"type:jsonb;serializer:json" json:"staffMemberIds"`
+	Notes          string      `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt      time.Time   `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time   `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (DutyShift) TableName() string {
+	return "duty_shifts"
+}
+
+// BeforeCreate sets the UUID before creating a duty shift
+func (d *DutyShift) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain DutyShift to an API DutyShift representation
+func (d *DutyShift) ToAPI() api.DutyShift {
+	staffMemberIDs := d.StaffMemberIDs
+	if staffMemberIDs == nil {
+		staffMemberIDs = []uuid.UUID{}
+	}
+
+	return api.DutyShift{
+		Id:             d.ID,
+		TenantId:       d.TenantID,
+		CampId:         d.CampID,
+		DutyTypeId:     d.DutyTypeID,
+		RotationId:     d.RotationID,
+		StartDate:      d.StartDate,
+		EndDate:        d.EndDate,
+		StaffCount:     d.StaffCount,
+		StaffMemberIds: staffMemberIDs,
+		Notes:          utils.StringToPtr(d.Notes),
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+// Validate checks that the shift ends after it starts and has room for its staff members
+func (d *DutyShift) Validate() error {
+	if !d.EndDate.After(d.StartDate) {
+		return fmt.Errorf("end date must be after start date")
+	}
+	if d.StaffCount < 1 {
+		return fmt.Errorf("staff count must be at least 1")
+	}
+	if len(d.StaffMemberIDs) > d.StaffCount {
+		return fmt.Errorf("shift needs %d staff members but %d are assigned", d.StaffCount, len(d.StaffMemberIDs))
+	}
+	return nil
+}
+
+// Overlaps reports whether the shift overlaps the period [start, end)
+func (d *DutyShift) Overlaps(start, end time.Time) bool {
+	return d.StartDate.Before(end) && start.Before(d.EndDate)
+}
+
+// HasStaffMember reports whether the staff member is assigned to the shift
+func (d *DutyShift) HasStaffMember(staffMemberID uuid.UUID) bool {
+	for _, id := range d.StaffMemberIDs {
+		if id == staffMemberID {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenSlots returns the number of staff members the shift still needs
+func (d *DutyShift) OpenSlots() int {
+	if open := d.StaffCount - len(d.StaffMemberIDs); open > 0 {
+		return open
+	}
+	return 0
+}
+
+// Duration returns how long the shift lasts
+func (d *DutyShift) Duration() time.Duration {
+	return d.EndDate.Sub(d.StartDate)
+}
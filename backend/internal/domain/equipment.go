@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// EquipmentCondition represents the state of an equipment item
+type EquipmentCondition string
+
+const (
+	EquipmentConditionGood        EquipmentCondition = "good"
+	EquipmentConditionFair        EquipmentCondition = "fair"
+	EquipmentConditionNeedsRepair EquipmentCondition = "needs_repair"
+	EquipmentConditionRetired     EquipmentCondition = "retired"
+)
+
+// IsValid checks if the condition is valid
+func (c EquipmentCondition) IsValid() bool {
+	switch c {
+	case EquipmentConditionGood, EquipmentConditionFair, EquipmentConditionNeedsRepair, EquipmentConditionRetired:
+		return true
+	}
+	return false
+}
+
+// IsUsable reports whether units in this condition can be checked out and used by events
+func (c EquipmentCondition) IsUsable() bool {
+	return c == EquipmentConditionGood || c == EquipmentConditionFair
+}
+
+// EquipmentItem represents a kind of equipment the camp owns a number of units of, e.g. youth life jackets
+type EquipmentItem struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID          `gorm:"type:uuid;not null;index:idx_equipment_items_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID          `gorm:"type:uuid;not null;index:idx_equipment_items_camp_id" json:"campId"`
+	Name           string             `gorm:"type:varchar(255);not null" json:"name"`
+	Description    string             `gorm:"type:text" json:"description,omitempty"`
+	Category       string             `gorm:"type:varchar(100)" json:"category,omitempty"`
+	Quantity       int                `gorm:"not null;default:0" json:"quantity"`
+	HomeLocationID *uuid.UUID         `gorm:"type:uuid;index:idx_equipment_items_home_location_id" json:"homeLocationId,omitempty"`
+	Condition      EquipmentCondition `gorm:"type:varchar(20);not null;default:good" json:"condition"`
+	Notes          string             `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt      time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time          `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (EquipmentItem) TableName() string {
+	return "equipment_items"
+}
+
+// BeforeCreate sets the UUID before creating an equipment item
+func (e *EquipmentItem) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain EquipmentItem to an API EquipmentItem representation given the units currently checked out
+func (e *EquipmentItem) ToAPI(checkedOut int) api.EquipmentItem {
+	return api.EquipmentItem{
+		Id:                 e.ID,
+		TenantId:           e.TenantID,
+		CampId:             e.CampID,
+		Name:               e.Name,
+		Description:        utils.StringToPtr(e.Description),
+		Category:           utils.StringToPtr(e.Category),
+		Quantity:           e.Quantity,
+		HomeLocationId:     e.HomeLocationID,
+		Condition:          api.EquipmentCondition(e.Condition),
+		Notes:              utils.StringToPtr(e.Notes),
+		CheckedOutQuantity: checkedOut,
+		AvailableQuantity:  e.Available(checkedOut),
+		CreatedAt:          e.CreatedAt,
+		UpdatedAt:          e.UpdatedAt,
+	}
+}
+
+// Validate checks that the item has a name, a quantity and a known condition
+func (e *EquipmentItem) Validate() error {
+	if strings.TrimSpace(e.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if e.Quantity < 0 {
+		return fmt.Errorf("quantity must not be negative")
+	}
+	if !e.Condition.IsValid() {
+		return fmt.Errorf("invalid condition: %s", e.Condition)
+	}
+	return nil
+}
+
+// Available returns the units that can be used when the given units are checked out
+func (e *EquipmentItem) Available(checkedOut int) int {
+	if !e.Condition.IsUsable() || checkedOut >= e.Quantity {
+		return 0
+	}
+	return e.Quantity - checkedOut
+}
+
+// EquipmentCheckout represents units of an equipment item taken out of storage until they are returned
+type EquipmentCheckout struct {
+	ID                uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID          uuid.UUID           `gorm:"type:uuid;not null;index:idx_equipment_checkouts_tenant_id" json:"tenantId"`
+	CampID            uuid.UUID           `gorm:"type:uuid;not null;index:idx_equipment_checkouts_camp_id" json:"campId"`
+	EquipmentItemID   uuid.UUID           `gorm:"type:uuid;not null;index:idx_equipment_checkouts_equipment_item_id" json:"equipmentItemId"`
+	Quantity          int                 `gorm:"not null" json:"quantity"`
+	StaffMemberID     *uuid.UUID          `gorm:"type:uuid" json:"staffMemberId,omitempty"`
+	EventID           *uuid.UUID          `gorm:"type:uuid" json:"eventId,omitempty"`
+	CheckedOutAt      time.Time           `gorm:"not null" json:"checkedOutAt"`
+	DueAt             *time.Time          `gorm:"type:timestamp" json:"dueAt,omitempty"`
+	ReturnedAt        *time.Time          `gorm:"type:timestamp" json:"returnedAt,omitempty"`
+	ReturnCondition   *EquipmentCondition `gorm:"type:varchar(20)" json:"returnCondition,omitempty"`
+	Notes             string              `gorm:"type:text" json:"notes,omitempty"`
+	CheckedOutBy      *uuid.UUID          `gorm:"type:uuid" json:"checkedOutBy,omitempty"`
+	CheckedOutByEmail string              `gorm:"type:varchar(255)" json:"checkedOutByEmail,omitempty"`
+	ReturnedBy        *uuid.UUID          `gorm:"type:uuid" json:"returnedBy,omitempty"`
+	ReturnedByEmail   string              `gorm:"type:varchar(255)" json:"returnedByEmail,omitempty"`
+	CreatedAt         time.Time           `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt         time.Time           `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (EquipmentCheckout) TableName() string {
+	return "equipment_checkouts"
+}
+
+// BeforeCreate sets the UUID before creating an equipment checkout
+func (c *EquipmentCheckout) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain EquipmentCheckout to an API EquipmentCheckout representation
+func (c *EquipmentCheckout) ToAPI() api.EquipmentCheckout {
+	checkout := api.EquipmentCheckout{
+		Id:                c.ID,
+		TenantId:          c.TenantID,
+		CampId:            c.CampID,
+		EquipmentItemId:   c.EquipmentItemID,
+		Quantity:          c.Quantity,
+		StaffMemberId:     c.StaffMemberID,
+		EventId:           c.EventID,
+		CheckedOutAt:      c.CheckedOutAt,
+		DueAt:             c.DueAt,
+		ReturnedAt:        c.ReturnedAt,
+		Notes:             utils.StringToPtr(c.Notes),
+		CheckedOutBy:      c.CheckedOutBy,
+		CheckedOutByEmail: utils.StringToPtr(c.CheckedOutByEmail),
+		ReturnedBy:        c.ReturnedBy,
+		ReturnedByEmail:   utils.StringToPtr(c.ReturnedByEmail),
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+	if c.ReturnCondition != nil {
+		condition := api.EquipmentCondition(*c.ReturnCondition)
+		checkout.ReturnCondition = &condition
+	}
+	return checkout
+}
+
+// Validate checks that at least one unit is checked out and that it is due after it was checked out
+func (c *EquipmentCheckout) Validate() error {
+	if c.Quantity < 1 {
+		return fmt.Errorf("quantity must be at least 1")
+	}
+	if c.DueAt != nil && !c.DueAt.After(c.CheckedOutAt) {
+		return fmt.Errorf("dueAt must be after the checkout")
+	}
+	return nil
+}
+
+// IsOpen reports whether the units have not been returned yet
+func (c *EquipmentCheckout) IsOpen() bool {
+	return c.ReturnedAt == nil
+}
+
+// OutBetween reports whether the units are out of storage at some time from start until end. Open checkouts
+// without a due time are out until they are returned.
+func (c *EquipmentCheckout) OutBetween(start, end time.Time) bool {
+	if !c.CheckedOutAt.Before(end) {
+		return false
+	}
+	switch {
+	case c.ReturnedAt != nil:
+		return c.ReturnedAt.After(start)
+	case c.DueAt != nil:
+		return c.DueAt.After(start) || !c.DueAt.After(time.Now())
+	}
+	return true
+}
+
+// EquipmentNeed is the number of units of an equipment item each participant of an activity needs
+type EquipmentNeed struct {
+	EquipmentItemID        uuid.UUID `json:"equipmentItemId"`
+	QuantityPerParticipant int       `json:"quantityPerParticipant"`
+}
+
+// EquipmentNeeds holds the equipment needs of an activity
+type EquipmentNeeds []EquipmentNeed
+
+// ParseEquipmentNeeds converts API equipment needs, rejecting duplicate items and quantities below one
+func ParseEquipmentNeeds(req *[]api.ActivityEquipmentNeed) (EquipmentNeeds, error) {
+	if req == nil || len(*req) == 0 {
+		return nil, nil
+	}
+	needs := make(EquipmentNeeds, 0, len(*req))
+	seen := make(map[uuid.UUID]bool)
+	for _, need := range *req {
+		if seen[need.EquipmentItemId] {
+			return nil, fmt.Errorf("equipment item %s is needed more than once", need.EquipmentItemId)
+		}
+		seen[need.EquipmentItemId] = true
+		if need.QuantityPerParticipant < 1 {
+			return nil, fmt.Errorf("quantity per participant must be at least 1")
+		}
+		needs = append(needs, EquipmentNeed{
+			EquipmentItemID:        need.EquipmentItemId,
+			QuantityPerParticipant: need.QuantityPerParticipant,
+		})
+	}
+	return needs, nil
+}
+
+// ToAPI converts the needs to their API representation, omitting them when empty
+func (n EquipmentNeeds) ToAPI() *[]api.ActivityEquipmentNeed {
+	if len(n) == 0 {
+		return nil
+	}
+	needs := make([]api.ActivityEquipmentNeed, len(n))
+	for i, need := range n {
+		needs[i] = api.ActivityEquipmentNeed{
+			EquipmentItemId:        need.EquipmentItemID,
+			QuantityPerParticipant: need.QuantityPerParticipant,
+		}
+	}
+	return &needs
+}
+
+// Scan implements the sql.Scanner interface for EquipmentNeeds
+func (n *EquipmentNeeds) Scan(value interface{}) error {
+	if value == nil {
+		*n = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan equipment needs: unexpected type %T", value)
+	}
+	return json.Unmarshal(bytes, n)
+}
+
+// Value implements the driver.Valuer interface for EquipmentNeeds
+func (n EquipmentNeeds) Value() (driver.Value, error) {
+	if len(n) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(n)
+}
@@ -23,10 +23,11 @@ const (
 type FieldType string
 
 const (
-	FieldTypeText   FieldType = "text"
-	FieldTypeNumber FieldType = "number"
-	FieldTypeDate   FieldType = "date"
-	FieldTypeUUID   FieldType = "uuid"
+	FieldTypeText    FieldType = "text"
+	FieldTypeNumber  FieldType = "number"
+	FieldTypeDate    FieldType = "date"
+	FieldTypeUUID    FieldType = "uuid"
+	FieldTypeBoolean FieldType = "boolean"
 )
 
 // SortOrder represents the sort direction
@@ -65,7 +66,7 @@ func ComparisonOperators() []FilterOperator {
 	return []FilterOperator{OpEqual, OpNotEqual, OpLessThanEqual, OpGreaterThanEqual}
 }
 
-// EqualityOperators returns operators valid for UUIDs
+// EqualityOperators returns operators valid for UUIDs and booleans
 func EqualityOperators() []FilterOperator {
 	return []FilterOperator{OpEqual, OpNotEqual}
 }
@@ -85,7 +86,7 @@ func IsValidOperatorForFieldType(operator FilterOperator, fieldType FieldType) b
 				return true
 			}
 		}
-	case FieldTypeUUID:
+	case FieldTypeUUID, FieldTypeBoolean:
 		for _, op := range EqualityOperators() {
 			if op == operator {
 				return true
@@ -102,7 +103,7 @@ func GetValidOperatorsForFieldType(fieldType FieldType) string {
 		return "==, !=, <=, >=, =@, !@, =^, =~"
 	case FieldTypeNumber, FieldTypeDate:
 		return "==, !=, <=, >="
-	case FieldTypeUUID:
+	case FieldTypeUUID, FieldTypeBoolean:
 		return "==, !="
 	default:
 		return "no operators"
@@ -11,16 +11,18 @@ import (
 
 // Group represents a group of campers, staff members, or nested groups
 type Group struct {
-	ID            uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	TenantID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_groups_tenant_id" json:"tenantId"`
-	CampID        uuid.UUID      `gorm:"type:uuid;not null;index:idx_groups_camp_id" json:"campId"`
-	Name          string         `gorm:"type:varchar(255);not null" json:"name"`
-	Description   string         `gorm:"type:text" json:"description,omitempty"`
-	SessionID     *uuid.UUID     `gorm:"type:uuid;index:idx_groups_session_id" json:"sessionId,omitempty"`
-	HousingRoomID *uuid.UUID     `gorm:"type:uuid;index:idx_groups_housing_room_id" json:"housingRoomId,omitempty"`
-	CreatedAt     time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt     time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt     gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+	ID              uuid.UUID         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID        uuid.UUID         `gorm:"type:uuid;not null;index:idx_groups_tenant_id" json:"tenantId"`
+	CampID          uuid.UUID         `gorm:"type:uuid;not null;index:idx_groups_camp_id" json:"campId"`
+	Name            string            `gorm:"type:varchar(255);not null" json:"name"`
+	Description     string            `gorm:"type:text" json:"description,omitempty"`
+	SessionID       *uuid.UUID        `gorm:"type:uuid;index:idx_groups_session_id" json:"sessionId,omitempty"`
+	HousingRoomID   *uuid.UUID        `gorm:"type:uuid;index:idx_groups_housing_room_id" json:"housingRoomId,omitempty"`
+	MembershipRules []string          `gorm:"type:jsonb;serializer:json" json:"membershipRules,omitempty"`
+	CustomFields    CustomFieldValues `gorm:"type:jsonb;not null;default:'{}'" json:"customFields,omitempty"`
+	CreatedAt       time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time         `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt    `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relationships (for preloading junction table data)
 	GroupCampers      []GroupCamper      `gorm:"foreignKey:GroupID" json:"-"`
@@ -53,6 +55,11 @@ func (g *Group) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// IsRuleBased reports whether the group's camper membership is derived from membership rules
+func (g *Group) IsRuleBased() bool {
+	return len(g.MembershipRules) > 0
+}
+
 // ToAPI converts the domain Group to an API Group representation
 func (g *Group) ToAPI() api.Group {
 	// Extract camper IDs from junction table data
@@ -77,6 +84,7 @@ func (g *Group) ToAPI() api.Group {
 	var camperIDsPtr *[]uuid.UUID
 	var staffIDsPtr *[]uuid.UUID
 	var groupIDsPtr *[]uuid.UUID
+	var membershipRulesPtr *[]string
 
 	if len(camperIDs) > 0 {
 		camperIDsPtr = &camperIDs
@@ -87,6 +95,9 @@ func (g *Group) ToAPI() api.Group {
 	if len(groupIDs) > 0 {
 		groupIDsPtr = &groupIDs
 	}
+	if g.IsRuleBased() {
+		membershipRulesPtr = &g.MembershipRules
+	}
 
 	return api.Group{
 		Meta: api.EntityMeta{
@@ -99,11 +110,13 @@ func (g *Group) ToAPI() api.Group {
 			UpdatedAt:   g.UpdatedAt,
 		},
 		Spec: api.GroupSpec{
-			SessionId:     g.SessionID,
-			HousingRoomId: g.HousingRoomID,
-			CamperIds:     camperIDsPtr,
-			StaffIds:      staffIDsPtr,
-			GroupIds:      groupIDsPtr,
+			SessionId:       g.SessionID,
+			HousingRoomId:   g.HousingRoomID,
+			CamperIds:       camperIDsPtr,
+			StaffIds:        staffIDsPtr,
+			GroupIds:        groupIDsPtr,
+			MembershipRules: membershipRulesPtr,
+			CustomFields:    g.CustomFields.ToAPI(),
 		},
 	}
 }
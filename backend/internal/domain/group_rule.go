@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupRuleFields defines the camper fields a group membership rule can filter on (API field names)
+var GroupRuleFields = map[string]FieldType{
+	"name":              FieldTypeText,
+	"gender":            FieldTypeText,
+	"birthday":          FieldTypeDate,
+	"housingGroupId":    FieldTypeUUID,
+	"sessionId":         FieldTypeUUID,
+	"enrollmentStatus":  FieldTypeText,
+	"ageAtSessionStart": FieldTypeNumber,
+}
+
+// groupRuleEnrollmentFields are evaluated against the camper's enrollments rather than the camper itself
+var groupRuleEnrollmentFields = map[string]bool{
+	"sessionId":        true,
+	"enrollmentStatus": true,
+}
+
+// groupRuleSkillLevelPrefix prefixes the skill track ID of a field filtering on the rank of the highest level
+// of the track a camper passed, starting at 1, or 0 when none
+const groupRuleSkillLevelPrefix = "skillLevel."
+
+// groupRuleFieldType returns the type of a membership rule field, including skill level fields
+func groupRuleFieldType(field string) (FieldType, bool) {
+	if trackID, found := strings.CutPrefix(field, groupRuleSkillLevelPrefix); found {
+		if _, err := uuid.Parse(trackID); err != nil {
+			return "", false
+		}
+		return FieldTypeNumber, true
+	}
+	fieldType, exists := GroupRuleFields[field]
+	return fieldType, exists
+}
+
+// MembershipRule is a parsed set of filters a camper must all match to belong to a rule-based group
+type MembershipRule []Filter
+
+// ParseMembershipRule parses and validates the filter expressions of a group membership rule
+func ParseMembershipRule(expressions []string) (MembershipRule, error) {
+	rule := make(MembershipRule, 0, len(expressions))
+	for _, expression := range expressions {
+		filter, err := ParseFilter(expression)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldType, exists := groupRuleFieldType(filter.Field)
+		if !exists {
+			return nil, fmt.Errorf("invalid field '%s': field cannot be used in a membership rule", filter.Field)
+		}
+
+		if !IsValidOperatorForFieldType(filter.Operator, fieldType) {
+			return nil, fmt.Errorf(
+				"operator '%s' is not valid for %s field '%s'. Use %s",
+				filter.Operator,
+				fieldType,
+				filter.Field,
+				GetValidOperatorsForFieldType(fieldType),
+			)
+		}
+
+		switch fieldType {
+		case FieldTypeNumber:
+			if _, err := strconv.ParseFloat(filter.Value, 64); err != nil {
+				return nil, fmt.Errorf("invalid value '%s' for number field '%s'", filter.Value, filter.Field)
+			}
+		case FieldTypeDate:
+			if _, err := time.Parse("2006-01-02", filter.Value); err != nil {
+				return nil, fmt.Errorf("invalid value '%s' for date field '%s': expected YYYY-MM-DD", filter.Value, filter.Field)
+			}
+		}
+
+		rule = append(rule, *filter)
+	}
+
+	return rule, nil
+}
+
+// Matches reports whether a camper satisfies every filter of the rule.
+// sessionStart is the first day of the session ageAtSessionStart refers to (nil when unknown).
+func (r MembershipRule) Matches(camper *Camper, sessionStart *time.Time) bool {
+	var enrollmentFilters []Filter
+
+	for _, filter := range r {
+		if groupRuleEnrollmentFields[filter.Field] {
+			enrollmentFilters = append(enrollmentFilters, filter)
+			continue
+		}
+
+		if trackID, found := strings.CutPrefix(filter.Field, groupRuleSkillLevelPrefix); found {
+			if !matchNumber(float64(camper.SkillRank(uuid.MustParse(trackID))), filter) {
+				return false
+			}
+			continue
+		}
+
+		var matched bool
+		switch filter.Field {
+		case "name":
+			matched = matchText(camper.Name, filter)
+		case "gender":
+			matched = matchText(camper.Gender, filter)
+		case "birthday":
+			matched = matchDate(camper.Birthday, filter)
+		case "housingGroupId":
+			value := ""
+			if camper.HousingGroupID != nil {
+				value = camper.HousingGroupID.String()
+			}
+			matched = matchUUID(value, filter)
+		case "ageAtSessionStart":
+			matched = sessionStart != nil && matchNumber(float64(AgeOn(camper.Birthday, *sessionStart)), filter)
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if len(enrollmentFilters) == 0 {
+		return true
+	}
+
+	// Session filters go through enrollments so campers attending several sessions match each of them
+	for _, enrollment := range camper.Enrollments {
+		if matchEnrollment(&enrollment, enrollmentFilters) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AgeOn returns the age in whole years of someone born on birthday at the given date
+func AgeOn(birthday time.Time, date time.Time) int {
+	age := date.Year() - birthday.Year()
+	if date.Month() < birthday.Month() || (date.Month() == birthday.Month() && date.Day() < birthday.Day()) {
+		age--
+	}
+	return age
+}
+
+// matchEnrollment reports whether a single enrollment satisfies all enrollment filters
+func matchEnrollment(enrollment *CamperEnrollment, filters []Filter) bool {
+	for _, filter := range filters {
+		var matched bool
+		switch filter.Field {
+		case "sessionId":
+			matched = matchUUID(enrollment.SessionID.String(), filter)
+		case "enrollmentStatus":
+			matched = matchText(string(enrollment.Status), filter)
+		}
+
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matchText applies a text filter the same way list filters are applied in the database
+func matchText(value string, filter Filter) bool {
+	lowerValue := strings.ToLower(value)
+	lowerFilter := strings.ToLower(filter.Value)
+
+	switch filter.Operator {
+	case OpEqual:
+		return value == filter.Value
+	case OpNotEqual:
+		return value != filter.Value
+	case OpLessThanEqual:
+		return value <= filter.Value
+	case OpGreaterThanEqual:
+		return value >= filter.Value
+	case OpContains:
+		return strings.Contains(lowerValue, lowerFilter)
+	case OpNotContains:
+		return !strings.Contains(lowerValue, lowerFilter)
+	case OpStartsWith:
+		return strings.HasPrefix(lowerValue, lowerFilter)
+	case OpEndsWith:
+		return strings.HasSuffix(lowerValue, lowerFilter)
+	}
+	return false
+}
+
+// matchNumber applies a number filter
+func matchNumber(value float64, filter Filter) bool {
+	target, err := strconv.ParseFloat(filter.Value, 64)
+	if err != nil {
+		return false
+	}
+
+	switch filter.Operator {
+	case OpEqual:
+		return value == target
+	case OpNotEqual:
+		return value != target
+	case OpLessThanEqual:
+		return value <= target
+	case OpGreaterThanEqual:
+		return value >= target
+	}
+	return false
+}
+
+// matchDate applies a date filter, comparing calendar days only
+func matchDate(value time.Time, filter Filter) bool {
+	target, err := time.Parse("2006-01-02", filter.Value)
+	if err != nil {
+		return false
+	}
+
+	day := value.Format("2006-01-02")
+	targetDay := target.Format("2006-01-02")
+
+	switch filter.Operator {
+	case OpEqual:
+		return day == targetDay
+	case OpNotEqual:
+		return day != targetDay
+	case OpLessThanEqual:
+		return day <= targetDay
+	case OpGreaterThanEqual:
+		return day >= targetDay
+	}
+	return false
+}
+
+// matchUUID applies a UUID filter
+func matchUUID(value string, filter Filter) bool {
+	switch filter.Operator {
+	case OpEqual:
+		return strings.EqualFold(value, filter.Value)
+	case OpNotEqual:
+		return !strings.EqualFold(value, filter.Value)
+	}
+	return false
+}
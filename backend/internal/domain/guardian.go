@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Guardian represents a parent or guardian who can be linked to one or more campers
+type Guardian struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID           uuid.UUID       `gorm:"type:uuid;not null;index:idx_guardians_tenant_id" json:"tenantId"`
+	CampID             uuid.UUID       `gorm:"type:uuid;not null;index:idx_guardians_camp_id" json:"campId"`
+	Name               string          `gorm:"type:varchar(255);not null" json:"name"`
+	Description        string          `gorm:"type:text" json:"description,omitempty"`
+	Relationship       string          `gorm:"type:varchar(100);not null" json:"relationship"`
+	Phones             json.RawMessage `gorm:"type:jsonb" json:"phones,omitempty"`
+	Email              string          `gorm:"type:varchar(255)" json:"email,omitempty"`
+	Address            string          `gorm:"type:text" json:"address,omitempty"`
+	IsEmergencyContact bool            `gorm:"default:false" json:"isEmergencyContact"`
+	EmergencyPriority  *int            `gorm:"type:integer" json:"emergencyPriority,omitempty"`
+	CustodyNotes       string          `gorm:"type:text" json:"custodyNotes,omitempty"`
+	CanPickUp          bool            `gorm:"default:false" json:"canPickUp"`
+	AuthorizedPickups  json.RawMessage `gorm:"type:jsonb" json:"authorizedPickups,omitempty"`
+	CreatedAt          time.Time       `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt          time.Time       `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt          gorm.DeletedAt  `gorm:"index" json:"deletedAt,omitempty"`
+
+	// Relationships (for preloading junction table data)
+	GuardianCampers []GuardianCamper `gorm:"foreignKey:GuardianID" json:"-"`
+}
+
+// GuardianCamper represents the junction table between guardians and campers
+type GuardianCamper struct {
+	GuardianID uuid.UUID `gorm:"type:uuid;primaryKey" json:"guardianId"`
+	CamperID   uuid.UUID `gorm:"type:uuid;primaryKey" json:"camperId"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName overrides the default table name for GuardianCamper
+func (GuardianCamper) TableName() string {
+	return "guardian_campers"
+}
+
+// TableName overrides the default table name
+func (Guardian) TableName() string {
+	return "guardians"
+}
+
+// BeforeCreate sets the UUID before creating a guardian
+func (g *Guardian) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == uuid.Nil {
+		g.ID = uuid.New()
+	}
+	return nil
+}
+
+// PickupList returns everyone this guardian allows to pick up the linked campers,
+// including the guardian themselves when they are allowed to pick up
+func (g *Guardian) PickupList() []api.AuthorizedPickup {
+	pickups := []api.AuthorizedPickup{}
+	guardianID := g.ID
+
+	if g.CanPickUp {
+		pickups = append(pickups, api.AuthorizedPickup{
+			Name:         g.Name,
+			Relationship: utils.StringToPtr(g.Relationship),
+			Phone:        g.primaryPhone(),
+			GuardianId:   &guardianID,
+		})
+	}
+
+	if len(g.AuthorizedPickups) > 0 && string(g.AuthorizedPickups) != "null" {
+		var extra []api.AuthorizedPickup
+		if err := json.Unmarshal(g.AuthorizedPickups, &extra); err == nil {
+			for _, pickup := range extra {
+				pickup.GuardianId = &guardianID
+				pickups = append(pickups, pickup)
+			}
+		}
+	}
+
+	return pickups
+}
+
+// primaryPhone returns the first phone number of the guardian, preferring mobile numbers
+func (g *Guardian) primaryPhone() *string {
+	if len(g.Phones) == 0 || string(g.Phones) == "null" {
+		return nil
+	}
+
+	var phones []api.GuardianPhone
+	if err := json.Unmarshal(g.Phones, &phones); err != nil || len(phones) == 0 {
+		return nil
+	}
+
+	for _, phone := range phones {
+		if phone.Type == api.GuardianPhoneTypeMobile {
+			return utils.StringToPtr(phone.Number)
+		}
+	}
+	return utils.StringToPtr(phones[0].Number)
+}
+
+// ToAPI converts the domain Guardian to an API Guardian representation
+func (g *Guardian) ToAPI() api.Guardian {
+	// Extract camper IDs from junction table data
+	camperIDs := []uuid.UUID{}
+	for _, gc := range g.GuardianCampers {
+		camperIDs = append(camperIDs, gc.CamperID)
+	}
+
+	spec := api.GuardianSpec{
+		Relationship:       g.Relationship,
+		Address:            utils.StringToPtr(g.Address),
+		IsEmergencyContact: utils.BoolToPtr(g.IsEmergencyContact),
+		EmergencyPriority:  g.EmergencyPriority,
+		CustodyNotes:       utils.StringToPtr(g.CustodyNotes),
+		CanPickUp:          utils.BoolToPtr(g.CanPickUp),
+		CamperIds:          &camperIDs,
+	}
+
+	if g.Email != "" {
+		email := openapi_types.Email(g.Email)
+		spec.Email = &email
+	}
+
+	// Unmarshal Phones if present
+	if len(g.Phones) > 0 && string(g.Phones) != "null" {
+		var phones []api.GuardianPhone
+		if err := json.Unmarshal(g.Phones, &phones); err == nil {
+			spec.Phones = &phones
+		}
+	}
+
+	// Unmarshal AuthorizedPickups if present
+	if len(g.AuthorizedPickups) > 0 && string(g.AuthorizedPickups) != "null" {
+		var pickups []api.AuthorizedPickup
+		if err := json.Unmarshal(g.AuthorizedPickups, &pickups); err == nil {
+			spec.AuthorizedPickups = &pickups
+		}
+	}
+
+	return api.Guardian{
+		Meta: api.EntityMeta{
+			Id:          g.ID,
+			TenantId:    g.TenantID,
+			CampId:      g.CampID,
+			Name:        g.Name,
+			Description: utils.StringToPtr(g.Description),
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   g.UpdatedAt,
+		},
+		Spec: spec,
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"gorm.io/gorm"
+)
+
+// HousingAssignmentStatus represents the state of a housing assignment proposal
+type HousingAssignmentStatus string
+
+const (
+	HousingAssignmentStatusProposed   HousingAssignmentStatus = "proposed"
+	HousingAssignmentStatusAccepted   HousingAssignmentStatus = "accepted"
+	HousingAssignmentStatusSuperseded HousingAssignmentStatus = "superseded"
+)
+
+// HousingAssignment represents a proposal placing the campers of a session into its housing groups
+type HousingAssignment struct {
+	ID               uuid.UUID               `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID         uuid.UUID               `gorm:"type:uuid;not null;index:idx_housing_assignments_tenant_id" json:"tenantId"`
+	CampID           uuid.UUID               `gorm:"type:uuid;not null;index:idx_housing_assignments_camp_id" json:"campId"`
+	SessionID        uuid.UUID               `gorm:"type:uuid;not null;index:idx_housing_assignments_session_id" json:"sessionId"`
+	Status           HousingAssignmentStatus `gorm:"type:varchar(50);not null;default:proposed" json:"status"`
+	MaxAgeSpanMonths *int                    `gorm:"type:integer" json:"maxAgeSpanMonths,omitempty"`
+	SeparateGenders  bool                    `gorm:"not null;default:true" json:"separateGenders"`
+	KeepExisting     bool                    `gorm:"not null;default:true" json:"keepExisting"`
+	Groups           json.RawMessage         `gorm:"type:jsonb" json:"groups,omitempty"`
+	Unassigned       json.RawMessage         `gorm:"type:jsonb" json:"unassigned,omitempty"`
+	Score            json.RawMessage         `gorm:"type:jsonb" json:"score,omitempty"`
+	CreatedBy        *uuid.UUID              `gorm:"type:uuid" json:"createdBy,omitempty"`
+	AcceptedBy       *uuid.UUID              `gorm:"type:uuid" json:"acceptedBy,omitempty"`
+	AcceptedAt       *time.Time              `json:"acceptedAt,omitempty"`
+	CreatedAt        time.Time               `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt        time.Time               `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (HousingAssignment) TableName() string {
+	return "housing_assignments"
+}
+
+// BeforeCreate sets the UUID before creating a housing assignment
+func (h *HousingAssignment) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+// Placements returns the housing group each camper of the proposal is placed in,
+// with nil for campers the proposal could not place
+func (h *HousingAssignment) Placements() map[uuid.UUID]*uuid.UUID {
+	apiAssignment := h.ToAPI()
+	placements := make(map[uuid.UUID]*uuid.UUID)
+	for _, group := range apiAssignment.Groups {
+		groupID := group.GroupId
+		for _, camperID := range group.CamperIds {
+			placements[camperID] = &groupID
+		}
+	}
+	for _, unassigned := range apiAssignment.Unassigned {
+		placements[unassigned.CamperId] = nil
+	}
+	return placements
+}
+
+// ToAPI converts the domain HousingAssignment to an API HousingAssignment representation
+func (h *HousingAssignment) ToAPI() api.HousingAssignment {
+	assignment := api.HousingAssignment{
+		Id:               h.ID,
+		TenantId:         h.TenantID,
+		CampId:           h.CampID,
+		SessionId:        h.SessionID,
+		Status:           api.HousingAssignmentStatus(h.Status),
+		MaxAgeSpanMonths: h.MaxAgeSpanMonths,
+		SeparateGenders:  h.SeparateGenders,
+		KeepExisting:     h.KeepExisting,
+		Groups:           []api.HousingAssignmentGroup{},
+		Unassigned:       []api.HousingAssignmentUnassigned{},
+		CreatedBy:        h.CreatedBy,
+		AcceptedBy:       h.AcceptedBy,
+		AcceptedAt:       h.AcceptedAt,
+		CreatedAt:        h.CreatedAt,
+		UpdatedAt:        h.UpdatedAt,
+	}
+
+	// Unmarshal the proposal details if present
+	if len(h.Groups) > 0 && string(h.Groups) != "null" {
+		var groups []api.HousingAssignmentGroup
+		if err := json.Unmarshal(h.Groups, &groups); err == nil {
+			assignment.Groups = groups
+		}
+	}
+	if len(h.Unassigned) > 0 && string(h.Unassigned) != "null" {
+		var unassigned []api.HousingAssignmentUnassigned
+		if err := json.Unmarshal(h.Unassigned, &unassigned); err == nil {
+			assignment.Unassigned = unassigned
+		}
+	}
+	if len(h.Score) > 0 && string(h.Score) != "null" {
+		_ = json.Unmarshal(h.Score, &assignment.Score)
+	}
+
+	return assignment
+}
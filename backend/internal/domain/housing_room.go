@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,17 +12,18 @@ import (
 
 // HousingRoom represents a housing room within a camp, optionally within an area
 type HousingRoom struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	TenantID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_housing_rooms_tenant_id" json:"tenantId"`
-	CampID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_housing_rooms_camp_id" json:"campId"`
-	AreaID      *uuid.UUID     `gorm:"type:uuid;index:idx_housing_rooms_area_id" json:"areaId,omitempty"`
-	Name        string         `gorm:"type:varchar(255);not null" json:"name"`
-	Description string         `gorm:"type:text" json:"description,omitempty"`
-	Beds        int            `gorm:"type:integer;not null" json:"beds"`
-	Bathroom    string         `gorm:"type:varchar(20)" json:"bathroom,omitempty"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+	ID           uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID     uuid.UUID      `gorm:"type:uuid;not null;index:idx_housing_rooms_tenant_id" json:"tenantId"`
+	CampID       uuid.UUID      `gorm:"type:uuid;not null;index:idx_housing_rooms_camp_id" json:"campId"`
+	AreaID       *uuid.UUID     `gorm:"type:uuid;index:idx_housing_rooms_area_id" json:"areaId,omitempty"`
+	Name         string         `gorm:"type:varchar(255);not null" json:"name"`
+	Description  string         `gorm:"type:text" json:"description,omitempty"`
+	Beds         int            `gorm:"type:integer;not null" json:"beds"`
+	Bathroom     string         `gorm:"type:varchar(20)" json:"bathroom,omitempty"`
+	GenderPolicy string         `gorm:"type:varchar(20);not null;default:'mixed'" json:"genderPolicy"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relations
 	Area *Area `gorm:"foreignKey:AreaID;constraint:OnDelete:SET NULL" json:"-"`
@@ -53,9 +55,45 @@ func (h *HousingRoom) ToAPI() api.HousingRoom {
 			UpdatedAt:   h.UpdatedAt,
 		},
 		Spec: api.HousingRoomSpec{
-			AreaId:   h.AreaID,
-			Beds:     h.Beds,
-			Bathroom: (*api.HousingRoomSpecBathroom)(utils.StringToPtr(h.Bathroom)),
+			AreaId:       h.AreaID,
+			Beds:         h.Beds,
+			Bathroom:     (*api.HousingRoomSpecBathroom)(utils.StringToPtr(h.Bathroom)),
+			GenderPolicy: (*api.HousingRoomGenderPolicy)(utils.StringToPtr(h.GenderPolicy)),
 		},
 	}
 }
+
+// Gender policies of a housing room
+const (
+	GenderPolicyMixed        = "mixed"
+	GenderPolicySingleGender = "single_gender"
+	GenderPolicyMale         = "male"
+	GenderPolicyFemale       = "female"
+)
+
+// IsValidGenderPolicy reports whether the policy is one of the known gender policies
+func IsValidGenderPolicy(policy string) bool {
+	switch policy {
+	case GenderPolicyMixed, GenderPolicySingleGender, GenderPolicyMale, GenderPolicyFemale:
+		return true
+	}
+	return false
+}
+
+// AdmitsGender checks that someone of the gender may sleep in the room on a night it is shared with
+// people of the roommate genders
+func (h *HousingRoom) AdmitsGender(gender string, roommateGenders []string) error {
+	switch h.GenderPolicy {
+	case GenderPolicyMale, GenderPolicyFemale:
+		if gender != h.GenderPolicy {
+			return fmt.Errorf("%s is reserved for %s occupants", h.Name, h.GenderPolicy)
+		}
+	case GenderPolicySingleGender:
+		for _, other := range roommateGenders {
+			if other != gender {
+				return fmt.Errorf("%s is single gender and already has %s occupants on these nights", h.Name, other)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// IncidentStatus represents the review state of an incident report
+type IncidentStatus string
+
+const (
+	IncidentStatusDraft     IncidentStatus = "draft"
+	IncidentStatusSubmitted IncidentStatus = "submitted"
+	IncidentStatusReturned  IncidentStatus = "returned"
+	IncidentStatusApproved  IncidentStatus = "approved"
+)
+
+// incidentReviewSteps lists the statuses each status can move to
+var incidentReviewSteps = map[IncidentStatus][]IncidentStatus{
+	IncidentStatusDraft:     {IncidentStatusSubmitted},
+	IncidentStatusSubmitted: {IncidentStatusApproved, IncidentStatusReturned},
+	IncidentStatusReturned:  {IncidentStatusSubmitted},
+	IncidentStatusApproved:  {IncidentStatusReturned},
+}
+
+// CanTransitionTo reports whether the status can move to the target status
+func (s IncidentStatus) CanTransitionTo(target IncidentStatus) bool {
+	for _, allowed := range incidentReviewSteps[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEditable reports whether the incident details can still be changed
+func (s IncidentStatus) IsEditable() bool {
+	return s != IncidentStatusApproved
+}
+
+// GuardianNotificationStatus represents whether guardians were told about an incident
+type GuardianNotificationStatus string
+
+const (
+	GuardianNotificationNotRequired GuardianNotificationStatus = "not_required"
+	GuardianNotificationPending     GuardianNotificationStatus = "pending"
+	GuardianNotificationNotified    GuardianNotificationStatus = "notified"
+)
+
+// IncidentSeverity ranks how serious an incident was
+type IncidentSeverity string
+
+const (
+	IncidentSeverityMinor    IncidentSeverity = "minor"
+	IncidentSeverityModerate IncidentSeverity = "moderate"
+	IncidentSeveritySerious  IncidentSeverity = "serious"
+	IncidentSeverityCritical IncidentSeverity = "critical"
+)
+
+// Incident represents an incident or injury report
+type Incident struct {
+	ID                         uuid.UUID                  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID                   uuid.UUID                  `gorm:"type:uuid;not null;index:idx_incidents_tenant_id" json:"tenantId"`
+	CampID                     uuid.UUID                  `gorm:"type:uuid;not null;index:idx_incidents_camp_id" json:"campId"`
+	Name                       string                     `gorm:"type:varchar(255);not null" json:"name"`
+	Description                string                     `gorm:"type:text" json:"description,omitempty"`
+	OccurredAt                 time.Time                  `gorm:"not null;index:idx_incidents_occurred_at" json:"occurredAt"`
+	Type                       string                     `gorm:"type:varchar(50);not null" json:"type"`
+	Severity                   IncidentSeverity           `gorm:"type:varchar(50);not null" json:"severity"`
+	CamperIDs                  []uuid.UUID                `gorm:"type:jsonb;serializer:json" json:"camperIds,omitempty"`
+	StaffMemberIDs             []uuid.UUID                `gorm:"type:jsonb;serializer:json" json:"staffMemberIds,omitempty"`
+	LocationID                 *uuid.UUID                 `gorm:"type:uuid;index:idx_incidents_location_id" json:"locationId,omitempty"`
+	EventID                    *uuid.UUID                 `gorm:"type:uuid;index:idx_incidents_event_id" json:"eventId,omitempty"`
+	ActivityID                 *uuid.UUID                 `gorm:"type:uuid;index:idx_incidents_activity_id" json:"activityId,omitempty"`
+	Narrative                  string                     `gorm:"type:text;not null" json:"narrative"`
+	ActionsTaken               string                     `gorm:"type:text" json:"actionsTaken,omitempty"`
+	GuardianNotificationStatus GuardianNotificationStatus `gorm:"type:varchar(50);not null;default:pending" json:"guardianNotificationStatus"`
+	GuardiansNotifiedAt        *time.Time                 `json:"guardiansNotifiedAt,omitempty"`
+	GuardianNotificationNotes  string                     `gorm:"type:text" json:"guardianNotificationNotes,omitempty"`
+	FollowUpTasks              json.RawMessage            `gorm:"type:jsonb" json:"followUpTasks,omitempty"`
+	Status                     IncidentStatus             `gorm:"type:varchar(50);not null;default:draft" json:"status"`
+	ReportedBy                 *uuid.UUID                 `gorm:"type:uuid" json:"reportedBy,omitempty"`
+	ReportedByEmail            string                     `gorm:"type:varchar(255)" json:"reportedByEmail,omitempty"`
+	SubmittedAt                *time.Time                 `json:"submittedAt,omitempty"`
+	ReviewedBy                 *uuid.UUID                 `gorm:"type:uuid" json:"reviewedBy,omitempty"`
+	ReviewedByEmail            string                     `gorm:"type:varchar(255)" json:"reviewedByEmail,omitempty"`
+	ReviewedAt                 *time.Time                 `json:"reviewedAt,omitempty"`
+	ReviewComment              string                     `gorm:"type:text" json:"reviewComment,omitempty"`
+	CreatedAt                  time.Time                  `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt                  time.Time                  `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt                  gorm.DeletedAt             `gorm:"index" json:"deletedAt,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Incident) TableName() string {
+	return "incidents"
+}
+
+// BeforeCreate sets the UUID before creating an incident
+func (i *Incident) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain Incident to an API Incident representation
+func (i *Incident) ToAPI() api.Incident {
+	spec := api.IncidentSpec{
+		OccurredAt:                 i.OccurredAt,
+		Type:                       api.IncidentType(i.Type),
+		Severity:                   api.IncidentSeverity(i.Severity),
+		LocationId:                 i.LocationID,
+		EventId:                    i.EventID,
+		ActivityId:                 i.ActivityID,
+		Narrative:                  i.Narrative,
+		ActionsTaken:               utils.StringToPtr(i.ActionsTaken),
+		GuardianNotificationStatus: api.GuardianNotificationStatus(i.GuardianNotificationStatus),
+		GuardiansNotifiedAt:        i.GuardiansNotifiedAt,
+		GuardianNotificationNotes:  utils.StringToPtr(i.GuardianNotificationNotes),
+		Status:                     api.IncidentStatus(i.Status),
+		ReportedBy:                 i.ReportedBy,
+		ReportedByEmail:            utils.StringToPtr(i.ReportedByEmail),
+		SubmittedAt:                i.SubmittedAt,
+		ReviewedBy:                 i.ReviewedBy,
+		ReviewedByEmail:            utils.StringToPtr(i.ReviewedByEmail),
+		ReviewedAt:                 i.ReviewedAt,
+		ReviewComment:              utils.StringToPtr(i.ReviewComment),
+	}
+
+	camperIDs := i.CamperIDs
+	if camperIDs == nil {
+		camperIDs = []uuid.UUID{}
+	}
+	spec.CamperIds = &camperIDs
+
+	staffMemberIDs := i.StaffMemberIDs
+	if staffMemberIDs == nil {
+		staffMemberIDs = []uuid.UUID{}
+	}
+	spec.StaffMemberIds = &staffMemberIDs
+
+	// Unmarshal FollowUpTasks if present
+	if len(i.FollowUpTasks) > 0 && string(i.FollowUpTasks) != "null" {
+		var tasks []api.IncidentFollowUpTask
+		if err := json.Unmarshal(i.FollowUpTasks, &tasks); err == nil {
+			spec.FollowUpTasks = &tasks
+		}
+	}
+
+	return api.Incident{
+		Meta: api.EntityMeta{
+			Id:          i.ID,
+			TenantId:    i.TenantID,
+			CampId:      i.CampID,
+			Name:        i.Name,
+			Description: utils.StringToPtr(i.Description),
+			CreatedAt:   i.CreatedAt,
+			UpdatedAt:   i.UpdatedAt,
+		},
+		Spec: spec,
+	}
+}
+
+// IncidentCount is the number of incidents of one severity in a report bucket
+type IncidentCount struct {
+	Key      string           `json:"key"`
+	Label    string           `json:"label"`
+	Severity IncidentSeverity `json:"severity"`
+	Count    int              `json:"count"`
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"fmt"
 	"time"
 
@@ -24,15 +26,29 @@ type JWTClaims struct {
 	jwt.RegisteredClaims
 }
 
+// AttachmentDownloadClaims represents the claims of a signed attachment download URL
+type AttachmentDownloadClaims struct {
+	TenantID     string `json:"tenantId"`
+	CampID       string `json:"campId"`
+	AttachmentID string `json:"attachmentId"`
+	jwt.RegisteredClaims
+}
+
 // JWTService handles JWT token operations
 type JWTService struct {
 	secretKey []byte
+	// downloadKey signs attachment download tokens, so they can never be used as access tokens
+	downloadKey []byte
 }
 
 // NewJWTService creates a new JWT service with the given secret key
 func NewJWTService(secretKey string) *JWTService {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte("attachment-download"))
+
 	return &JWTService{
-		secretKey: []byte(secretKey),
+		secretKey:   []byte(secretKey),
+		downloadKey: mac.Sum(nil),
 	}
 }
 
@@ -104,3 +120,47 @@ func (s *JWTService) GenerateRefreshToken(userID, tenantID string) (string, erro
 
 	return tokenString, nil
 }
+
+// GenerateAttachmentDownloadToken creates a short-lived token authorizing the download of one attachment
+func (s *JWTService) GenerateAttachmentDownloadToken(tenantID, campID, attachmentID string, expiresAt time.Time) (string, error) {
+	now := time.Now()
+	claims := &AttachmentDownloadClaims{
+		TenantID:     tenantID,
+		CampID:       campID,
+		AttachmentID: attachmentID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString(s.downloadKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign download token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// ValidateAttachmentDownloadToken validates an attachment download token and returns its claims
+func (s *JWTService) ValidateAttachmentDownloadToken(tokenString string) (*AttachmentDownloadClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &AttachmentDownloadClaims{}, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.downloadKey, nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse download token: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*AttachmentDownloadClaims); ok && token.Valid {
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("invalid download token")
+}
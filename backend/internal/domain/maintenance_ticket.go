@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MaintenanceEntityType represents the kind of facility a maintenance ticket is about
+type MaintenanceEntityType string
+
+const (
+	MaintenanceEntityTypeLocation    MaintenanceEntityType = "location"
+	MaintenanceEntityTypeHousingRoom MaintenanceEntityType = "housing_room"
+	MaintenanceEntityTypeArea        MaintenanceEntityType = "area"
+)
+
+// IsValid checks if the entity type is valid
+func (t MaintenanceEntityType) IsValid() bool {
+	switch t {
+	case MaintenanceEntityTypeLocation, MaintenanceEntityTypeHousingRoom, MaintenanceEntityTypeArea:
+		return true
+	}
+	return false
+}
+
+// MaintenancePriority ranks how soon a maintenance ticket needs to be handled
+type MaintenancePriority string
+
+const (
+	MaintenancePriorityLow    MaintenancePriority = "low"
+	MaintenancePriorityMedium MaintenancePriority = "medium"
+	MaintenancePriorityHigh   MaintenancePriority = "high"
+	MaintenancePriorityUrgent MaintenancePriority = "urgent"
+)
+
+// IsValid checks if the priority is valid
+func (p MaintenancePriority) IsValid() bool {
+	switch p {
+	case MaintenancePriorityLow, MaintenancePriorityMedium, MaintenancePriorityHigh, MaintenancePriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// MaintenanceStatus represents the progress of a maintenance ticket
+type MaintenanceStatus string
+
+const (
+	MaintenanceStatusOpen       MaintenanceStatus = "open"
+	MaintenanceStatusInProgress MaintenanceStatus = "in_progress"
+	MaintenanceStatusResolved   MaintenanceStatus = "resolved"
+	MaintenanceStatusClosed     MaintenanceStatus = "closed"
+)
+
+// IsValid checks if the status is valid
+func (s MaintenanceStatus) IsValid() bool {
+	switch s {
+	case MaintenanceStatusOpen, MaintenanceStatusInProgress, MaintenanceStatusResolved, MaintenanceStatusClosed:
+		return true
+	}
+	return false
+}
+
+// IsDone reports whether the ticket no longer needs work
+func (s MaintenanceStatus) IsDone() bool {
+	return s == MaintenanceStatusResolved || s == MaintenanceStatusClosed
+}
+
+// MaintenanceTicket represents a problem with a location, housing room or area that needs fixing.
+// While it is not done, the facility is out of service from OutOfServiceFrom until OutOfServiceUntil.
+type MaintenanceTicket struct {
+	ID                uuid.UUID             `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID          uuid.UUID             `gorm:"type:uuid;not null;index:idx_maintenance_tickets_tenant_id" json:"tenantId"`
+	CampID            uuid.UUID             `gorm:"type:uuid;not null;index:idx_maintenance_tickets_camp_id" json:"campId"`
+	EntityType        MaintenanceEntityType `gorm:"type:varchar(50);not null" json:"entityType"`
+	EntityID          uuid.UUID             `gorm:"type:uuid;not null" json:"entityId"`
+	Title             string                `gorm:"type:varchar(255);not null" json:"title"`
+	Description       string                `gorm:"type:text" json:"description,omitempty"`
+	Priority          MaintenancePriority   `gorm:"type:varchar(20);not null;default:medium" json:"priority"`
+	Status            MaintenanceStatus     `gorm:"type:varchar(20);not null;default:open" json:"status"`
+	AssigneeID        *uuid.UUID            `gorm:"type:uuid;index:idx_maintenance_tickets_assignee_id" json:"assigneeId,omitempty"`
+	OutOfServiceFrom  *time.Time            `gorm:"type:timestamp" json:"outOfServiceFrom,omitempty"`
+	OutOfServiceUntil *time.Time            `gorm:"type:timestamp" json:"outOfServiceUntil,omitempty"`
+	ReportedBy        *uuid.UUID            `gorm:"type:uuid" json:"reportedBy,omitempty"`
+	ReportedByEmail   string                `gorm:"type:varchar(255)" json:"reportedByEmail,omitempty"`
+	ResolvedAt        *time.Time            `gorm:"type:timestamp" json:"resolvedAt,omitempty"`
+	CreatedAt         time.Time             `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt         time.Time             `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (MaintenanceTicket) TableName() string {
+	return "maintenance_tickets"
+}
+
+// BeforeCreate sets the UUID before creating a maintenance ticket
+func (t *MaintenanceTicket) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain MaintenanceTicket to an API MaintenanceTicket representation
+func (t *MaintenanceTicket) ToAPI() api.MaintenanceTicket {
+	return api.MaintenanceTicket{
+		Id:                t.ID,
+		TenantId:          t.TenantID,
+		CampId:            t.CampID,
+		EntityType:        api.MaintenanceTicketEntityType(t.EntityType),
+		EntityId:          t.EntityID,
+		Title:             t.Title,
+		Description:       utils.StringToPtr(t.Description),
+		Priority:          api.MaintenanceTicketPriority(t.Priority),
+		Status:            api.MaintenanceTicketStatus(t.Status),
+		AssigneeId:        t.AssigneeID,
+		OutOfServiceFrom:  t.OutOfServiceFrom,
+		OutOfServiceUntil: t.OutOfServiceUntil,
+		ReportedBy:        t.ReportedBy,
+		ReportedByEmail:   utils.StringToPtr(t.ReportedByEmail),
+		ResolvedAt:        t.ResolvedAt,
+		CreatedAt:         t.CreatedAt,
+		UpdatedAt:         t.UpdatedAt,
+	}
+}
+
+// Validate checks that the ticket has a title, known enums and a coherent out-of-service period
+func (t *MaintenanceTicket) Validate() error {
+	if strings.TrimSpace(t.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if !t.EntityType.IsValid() {
+		return fmt.Errorf("invalid entity type: %s", t.EntityType)
+	}
+	if !t.Priority.IsValid() {
+		return fmt.Errorf("invalid priority: %s", t.Priority)
+	}
+	if !t.Status.IsValid() {
+		return fmt.Errorf("invalid status: %s", t.Status)
+	}
+	if t.OutOfServiceUntil != nil {
+		if t.OutOfServiceFrom == nil {
+			return fmt.Errorf("outOfServiceUntil requires outOfServiceFrom")
+		}
+		if !t.OutOfServiceUntil.After(*t.OutOfServiceFrom) {
+			return fmt.Errorf("outOfServiceUntil must be after outOfServiceFrom")
+		}
+	}
+	return nil
+}
+
+// SetStatus changes the status, recording when the ticket was resolved or closed
+func (t *MaintenanceTicket) SetStatus(status MaintenanceStatus, now time.Time) {
+	switch {
+	case status.IsDone() && t.ResolvedAt == nil:
+		t.ResolvedAt = &now
+	case !status.IsDone():
+		t.ResolvedAt = nil
+	}
+	t.Status = status
+}
+
+// OutOfServiceBetween reports whether the ticket takes its facility out of service at some time from start until end
+func (t *MaintenanceTicket) OutOfServiceBetween(start, end time.Time) bool {
+	if t.Status.IsDone() || t.OutOfServiceFrom == nil {
+		return false
+	}
+	return t.OutOfServiceFrom.Before(end) && (t.OutOfServiceUntil == nil || t.OutOfServiceUntil.After(start))
+}
+
+// Covers reports whether the ticket is about the facility or about the area the facility is in
+func (t *MaintenanceTicket) Covers(entityType MaintenanceEntityType, entityID uuid.UUID, areaID *uuid.UUID) bool {
+	if t.EntityType == entityType && t.EntityID == entityID {
+		return true
+	}
+	return areaID != nil && t.EntityType == MaintenanceEntityTypeArea && t.EntityID == *areaID
+}
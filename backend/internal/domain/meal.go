@@ -0,0 +1,306 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// DietaryRestriction represents a diet a person follows and a menu item can be suitable for
+type DietaryRestriction string
+
+const (
+	DietaryRestrictionVegetarian DietaryRestriction = "vegetarian"
+	DietaryRestrictionVegan      DietaryRestriction = "vegan"
+	DietaryRestrictionGlutenFree DietaryRestriction = "gluten_free"
+	DietaryRestrictionNutFree    DietaryRestriction = "nut_free"
+	DietaryRestrictionDairyFree  DietaryRestriction = "dairy_free"
+	DietaryRestrictionHalal      DietaryRestriction = "halal"
+	DietaryRestrictionKosher     DietaryRestriction = "kosher"
+)
+
+// DietaryRestrictions lists all diets in the order they are reported
+var DietaryRestrictions = []DietaryRestriction{
+	DietaryRestrictionVegetarian,
+	DietaryRestrictionVegan,
+	DietaryRestrictionGlutenFree,
+	DietaryRestrictionNutFree,
+	DietaryRestrictionDairyFree,
+	DietaryRestrictionHalal,
+	DietaryRestrictionKosher,
+}
+
+// IsValid reports whether the diet is one of the known diets
+func (d DietaryRestriction) IsValid() bool {
+	for _, diet := range DietaryRestrictions {
+		if diet == d {
+			return true
+		}
+	}
+	return false
+}
+
+// Allergen represents a food allergen a person can be allergic to and a menu item can contain
+type Allergen string
+
+const (
+	AllergenPeanuts   Allergen = "peanuts"
+	AllergenTreeNuts  Allergen = "tree_nuts"
+	AllergenMilk      Allergen = "milk"
+	AllergenEggs      Allergen = "eggs"
+	AllergenWheat     Allergen = "wheat"
+	AllergenGluten    Allergen = "gluten"
+	AllergenSoy       Allergen = "soy"
+	AllergenFish      Allergen = "fish"
+	AllergenShellfish Allergen = "shellfish"
+	AllergenSesame    Allergen = "sesame"
+)
+
+// Allergens lists all allergens in the order they are reported
+var Allergens = []Allergen{
+	AllergenPeanuts,
+	AllergenTreeNuts,
+	AllergenMilk,
+	AllergenEggs,
+	AllergenWheat,
+	AllergenGluten,
+	AllergenSoy,
+	AllergenFish,
+	AllergenShellfish,
+	AllergenSesame,
+}
+
+// IsValid reports whether the allergen is one of the known allergens
+func (a Allergen) IsValid() bool {
+	for _, allergen := range Allergens {
+		if allergen == a {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDietaryRestrictions validates API diets, dropping duplicates
+func ParseDietaryRestrictions(values []api.DietaryRestriction) ([]DietaryRestriction, error) {
+	diets := []DietaryRestriction{}
+	seen := make(map[DietaryRestriction]bool, len(values))
+	for _, value := range values {
+		diet := DietaryRestriction(value)
+		if !diet.IsValid() {
+			return nil, fmt.Errorf("invalid dietary restriction '%s'", value)
+		}
+		if !seen[diet] {
+			seen[diet] = true
+			diets = append(diets, diet)
+		}
+	}
+	return diets, nil
+}
+
+// ParseAllergens validates API allergens, dropping duplicates
+func ParseAllergens(values []api.Allergen) ([]Allergen, error) {
+	allergens := []Allergen{}
+	seen := make(map[Allergen]bool, len(values))
+	for _, value := range values {
+		allergen := Allergen(value)
+		if !allergen.IsValid() {
+			return nil, fmt.Errorf("invalid allergen '%s'", value)
+		}
+		if !seen[allergen] {
+			seen[allergen] = true
+			allergens = append(allergens, allergen)
+		}
+	}
+	return allergens, nil
+}
+
+// DietaryRestrictionsToAPI converts diets to their API representation, omitting them when empty
+func DietaryRestrictionsToAPI(diets []DietaryRestriction) *api.DietaryRestrictions {
+	if len(diets) == 0 {
+		return nil
+	}
+	values := make(api.DietaryRestrictions, len(diets))
+	for i, diet := range diets {
+		values[i] = api.DietaryRestriction(diet)
+	}
+	return &values
+}
+
+// AllergensToAPI converts allergens to their API representation, omitting them when empty
+func AllergensToAPI(allergens []Allergen) *api.Allergies {
+	if len(allergens) == 0 {
+		return nil
+	}
+	values := make(api.Allergies, len(allergens))
+	for i, allergen := range allergens {
+		values[i] = api.Allergen(allergen)
+	}
+	return &values
+}
+
+// MealPeriod represents a meal served every day of the camp, e.g. breakfast from 07:30 to 08:30
+type MealPeriod struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID  uuid.UUID `gorm:"type:uuid;not null;index:idx_meal_periods_tenant_id" json:"tenantId"`
+	CampID    uuid.UUID `gorm:"type:uuid;not null;index:idx_meal_periods_camp_id" json:"campId"`
+	Name      string    `gorm:"type:varchar(255);not null" json:"name"`
+	StartTime string    `gorm:"type:varchar(5);not null" json:"startTime"` // Format: HH:MM
+	EndTime   string    `gorm:"type:varchar(5);not null" json:"endTime"`   // Format: HH:MM
+	Position  int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (MealPeriod) TableName() string {
+	return "meal_periods"
+}
+
+// BeforeCreate sets the UUID before creating a meal period
+func (m *MealPeriod) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain MealPeriod to an API MealPeriod representation
+func (m *MealPeriod) ToAPI() api.MealPeriod {
+	return api.MealPeriod{
+		Id:        m.ID,
+		TenantId:  m.TenantID,
+		CampId:    m.CampID,
+		Name:      m.Name,
+		StartTime: m.StartTime,
+		EndTime:   m.EndTime,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// Validate checks that the meal period has a name and ends after it starts
+func (m *MealPeriod) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	start, err := time.Parse("15:04", m.StartTime)
+	if err != nil {
+		return fmt.Errorf("start time must be in HH:MM format")
+	}
+	end, err := time.Parse("15:04", m.EndTime)
+	if err != nil {
+		return fmt.Errorf("end time must be in HH:MM format")
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	return nil
+}
+
+// Window returns the instants the meal starts and ends on a day in the given time zone
+func (m *MealPeriod) Window(day time.Time, loc *time.Location) (time.Time, time.Time) {
+	at := func(clock string) time.Time {
+		t, _ := time.Parse("15:04", clock)
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	}
+	return at(m.StartTime), at(m.EndTime)
+}
+
+// MenuItem represents a dish served at a meal
+type MenuItem struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Allergens   []Allergen           `json:"allergens,omitempty"`
+	SuitableFor []DietaryRestriction `json:"suitableFor,omitempty"`
+}
+
+// Menu represents the dishes served at a meal period on a day
+type Menu struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID     uuid.UUID  `gorm:"type:uuid;not null;index:idx_menus_tenant_id" json:"tenantId"`
+	CampID       uuid.UUID  `gorm:"type:uuid;not null;index:idx_menus_camp_id" json:"campId"`
+	Date         time.Time  `gorm:"type:date;not null" json:"date"`
+	MealPeriodID uuid.UUID  `gorm:"type:uuid;not null;index:idx_menus_meal_period_id" json:"mealPeriodId"`
+	Items        []MenuItem `gorm:"type:jsonb;serializer:json" json:"items"`
+	Notes        string     `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (Menu) TableName() string {
+	return "menus"
+}
+
+// BeforeCreate sets the UUID before creating a menu
+func (m *Menu) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain Menu to an API Menu representation
+func (m *Menu) ToAPI() api.Menu {
+	items := make([]api.MenuItem, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = api.MenuItem{
+			Name:        item.Name,
+			Description: utils.StringToPtr(item.Description),
+			Allergens:   AllergensToAPI(item.Allergens),
+			SuitableFor: DietaryRestrictionsToAPI(item.SuitableFor),
+		}
+	}
+
+	return api.Menu{
+		Id:           m.ID,
+		TenantId:     m.TenantID,
+		CampId:       m.CampID,
+		Date:         openapi_types.Date{Time: m.Date},
+		MealPeriodId: m.MealPeriodID,
+		Items:        items,
+		Notes:        utils.StringToPtr(m.Notes),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// ParseMenuItems validates API menu items and converts them to their stored form
+func ParseMenuItems(items []api.MenuItem) ([]MenuItem, error) {
+	parsed := make([]MenuItem, len(items))
+	for i, item := range items {
+		name := strings.TrimSpace(item.Name)
+		if name == "" {
+			return nil, fmt.Errorf("menu item %d needs a name", i+1)
+		}
+
+		parsed[i] = MenuItem{
+			Name:        name,
+			Description: utils.PtrToString(item.Description),
+		}
+		if item.Allergens != nil {
+			allergens, err := ParseAllergens(*item.Allergens)
+			if err != nil {
+				return nil, fmt.Errorf("menu item '%s': %w", name, err)
+			}
+			parsed[i].Allergens = allergens
+		}
+		if item.SuitableFor != nil {
+			diets, err := ParseDietaryRestrictions(*item.SuitableFor)
+			if err != nil {
+				return nil, fmt.Errorf("menu item '%s': %w", name, err)
+			}
+			parsed[i].SuitableFor = diets
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// DoseOverdueGracePeriod is how long after its scheduled time a pending dose is flagged as overdue
+const DoseOverdueGracePeriod = 30 * time.Minute
+
+// MedicationDoseStatus represents the outcome recorded for a medication dose
+type MedicationDoseStatus string
+
+const (
+	MedicationDoseStatusGiven   MedicationDoseStatus = "given"
+	MedicationDoseStatusRefused MedicationDoseStatus = "refused"
+	MedicationDoseStatusMissed  MedicationDoseStatus = "missed"
+)
+
+// Medication represents a medication on a camper's medication list
+type Medication struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_medications_tenant_id" json:"tenantId"`
+	CampID        uuid.UUID      `gorm:"type:uuid;not null;index:idx_medications_camp_id" json:"campId"`
+	Name          string         `gorm:"type:varchar(255);not null" json:"name"`
+	Description   string         `gorm:"type:text" json:"description,omitempty"`
+	CamperID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_medications_camper_id" json:"camperId"`
+	Dosage        string         `gorm:"type:varchar(100);not null" json:"dosage"`
+	Route         string         `gorm:"type:varchar(50)" json:"route,omitempty"`
+	Instructions  string         `gorm:"type:text" json:"instructions,omitempty"`
+	ScheduleTimes []string       `gorm:"type:jsonb;serializer:json" json:"scheduleTimes,omitempty"` // Format: HH:MM
+	DaysOfWeek    DaysOfWeek     `gorm:"type:jsonb" json:"daysOfWeek,omitempty"`
+	StartDate     *time.Time     `gorm:"type:date" json:"startDate,omitempty"`
+	EndDate       *time.Time     `gorm:"type:date" json:"endDate,omitempty"`
+	AsNeeded      bool           `gorm:"default:false" json:"asNeeded"`
+	CreatedAt     time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt     time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Medication) TableName() string {
+	return "medications"
+}
+
+// BeforeCreate sets the UUID before creating a medication
+func (m *Medication) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// DueTimes returns the times doses are due on the given day, in chronological order.
+// The day is a calendar date and schedule times are interpreted in the camp's location.
+func (m *Medication) DueTimes(day time.Time, loc *time.Location) []time.Time {
+	if m.AsNeeded || len(m.ScheduleTimes) == 0 {
+		return nil
+	}
+
+	date := day.Format("2006-01-02")
+	if m.StartDate != nil && date < m.StartDate.Format("2006-01-02") {
+		return nil
+	}
+	if m.EndDate != nil && date > m.EndDate.Format("2006-01-02") {
+		return nil
+	}
+
+	if len(m.DaysOfWeek) > 0 {
+		weekday := strings.ToLower(day.Weekday().String())
+		found := false
+		for _, d := range m.DaysOfWeek {
+			if d == weekday {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	times := make([]time.Time, 0, len(m.ScheduleTimes))
+	for _, scheduleTime := range m.ScheduleTimes {
+		t, err := time.ParseInLocation("2006-01-02 15:04", date+" "+scheduleTime, loc)
+		if err != nil {
+			continue
+		}
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	return times
+}
+
+// ToAPI converts the domain Medication to an API Medication representation
+func (m *Medication) ToAPI() api.Medication {
+	spec := api.MedicationSpec{
+		CamperId:     m.CamperID,
+		Dosage:       m.Dosage,
+		Route:        utils.StringToPtr(m.Route),
+		Instructions: utils.StringToPtr(m.Instructions),
+		AsNeeded:     utils.BoolToPtr(m.AsNeeded),
+	}
+
+	if len(m.ScheduleTimes) > 0 {
+		scheduleTimes := m.ScheduleTimes
+		spec.ScheduleTimes = &scheduleTimes
+	}
+
+	if len(m.DaysOfWeek) > 0 {
+		days := make([]api.MedicationSpecDaysOfWeek, len(m.DaysOfWeek))
+		for i, day := range m.DaysOfWeek {
+			days[i] = api.MedicationSpecDaysOfWeek(day)
+		}
+		spec.DaysOfWeek = &days
+	}
+
+	if m.StartDate != nil {
+		spec.StartDate = &openapi_types.Date{Time: *m.StartDate}
+	}
+	if m.EndDate != nil {
+		spec.EndDate = &openapi_types.Date{Time: *m.EndDate}
+	}
+
+	return api.Medication{
+		Meta: api.EntityMeta{
+			Id:          m.ID,
+			TenantId:    m.TenantID,
+			CampId:      m.CampID,
+			Name:        m.Name,
+			Description: utils.StringToPtr(m.Description),
+			CreatedAt:   m.CreatedAt,
+			UpdatedAt:   m.UpdatedAt,
+		},
+		Spec: spec,
+	}
+}
+
+// MedicationDose records a dose given, refused or missed by a camper
+type MedicationDose struct {
+	ID             uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID       uuid.UUID            `gorm:"type:uuid;not null;index:idx_medication_doses_tenant_id" json:"tenantId"`
+	CampID         uuid.UUID            `gorm:"type:uuid;not null;index:idx_medication_doses_camp_id" json:"campId"`
+	MedicationID   uuid.UUID            `gorm:"type:uuid;not null;index:idx_medication_doses_medication_id" json:"medicationId"`
+	CamperID       uuid.UUID            `gorm:"type:uuid;not null;index:idx_medication_doses_camper_id" json:"camperId"`
+	ScheduledFor   *time.Time           `json:"scheduledFor,omitempty"`
+	Status         MedicationDoseStatus `gorm:"type:varchar(50);not null" json:"status"`
+	AdministeredAt *time.Time           `json:"administeredAt,omitempty"`
+	Initials       string               `gorm:"type:varchar(10);not null" json:"initials"`
+	Notes          string               `gorm:"type:text" json:"notes,omitempty"`
+	RecordedBy     *uuid.UUID           `gorm:"type:uuid" json:"recordedBy,omitempty"`
+	RecordedAt     time.Time            `gorm:"not null" json:"recordedAt"`
+	CreatedAt      time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time            `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (MedicationDose) TableName() string {
+	return "medication_doses"
+}
+
+// BeforeCreate sets the UUID before creating a dose record
+func (d *MedicationDose) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain MedicationDose to an API MedicationDose representation
+func (d *MedicationDose) ToAPI() api.MedicationDose {
+	return api.MedicationDose{
+		Id:             d.ID,
+		TenantId:       d.TenantID,
+		CampId:         d.CampID,
+		MedicationId:   d.MedicationID,
+		CamperId:       d.CamperID,
+		ScheduledFor:   d.ScheduledFor,
+		Status:         api.MedicationDoseStatus(d.Status),
+		AdministeredAt: d.AdministeredAt,
+		Initials:       d.Initials,
+		Notes:          utils.StringToPtr(d.Notes),
+		RecordedBy:     d.RecordedBy,
+		RecordedAt:     d.RecordedAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// NoteEntityType represents the kind of person a note is about
+type NoteEntityType string
+
+const (
+	NoteEntityTypeCamper      NoteEntityType = "camper"
+	NoteEntityTypeStaffMember NoteEntityType = "staff_member"
+)
+
+// NoteCategory represents what a note is about
+type NoteCategory string
+
+const (
+	NoteCategoryObservation NoteCategory = "observation"
+	NoteCategoryBehavior    NoteCategory = "behavior"
+	NoteCategoryHealth      NoteCategory = "health"
+	NoteCategoryAchievement NoteCategory = "achievement"
+	NoteCategoryFamily      NoteCategory = "family"
+	NoteCategoryOther       NoteCategory = "other"
+)
+
+// IsValid reports whether the category is one of the known note categories
+func (c NoteCategory) IsValid() bool {
+	switch c {
+	case NoteCategoryObservation, NoteCategoryBehavior, NoteCategoryHealth, NoteCategoryAchievement, NoteCategoryFamily, NoteCategoryOther:
+		return true
+	}
+	return false
+}
+
+// NoteVisibility represents who can read a note
+type NoteVisibility string
+
+const (
+	NoteVisibilityAllStaff NoteVisibility = "all_staff"
+	NoteVisibilityAdmins   NoteVisibility = "admins"
+	NoteVisibilityHealth   NoteVisibility = "health"
+)
+
+// noteVisibilityRoles lists the roles that can read notes of each visibility level
+var noteVisibilityRoles = map[NoteVisibility][]string{
+	NoteVisibilityAllStaff: {"admin", "program-admin", "viewer", "health"},
+	NoteVisibilityAdmins:   {"admin", "program-admin"},
+	NoteVisibilityHealth:   {"admin", "health"},
+}
+
+// IsValid reports whether the visibility is one of the known visibility levels
+func (v NoteVisibility) IsValid() bool {
+	_, ok := noteVisibilityRoles[v]
+	return ok
+}
+
+// VisibleNoteLevels returns the visibility levels a user holding the given roles can read
+func VisibleNoteLevels(roles map[string]bool) []NoteVisibility {
+	var levels []NoteVisibility
+	for _, level := range []NoteVisibility{NoteVisibilityAllStaff, NoteVisibilityAdmins, NoteVisibilityHealth} {
+		for _, role := range noteVisibilityRoles[level] {
+			if roles[role] {
+				levels = append(levels, level)
+				break
+			}
+		}
+	}
+	return levels
+}
+
+// Note represents an observation a staff user logged about a camper or staff member
+type Note struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_notes_tenant_id" json:"tenantId"`
+	CampID      uuid.UUID      `gorm:"type:uuid;not null;index:idx_notes_camp_id" json:"campId"`
+	EntityType  NoteEntityType `gorm:"type:varchar(50);not null" json:"entityType"`
+	EntityID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_notes_entity" json:"entityId"`
+	Category    NoteCategory   `gorm:"type:varchar(50);not null" json:"category"`
+	Visibility  NoteVisibility `gorm:"type:varchar(50);not null;default:all_staff" json:"visibility"`
+	Body        string         `gorm:"type:text;not null" json:"body"`
+	Pinned      bool           `gorm:"not null;default:false" json:"pinned"`
+	AuthorID    *uuid.UUID     `gorm:"type:uuid" json:"authorId,omitempty"`
+	AuthorEmail string         `gorm:"type:varchar(255)" json:"authorEmail,omitempty"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+}
+
+// TableName overrides the default table name
+func (Note) TableName() string {
+	return "notes"
+}
+
+// BeforeCreate sets the UUID before creating a note
+func (n *Note) BeforeCreate(tx *gorm.DB) error {
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsAuthor reports whether the given user wrote the note
+func (n *Note) IsAuthor(userID *uuid.UUID) bool {
+	return userID != nil && n.AuthorID != nil && *n.AuthorID == *userID
+}
+
+// ToAPI converts the domain Note to an API Note representation
+func (n *Note) ToAPI() api.Note {
+	return api.Note{
+		Id:          n.ID,
+		TenantId:    n.TenantID,
+		CampId:      n.CampID,
+		EntityType:  api.NoteEntityType(n.EntityType),
+		EntityId:    n.EntityID,
+		Category:    api.NoteCategory(n.Category),
+		Visibility:  api.NoteVisibility(n.Visibility),
+		Body:        n.Body,
+		Pinned:      n.Pinned,
+		AuthorId:    n.AuthorID,
+		AuthorEmail: utils.StringToPtr(n.AuthorEmail),
+		CreatedAt:   n.CreatedAt,
+		UpdatedAt:   n.UpdatedAt,
+	}
+}
+
+// GroupMembershipChangeType represents whether a person joined or left a group
+type GroupMembershipChangeType string
+
+const (
+	GroupMembershipAdded   GroupMembershipChangeType = "added"
+	GroupMembershipRemoved GroupMembershipChangeType = "removed"
+)
+
+// GroupMembershipChange is a row of the group membership history written by database triggers
+// whenever a camper or staff member is added to or removed from a group
+type GroupMembershipChange struct {
+	ID         uuid.UUID                 `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID   uuid.UUID                 `gorm:"type:uuid;not null" json:"tenantId"`
+	CampID     uuid.UUID                 `gorm:"type:uuid;not null" json:"campId"`
+	GroupID    uuid.UUID                 `gorm:"type:uuid;not null" json:"groupId"`
+	MemberType NoteEntityType            `gorm:"type:varchar(50);not null" json:"memberType"`
+	MemberID   uuid.UUID                 `gorm:"type:uuid;not null" json:"memberId"`
+	Change     GroupMembershipChangeType `gorm:"type:varchar(20);not null" json:"change"`
+	CreatedAt  time.Time                 `gorm:"autoCreateTime" json:"createdAt"`
+
+	// GroupName is read from the groups table when listing the history
+	GroupName string `gorm:"->" json:"groupName,omitempty"`
+}
+
+// TableName overrides the default table name
+func (GroupMembershipChange) TableName() string {
+	return "group_membership_changes"
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// OnboardingItem represents a task on an onboarding checklist, e.g. signing the contract
+type OnboardingItem struct {
+	ID                 uuid.UUID  `json:"id"`
+	Title              string     `json:"title"`
+	Description        string     `json:"description,omitempty"`
+	DueOn              *time.Time `json:"dueOn,omitempty"`
+	RequiresAttachment bool       `json:"requiresAttachment"`
+}
+
+// ParseOnboardingItems converts API checklist items to domain items. Items sent with the ID of one of
+// the existing items keep it so their completions still apply; all others get a new ID.
+func ParseOnboardingItems(inputs []api.OnboardingItemInput, existing []OnboardingItem) ([]OnboardingItem, error) {
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, item := range existing {
+		known[item.ID] = true
+	}
+
+	items := make([]OnboardingItem, 0, len(inputs))
+	seen := make(map[uuid.UUID]bool, len(inputs))
+	for _, input := range inputs {
+		item := OnboardingItem{
+			ID:                 uuid.New(),
+			Title:              strings.TrimSpace(input.Title),
+			Description:        utils.PtrToString(input.Description),
+			RequiresAttachment: input.RequiresAttachment != nil && *input.RequiresAttachment,
+		}
+		if input.Id != nil && known[*input.Id] && !seen[*input.Id] {
+			item.ID = *input.Id
+		}
+		if input.DueOn != nil {
+			item.DueOn = &input.DueOn.Time
+		}
+		if item.Title == "" {
+			return nil, fmt.Errorf("checklist items need a title")
+		}
+		seen[item.ID] = true
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ToAPI converts the domain OnboardingItem to an API OnboardingItem representation
+func (i *OnboardingItem) ToAPI() api.OnboardingItem {
+	item := api.OnboardingItem{
+		Id:                 i.ID,
+		Title:              i.Title,
+		Description:        utils.StringToPtr(i.Description),
+		RequiresAttachment: i.RequiresAttachment,
+	}
+	if i.DueOn != nil {
+		item.DueOn = &openapi_types.Date{Time: *i.DueOn}
+	}
+	return item
+}
+
+// OverdueOn reports whether the item's due day has passed on the given day
+func (i *OnboardingItem) OverdueOn(day time.Time) bool {
+	return i.DueOn != nil && i.DueOn.Before(day)
+}
+
+// OnboardingTemplate represents the checklist and certifications staff members must complete before they can work
+type OnboardingTemplate struct {
+	ID                       uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID                 uuid.UUID        `gorm:"type:uuid;not null;index:idx_onboarding_templates_tenant_id" json:"tenantId"`
+	CampID                   uuid.UUID        `gorm:"type:uuid;not null;index:idx_onboarding_templates_camp_id" json:"campId"`
+	Name                     string           `gorm:"type:varchar(255);not null" json:"name"`
+	Description              string           `gorm:"type:text" json:"description,omitempty"`
+	IsDefault                bool             `gorm:"not null;default:false" json:"isDefault"`
+	Items                    []OnboardingItem `gorm:"type:jsonb;serializer:json" json:"items"`
+	RequiredCertificationIDs []uuid.UUID      `gorm:"type:jsonb;serializer:json" json:"requiredCertificationIds"`
+	CreatedAt                time.Time        `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt                time.Time        `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (OnboardingTemplate) TableName() string {
+	return "onboarding_templates"
+}
+
+// BeforeCreate sets the UUID before creating an onboarding template
+func (t *OnboardingTemplate) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain OnboardingTemplate to an API OnboardingTemplate representation
+func (t *OnboardingTemplate) ToAPI() api.OnboardingTemplate {
+	items := make([]api.OnboardingItem, len(t.Items))
+	for i := range t.Items {
+		items[i] = t.Items[i].ToAPI()
+	}
+	certificationIDs := t.RequiredCertificationIDs
+	if certificationIDs == nil {
+		certificationIDs = []uuid.UUID{}
+	}
+
+	return api.OnboardingTemplate{
+		Id:                       t.ID,
+		TenantId:                 t.TenantID,
+		CampId:                   t.CampID,
+		Name:                     t.Name,
+		Description:              utils.StringToPtr(t.Description),
+		IsDefault:                t.IsDefault,
+		Items:                    items,
+		RequiredCertificationIds: certificationIDs,
+		CreatedAt:                t.CreatedAt,
+		UpdatedAt:                t.UpdatedAt,
+	}
+}
+
+// Validate checks that the template has a name
+func (t *OnboardingTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+// Item returns the checklist item with the ID, or nil if the template has none
+func (t *OnboardingTemplate) Item(itemID uuid.UUID) *OnboardingItem {
+	for i := range t.Items {
+		if t.Items[i].ID == itemID {
+			return &t.Items[i]
+		}
+	}
+	return nil
+}
+
+// OnboardingCompletion represents a staff member having completed an onboarding checklist item
+type OnboardingCompletion struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID         uuid.UUID  `gorm:"type:uuid;not null;index:idx_onboarding_completions_tenant_id" json:"tenantId"`
+	CampID           uuid.UUID  `gorm:"type:uuid;not null;index:idx_onboarding_completions_camp_id" json:"campId"`
+	StaffMemberID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_onboarding_completions_staff_member_id" json:"staffMemberId"`
+	ItemID           uuid.UUID  `gorm:"type:uuid;not null" json:"itemId"`
+	AttachmentID     *uuid.UUID `gorm:"type:uuid" json:"attachmentId,omitempty"`
+	Notes            string     `gorm:"type:text" json:"notes,omitempty"`
+	CompletedBy      *uuid.UUID `gorm:"type:uuid" json:"completedBy,omitempty"`
+	CompletedByEmail string     `gorm:"type:varchar(255)" json:"completedByEmail,omitempty"`
+	CompletedAt      time.Time  `gorm:"not null" json:"completedAt"`
+}
+
+// TableName overrides the default table name
+func (OnboardingCompletion) TableName() string {
+	return "onboarding_completions"
+}
+
+// BeforeCreate sets the UUID before creating an onboarding completion
+func (c *OnboardingCompletion) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
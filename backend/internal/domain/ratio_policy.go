@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// RatioPolicy represents the most campers of an age band a single staff member may supervise,
+// e.g. 1:6 for campers under 8
+type RatioPolicy struct {
+	ID                 uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID           uuid.UUID   `gorm:"type:uuid;not null;index:idx_ratio_policies_tenant_id" json:"tenantId"`
+	CampID             uuid.UUID   `gorm:"type:uuid;not null;index:idx_ratio_policies_camp_id" json:"campId"`
+	Name               string      `gorm:"type:varchar(255);not null" json:"name"`
+	Description        string      `gorm:"type:text" json:"description,omitempty"`
+	MinAge             *int        `gorm:"type:integer" json:"minAge,omitempty"`
+	MaxAge             *int        `gorm:"type:integer" json:"maxAge,omitempty"`
+	CampersPerStaff    int         `gorm:"not null" json:"campersPerStaff"`
+	AppliesToEvents    bool        `gorm:"not null;default:true" json:"appliesToEvents"`
+	AppliesToOvernight bool        `gorm:"not null;default:true" json:"appliesToOvernight"`
+	ActivityIDs        []uuid.UUID `gorm:"type:jsonb;serializer:json" json:"activityIds"`
+	ProgramIDs         []uuid.UUID `gorm:"type:jsonb;serializer:json" json:"programIds"`
+	CreatedAt          time.Time   `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt          time.Time   `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (RatioPolicy) TableName() string {
+	return "ratio_policies"
+}
+
+// BeforeCreate sets the UUID before creating a ratio policy
+func (p *RatioPolicy) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain RatioPolicy to an API RatioPolicy representation
+func (p *RatioPolicy) ToAPI() api.RatioPolicy {
+	activityIDs := p.ActivityIDs
+	if activityIDs == nil {
+		activityIDs = []uuid.UUID{}
+	}
+	programIDs := p.ProgramIDs
+	if programIDs == nil {
+		programIDs = []uuid.UUID{}
+	}
+
+	return api.RatioPolicy{
+		Id:                 p.ID,
+		TenantId:           p.TenantID,
+		CampId:             p.CampID,
+		Name:               p.Name,
+		Description:        utils.StringToPtr(p.Description),
+		MinAge:             p.MinAge,
+		MaxAge:             p.MaxAge,
+		CampersPerStaff:    p.CampersPerStaff,
+		AppliesToEvents:    p.AppliesToEvents,
+		AppliesToOvernight: p.AppliesToOvernight,
+		ActivityIds:        activityIDs,
+		ProgramIds:         programIDs,
+		CreatedAt:          p.CreatedAt,
+		UpdatedAt:          p.UpdatedAt,
+	}
+}
+
+// Validate checks the policy's name, age band and ratio
+func (p *RatioPolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if (p.MinAge != nil && *p.MinAge < 0) || (p.MaxAge != nil && *p.MaxAge < 0) {
+		return fmt.Errorf("ages must not be negative")
+	}
+	if p.MinAge != nil && p.MaxAge != nil && *p.MaxAge < *p.MinAge {
+		return fmt.Errorf("maximum age must not be below minimum age")
+	}
+	if p.CampersPerStaff < 1 {
+		return fmt.Errorf("campers per staff member must be at least 1")
+	}
+	if !p.AppliesToEvents && !p.AppliesToOvernight {
+		return fmt.Errorf("policy must apply to events, overnight or both")
+	}
+	return nil
+}
+
+// CoversAge reports whether a camper of the given age falls in the policy's age band
+func (p *RatioPolicy) CoversAge(age int) bool {
+	if p.MinAge != nil && age < *p.MinAge {
+		return false
+	}
+	if p.MaxAge != nil && age > *p.MaxAge {
+		return false
+	}
+	return true
+}
+
+// CoversEvent reports whether the policy applies to an event of the given activity and program
+func (p *RatioPolicy) CoversEvent(activityID, programID *uuid.UUID) bool {
+	if !p.AppliesToEvents {
+		return false
+	}
+	if len(p.ActivityIDs) == 0 && len(p.ProgramIDs) == 0 {
+		return true
+	}
+	for _, id := range p.ActivityIDs {
+		if activityID != nil && *activityID == id {
+			return true
+		}
+	}
+	for _, id := range p.ProgramIDs {
+		if programID != nil && *programID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,11 +20,23 @@ type Session struct {
 	Description string         `gorm:"type:text" json:"description,omitempty"`
 	StartDate   time.Time      `gorm:"type:date;not null" json:"startDate"`
 	EndDate     time.Time      `gorm:"type:date;not null" json:"endDate"`
+	Capacity    *int           `gorm:"type:integer" json:"capacity,omitempty"`
 	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"createdAt"`
 	UpdatedAt   time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
 }
 
+// SessionFullError is returned when a session has no place left for another camper
+type SessionFullError struct {
+	Enrolled int64
+	Capacity int
+}
+
+// Error implements the error interface
+func (e *SessionFullError) Error() string {
+	return fmt.Sprintf("session is full (%d of %d places taken)", e.Enrolled, e.Capacity)
+}
+
 // TableName overrides the default table name
 func (Session) TableName() string {
 	return "sessions"
@@ -52,6 +65,7 @@ func (s *Session) ToAPI() api.Session {
 		Spec: api.SessionSpec{
 			StartDate: openapi_types.Date{Time: s.StartDate},
 			EndDate:   openapi_types.Date{Time: s.EndDate},
+			Capacity:  s.Capacity,
 		},
 	}
 }
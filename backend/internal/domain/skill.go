@@ -0,0 +1,288 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// SkillLevel is a level of a skill track campers are assessed at, optionally awarding a badge when passed
+type SkillLevel struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	BadgeName   string    `json:"badgeName,omitempty"`
+}
+
+// SkillLevels holds the levels of a skill track from lowest to highest
+type SkillLevels []SkillLevel
+
+// Scan implements the sql.Scanner interface for SkillLevels
+func (l *SkillLevels) Scan(value interface{}) error {
+	if value == nil {
+		*l = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan skill levels: unexpected type %T", value)
+	}
+	return json.Unmarshal(bytes, l)
+}
+
+// Value implements the driver.Valuer interface for SkillLevels
+func (l SkillLevels) Value() (driver.Value, error) {
+	if l == nil {
+		return json.Marshal([]SkillLevel{})
+	}
+	return json.Marshal(l)
+}
+
+// ParseSkillLevels converts API levels, keeping the IDs of existing levels and giving new levels an ID
+func ParseSkillLevels(req []api.SkillLevel, existing SkillLevels) (SkillLevels, error) {
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, level := range existing {
+		known[level.ID] = true
+	}
+
+	levels := make(SkillLevels, 0, len(req))
+	seenIDs := make(map[uuid.UUID]bool)
+	seenNames := make(map[string]bool)
+	for _, level := range req {
+		name := strings.TrimSpace(level.Name)
+		if name == "" {
+			return nil, fmt.Errorf("level name is required")
+		}
+		if seenNames[strings.ToLower(name)] {
+			return nil, fmt.Errorf("level '%s' appears more than once", name)
+		}
+		seenNames[strings.ToLower(name)] = true
+
+		id := uuid.New()
+		if level.Id != nil {
+			if !known[*level.Id] {
+				return nil, fmt.Errorf("level %s is not a level of this skill track", *level.Id)
+			}
+			if seenIDs[*level.Id] {
+				return nil, fmt.Errorf("level %s appears more than once", *level.Id)
+			}
+			id = *level.Id
+		}
+		seenIDs[id] = true
+
+		levels = append(levels, SkillLevel{
+			ID:          id,
+			Name:        name,
+			Description: utils.PtrToString(level.Description),
+			BadgeName:   strings.TrimSpace(utils.PtrToString(level.BadgeName)),
+		})
+	}
+	return levels, nil
+}
+
+// SkillTrack represents a skill campers progress through in ordered levels, e.g. swimming or archery
+type SkillTrack struct {
+	ID          uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID    uuid.UUID   `gorm:"type:uuid;not null;index:idx_skill_tracks_tenant_id" json:"tenantId"`
+	CampID      uuid.UUID   `gorm:"type:uuid;not null;index:idx_skill_tracks_camp_id" json:"campId"`
+	Name        string      `gorm:"type:varchar(255);not null" json:"name"`
+	Description string      `gorm:"type:text" json:"description,omitempty"`
+	Levels      SkillLevels `gorm:"type:jsonb;not null;default:'[]'" json:"levels"`
+	CreatedAt   time.Time   `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt   time.Time   `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (SkillTrack) TableName() string {
+	return "skill_tracks"
+}
+
+// BeforeCreate sets the UUID before creating a skill track
+func (t *SkillTrack) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain SkillTrack to an API SkillTrack representation
+func (t *SkillTrack) ToAPI() api.SkillTrack {
+	levels := make([]api.SkillLevel, len(t.Levels))
+	for i, level := range t.Levels {
+		id := level.ID
+		levels[i] = api.SkillLevel{
+			Id:          &id,
+			Name:        level.Name,
+			Description: utils.StringToPtr(level.Description),
+			BadgeName:   utils.StringToPtr(level.BadgeName),
+		}
+	}
+
+	return api.SkillTrack{
+		Id:          t.ID,
+		TenantId:    t.TenantID,
+		CampId:      t.CampID,
+		Name:        t.Name,
+		Description: utils.StringToPtr(t.Description),
+		Levels:      levels,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+// Validate checks that the track has a name and at least one level
+func (t *SkillTrack) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(t.Levels) == 0 {
+		return fmt.Errorf("at least one level is required")
+	}
+	return nil
+}
+
+// Level returns a level of the track with its position starting at 1, or 0 when it is not a level of the track
+func (t *SkillTrack) Level(levelID uuid.UUID) (*SkillLevel, int) {
+	for i := range t.Levels {
+		if t.Levels[i].ID == levelID {
+			return &t.Levels[i], i + 1
+		}
+	}
+	return nil, 0
+}
+
+// SkillAssessment represents a staff member's assessment of a camper at a level of a skill track
+type SkillAssessment struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID        uuid.UUID  `gorm:"type:uuid;not null;index:idx_skill_assessments_tenant_id" json:"tenantId"`
+	CampID          uuid.UUID  `gorm:"type:uuid;not null;index:idx_skill_assessments_camp_id" json:"campId"`
+	CamperID        uuid.UUID  `gorm:"type:uuid;not null;index:idx_skill_assessments_camper_id" json:"camperId"`
+	SkillTrackID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_skill_assessments_skill_track_id" json:"skillTrackId"`
+	LevelID         uuid.UUID  `gorm:"type:uuid;not null" json:"levelId"`
+	LevelName       string     `gorm:"type:varchar(255);not null" json:"levelName"`
+	Passed          bool       `gorm:"not null" json:"passed"`
+	AssessedOn      time.Time  `gorm:"type:date;not null" json:"assessedOn"`
+	EvaluatorID     *uuid.UUID `gorm:"type:uuid" json:"evaluatorId,omitempty"`
+	Notes           string     `gorm:"type:text" json:"notes,omitempty"`
+	RecordedBy      *uuid.UUID `gorm:"type:uuid" json:"recordedBy,omitempty"`
+	RecordedByEmail string     `gorm:"type:varchar(255)" json:"recordedByEmail,omitempty"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updatedAt"`
+
+	// SkillTrack is preloaded with the assessments of campers to rank the levels they passed
+	SkillTrack *SkillTrack `gorm:"foreignKey:SkillTrackID" json:"-"`
+}
+
+// TableName overrides the default table name
+func (SkillAssessment) TableName() string {
+	return "skill_assessments"
+}
+
+// BeforeCreate sets the UUID before creating a skill assessment
+func (a *SkillAssessment) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain SkillAssessment to an API SkillAssessment representation
+func (a *SkillAssessment) ToAPI() api.SkillAssessment {
+	return api.SkillAssessment{
+		Id:              a.ID,
+		TenantId:        a.TenantID,
+		CampId:          a.CampID,
+		CamperId:        a.CamperID,
+		SkillTrackId:    a.SkillTrackID,
+		LevelId:         a.LevelID,
+		LevelName:       a.LevelName,
+		Passed:          a.Passed,
+		AssessedOn:      openapi_types.Date{Time: a.AssessedOn},
+		EvaluatorId:     a.EvaluatorID,
+		Notes:           utils.StringToPtr(a.Notes),
+		RecordedBy:      a.RecordedBy,
+		RecordedByEmail: utils.StringToPtr(a.RecordedByEmail),
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+// CamperBadge represents a badge a camper was awarded for passing a level of a skill track
+type CamperBadge struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID     uuid.UUID `gorm:"type:uuid;not null;index:idx_camper_badges_tenant_id" json:"tenantId"`
+	CampID       uuid.UUID `gorm:"type:uuid;not null;index:idx_camper_badges_camp_id" json:"campId"`
+	CamperID     uuid.UUID `gorm:"type:uuid;not null;index:idx_camper_badges_camper_id" json:"camperId"`
+	SkillTrackID uuid.UUID `gorm:"type:uuid;not null" json:"skillTrackId"`
+	LevelID      uuid.UUID `gorm:"type:uuid;not null" json:"levelId"`
+	AssessmentID uuid.UUID `gorm:"type:uuid;not null" json:"assessmentId"`
+	Name         string    `gorm:"type:varchar(255);not null" json:"name"`
+	AwardedOn    time.Time `gorm:"type:date;not null" json:"awardedOn"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName overrides the default table name
+func (CamperBadge) TableName() string {
+	return "camper_badges"
+}
+
+// BeforeCreate sets the UUID before creating a camper badge
+func (b *CamperBadge) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain CamperBadge to an API CamperBadge representation
+func (b *CamperBadge) ToAPI() api.CamperBadge {
+	return api.CamperBadge{
+		Id:           b.ID,
+		CamperId:     b.CamperID,
+		SkillTrackId: b.SkillTrackID,
+		LevelId:      b.LevelID,
+		AssessmentId: b.AssessmentID,
+		Name:         b.Name,
+		AwardedOn:    openapi_types.Date{Time: b.AwardedOn},
+		CreatedAt:    b.CreatedAt,
+	}
+}
+
+// SkillProgress returns the highest level of a skill track passed in the given assessments, its position
+// starting at 1 (0 when no level was passed) and the assessment it was passed in
+func SkillProgress(track *SkillTrack, assessments []SkillAssessment) (*SkillLevel, int, *SkillAssessment) {
+	var best *SkillLevel
+	var bestAssessment *SkillAssessment
+	bestRank := 0
+	for i := range assessments {
+		assessment := &assessments[i]
+		if !assessment.Passed || assessment.SkillTrackID != track.ID {
+			continue
+		}
+		level, rank := track.Level(assessment.LevelID)
+		if rank > bestRank || (rank == bestRank && rank > 0 && assessment.AssessedOn.Before(bestAssessment.AssessedOn)) {
+			best, bestRank, bestAssessment = level, rank, assessment
+		}
+	}
+	return best, bestRank, bestAssessment
+}
+
+// SkillRank returns the position, starting at 1, of the highest level of a skill track the camper passed,
+// or 0 when none. It relies on the camper's skill assessments being preloaded with their tracks.
+func (c *Camper) SkillRank(skillTrackID uuid.UUID) int {
+	for _, assessment := range c.SkillAssessments {
+		if assessment.SkillTrackID == skillTrackID && assessment.SkillTrack != nil {
+			_, rank, _ := SkillProgress(assessment.SkillTrack, c.SkillAssessments)
+			return rank
+		}
+	}
+	return 0
+}
@@ -1,9 +1,12 @@
 package domain
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/tbechar/camp-manager-backend/internal/api"
 	"github.com/tbechar/camp-manager-backend/pkg/utils"
 	"gorm.io/gorm"
@@ -11,19 +14,25 @@ import (
 
 // StaffMember represents a staff member working at the camp
 type StaffMember struct {
-	ID             uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	TenantID       uuid.UUID      `gorm:"type:uuid;not null;index:idx_staff_members_tenant_id" json:"tenantId"`
-	CampID         uuid.UUID      `gorm:"type:uuid;not null;index:idx_staff_members_camp_id" json:"campId"`
-	Name           string         `gorm:"type:varchar(255);not null" json:"name"`
-	Description    string         `gorm:"type:text" json:"description,omitempty"`
-	Birthday       time.Time      `gorm:"type:date;not null" json:"birthday"`
-	Gender         string         `gorm:"type:varchar(50);not null" json:"gender"`
-	RoleID         uuid.UUID      `gorm:"type:uuid;not null;index:idx_staff_members_role_id" json:"roleId"`
-	Phone          string         `gorm:"type:varchar(50)" json:"phone,omitempty"`
-	HousingGroupID *uuid.UUID     `gorm:"type:uuid;index:idx_staff_members_housing_group_id" json:"housingGroupId,omitempty"`
-	CreatedAt      time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt      time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt      gorm.DeletedAt `gorm:"index" json:"deletedAt,omitempty"`
+	ID                   uuid.UUID            `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID             uuid.UUID            `gorm:"type:uuid;not null;index:idx_staff_members_tenant_id" json:"tenantId"`
+	CampID               uuid.UUID            `gorm:"type:uuid;not null;index:idx_staff_members_camp_id" json:"campId"`
+	Name                 string               `gorm:"type:varchar(255);not null" json:"name"`
+	Description          string               `gorm:"type:text" json:"description,omitempty"`
+	Birthday             time.Time            `gorm:"type:date;not null" json:"birthday"`
+	Gender               string               `gorm:"type:varchar(50);not null" json:"gender"`
+	RoleID               uuid.UUID            `gorm:"type:uuid;not null;index:idx_staff_members_role_id" json:"roleId"`
+	Phone                string               `gorm:"type:varchar(50)" json:"phone,omitempty"`
+	HousingGroupID       *uuid.UUID           `gorm:"type:uuid;index:idx_staff_members_housing_group_id" json:"housingGroupId,omitempty"`
+	UserID               *uuid.UUID           `gorm:"type:uuid;index:idx_staff_members_user_id" json:"userId,omitempty"`
+	OnboardingTemplateID *uuid.UUID           `gorm:"type:uuid;index:idx_staff_members_onboarding_template_id" json:"onboardingTemplateId,omitempty"`
+	CustomFields         CustomFieldValues    `gorm:"type:jsonb;not null;default:'{}'" json:"customFields,omitempty"`
+	DietaryRestrictions  []DietaryRestriction `gorm:"type:jsonb;serializer:json" json:"dietaryRestrictions,omitempty"`
+	Allergies            []Allergen           `gorm:"type:jsonb;serializer:json" json:"allergies,omitempty"`
+	UnavailableWindows   []UnavailableWindow  `gorm:"type:jsonb;serializer:json" json:"unavailableWindows,omitempty"`
+	CreatedAt            time.Time            `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt            time.Time            `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt            gorm.DeletedAt       `gorm:"index" json:"deletedAt,omitempty"`
 
 	// Relationships (for preloading junction table data)
 	GroupStaffMembers   []GroupStaffMember   `gorm:"foreignKey:StaffMemberID" json:"-"`
@@ -42,11 +51,18 @@ func (GroupStaffMember) TableName() string {
 	return "group_staff_members"
 }
 
-// StaffCertification represents the junction table between staff members and certifications
+// StaffCertification represents the junction table between staff members and certifications,
+// carrying the details of the certificate the staff member holds
 type StaffCertification struct {
-	StaffMemberID   uuid.UUID `gorm:"type:uuid;primaryKey" json:"staffMemberId"`
-	CertificationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"certificationId"`
-	CreatedAt       time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	StaffMemberID     uuid.UUID  `gorm:"type:uuid;primaryKey" json:"staffMemberId"`
+	CertificationID   uuid.UUID  `gorm:"type:uuid;primaryKey" json:"certificationId"`
+	IssuedOn          *time.Time `gorm:"type:date" json:"issuedOn,omitempty"`
+	ExpiresOn         *time.Time `gorm:"type:date" json:"expiresOn,omitempty"`
+	CertificateNumber string     `gorm:"type:varchar(255)" json:"certificateNumber,omitempty"`
+	VerifiedBy        *uuid.UUID `gorm:"type:uuid" json:"verifiedBy,omitempty"`
+	VerifiedByEmail   string     `gorm:"type:varchar(255)" json:"verifiedByEmail,omitempty"`
+	VerifiedAt        *time.Time `json:"verifiedAt,omitempty"`
+	CreatedAt         time.Time  `gorm:"autoCreateTime" json:"createdAt"`
 }
 
 // TableName overrides the default table name for StaffCertification
@@ -54,6 +70,77 @@ func (StaffCertification) TableName() string {
 	return "staff_member_certifications"
 }
 
+// ExpiredOn reports whether the certificate is no longer valid on the given day.
+// Certificates are valid through their expiry day and never expire without one.
+func (c *StaffCertification) ExpiredOn(day time.Time) bool {
+	return c.ExpiresOn != nil && c.ExpiresOn.Before(day)
+}
+
+// SameCertificate reports whether both rows describe the same certificate, i.e. whether a
+// verification of one still applies to the other
+func (c *StaffCertification) SameCertificate(other *StaffCertification) bool {
+	return c.CertificationID == other.CertificationID &&
+		c.CertificateNumber == other.CertificateNumber &&
+		sameDay(c.IssuedOn, other.IssuedOn) &&
+		sameDay(c.ExpiresOn, other.ExpiresOn)
+}
+
+// ToAPI converts the certificate details to their API representation
+func (c *StaffCertification) ToAPI() api.StaffCertification {
+	cert := api.StaffCertification{
+		CertificationId:   c.CertificationID,
+		CertificateNumber: utils.StringToPtr(c.CertificateNumber),
+		VerifiedBy:        c.VerifiedBy,
+		VerifiedByEmail:   utils.StringToPtr(c.VerifiedByEmail),
+		VerifiedAt:        c.VerifiedAt,
+	}
+	if c.IssuedOn != nil {
+		cert.IssuedOn = &openapi_types.Date{Time: *c.IssuedOn}
+	}
+	if c.ExpiresOn != nil {
+		cert.ExpiresOn = &openapi_types.Date{Time: *c.ExpiresOn}
+	}
+	return cert
+}
+
+// ParseStaffCertifications converts the API certificate details of a staff member to junction rows,
+// dropping repeated certifications
+func ParseStaffCertifications(staffMemberID uuid.UUID, inputs []api.StaffCertificationInput) ([]StaffCertification, error) {
+	certs := []StaffCertification{}
+	seen := make(map[uuid.UUID]bool, len(inputs))
+	for _, input := range inputs {
+		if seen[input.CertificationId] {
+			continue
+		}
+		seen[input.CertificationId] = true
+
+		cert := StaffCertification{
+			StaffMemberID:     staffMemberID,
+			CertificationID:   input.CertificationId,
+			CertificateNumber: strings.TrimSpace(utils.PtrToString(input.CertificateNumber)),
+		}
+		if input.IssuedOn != nil {
+			cert.IssuedOn = &input.IssuedOn.Time
+		}
+		if input.ExpiresOn != nil {
+			cert.ExpiresOn = &input.ExpiresOn.Time
+		}
+		if cert.IssuedOn != nil && cert.ExpiresOn != nil && cert.ExpiresOn.Before(*cert.IssuedOn) {
+			return nil, fmt.Errorf("certificate %s expires before it was issued", input.CertificationId)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// sameDay reports whether two optional dates are both unset or fall on the same day
+func sameDay(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
+
 // TableName overrides the default table name
 func (StaffMember) TableName() string {
 	return "staff_members"
@@ -67,6 +154,39 @@ func (s *StaffMember) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// Certification returns the staff member's certificate of a certification, or nil if they do not hold it
+func (s *StaffMember) Certification(certificationID uuid.UUID) *StaffCertification {
+	for i := range s.StaffCertifications {
+		if s.StaffCertifications[i].CertificationID == certificationID {
+			return &s.StaffCertifications[i]
+		}
+	}
+	return nil
+}
+
+// AvailabilityToAPI converts the staff member's weekly unavailable windows to their API representation
+func (s *StaffMember) AvailabilityToAPI() api.StaffAvailability {
+	windows := make([]api.UnavailableWindow, len(s.UnavailableWindows))
+	for i := range s.UnavailableWindows {
+		windows[i] = s.UnavailableWindows[i].ToAPI()
+	}
+	return api.StaffAvailability{
+		StaffMemberId:      s.ID,
+		UnavailableWindows: windows,
+	}
+}
+
+// UnavailableDuring reports whether one of the staff member's weekly unavailable windows overlaps
+// the period [start, end) in the given time zone
+func (s *StaffMember) UnavailableDuring(start, end time.Time, loc *time.Location) bool {
+	for i := range s.UnavailableWindows {
+		if s.UnavailableWindows[i].Overlaps(start, end, loc) {
+			return true
+		}
+	}
+	return false
+}
+
 // ToAPI converts the domain StaffMember to an API StaffMember representation
 func (s *StaffMember) ToAPI() api.StaffMember {
 	// Extract group IDs from junction table data
@@ -75,10 +195,17 @@ func (s *StaffMember) ToAPI() api.StaffMember {
 		groupIDs = append(groupIDs, gsm.GroupID)
 	}
 
-	// Extract certification IDs from junction table data
+	// Extract certification IDs and certificate details from junction table data. Expired certificates
+	// keep their details but are not listed as held, so they no longer meet event requirements.
+	today := time.Now().UTC().Truncate(24 * time.Hour)
 	var certificationIDs []uuid.UUID
-	for _, sc := range s.StaffCertifications {
-		certificationIDs = append(certificationIDs, sc.CertificationID)
+	certifications := []api.StaffCertification{}
+	for i := range s.StaffCertifications {
+		sc := &s.StaffCertifications[i]
+		if !sc.ExpiredOn(today) {
+			certificationIDs = append(certificationIDs, sc.CertificationID)
+		}
+		certifications = append(certifications, sc.ToAPI())
 	}
 
 	return api.StaffMember{
@@ -92,13 +219,19 @@ func (s *StaffMember) ToAPI() api.StaffMember {
 			UpdatedAt:   s.UpdatedAt,
 		},
 		Spec: api.StaffMemberSpec{
-			Birthday:         api.Birthday{Time: s.Birthday},
-			Gender:           api.Gender(s.Gender),
-			RoleId:           s.RoleID,
-			Phone:            utils.StringToPtr(s.Phone),
-			HousingGroupId:   s.HousingGroupID,
-			GroupIds:         &groupIDs,
-			CertificationIds: &certificationIDs,
+			Birthday:             api.Birthday{Time: s.Birthday},
+			Gender:               api.Gender(s.Gender),
+			RoleId:               s.RoleID,
+			Phone:                utils.StringToPtr(s.Phone),
+			UserId:               s.UserID,
+			OnboardingTemplateId: s.OnboardingTemplateID,
+			HousingGroupId:       s.HousingGroupID,
+			GroupIds:             &groupIDs,
+			CertificationIds:     &certificationIDs,
+			Certifications:       &certifications,
+			CustomFields:         s.CustomFields.ToAPI(),
+			DietaryRestrictions:  DietaryRestrictionsToAPI(s.DietaryRestrictions),
+			Allergies:            AllergensToAPI(s.Allergies),
 		},
 	}
 }
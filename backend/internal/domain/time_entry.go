@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MaxTimeEntryDuration is the longest a single time entry can last
+const MaxTimeEntryDuration = 24 * time.Hour
+
+// TimeEntryStatus represents the review state of a time entry
+type TimeEntryStatus string
+
+const (
+	TimeEntryStatusPending  TimeEntryStatus = "pending"
+	TimeEntryStatusApproved TimeEntryStatus = "approved"
+	TimeEntryStatusRejected TimeEntryStatus = "rejected"
+)
+
+// IsValid reports whether the status is one of the known review states
+func (s TimeEntryStatus) IsValid() bool {
+	switch s {
+	case TimeEntryStatusPending, TimeEntryStatusApproved, TimeEntryStatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsEditable reports whether the entry's times can still be changed
+func (s TimeEntryStatus) IsEditable() bool {
+	return s != TimeEntryStatusApproved
+}
+
+// TimeEntry represents a staff member's clock-in and clock-out
+type TimeEntry struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	TenantID        uuid.UUID       `gorm:"type:uuid;not null;index:idx_time_entries_tenant_id" json:"tenantId"`
+	CampID          uuid.UUID       `gorm:"type:uuid;not null;index:idx_time_entries_camp_id" json:"campId"`
+	StaffMemberID   uuid.UUID       `gorm:"type:uuid;not null;index:idx_time_entries_staff_member_id" json:"staffMemberId"`
+	ClockInAt       time.Time       `gorm:"not null;index:idx_time_entries_clock_in_at" json:"clockInAt"`
+	ClockOutAt      *time.Time      `json:"clockOutAt,omitempty"`
+	Notes           string          `gorm:"type:text" json:"notes,omitempty"`
+	Status          TimeEntryStatus `gorm:"type:varchar(50);not null;default:pending" json:"status"`
+	ReviewedBy      *uuid.UUID      `gorm:"type:uuid" json:"reviewedBy,omitempty"`
+	ReviewedByEmail string          `gorm:"type:varchar(255)" json:"reviewedByEmail,omitempty"`
+	ReviewedAt      *time.Time      `json:"reviewedAt,omitempty"`
+	ReviewComment   string          `gorm:"type:text" json:"reviewComment,omitempty"`
+	CreatedAt       time.Time       `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time       `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName overrides the default table name
+func (TimeEntry) TableName() string {
+	return "time_entries"
+}
+
+// BeforeCreate sets the UUID before creating a time entry
+func (e *TimeEntry) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// ToAPI converts the domain TimeEntry to an API TimeEntry representation
+func (e *TimeEntry) ToAPI() api.TimeEntry {
+	entry := api.TimeEntry{
+		Id:              e.ID,
+		TenantId:        e.TenantID,
+		CampId:          e.CampID,
+		StaffMemberId:   e.StaffMemberID,
+		ClockInAt:       e.ClockInAt,
+		ClockOutAt:      e.ClockOutAt,
+		Notes:           utils.StringToPtr(e.Notes),
+		Status:          api.TimeEntryStatus(e.Status),
+		ReviewedBy:      e.ReviewedBy,
+		ReviewedByEmail: utils.StringToPtr(e.ReviewedByEmail),
+		ReviewedAt:      e.ReviewedAt,
+		ReviewComment:   utils.StringToPtr(e.ReviewComment),
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+	if e.ClockOutAt != nil {
+		hours := e.Hours()
+		entry.Hours = &hours
+	}
+	return entry
+}
+
+// Validate checks that the entry ends after it starts and does not last longer than a day
+func (e *TimeEntry) Validate() error {
+	if e.ClockOutAt == nil {
+		return nil
+	}
+	if !e.ClockOutAt.After(e.ClockInAt) {
+		return fmt.Errorf("clock-out must be after clock-in")
+	}
+	if e.ClockOutAt.Sub(e.ClockInAt) > MaxTimeEntryDuration {
+		return fmt.Errorf("a time entry can last at most %d hours", int(MaxTimeEntryDuration.Hours()))
+	}
+	return nil
+}
+
+// IsOpen reports whether the staff member has not clocked out yet
+func (e *TimeEntry) IsOpen() bool {
+	return e.ClockOutAt == nil
+}
+
+// Hours returns the hours worked, rounded to the minute; zero while the entry is open
+func (e *TimeEntry) Hours() float64 {
+	if e.ClockOutAt == nil {
+		return 0
+	}
+	return RoundHours(e.ClockOutAt.Sub(e.ClockInAt))
+}
+
+// RoundHours converts a duration to hours, rounded to the minute and to two decimals
+func RoundHours(d time.Duration) float64 {
+	return math.Round(d.Round(time.Minute).Hours()*100) / 100
+}
@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// ApplicationsHandler handles camper application HTTP requests
+type ApplicationsHandler struct {
+	service service.ApplicationsService
+}
+
+// NewApplicationsHandler creates a new applications handler
+func NewApplicationsHandler(service service.ApplicationsService) *ApplicationsHandler {
+	return &ApplicationsHandler{
+		service: service,
+	}
+}
+
+// ListApplications handles GET /api/v1/camps/{camp_id}/applications
+func (h *ApplicationsHandler) ListApplications(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListApplicationsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Set default pagination values
+	limit := 50
+	offset := 0
+
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	// Extract filter and sort parameters
+	filterStrings := []string{}
+	if params.FilterBy != nil {
+		filterStrings = *params.FilterBy
+	}
+
+	sortOrder := "asc"
+	if params.SortOrder != nil {
+		sortOrder = string(*params.SortOrder)
+	}
+
+	sortBy := ""
+	if params.SortBy != nil {
+		sortBy = string(*params.SortBy)
+	}
+
+	// Call service
+	response, err := h.service.List(r.Context(), tenantID, campUUID, limit, offset, params.Search, filterStrings, &sortBy, sortOrder)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CreateApplication handles POST /api/v1/camps/{camp_id}/applications
+func (h *ApplicationsHandler) CreateApplication(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.ApplicationCreationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	application, err := h.service.Create(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, application); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// GetApplicationById handles GET /api/v1/camps/{camp_id}/applications/{id}
+func (h *ApplicationsHandler) GetApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	applicationID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid application ID", err))
+		return
+	}
+
+	// Call service
+	application, err := h.service.GetByID(r.Context(), tenantID, campUUID, applicationID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, application); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// UpdateApplicationById handles PUT /api/v1/camps/{camp_id}/applications/{id}
+func (h *ApplicationsHandler) UpdateApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	applicationID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid application ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.ApplicationUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	application, err := h.service.Update(r.Context(), tenantID, campUUID, applicationID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, application); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteApplicationById handles DELETE /api/v1/camps/{camp_id}/applications/{id}
+func (h *ApplicationsHandler) DeleteApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	applicationID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid application ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.Delete(r.Context(), tenantID, campUUID, applicationID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListApplicationTransitions handles GET /api/v1/camps/{camp_id}/applications/{id}/transitions
+func (h *ApplicationsHandler) ListApplicationTransitions(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	applicationID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid application ID", err))
+		return
+	}
+
+	// Call service
+	transitions, err := h.service.ListTransitions(r.Context(), tenantID, campUUID, applicationID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, transitions); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// TransitionApplication handles POST /api/v1/camps/{camp_id}/applications/{id}/transitions
+func (h *ApplicationsHandler) TransitionApplication(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	applicationID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid application ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.ApplicationTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	application, err := h.service.Transition(r.Context(), tenantID, campUUID, applicationID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, application); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
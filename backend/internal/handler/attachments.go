@@ -0,0 +1,263 @@
+package handler
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// multipartOverhead leaves room for the form fields and boundaries around an uploaded file
+const multipartOverhead = 1 << 20 // 1 MB
+
+// AttachmentsHandler handles attachment-related HTTP requests
+type AttachmentsHandler struct {
+	service      service.AttachmentsService
+	maxSizeBytes int64
+}
+
+// NewAttachmentsHandler creates a new attachments handler
+func NewAttachmentsHandler(service service.AttachmentsService, maxSizeBytes int64) *AttachmentsHandler {
+	return &AttachmentsHandler{
+		service:      service,
+		maxSizeBytes: maxSizeBytes,
+	}
+}
+
+// ListAttachments handles GET /api/v1/camps/{camp_id}/attachments
+func (h *AttachmentsHandler) ListAttachments(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListAttachmentsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Call service
+	response, err := h.service.List(r.Context(), tenantID, campUUID, params.EntityType, params.EntityId)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// UploadAttachment handles POST /api/v1/camps/{camp_id}/attachments
+func (h *AttachmentsHandler) UploadAttachment(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse multipart form, keeping at most the form overhead in memory
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSizeBytes+multipartOverhead)
+	if err := r.ParseMultipartForm(multipartOverhead); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if stderrors.As(err, &maxBytesErr) {
+			errors.WriteError(w, errors.NewAppError(http.StatusRequestEntityTooLarge, fmt.Sprintf("File exceeds the maximum attachment size of %d MB", h.maxSizeBytes>>20), err))
+			return
+		}
+		errors.WriteError(w, errors.BadRequest("Invalid multipart form", err))
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	// Get file from form
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("No file provided", err))
+		return
+	}
+	defer file.Close()
+
+	entityID, err := uuid.Parse(r.FormValue("entityId"))
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid entity ID", err))
+		return
+	}
+
+	upload := &service.AttachmentUpload{
+		File:       file,
+		FileName:   fileHeader.Filename,
+		Size:       fileHeader.Size,
+		EntityType: api.AttachmentEntityType(r.FormValue("entityType")),
+		EntityID:   entityID,
+		Category:   api.AttachmentCategory(r.FormValue("category")),
+	}
+	if description := r.FormValue("description"); description != "" {
+		upload.Description = &description
+	}
+
+	// Call service
+	attachment, err := h.service.Upload(r.Context(), tenantID, campUUID, upload)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, attachment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// GetAttachmentById handles GET /api/v1/camps/{camp_id}/attachments/{id}
+func (h *AttachmentsHandler) GetAttachmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	attachmentID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid attachment ID", err))
+		return
+	}
+
+	// Call service
+	attachment, err := h.service.GetByID(r.Context(), tenantID, campUUID, attachmentID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, attachment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteAttachmentById handles DELETE /api/v1/camps/{camp_id}/attachments/{id}
+func (h *AttachmentsHandler) DeleteAttachmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	attachmentID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid attachment ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.Delete(r.Context(), tenantID, campUUID, attachmentID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateAttachmentDownloadUrl handles POST /api/v1/camps/{camp_id}/attachments/{id}/download-url
+func (h *AttachmentsHandler) CreateAttachmentDownloadUrl(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	attachmentID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid attachment ID", err))
+		return
+	}
+
+	// Call service
+	downloadURL, err := h.service.CreateDownloadURL(r.Context(), tenantID, campUUID, attachmentID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, downloadURL); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DownloadAttachment handles GET /api/v1/attachments/download/{token}.
+// The signed token authorizes the download, so no authorization header is needed.
+func (h *AttachmentsHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request, token string) {
+	// Call service
+	attachment, contents, err := h.service.Open(r.Context(), token)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+	defer contents.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+
+	// Headers are already sent, so a failed copy can only abort the response
+	io.Copy(w, contents)
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// AttendanceHandler handles daily camper check-in and check-out HTTP requests
+type AttendanceHandler struct {
+	service service.AttendanceService
+}
+
+// NewAttendanceHandler creates a new attendance handler
+func NewAttendanceHandler(service service.AttendanceService) *AttendanceHandler {
+	return &AttendanceHandler{
+		service: service,
+	}
+}
+
+// ListAttendanceRecords handles GET /api/v1/camps/{camp_id}/attendance
+func (h *AttendanceHandler) ListAttendanceRecords(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListAttendanceRecordsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	var date *time.Time
+	if params.Date != nil {
+		date = &params.Date.Time
+	}
+
+	// Call service
+	response, err := h.service.ListRecords(r.Context(), tenantID, campUUID, date, params.CamperId)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CheckInCamper handles POST /api/v1/camps/{camp_id}/attendance/check-in
+func (h *AttendanceHandler) CheckInCamper(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.AttendanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	record, err := h.service.CheckIn(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, record); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CheckOutCamper handles POST /api/v1/camps/{camp_id}/attendance/check-out
+func (h *AttendanceHandler) CheckOutCamper(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.AttendanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	record, err := h.service.CheckOut(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, record); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteAttendanceRecord handles DELETE /api/v1/camps/{camp_id}/attendance/{id}
+func (h *AttendanceHandler) DeleteAttendanceRecord(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	recordID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid attendance record ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.DeleteRecord(r.Context(), tenantID, campUUID, recordID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetOnSiteReport handles GET /api/v1/camps/{camp_id}/attendance/on-site
+func (h *AttendanceHandler) GetOnSiteReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetOnSiteReportParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	var date *time.Time
+	if params.Date != nil {
+		date = &params.Date.Time
+	}
+
+	// Call service
+	report, err := h.service.GetOnSiteReport(r.Context(), tenantID, campUUID, date)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, report); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
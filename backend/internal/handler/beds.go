@@ -0,0 +1,393 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// BedsHandler handles bed, bed assignment and housing occupancy HTTP requests
+type BedsHandler struct {
+	service service.BedsService
+}
+
+// NewBedsHandler creates a new beds handler
+func NewBedsHandler(service service.BedsService) *BedsHandler {
+	return &BedsHandler{
+		service: service,
+	}
+}
+
+// ListBeds handles GET /api/v1/camps/{camp_id}/beds
+func (h *BedsHandler) ListBeds(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBedsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Call service
+	response, err := h.service.ListBeds(r.Context(), tenantID, campUUID, params.HousingRoomId)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CreateBed handles POST /api/v1/camps/{camp_id}/beds
+func (h *BedsHandler) CreateBed(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.BedCreationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	bed, err := h.service.CreateBed(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, bed); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// GetBedById handles GET /api/v1/camps/{camp_id}/beds/{id}
+func (h *BedsHandler) GetBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	bedID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bed ID", err))
+		return
+	}
+
+	// Call service
+	bed, err := h.service.GetBed(r.Context(), tenantID, campUUID, bedID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, bed); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// UpdateBedById handles PUT /api/v1/camps/{camp_id}/beds/{id}
+func (h *BedsHandler) UpdateBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	bedID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bed ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.BedUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	bed, err := h.service.UpdateBed(r.Context(), tenantID, campUUID, bedID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, bed); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteBedById handles DELETE /api/v1/camps/{camp_id}/beds/{id}
+func (h *BedsHandler) DeleteBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	bedID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bed ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.DeleteBed(r.Context(), tenantID, campUUID, bedID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListBedAssignments handles GET /api/v1/camps/{camp_id}/bed-assignments
+func (h *BedsHandler) ListBedAssignments(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBedAssignmentsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Call service
+	response, err := h.service.ListAssignments(r.Context(), tenantID, campUUID, params.SessionId, params.HousingRoomId)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CreateBedAssignment handles POST /api/v1/camps/{camp_id}/bed-assignments
+func (h *BedsHandler) CreateBedAssignment(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.BedAssignmentCreationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	assignment, err := h.service.CreateAssignment(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, assignment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// UpdateBedAssignmentById handles PUT /api/v1/camps/{camp_id}/bed-assignments/{id}
+func (h *BedsHandler) UpdateBedAssignmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	assignmentID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bed assignment ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.BedAssignmentUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	assignment, err := h.service.UpdateAssignment(r.Context(), tenantID, campUUID, assignmentID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, assignment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteBedAssignmentById handles DELETE /api/v1/camps/{camp_id}/bed-assignments/{id}
+func (h *BedsHandler) DeleteBedAssignmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	assignmentID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bed assignment ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.DeleteAssignment(r.Context(), tenantID, campUUID, assignmentID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetHousingOccupancy handles GET /api/v1/camps/{camp_id}/housing-occupancy
+func (h *BedsHandler) GetHousingOccupancy(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetHousingOccupancyParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	var from, to *time.Time
+	if params.From != nil {
+		from = &params.From.Time
+	}
+	if params.To != nil {
+		to = &params.To.Time
+	}
+
+	// Call service
+	report, err := h.service.GetOccupancy(r.Context(), tenantID, campUUID, params.SessionId, from, to)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, report); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// BunkRequestsHandler handles bunkmate request HTTP requests
+type BunkRequestsHandler struct {
+	service service.BunkRequestsService
+}
+
+// NewBunkRequestsHandler creates a new bunk requests handler
+func NewBunkRequestsHandler(service service.BunkRequestsService) *BunkRequestsHandler {
+	return &BunkRequestsHandler{
+		service: service,
+	}
+}
+
+// ListBunkRequests handles GET /api/v1/camps/{camp_id}/bunk-requests
+func (h *BunkRequestsHandler) ListBunkRequests(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBunkRequestsParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Call service
+	response, err := h.service.List(r.Context(), tenantID, campUUID, params.SessionId, params.CamperId)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, response); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CreateBunkRequest handles POST /api/v1/camps/{camp_id}/bunk-requests
+func (h *BunkRequestsHandler) CreateBunkRequest(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	// Parse request body
+	var req api.BunkRequestCreationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	request, err := h.service.Create(r.Context(), tenantID, campUUID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, request); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteBunkRequest handles DELETE /api/v1/camps/{camp_id}/bunk-requests/{id}
+func (h *BunkRequestsHandler) DeleteBunkRequest(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	requestID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid bunk request ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.Delete(r.Context(), tenantID, campUUID, requestID); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// CamperEnrollmentsHandler handles camper session enrollment HTTP requests
+type CamperEnrollmentsHandler struct {
+	service service.CamperEnrollmentsService
+}
+
+// NewCamperEnrollmentsHandler creates a new camper enrollments handler
+func NewCamperEnrollmentsHandler(service service.CamperEnrollmentsService) *CamperEnrollmentsHandler {
+	return &CamperEnrollmentsHandler{
+		service: service,
+	}
+}
+
+// ListCamperEnrollments handles GET /api/v1/camps/{camp_id}/campers/{id}/enrollments
+func (h *CamperEnrollmentsHandler) ListCamperEnrollments(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	camperID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid camper ID", err))
+		return
+	}
+
+	// Call service
+	enrollments, err := h.service.List(r.Context(), tenantID, campUUID, camperID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, enrollments); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// CreateCamperEnrollment handles POST /api/v1/camps/{camp_id}/campers/{id}/enrollments
+func (h *CamperEnrollmentsHandler) CreateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	camperID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid camper ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.CamperEnrollmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	enrollment, err := h.service.Create(r.Context(), tenantID, campUUID, camperID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusCreated, enrollment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// UpdateCamperEnrollment handles PUT /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id}
+func (h *CamperEnrollmentsHandler) UpdateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, enrollmentId openapi_types.UUID) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	camperID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid camper ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.CamperEnrollmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	enrollment, err := h.service.Update(r.Context(), tenantID, campUUID, camperID, uuid.UUID(enrollmentId), &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, enrollment); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// DeleteCamperEnrollment handles DELETE /api/v1/camps/{camp_id}/campers/{id}/enrollments/{enrollment_id}
+func (h *CamperEnrollmentsHandler) DeleteCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, enrollmentId openapi_types.UUID) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	camperID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid camper ID", err))
+		return
+	}
+
+	// Call service
+	if err := h.service.Delete(r.Context(), tenantID, campUUID, camperID, uuid.UUID(enrollmentId)); err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write no content response
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/api"
@@ -232,3 +233,39 @@ func (h *CertificationsHandler) DeleteCertificationById(w http.ResponseWriter, r
 	// Write no content response
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// GetCertificationCompliance handles GET /api/v1/camps/{camp_id}/certifications/compliance
+func (h *CertificationsHandler) GetCertificationCompliance(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetCertificationComplianceParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	var before *time.Time
+	if params.Before != nil {
+		before = &params.Before.Time
+	}
+
+	// Call service
+	report, err := h.service.GetComplianceReport(r.Context(), tenantID, campUUID, before)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, report); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
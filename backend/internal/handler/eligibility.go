@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// EligibilityHandler handles activity eligibility HTTP requests
+type EligibilityHandler struct {
+	service service.EligibilityService
+}
+
+// NewEligibilityHandler creates a new eligibility handler
+func NewEligibilityHandler(service service.EligibilityService) *EligibilityHandler {
+	return &EligibilityHandler{
+		service: service,
+	}
+}
+
+// GetEventEligibility handles GET /api/v1/camps/{camp_id}/events/{id}/eligibility
+func (h *EligibilityHandler) GetEventEligibility(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	eventID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid event ID", err))
+		return
+	}
+
+	// Call service
+	eligibility, err := h.service.GetEventEligibility(r.Context(), tenantID, campUUID, eventID)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, eligibility); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// GetEligibilityReport handles GET /api/v1/camps/{camp_id}/activity-eligibility
+func (h *EligibilityHandler) GetEligibilityReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetEligibilityReportParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	var from, to *time.Time
+	if params.From != nil {
+		from = &params.From.Time
+	}
+	if params.To != nil {
+		to = &params.To.Time
+	}
+
+	// Call service
+	report, err := h.service.GetReport(r.Context(), tenantID, campUUID, from, to)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, report); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
@@ -12,49 +12,105 @@ import (
 	"github.com/tbechar/camp-manager-backend/internal/service"
 	"github.com/tbechar/camp-manager-backend/pkg/csvimport"
 	"github.com/tbechar/camp-manager-backend/pkg/csvimport/entities"
+	"github.com/tbechar/camp-manager-backend/pkg/storage"
 )
 
 // Handler aggregates all entity handlers and implements the ServerInterface
 type Handler struct {
-	activities     *ActivitiesHandler
-	areas          *AreasHandler
-	auth           *AuthHandler
-	campers        *CampersHandler
-	camps          *CampsHandler
-	certifications *CertificationsHandler
-	colors         *ColorsHandler
-	events         *EventsHandler
-	groups         *GroupsHandler
-	housingRooms   *HousingRoomsHandler
-	imports        *ImportsHandler
-	locations      *LocationsHandler
-	programs       *ProgramsHandler
-	roles          *RolesHandler
-	sessions       *SessionsHandler
-	staffMembers   *StaffMembersHandler
-	tenants        *TenantsHandler
-	timeBlocks     *TimeBlocksHandler
-	health         *HealthHandler
+	activities         *ActivitiesHandler
+	applications       *ApplicationsHandler
+	areas              *AreasHandler
+	attachments        *AttachmentsHandler
+	attendance         *AttendanceHandler
+	auth               *AuthHandler
+	bunkRequests       *BunkRequestsHandler
+	campers            *CampersHandler
+	camperEnrollments  *CamperEnrollmentsHandler
+	camperMerges       *CamperMergesHandler
+	camps              *CampsHandler
+	certifications     *CertificationsHandler
+	colors             *ColorsHandler
+	customFields       *CustomFieldsHandler
+	dutyRoster         *DutyRosterHandler
+	events             *EventsHandler
+	eligibility        *EligibilityHandler
+	groups             *GroupsHandler
+	guardians          *GuardiansHandler
+	housingAssignments *HousingAssignmentsHandler
+	housingRooms       *HousingRoomsHandler
+	beds               *BedsHandler
+	maintenance        *MaintenanceHandler
+	equipment          *EquipmentHandler
+	skills             *SkillsHandler
+	imports            *ImportsHandler
+	incidents          *IncidentsHandler
+	locations          *LocationsHandler
+	mar                *MarHandler
+	meals              *MealsHandler
+	medications        *MedicationsHandler
+	notes              *NotesHandler
+	programs           *ProgramsHandler
+	ratioCompliance    *RatioComplianceHandler
+	roles              *RolesHandler
+	sessions           *SessionsHandler
+	staffMembers       *StaffMembersHandler
+	tenants            *TenantsHandler
+	timeBlocks         *TimeBlocksHandler
+	timeline           *TimelineHandler
+	timesheets         *TimesheetsHandler
+	staffPortal        *StaffPortalHandler
+	onboarding         *OnboardingHandler
+	health             *HealthHandler
 }
 
 // NewHandler creates a new handler with all dependencies wired up
-func NewHandler(db *database.Database, cfg *config.Config) *Handler {
+func NewHandler(db *database.Database, cfg *config.Config, attachmentStorage storage.Storage) *Handler {
 	// Initialize repositories
 	activitiesRepo := repository.NewActivitiesRepository(db)
+	applicationsRepo := repository.NewApplicationsRepository(db)
 	areasRepo := repository.NewAreasRepository(db)
+	attachmentsRepo := repository.NewAttachmentsRepository(db)
+	attendanceRepo := repository.NewAttendanceRepository(db)
+	bunkRequestsRepo := repository.NewBunkRequestsRepository(db)
 	campersRepo := repository.NewCampersRepository(db)
+	camperEnrollmentsRepo := repository.NewCamperEnrollmentsRepository(db)
+	camperMergesRepo := repository.NewCamperMergesRepository(db)
 	campsRepo := repository.NewCampsRepository(db)
+	campCloneJobsRepo := repository.NewCampCloneJobsRepository(db)
 	certificationsRepo := repository.NewCertificationsRepository(db)
 	colorsRepo := repository.NewColorsRepository(db)
+	customFieldsRepo := repository.NewCustomFieldsRepository(db)
+	dutyRotationsRepo := repository.NewDutyRotationsRepository(db)
+	dutyShiftsRepo := repository.NewDutyShiftsRepository(db)
+	dutyTypesRepo := repository.NewDutyTypesRepository(db)
 	eventsRepo := repository.NewEventsRepository(db)
 	groupsRepo := repository.NewGroupsRepository(db)
+	guardiansRepo := repository.NewGuardiansRepository(db)
+	housingAssignmentsRepo := repository.NewHousingAssignmentsRepository(db)
 	housingRoomsRepo := repository.NewHousingRoomsRepository(db)
+	bedsRepo := repository.NewBedsRepository(db)
+	bedAssignmentsRepo := repository.NewBedAssignmentsRepository(db)
+	incidentsRepo := repository.NewIncidentsRepository(db)
 	locationsRepo := repository.NewLocationsRepository(db)
+	maintenanceTicketsRepo := repository.NewMaintenanceTicketsRepository(db)
+	equipmentItemsRepo := repository.NewEquipmentItemsRepository(db)
+	equipmentCheckoutsRepo := repository.NewEquipmentCheckoutsRepository(db)
+	skillTracksRepo := repository.NewSkillTracksRepository(db)
+	skillAssessmentsRepo := repository.NewSkillAssessmentsRepository(db)
+	mealPeriodsRepo := repository.NewMealPeriodsRepository(db)
+	medicationsRepo := repository.NewMedicationsRepository(db)
+	menusRepo := repository.NewMenusRepository(db)
+	notesRepo := repository.NewNotesRepository(db)
 	programsRepo := repository.NewProgramsRepository(db)
+	ratioPoliciesRepo := repository.NewRatioPoliciesRepository(db)
 	rolesRepo := repository.NewRolesRepository(db)
 	sessionsRepo := repository.NewSessionsRepository(db)
 	staffMembersRepo := repository.NewStaffMembersRepository(db)
 	tenantsRepo := repository.NewTenantsRepository(db)
+	timeEntriesRepo := repository.NewTimeEntriesRepository(db)
+	timeOffRequestsRepo := repository.NewTimeOffRequestsRepository(db)
+	onboardingTemplatesRepo := repository.NewOnboardingTemplatesRepository(db)
+	onboardingCompletionsRepo := repository.NewOnboardingCompletionsRepository(db)
 	timeBlocksRepo := repository.NewTimeBlocksRepository(db)
 	usersRepo := repository.NewUsersRepository(db)
 
@@ -65,8 +121,8 @@ func NewHandler(db *database.Database, cfg *config.Config) *Handler {
 	importJobsRepo := repository.NewImportJobsRepository(db)
 
 	// Create validators and mappers for import entities
-	camperValidator := entities.NewCamperImportValidator(sessionsRepo, groupsRepo)
-	camperMapper := entities.NewCamperImportMapper(sessionsRepo, groupsRepo)
+	camperValidator := entities.NewCamperImportValidator(sessionsRepo, groupsRepo, customFieldsRepo)
+	camperMapper := entities.NewCamperImportMapper(sessionsRepo, groupsRepo, customFieldsRepo)
 
 	validators := map[domain.ImportEntityType]csvimport.EntityValidator{
 		domain.ImportEntityTypeCampers: camperValidator,
@@ -77,23 +133,61 @@ func NewHandler(db *database.Database, cfg *config.Config) *Handler {
 	}
 
 	// Initialize services
-	eventsService := service.NewEventsService(eventsRepo, activitiesRepo, programsRepo, locationsRepo, groupsRepo)
-	activitiesService := service.NewActivitiesService(activitiesRepo, programsRepo, locationsRepo, timeBlocksRepo, certificationsRepo, eventsRepo)
+	eventsService := service.NewEventsService(eventsRepo, activitiesRepo, programsRepo, locationsRepo, maintenanceTicketsRepo, groupsRepo, staffMembersRepo, onboardingTemplatesRepo, onboardingCompletionsRepo, certificationsRepo, campsRepo, campersRepo, customFieldsRepo, skillTracksRepo)
+	eligibilityService := service.NewEligibilityService(activitiesRepo, eventsRepo, groupsRepo, campersRepo, customFieldsRepo, campsRepo, skillTracksRepo)
+	activitiesService := service.NewActivitiesService(activitiesRepo, programsRepo, locationsRepo, timeBlocksRepo, certificationsRepo, eventsRepo, customFieldsRepo, equipmentItemsRepo, skillTracksRepo)
+	applicationsService := service.NewApplicationsService(applicationsRepo, campersRepo, camperEnrollmentsRepo, guardiansRepo, sessionsRepo, groupsRepo, customFieldsRepo)
 	areasService := service.NewAreasService(areasRepo)
+	attachmentsService := service.NewAttachmentsService(
+		attachmentsRepo,
+		attachmentStorage,
+		jwtService,
+		campersRepo,
+		staffMembersRepo,
+		certificationsRepo,
+		incidentsRepo,
+		maintenanceTicketsRepo,
+		service.AttachmentsServiceConfig{
+			MaxSizeBytes:   cfg.Attachments.MaxSizeBytes,
+			DownloadURLTTL: cfg.Attachments.DownloadURLTTL,
+		},
+	)
+	attendanceService := service.NewAttendanceService(attendanceRepo, campsRepo, campersRepo, guardiansRepo, staffMembersRepo, groupsRepo, housingRoomsRepo)
 	authService := service.NewAuthService(usersRepo, tenantsRepo, jwtService)
-	campersService := service.NewCampersService(campersRepo, sessionsRepo, groupsRepo)
-	campsService := service.NewCampsService(campsRepo)
-	certificationsService := service.NewCertificationsService(certificationsRepo)
+	bunkRequestsService := service.NewBunkRequestsService(bunkRequestsRepo, campersRepo, camperEnrollmentsRepo, sessionsRepo)
+	campersService := service.NewCampersService(campersRepo, sessionsRepo, groupsRepo, camperEnrollmentsRepo, customFieldsRepo)
+	camperEnrollmentsService := service.NewCamperEnrollmentsService(camperEnrollmentsRepo, campersRepo, sessionsRepo, groupsRepo)
+	camperMergesService := service.NewCamperMergesService(camperMergesRepo, campersRepo, guardiansRepo, groupsRepo, sessionsRepo)
+	campsService := service.NewCampsService(campsRepo, campCloneJobsRepo)
+	certificationsService := service.NewCertificationsService(certificationsRepo, staffMembersRepo, eventsRepo, campsRepo)
 	colorsService := service.NewColorsService(colorsRepo)
-	groupsService := service.NewGroupsService(groupsRepo, sessionsRepo, housingRoomsRepo)
-	housingRoomsService := service.NewHousingRoomsService(housingRoomsRepo, areasRepo)
+	customFieldsService := service.NewCustomFieldsService(customFieldsRepo)
+	dutyRosterService := service.NewDutyRosterService(dutyTypesRepo, dutyRotationsRepo, dutyShiftsRepo, staffMembersRepo, certificationsRepo, eventsRepo, timeOffRequestsRepo, campsRepo)
+	groupsService := service.NewGroupsService(groupsRepo, sessionsRepo, housingRoomsRepo, campersRepo, customFieldsRepo)
+	guardiansService := service.NewGuardiansService(guardiansRepo, campersRepo)
+	housingAssignmentsService := service.NewHousingAssignmentsService(housingAssignmentsRepo, sessionsRepo, groupsRepo, housingRoomsRepo, staffMembersRepo, campersRepo, camperEnrollmentsRepo, bunkRequestsRepo)
+	housingRoomsService := service.NewHousingRoomsService(housingRoomsRepo, areasRepo, bedsRepo)
+	bedsService := service.NewBedsService(bedsRepo, bedAssignmentsRepo, housingRoomsRepo, maintenanceTicketsRepo, sessionsRepo, campersRepo, camperEnrollmentsRepo, staffMembersRepo, campsRepo)
+	maintenanceService := service.NewMaintenanceService(maintenanceTicketsRepo, locationsRepo, housingRoomsRepo, areasRepo, staffMembersRepo)
+	equipmentService := service.NewEquipmentService(equipmentItemsRepo, equipmentCheckoutsRepo, locationsRepo, staffMembersRepo, activitiesRepo, eventsRepo, groupsRepo, campersRepo, campsRepo)
+	skillsService := service.NewSkillsService(skillTracksRepo, skillAssessmentsRepo, campersRepo, staffMembersRepo, groupsRepo, sessionsRepo)
+	incidentsService := service.NewIncidentsService(incidentsRepo, campsRepo, campersRepo, staffMembersRepo, locationsRepo, eventsRepo, activitiesRepo)
 	locationsService := service.NewLocationsService(locationsRepo, areasRepo)
+	marService := service.NewMarService(medicationsRepo, campersRepo, campsRepo, camperEnrollmentsRepo, sessionsRepo)
+	mealsService := service.NewMealsService(mealPeriodsRepo, menusRepo, campsRepo, campersRepo, staffMembersRepo, attendanceRepo)
+	medicationsService := service.NewMedicationsService(medicationsRepo, campersRepo)
+	notesService := service.NewNotesService(notesRepo, campersRepo, staffMembersRepo)
 	programsService := service.NewProgramsService(programsRepo, colorsRepo, locationsRepo, groupsRepo, activitiesRepo, eventsRepo)
+	ratioComplianceService := service.NewRatioComplianceService(ratioPoliciesRepo, activitiesRepo, programsRepo, eventsRepo, groupsRepo, campersRepo, campsRepo)
 	rolesService := service.NewRolesService(rolesRepo)
-	sessionsService := service.NewSessionsService(sessionsRepo)
-	staffMembersService := service.NewStaffMembersService(staffMembersRepo, groupsRepo, rolesRepo)
+	sessionsService := service.NewSessionsService(sessionsRepo, groupsRepo, campersRepo)
+	staffMembersService := service.NewStaffMembersService(staffMembersRepo, groupsRepo, rolesRepo, customFieldsRepo, usersRepo, onboardingTemplatesRepo)
 	tenantsService := service.NewTenantsService(tenantsRepo)
 	timeBlocksService := service.NewTimeBlocksService(timeBlocksRepo)
+	timelineService := service.NewTimelineService(notesRepo, campersRepo, staffMembersRepo, attendanceRepo, incidentsRepo, groupsRepo)
+	timesheetsService := service.NewTimesheetsService(timeEntriesRepo, staffMembersRepo, eventsRepo, dutyShiftsRepo, dutyTypesRepo, campsRepo)
+	staffPortalService := service.NewStaffPortalService(staffMembersRepo, timeOffRequestsRepo, groupsRepo, campersRepo, eventsRepo, dutyShiftsRepo, dutyTypesRepo, campsRepo)
+	onboardingService := service.NewOnboardingService(onboardingTemplatesRepo, onboardingCompletionsRepo, staffMembersRepo, certificationsRepo, attachmentsRepo, campsRepo)
 
 	// Initialize import service
 	importService := service.NewImportService(
@@ -107,25 +201,50 @@ func NewHandler(db *database.Database, cfg *config.Config) *Handler {
 
 	// Initialize handlers
 	return &Handler{
-		activities:     NewActivitiesHandler(activitiesService),
-		areas:          NewAreasHandler(areasService),
-		auth:           NewAuthHandler(authService),
-		campers:        NewCampersHandler(campersService),
-		camps:          NewCampsHandler(campsService),
-		certifications: NewCertificationsHandler(certificationsService),
-		colors:         NewColorsHandler(colorsService),
-		events:         NewEventsHandler(eventsService),
-		groups:         NewGroupsHandler(groupsService),
-		housingRooms:   NewHousingRoomsHandler(housingRoomsService),
-		imports:        NewImportsHandler(importService),
-		locations:      NewLocationsHandler(locationsService),
-		programs:       NewProgramsHandler(programsService),
-		roles:          NewRolesHandler(rolesService),
-		sessions:       NewSessionsHandler(sessionsService),
-		staffMembers:   NewStaffMembersHandler(staffMembersService),
-		tenants:        NewTenantsHandler(tenantsService),
-		timeBlocks:     NewTimeBlocksHandler(timeBlocksService),
-		health:         NewHealthHandler(db),
+		activities:         NewActivitiesHandler(activitiesService),
+		applications:       NewApplicationsHandler(applicationsService),
+		areas:              NewAreasHandler(areasService),
+		attachments:        NewAttachmentsHandler(attachmentsService, cfg.Attachments.MaxSizeBytes),
+		attendance:         NewAttendanceHandler(attendanceService),
+		auth:               NewAuthHandler(authService),
+		bunkRequests:       NewBunkRequestsHandler(bunkRequestsService),
+		campers:            NewCampersHandler(campersService),
+		camperEnrollments:  NewCamperEnrollmentsHandler(camperEnrollmentsService),
+		camperMerges:       NewCamperMergesHandler(camperMergesService),
+		camps:              NewCampsHandler(campsService),
+		certifications:     NewCertificationsHandler(certificationsService),
+		colors:             NewColorsHandler(colorsService),
+		customFields:       NewCustomFieldsHandler(customFieldsService),
+		dutyRoster:         NewDutyRosterHandler(dutyRosterService),
+		events:             NewEventsHandler(eventsService),
+		eligibility:        NewEligibilityHandler(eligibilityService),
+		groups:             NewGroupsHandler(groupsService),
+		guardians:          NewGuardiansHandler(guardiansService),
+		housingAssignments: NewHousingAssignmentsHandler(housingAssignmentsService),
+		housingRooms:       NewHousingRoomsHandler(housingRoomsService),
+		beds:               NewBedsHandler(bedsService),
+		maintenance:        NewMaintenanceHandler(maintenanceService),
+		equipment:          NewEquipmentHandler(equipmentService),
+		skills:             NewSkillsHandler(skillsService),
+		imports:            NewImportsHandler(importService),
+		incidents:          NewIncidentsHandler(incidentsService),
+		locations:          NewLocationsHandler(locationsService),
+		mar:                NewMarHandler(marService),
+		meals:              NewMealsHandler(mealsService),
+		medications:        NewMedicationsHandler(medicationsService),
+		notes:              NewNotesHandler(notesService),
+		programs:           NewProgramsHandler(programsService),
+		ratioCompliance:    NewRatioComplianceHandler(ratioComplianceService),
+		roles:              NewRolesHandler(rolesService),
+		sessions:           NewSessionsHandler(sessionsService),
+		staffMembers:       NewStaffMembersHandler(staffMembersService),
+		tenants:            NewTenantsHandler(tenantsService),
+		timeBlocks:         NewTimeBlocksHandler(timeBlocksService),
+		timeline:           NewTimelineHandler(timelineService),
+		timesheets:         NewTimesheetsHandler(timesheetsService),
+		staffPortal:        NewStaffPortalHandler(staffPortalService),
+		onboarding:         NewOnboardingHandler(onboardingService),
+		health:             NewHealthHandler(db),
 	}
 }
 
@@ -151,6 +270,36 @@ func (h *Handler) DeleteActivityById(w http.ResponseWriter, r *http.Request, cam
 	h.activities.DeleteActivityById(w, r, campId, id, params)
 }
 
+// Applications handlers - delegate to ApplicationsHandler
+
+func (h *Handler) ListApplications(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListApplicationsParams) {
+	h.applications.ListApplications(w, r, campId, params)
+}
+
+func (h *Handler) CreateApplication(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.applications.CreateApplication(w, r, campId)
+}
+
+func (h *Handler) GetApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.applications.GetApplicationById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.applications.UpdateApplicationById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteApplicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.applications.DeleteApplicationById(w, r, campId, id)
+}
+
+func (h *Handler) ListApplicationTransitions(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.applications.ListApplicationTransitions(w, r, campId, id)
+}
+
+func (h *Handler) TransitionApplication(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.applications.TransitionApplication(w, r, campId, id)
+}
+
 // Areas handlers - delegate to AreasHandler
 
 func (h *Handler) ListAreas(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListAreasParams) {
@@ -195,6 +344,80 @@ func (h *Handler) DeleteCampById(w http.ResponseWriter, r *http.Request, id api.
 	h.camps.DeleteCampById(w, r, id)
 }
 
+func (h *Handler) CloneCamp(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.camps.CloneCamp(w, r, campId)
+}
+
+func (h *Handler) ListCampCloneJobs(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.camps.ListCampCloneJobs(w, r, campId)
+}
+
+func (h *Handler) GetCampCloneJobById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.camps.GetCampCloneJobById(w, r, campId, id)
+}
+
+// Attachments handlers - delegate to AttachmentsHandler
+
+func (h *Handler) ListAttachments(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListAttachmentsParams) {
+	h.attachments.ListAttachments(w, r, campId, params)
+}
+
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.attachments.UploadAttachment(w, r, campId)
+}
+
+func (h *Handler) GetAttachmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.attachments.GetAttachmentById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteAttachmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.attachments.DeleteAttachmentById(w, r, campId, id)
+}
+
+func (h *Handler) CreateAttachmentDownloadUrl(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.attachments.CreateAttachmentDownloadUrl(w, r, campId, id)
+}
+
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request, token string) {
+	h.attachments.DownloadAttachment(w, r, token)
+}
+
+// Attendance handlers - delegate to AttendanceHandler
+
+func (h *Handler) ListAttendanceRecords(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListAttendanceRecordsParams) {
+	h.attendance.ListAttendanceRecords(w, r, campId, params)
+}
+
+func (h *Handler) CheckInCamper(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.attendance.CheckInCamper(w, r, campId)
+}
+
+func (h *Handler) CheckOutCamper(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.attendance.CheckOutCamper(w, r, campId)
+}
+
+func (h *Handler) DeleteAttendanceRecord(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.attendance.DeleteAttendanceRecord(w, r, campId, id)
+}
+
+func (h *Handler) GetOnSiteReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetOnSiteReportParams) {
+	h.attendance.GetOnSiteReport(w, r, campId, params)
+}
+
+// Bunk Requests handlers - delegate to BunkRequestsHandler
+
+func (h *Handler) ListBunkRequests(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBunkRequestsParams) {
+	h.bunkRequests.ListBunkRequests(w, r, campId, params)
+}
+
+func (h *Handler) CreateBunkRequest(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.bunkRequests.CreateBunkRequest(w, r, campId)
+}
+
+func (h *Handler) DeleteBunkRequest(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.bunkRequests.DeleteBunkRequest(w, r, campId, id)
+}
+
 // Campers handlers - delegate to CampersHandler
 
 func (h *Handler) ListCampers(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListCampersParams) {
@@ -239,6 +462,10 @@ func (h *Handler) DeleteCertificationById(w http.ResponseWriter, r *http.Request
 	h.certifications.DeleteCertificationById(w, r, campId, id)
 }
 
+func (h *Handler) GetCertificationCompliance(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetCertificationComplianceParams) {
+	h.certifications.GetCertificationCompliance(w, r, campId, params)
+}
+
 // Colors handlers - delegate to ColorsHandler
 
 func (h *Handler) ListColors(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListColorsParams) {
@@ -261,6 +488,102 @@ func (h *Handler) DeleteColorById(w http.ResponseWriter, r *http.Request, campId
 	h.colors.DeleteColorById(w, r, campId, id)
 }
 
+// Custom Fields handlers - delegate to CustomFieldsHandler
+
+func (h *Handler) ListCustomFields(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListCustomFieldsParams) {
+	h.customFields.ListCustomFields(w, r, campId, params)
+}
+
+func (h *Handler) CreateCustomField(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.customFields.CreateCustomField(w, r, campId)
+}
+
+func (h *Handler) GetCustomFieldById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.customFields.GetCustomFieldById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateCustomFieldById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.customFields.UpdateCustomFieldById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteCustomFieldById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.customFields.DeleteCustomFieldById(w, r, campId, id)
+}
+
+// Duty Roster handlers - delegate to DutyRosterHandler
+
+func (h *Handler) ListDutyTypes(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.ListDutyTypes(w, r, campId)
+}
+
+func (h *Handler) CreateDutyType(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.CreateDutyType(w, r, campId)
+}
+
+func (h *Handler) GetDutyTypeById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.GetDutyTypeById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateDutyTypeById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.UpdateDutyTypeById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteDutyTypeById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.DeleteDutyTypeById(w, r, campId, id)
+}
+
+func (h *Handler) ListDutyRotations(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.ListDutyRotations(w, r, campId)
+}
+
+func (h *Handler) CreateDutyRotation(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.CreateDutyRotation(w, r, campId)
+}
+
+func (h *Handler) GetDutyRotationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.GetDutyRotationById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateDutyRotationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.UpdateDutyRotationById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteDutyRotationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.DeleteDutyRotationById(w, r, campId, id)
+}
+
+func (h *Handler) GenerateDutyRotationShifts(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.GenerateDutyRotationShifts(w, r, campId, id)
+}
+
+func (h *Handler) ListDutyShifts(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListDutyShiftsParams) {
+	h.dutyRoster.ListDutyShifts(w, r, campId, params)
+}
+
+func (h *Handler) CreateDutyShift(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.CreateDutyShift(w, r, campId)
+}
+
+func (h *Handler) GetDutyShiftById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.GetDutyShiftById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateDutyShiftById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.UpdateDutyShiftById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteDutyShiftById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.dutyRoster.DeleteDutyShiftById(w, r, campId, id)
+}
+
+func (h *Handler) AutoAssignDutyShifts(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.dutyRoster.AutoAssignDutyShifts(w, r, campId)
+}
+
+func (h *Handler) GetDutyShiftConflicts(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetDutyShiftConflictsParams) {
+	h.dutyRoster.GetDutyShiftConflicts(w, r, campId, params)
+}
+
 // Events handlers - delegate to EventsHandler
 
 func (h *Handler) ListEvents(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListEventsParams) {
@@ -283,6 +606,16 @@ func (h *Handler) DeleteEventById(w http.ResponseWriter, r *http.Request, campId
 	h.events.DeleteEventById(w, r, campId, id, params)
 }
 
+// Eligibility handlers - delegate to EligibilityHandler
+
+func (h *Handler) GetEventEligibility(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.eligibility.GetEventEligibility(w, r, campId, id)
+}
+
+func (h *Handler) GetEligibilityReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetEligibilityReportParams) {
+	h.eligibility.GetEligibilityReport(w, r, campId, params)
+}
+
 // Groups handlers - delegate to GroupsHandler
 
 func (h *Handler) ListGroups(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListGroupsParams) {
@@ -305,6 +638,86 @@ func (h *Handler) DeleteGroupById(w http.ResponseWriter, r *http.Request, campId
 	h.groups.DeleteGroupById(w, r, campId, id)
 }
 
+// Camper Enrollments handlers - delegate to CamperEnrollmentsHandler
+
+func (h *Handler) ListCamperEnrollments(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.camperEnrollments.ListCamperEnrollments(w, r, campId, id)
+}
+
+func (h *Handler) CreateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.camperEnrollments.CreateCamperEnrollment(w, r, campId, id)
+}
+
+func (h *Handler) UpdateCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, enrollmentId openapi_types.UUID) {
+	h.camperEnrollments.UpdateCamperEnrollment(w, r, campId, id, enrollmentId)
+}
+
+func (h *Handler) DeleteCamperEnrollment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, enrollmentId openapi_types.UUID) {
+	h.camperEnrollments.DeleteCamperEnrollment(w, r, campId, id, enrollmentId)
+}
+
+// Camper Merges handlers - delegate to CamperMergesHandler
+
+func (h *Handler) ListCamperDuplicates(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListCamperDuplicatesParams) {
+	h.camperMerges.ListCamperDuplicates(w, r, campId, params)
+}
+
+func (h *Handler) MergeCamper(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.camperMerges.MergeCamper(w, r, campId, id)
+}
+
+func (h *Handler) ListCamperMerges(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.camperMerges.ListCamperMerges(w, r, campId)
+}
+
+// Guardians handlers - delegate to GuardiansHandler
+
+func (h *Handler) ListGuardians(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListGuardiansParams) {
+	h.guardians.ListGuardians(w, r, campId, params)
+}
+
+func (h *Handler) CreateGuardian(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.guardians.CreateGuardian(w, r, campId)
+}
+
+func (h *Handler) GetGuardianById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.guardians.GetGuardianById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateGuardianById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.guardians.UpdateGuardianById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteGuardianById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.guardians.DeleteGuardianById(w, r, campId, id)
+}
+
+func (h *Handler) GetCamperContacts(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.guardians.GetCamperContacts(w, r, campId, id)
+}
+
+func (h *Handler) GetCamperFamily(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.guardians.GetCamperFamily(w, r, campId, id)
+}
+
+// Housing Assignments handlers - delegate to HousingAssignmentsHandler
+
+func (h *Handler) ListHousingAssignments(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListHousingAssignmentsParams) {
+	h.housingAssignments.ListHousingAssignments(w, r, campId, params)
+}
+
+func (h *Handler) CreateHousingAssignment(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.housingAssignments.CreateHousingAssignment(w, r, campId)
+}
+
+func (h *Handler) GetHousingAssignmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.housingAssignments.GetHousingAssignmentById(w, r, campId, id)
+}
+
+func (h *Handler) AcceptHousingAssignment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.housingAssignments.AcceptHousingAssignment(w, r, campId, id)
+}
+
 // Housing Rooms handlers - delegate to HousingRoomsHandler
 
 func (h *Handler) ListHousingRooms(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListHousingRoomsParams) {
@@ -327,6 +740,180 @@ func (h *Handler) DeleteHousingRoomById(w http.ResponseWriter, r *http.Request,
 	h.housingRooms.DeleteHousingRoomById(w, r, campId, id)
 }
 
+// Beds handlers - delegate to BedsHandler
+
+func (h *Handler) ListBeds(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBedsParams) {
+	h.beds.ListBeds(w, r, campId, params)
+}
+
+func (h *Handler) CreateBed(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.beds.CreateBed(w, r, campId)
+}
+
+func (h *Handler) GetBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.beds.GetBedById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.beds.UpdateBedById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteBedById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.beds.DeleteBedById(w, r, campId, id)
+}
+
+func (h *Handler) ListBedAssignments(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListBedAssignmentsParams) {
+	h.beds.ListBedAssignments(w, r, campId, params)
+}
+
+func (h *Handler) CreateBedAssignment(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.beds.CreateBedAssignment(w, r, campId)
+}
+
+func (h *Handler) UpdateBedAssignmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.beds.UpdateBedAssignmentById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteBedAssignmentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.beds.DeleteBedAssignmentById(w, r, campId, id)
+}
+
+func (h *Handler) GetHousingOccupancy(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetHousingOccupancyParams) {
+	h.beds.GetHousingOccupancy(w, r, campId, params)
+}
+
+// Maintenance handlers - delegate to MaintenanceHandler
+
+func (h *Handler) ListMaintenanceTickets(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListMaintenanceTicketsParams) {
+	h.maintenance.ListMaintenanceTickets(w, r, campId, params)
+}
+
+func (h *Handler) CreateMaintenanceTicket(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.maintenance.CreateMaintenanceTicket(w, r, campId)
+}
+
+func (h *Handler) GetMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.maintenance.GetMaintenanceTicketById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.maintenance.UpdateMaintenanceTicketById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteMaintenanceTicketById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.maintenance.DeleteMaintenanceTicketById(w, r, campId, id)
+}
+
+func (h *Handler) ListOutOfServicePeriods(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListOutOfServicePeriodsParams) {
+	h.maintenance.ListOutOfServicePeriods(w, r, campId, params)
+}
+
+// Equipment handlers - delegate to EquipmentHandler
+
+func (h *Handler) ListEquipmentItems(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListEquipmentItemsParams) {
+	h.equipment.ListEquipmentItems(w, r, campId, params)
+}
+
+func (h *Handler) CreateEquipmentItem(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.equipment.CreateEquipmentItem(w, r, campId)
+}
+
+func (h *Handler) GetEquipmentItemById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.equipment.GetEquipmentItemById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateEquipmentItemById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.equipment.UpdateEquipmentItemById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteEquipmentItemById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.equipment.DeleteEquipmentItemById(w, r, campId, id)
+}
+
+func (h *Handler) ListEquipmentCheckouts(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, params api.ListEquipmentCheckoutsParams) {
+	h.equipment.ListEquipmentCheckouts(w, r, campId, id, params)
+}
+
+func (h *Handler) CheckOutEquipment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.equipment.CheckOutEquipment(w, r, campId, id)
+}
+
+func (h *Handler) ReturnEquipment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, checkoutId api.CheckoutId) {
+	h.equipment.ReturnEquipment(w, r, campId, id, checkoutId)
+}
+
+func (h *Handler) GetEquipmentConflicts(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetEquipmentConflictsParams) {
+	h.equipment.GetEquipmentConflicts(w, r, campId, params)
+}
+
+// Skills handlers - delegate to SkillsHandler
+
+func (h *Handler) ListSkillTracks(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.skills.ListSkillTracks(w, r, campId)
+}
+
+func (h *Handler) CreateSkillTrack(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.skills.CreateSkillTrack(w, r, campId)
+}
+
+func (h *Handler) GetSkillTrackById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.skills.GetSkillTrackById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateSkillTrackById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.skills.UpdateSkillTrackById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteSkillTrackById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.skills.DeleteSkillTrackById(w, r, campId, id)
+}
+
+func (h *Handler) GetCamperSkills(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.skills.GetCamperSkills(w, r, campId, id)
+}
+
+func (h *Handler) CreateSkillAssessment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.skills.CreateSkillAssessment(w, r, campId, id)
+}
+
+func (h *Handler) DeleteSkillAssessment(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, assessmentId api.AssessmentId) {
+	h.skills.DeleteSkillAssessment(w, r, campId, id, assessmentId)
+}
+
+// Incidents handlers - delegate to IncidentsHandler
+
+func (h *Handler) ListIncidents(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListIncidentsParams) {
+	h.incidents.ListIncidents(w, r, campId, params)
+}
+
+func (h *Handler) CreateIncident(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.incidents.CreateIncident(w, r, campId)
+}
+
+func (h *Handler) GetIncidentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.incidents.GetIncidentById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateIncidentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.incidents.UpdateIncidentById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteIncidentById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.incidents.DeleteIncidentById(w, r, campId, id)
+}
+
+func (h *Handler) SubmitIncident(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.incidents.SubmitIncident(w, r, campId, id)
+}
+
+func (h *Handler) ReviewIncident(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.incidents.ReviewIncident(w, r, campId, id)
+}
+
+func (h *Handler) GetIncidentReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetIncidentReportParams) {
+	h.incidents.GetIncidentReport(w, r, campId, params)
+}
+
 // Locations handlers - delegate to LocationsHandler
 
 func (h *Handler) ListLocations(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListLocationsParams) {
@@ -349,6 +936,114 @@ func (h *Handler) DeleteLocationById(w http.ResponseWriter, r *http.Request, cam
 	h.locations.DeleteLocationById(w, r, campId, id)
 }
 
+// MAR handlers - delegate to MarHandler
+
+func (h *Handler) ListDueDoses(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListDueDosesParams) {
+	h.mar.ListDueDoses(w, r, campId, params)
+}
+
+func (h *Handler) GetMarReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetMarReportParams) {
+	h.mar.GetMarReport(w, r, campId, params)
+}
+
+func (h *Handler) RecordMedicationDose(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.mar.RecordMedicationDose(w, r, campId)
+}
+
+func (h *Handler) UpdateMedicationDose(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.mar.UpdateMedicationDose(w, r, campId, id)
+}
+
+// Meals handlers - delegate to MealsHandler
+
+func (h *Handler) ListMealPeriods(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.meals.ListMealPeriods(w, r, campId)
+}
+
+func (h *Handler) CreateMealPeriod(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.meals.CreateMealPeriod(w, r, campId)
+}
+
+func (h *Handler) GetMealPeriodById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.GetMealPeriodById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateMealPeriodById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.UpdateMealPeriodById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteMealPeriodById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.DeleteMealPeriodById(w, r, campId, id)
+}
+
+func (h *Handler) ListMenus(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListMenusParams) {
+	h.meals.ListMenus(w, r, campId, params)
+}
+
+func (h *Handler) CreateMenu(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.meals.CreateMenu(w, r, campId)
+}
+
+func (h *Handler) GetMenuById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.GetMenuById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateMenuById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.UpdateMenuById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteMenuById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.meals.DeleteMenuById(w, r, campId, id)
+}
+
+func (h *Handler) GetMealHeadcounts(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetMealHeadcountsParams) {
+	h.meals.GetMealHeadcounts(w, r, campId, params)
+}
+
+// Medications handlers - delegate to MedicationsHandler
+
+func (h *Handler) ListMedications(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListMedicationsParams) {
+	h.medications.ListMedications(w, r, campId, params)
+}
+
+func (h *Handler) CreateMedication(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.medications.CreateMedication(w, r, campId)
+}
+
+func (h *Handler) GetMedicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.medications.GetMedicationById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateMedicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.medications.UpdateMedicationById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteMedicationById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.medications.DeleteMedicationById(w, r, campId, id)
+}
+
+// Notes handlers - delegate to NotesHandler
+
+func (h *Handler) ListNotes(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListNotesParams) {
+	h.notes.ListNotes(w, r, campId, params)
+}
+
+func (h *Handler) CreateNote(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.notes.CreateNote(w, r, campId)
+}
+
+func (h *Handler) GetNoteById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.notes.GetNoteById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateNoteById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.notes.UpdateNoteById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteNoteById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.notes.DeleteNoteById(w, r, campId, id)
+}
+
 // Programs handlers - delegate to ProgramsHandler
 
 func (h *Handler) ListPrograms(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListProgramsParams) {
@@ -371,6 +1066,32 @@ func (h *Handler) DeleteProgramById(w http.ResponseWriter, r *http.Request, camp
 	h.programs.DeleteProgramById(w, r, campId, id, params)
 }
 
+// Ratio Compliance handlers - delegate to RatioComplianceHandler
+
+func (h *Handler) ListRatioPolicies(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.ratioCompliance.ListRatioPolicies(w, r, campId)
+}
+
+func (h *Handler) CreateRatioPolicy(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.ratioCompliance.CreateRatioPolicy(w, r, campId)
+}
+
+func (h *Handler) GetRatioPolicyById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.ratioCompliance.GetRatioPolicyById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateRatioPolicyById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.ratioCompliance.UpdateRatioPolicyById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteRatioPolicyById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.ratioCompliance.DeleteRatioPolicyById(w, r, campId, id)
+}
+
+func (h *Handler) GetRatioCompliance(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetRatioComplianceParams) {
+	h.ratioCompliance.GetRatioCompliance(w, r, campId, params)
+}
+
 // Roles handlers - delegate to RolesHandler
 
 func (h *Handler) ListRoles(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListRolesParams) {
@@ -437,6 +1158,10 @@ func (h *Handler) DeleteStaffMemberById(w http.ResponseWriter, r *http.Request,
 	h.staffMembers.DeleteStaffMemberById(w, r, campId, id)
 }
 
+func (h *Handler) VerifyStaffMemberCertification(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.staffMembers.VerifyStaffMemberCertification(w, r, campId, id)
+}
+
 // Authentication handlers - delegate to AuthHandler
 
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
@@ -487,6 +1212,140 @@ func (h *Handler) DeleteTimeBlockById(w http.ResponseWriter, r *http.Request, ca
 	h.timeBlocks.DeleteTimeBlockById(w, r, campId, id)
 }
 
+// Timesheets handlers - delegate to TimesheetsHandler
+
+func (h *Handler) ListTimeEntries(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListTimeEntriesParams) {
+	h.timesheets.ListTimeEntries(w, r, campId, params)
+}
+
+func (h *Handler) CreateTimeEntry(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.timesheets.CreateTimeEntry(w, r, campId)
+}
+
+func (h *Handler) GetTimeEntryById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.GetTimeEntryById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateTimeEntryById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.UpdateTimeEntryById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteTimeEntryById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.DeleteTimeEntryById(w, r, campId, id)
+}
+
+func (h *Handler) ReviewTimeEntry(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.ReviewTimeEntry(w, r, campId, id)
+}
+
+func (h *Handler) ClockInStaffMember(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.ClockInStaffMember(w, r, campId, id)
+}
+
+func (h *Handler) ClockOutStaffMember(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.timesheets.ClockOutStaffMember(w, r, campId, id)
+}
+
+func (h *Handler) GetTimesheets(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetTimesheetsParams) {
+	h.timesheets.GetTimesheets(w, r, campId, params)
+}
+
+func (h *Handler) ExportTimesheets(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ExportTimesheetsParams) {
+	h.timesheets.ExportTimesheets(w, r, campId, params)
+}
+
+// Staff portal handlers - delegate to StaffPortalHandler
+
+func (h *Handler) GetMyStaffMember(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.GetMyStaffMember(w, r, campId)
+}
+
+func (h *Handler) GetMySchedule(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetMyScheduleParams) {
+	h.staffPortal.GetMySchedule(w, r, campId, params)
+}
+
+func (h *Handler) ListMyGroups(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.ListMyGroups(w, r, campId)
+}
+
+func (h *Handler) ListMyCampers(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.ListMyCampers(w, r, campId)
+}
+
+func (h *Handler) GetMyAvailability(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.GetMyAvailability(w, r, campId)
+}
+
+func (h *Handler) UpdateMyAvailability(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.UpdateMyAvailability(w, r, campId)
+}
+
+func (h *Handler) ListMyTimeOffRequests(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.ListMyTimeOffRequests(w, r, campId)
+}
+
+func (h *Handler) CreateMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.staffPortal.CreateMyTimeOffRequest(w, r, campId)
+}
+
+func (h *Handler) CancelMyTimeOffRequest(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.staffPortal.CancelMyTimeOffRequest(w, r, campId, id)
+}
+
+func (h *Handler) GetStaffMemberAvailability(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.staffPortal.GetStaffMemberAvailability(w, r, campId, id)
+}
+
+func (h *Handler) ListTimeOffRequests(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListTimeOffRequestsParams) {
+	h.staffPortal.ListTimeOffRequests(w, r, campId, params)
+}
+
+func (h *Handler) ReviewTimeOffRequest(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.staffPortal.ReviewTimeOffRequest(w, r, campId, id)
+}
+
+// Onboarding handlers - delegate to OnboardingHandler
+
+func (h *Handler) ListOnboardingTemplates(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.onboarding.ListOnboardingTemplates(w, r, campId)
+}
+
+func (h *Handler) CreateOnboardingTemplate(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.onboarding.CreateOnboardingTemplate(w, r, campId)
+}
+
+func (h *Handler) GetOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.onboarding.GetOnboardingTemplateById(w, r, campId, id)
+}
+
+func (h *Handler) UpdateOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.onboarding.UpdateOnboardingTemplateById(w, r, campId, id)
+}
+
+func (h *Handler) DeleteOnboardingTemplateById(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.onboarding.DeleteOnboardingTemplateById(w, r, campId, id)
+}
+
+func (h *Handler) GetStaffMemberOnboarding(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	h.onboarding.GetStaffMemberOnboarding(w, r, campId, id)
+}
+
+func (h *Handler) CompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, itemId api.OnboardingItemId) {
+	h.onboarding.CompleteStaffMemberOnboardingItem(w, r, campId, id, itemId)
+}
+
+func (h *Handler) UncompleteStaffMemberOnboardingItem(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, itemId api.OnboardingItemId) {
+	h.onboarding.UncompleteStaffMemberOnboardingItem(w, r, campId, id, itemId)
+}
+
+func (h *Handler) GetOnboardingReport(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.GetOnboardingReportParams) {
+	h.onboarding.GetOnboardingReport(w, r, campId, params)
+}
+
+func (h *Handler) GetMyOnboarding(w http.ResponseWriter, r *http.Request, campId api.CampId) {
+	h.onboarding.GetMyOnboarding(w, r, campId)
+}
+
 // Import handlers - delegate to ImportsHandler
 
 func (h *Handler) ListImportJobs(w http.ResponseWriter, r *http.Request, campId api.CampId, params api.ListImportJobsParams) {
@@ -508,3 +1367,13 @@ func (h *Handler) StartImport(w http.ResponseWriter, r *http.Request, campId api
 func (h *Handler) GetImportTemplate(w http.ResponseWriter, r *http.Request, campId api.CampId, entityType api.ImportEntityType) {
 	h.imports.GetTemplate(w, r, campId.String(), string(entityType))
 }
+
+// Timeline handlers - delegate to TimelineHandler
+
+func (h *Handler) GetCamperTimeline(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, params api.GetCamperTimelineParams) {
+	h.timeline.GetCamperTimeline(w, r, campId, id, params)
+}
+
+func (h *Handler) GetStaffMemberTimeline(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, params api.GetStaffMemberTimelineParams) {
+	h.timeline.GetStaffMemberTimeline(w, r, campId, id, params)
+}
@@ -223,3 +223,46 @@ func (h *StaffMembersHandler) DeleteStaffMemberById(w http.ResponseWriter, r *ht
 	// Write no content response
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// VerifyStaffMemberCertification handles POST /api/v1/camps/{camp_id}/staff-members/{id}/certifications/verify
+func (h *StaffMembersHandler) VerifyStaffMemberCertification(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id) {
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	staffMemberID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid staff member ID", err))
+		return
+	}
+
+	// Parse request body
+	var req api.StaffCertificationVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid request body", err))
+		return
+	}
+
+	// Call service
+	staffMember, err := h.service.VerifyCertification(r.Context(), tenantID, campUUID, staffMemberID, &req)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, staffMember); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/service"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	"github.com/tbechar/camp-manager-backend/pkg/errors"
+)
+
+// TimelineHandler handles person timeline HTTP requests
+type TimelineHandler struct {
+	service service.TimelineService
+}
+
+// NewTimelineHandler creates a new timeline handler
+func NewTimelineHandler(service service.TimelineService) *TimelineHandler {
+	return &TimelineHandler{
+		service: service,
+	}
+}
+
+// GetCamperTimeline handles GET /api/v1/camps/{camp_id}/campers/{id}/timeline
+func (h *TimelineHandler) GetCamperTimeline(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, params api.GetCamperTimelineParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	camperID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid camper ID", err))
+		return
+	}
+
+	// Call service
+	timeline, err := h.service.CamperTimeline(r.Context(), tenantID, campUUID, camperID, params.From, params.To)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, timeline); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
+
+// GetStaffMemberTimeline handles GET /api/v1/camps/{camp_id}/staff-members/{id}/timeline
+func (h *TimelineHandler) GetStaffMemberTimeline(w http.ResponseWriter, r *http.Request, campId api.CampId, id api.Id, params api.GetStaffMemberTimelineParams) {
+	// Extract tenant ID from context
+	tenantIDStr, err := pkgcontext.ExtractTenantID(r.Context())
+	if err != nil {
+		errors.WriteError(w, errors.Unauthorized("Tenant ID not found in context", err))
+		return
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid tenant ID", err))
+		return
+	}
+
+	campUUID := uuid.UUID(campId)
+
+	staffMemberID, err := uuid.Parse(id)
+	if err != nil {
+		errors.WriteError(w, errors.BadRequest("Invalid staff member ID", err))
+		return
+	}
+
+	// Call service
+	timeline, err := h.service.StaffMemberTimeline(r.Context(), tenantID, campUUID, staffMemberID, params.From, params.To)
+	if err != nil {
+		errors.WriteError(w, err)
+		return
+	}
+
+	// Write response
+	if err := errors.WriteJSON(w, http.StatusOK, timeline); err != nil {
+		errors.WriteError(w, errors.InternalServerError("Failed to write response", err))
+		return
+	}
+}
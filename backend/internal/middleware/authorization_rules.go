@@ -42,6 +42,10 @@ var OperationRoles = map[string][]string{
 	"getEventById":        {"admin", "program-admin", "viewer"},
 	"updateEventById":     {"admin", "program-admin"},
 	"deleteEventById":     {"admin", "program-admin"},
+	"getEventEligibility": {"admin", "program-admin", "viewer"},
+
+	// Activity eligibility - read-only report of ineligible campers in events
+	"getEligibilityReport": {"admin", "program-admin", "viewer"},
 
 	// Campers - admin only for CUD, all for read
 	"listCampers":         {"admin", "program-admin", "viewer"},
@@ -49,6 +53,147 @@ var OperationRoles = map[string][]string{
 	"getCamperById":       {"admin", "program-admin", "viewer"},
 	"updateCamperById":    {"admin"},
 	"deleteCamperById":    {"admin"},
+	"getCamperContacts":   {"admin", "program-admin", "viewer"},
+	"getCamperFamily":     {"admin", "program-admin", "viewer"},
+	"listCamperEnrollments":  {"admin", "program-admin", "viewer"},
+	"createCamperEnrollment": {"admin"},
+	"updateCamperEnrollment": {"admin"},
+	"deleteCamperEnrollment": {"admin"},
+	"listCamperDuplicates":   {"admin", "program-admin"},
+	"mergeCamper":            {"admin"},
+	"listCamperMerges":       {"admin"},
+	"getCamperTimeline":      {"admin", "program-admin", "viewer", "health"},
+
+	// Applications - admin only for CUD and transitions, all for read
+	"listApplications":           {"admin", "program-admin", "viewer"},
+	"createApplication":          {"admin"},
+	"getApplicationById":         {"admin", "program-admin", "viewer"},
+	"updateApplicationById":      {"admin"},
+	"deleteApplicationById":      {"admin"},
+	"listApplicationTransitions": {"admin", "program-admin", "viewer"},
+	"transitionApplication":      {"admin"},
+
+	// Guardians - admin only for CUD, all for read
+	"listGuardians":       {"admin", "program-admin", "viewer"},
+	"createGuardian":      {"admin"},
+	"getGuardianById":     {"admin", "program-admin", "viewer"},
+	"updateGuardianById":  {"admin"},
+	"deleteGuardianById":  {"admin"},
+
+	// Incidents - admin, program-admin and health staff file reports, admin only for review and delete
+	"listIncidents":      {"admin", "program-admin", "health"},
+	"createIncident":     {"admin", "program-admin", "health"},
+	"getIncidentById":    {"admin", "program-admin", "health"},
+	"updateIncidentById": {"admin", "program-admin", "health"},
+	"deleteIncidentById": {"admin"},
+	"submitIncident":     {"admin", "program-admin", "health"},
+	"reviewIncident":     {"admin"},
+	"getIncidentReport":  {"admin", "program-admin", "health"},
+
+	// Notes - visibility and authorship are further checked by the notes service
+	"listNotes":      {"admin", "program-admin", "viewer", "health"},
+	"createNote":     {"admin", "program-admin", "health"},
+	"getNoteById":    {"admin", "program-admin", "viewer", "health"},
+	"updateNoteById": {"admin", "program-admin", "health"},
+	"deleteNoteById": {"admin", "program-admin", "health"},
+
+	// Meal planning - meal periods, menus and dietary headcounts
+	"listMealPeriods":      {"admin", "program-admin", "viewer", "health"},
+	"createMealPeriod":     {"admin"},
+	"getMealPeriodById":    {"admin", "program-admin", "viewer", "health"},
+	"updateMealPeriodById": {"admin"},
+	"deleteMealPeriodById": {"admin"},
+	"listMenus":            {"admin", "program-admin", "viewer", "health"},
+	"createMenu":           {"admin", "program-admin"},
+	"getMenuById":          {"admin", "program-admin", "viewer", "health"},
+	"updateMenuById":       {"admin", "program-admin"},
+	"deleteMenuById":       {"admin", "program-admin"},
+	"getMealHeadcounts":    {"admin", "program-admin", "viewer", "health"},
+
+	// Duty roster - duty types, rotation templates, shifts and conflicts
+	"listDutyTypes":              {"admin", "program-admin", "viewer"},
+	"createDutyType":             {"admin"},
+	"getDutyTypeById":            {"admin", "program-admin", "viewer"},
+	"updateDutyTypeById":         {"admin"},
+	"deleteDutyTypeById":         {"admin"},
+	"listDutyRotations":          {"admin", "program-admin", "viewer"},
+	"createDutyRotation":         {"admin", "program-admin"},
+	"getDutyRotationById":        {"admin", "program-admin", "viewer"},
+	"updateDutyRotationById":     {"admin", "program-admin"},
+	"deleteDutyRotationById":     {"admin", "program-admin"},
+	"generateDutyRotationShifts": {"admin", "program-admin"},
+	"listDutyShifts":             {"admin", "program-admin", "viewer"},
+	"createDutyShift":            {"admin", "program-admin"},
+	"getDutyShiftById":           {"admin", "program-admin", "viewer"},
+	"updateDutyShiftById":        {"admin", "program-admin"},
+	"deleteDutyShiftById":        {"admin", "program-admin"},
+	"autoAssignDutyShifts":       {"admin", "program-admin"},
+	"getDutyShiftConflicts":      {"admin", "program-admin", "viewer"},
+
+	// Supervision ratios - ratio policies and compliance report
+	"listRatioPolicies":     {"admin", "program-admin", "viewer"},
+	"createRatioPolicy":     {"admin"},
+	"getRatioPolicyById":    {"admin", "program-admin", "viewer"},
+	"updateRatioPolicyById": {"admin"},
+	"deleteRatioPolicyById": {"admin"},
+	"getRatioCompliance":    {"admin", "program-admin", "viewer"},
+
+	// Timesheets - staff time entries, approval, weekly hours and payroll export
+	"listTimeEntries":     {"admin", "program-admin"},
+	"createTimeEntry":     {"admin", "program-admin"},
+	"getTimeEntryById":    {"admin", "program-admin"},
+	"updateTimeEntryById": {"admin", "program-admin"},
+	"deleteTimeEntryById": {"admin", "program-admin"},
+	"reviewTimeEntry":     {"admin", "program-admin"},
+	"clockInStaffMember":  {"admin", "program-admin"},
+	"clockOutStaffMember": {"admin", "program-admin"},
+	"getTimesheets":       {"admin", "program-admin"},
+	"exportTimesheets":    {"admin"},
+
+	// Staff portal - staff members linked to a user account see only their own schedule, groups and campers
+	// and manage their own availability and time off; the service resolves the linked staff member
+	"getMyStaffMember":           {"admin", "program-admin", "viewer", "health", "staff"},
+	"getMySchedule":              {"admin", "program-admin", "viewer", "health", "staff"},
+	"listMyGroups":               {"admin", "program-admin", "viewer", "health", "staff"},
+	"listMyCampers":              {"admin", "program-admin", "viewer", "health", "staff"},
+	"getMyAvailability":          {"admin", "program-admin", "viewer", "health", "staff"},
+	"updateMyAvailability":       {"admin", "program-admin", "viewer", "health", "staff"},
+	"listMyTimeOffRequests":      {"admin", "program-admin", "viewer", "health", "staff"},
+	"createMyTimeOffRequest":     {"admin", "program-admin", "viewer", "health", "staff"},
+	"cancelMyTimeOffRequest":     {"admin", "program-admin", "viewer", "health", "staff"},
+	"getStaffMemberAvailability": {"admin", "program-admin", "viewer"},
+	"listTimeOffRequests":        {"admin", "program-admin"},
+	"reviewTimeOffRequest":       {"admin", "program-admin"},
+
+	// Onboarding - checklists and certifications staff members must complete before they can be assigned to events
+	"listOnboardingTemplates":             {"admin", "program-admin", "viewer"},
+	"createOnboardingTemplate":            {"admin"},
+	"getOnboardingTemplateById":           {"admin", "program-admin", "viewer"},
+	"updateOnboardingTemplateById":        {"admin"},
+	"deleteOnboardingTemplateById":        {"admin"},
+	"getStaffMemberOnboarding":            {"admin", "program-admin", "viewer"},
+	"completeStaffMemberOnboardingItem":   {"admin", "program-admin"},
+	"uncompleteStaffMemberOnboardingItem": {"admin", "program-admin"},
+	"getOnboardingReport":                 {"admin", "program-admin", "viewer"},
+	"getMyOnboarding":                     {"admin", "program-admin", "viewer", "health", "staff"},
+
+	// Attachments - waivers, medical forms, photos and certificates
+	"listAttachments":             {"admin", "program-admin", "health"},
+	"uploadAttachment":            {"admin", "program-admin", "health"},
+	"getAttachmentById":           {"admin", "program-admin", "health"},
+	"deleteAttachmentById":        {"admin"},
+	"createAttachmentDownloadUrl": {"admin", "program-admin", "health"},
+
+	// Medications and MAR - health staff only
+	"listMedications":      {"health"},
+	"createMedication":     {"health"},
+	"getMedicationById":    {"health"},
+	"updateMedicationById": {"health"},
+	"deleteMedicationById": {"health"},
+	"listDueDoses":         {"health"},
+	"recordMedicationDose": {"health"},
+	"updateMedicationDose": {"health"},
+	"getMarReport":         {"health"},
 
 	// Staff Members - admin only for CUD, all for read
 	"listStaffMembers":    {"admin", "program-admin", "viewer"},
@@ -56,6 +201,7 @@ var OperationRoles = map[string][]string{
 	"getStaffMemberById":  {"admin", "program-admin", "viewer"},
 	"updateStaffMemberById": {"admin"},
 	"deleteStaffMemberById": {"admin"},
+	"getStaffMemberTimeline": {"admin", "program-admin", "viewer", "health"},
 
 	// Areas - admin only for CUD, all for read
 	"listAreas":           {"admin", "program-admin", "viewer"},
@@ -64,6 +210,13 @@ var OperationRoles = map[string][]string{
 	"updateAreaById":      {"admin"},
 	"deleteAreaById":      {"admin"},
 
+	// Attendance - admin and program-admin sign campers in and out, all for read, admin only for corrections
+	"listAttendanceRecords":  {"admin", "program-admin", "viewer"},
+	"checkInCamper":          {"admin", "program-admin"},
+	"checkOutCamper":         {"admin", "program-admin"},
+	"deleteAttendanceRecord": {"admin"},
+	"getOnSiteReport":        {"admin", "program-admin", "viewer"},
+
 	// Locations - admin only for CUD, all for read
 	"listLocations":       {"admin", "program-admin", "viewer"},
 	"createLocation":      {"admin"},
@@ -78,6 +231,13 @@ var OperationRoles = map[string][]string{
 	"updateColorById":     {"admin"},
 	"deleteColorById":     {"admin"},
 
+	// Custom Fields - admin only for CUD, all for read
+	"listCustomFields":      {"admin", "program-admin", "viewer"},
+	"createCustomField":     {"admin"},
+	"getCustomFieldById":    {"admin", "program-admin", "viewer"},
+	"updateCustomFieldById": {"admin"},
+	"deleteCustomFieldById": {"admin"},
+
 	// Roles - admin only for CUD, all for read
 	"listRoles":           {"admin", "program-admin", "viewer"},
 	"createRole":          {"admin"},
@@ -91,6 +251,19 @@ var OperationRoles = map[string][]string{
 	"getCertificationById": {"admin", "program-admin", "viewer"},
 	"updateCertificationById": {"admin"},
 	"deleteCertificationById": {"admin"},
+	"getCertificationCompliance": {"admin", "program-admin"},
+	"verifyStaffMemberCertification": {"admin"},
+
+	// Bunk Requests - admin and program-admin record requests, all for read
+	"listBunkRequests":  {"admin", "program-admin", "viewer"},
+	"createBunkRequest": {"admin", "program-admin"},
+	"deleteBunkRequest": {"admin", "program-admin"},
+
+	// Housing Assignments - admin only for generating and accepting proposals
+	"listHousingAssignments":   {"admin", "program-admin"},
+	"createHousingAssignment":  {"admin"},
+	"getHousingAssignmentById": {"admin", "program-admin"},
+	"acceptHousingAssignment":  {"admin"},
 
 	// Housing Rooms - admin only for CUD, all for read
 	"listHousingRooms":    {"admin", "program-admin", "viewer"},
@@ -99,6 +272,53 @@ var OperationRoles = map[string][]string{
 	"updateHousingRoomById": {"admin"},
 	"deleteHousingRoomById": {"admin"},
 
+	// Beds - admin only for CUD, all for read; bed assignments are made by program admins too
+	"listBeds":                {"admin", "program-admin", "viewer"},
+	"createBed":               {"admin"},
+	"getBedById":              {"admin", "program-admin", "viewer"},
+	"updateBedById":           {"admin"},
+	"deleteBedById":           {"admin"},
+	"listBedAssignments":      {"admin", "program-admin", "viewer"},
+	"createBedAssignment":     {"admin", "program-admin"},
+	"updateBedAssignmentById": {"admin", "program-admin"},
+	"deleteBedAssignmentById": {"admin", "program-admin"},
+	"getHousingOccupancy":     {"admin", "program-admin", "viewer"},
+
+	// Maintenance tickets - reported by admin, program-admin and health staff, handled by admins
+	"listMaintenanceTickets":      {"admin", "program-admin", "viewer", "health"},
+	"createMaintenanceTicket":     {"admin", "program-admin", "health"},
+	"getMaintenanceTicketById":    {"admin", "program-admin", "viewer", "health"},
+	"updateMaintenanceTicketById": {"admin", "program-admin"},
+	"deleteMaintenanceTicketById": {"admin"},
+	"listOutOfServicePeriods":     {"admin", "program-admin", "viewer", "health"},
+
+	// Equipment inventory - managed and checked out by admin and program-admin, retired by admins
+	"listEquipmentItems":      {"admin", "program-admin", "viewer"},
+	"createEquipmentItem":     {"admin", "program-admin"},
+	"getEquipmentItemById":    {"admin", "program-admin", "viewer"},
+	"updateEquipmentItemById": {"admin", "program-admin"},
+	"deleteEquipmentItemById": {"admin"},
+	"listEquipmentCheckouts":  {"admin", "program-admin", "viewer"},
+	"checkOutEquipment":       {"admin", "program-admin"},
+	"returnEquipment":         {"admin", "program-admin"},
+	"getEquipmentConflicts":   {"admin", "program-admin", "viewer"},
+
+	// Skill progression - tracks managed by admin and program-admin, campers assessed by staff too
+	// (staff only see and assess the campers of their own groups; the service checks the linked staff member)
+	"listSkillTracks":       {"admin", "program-admin", "viewer", "staff"},
+	"createSkillTrack":      {"admin", "program-admin"},
+	"getSkillTrackById":     {"admin", "program-admin", "viewer", "staff"},
+	"updateSkillTrackById":  {"admin", "program-admin"},
+	"deleteSkillTrackById":  {"admin"},
+	"getCamperSkills":       {"admin", "program-admin", "viewer", "staff"},
+	"createSkillAssessment": {"admin", "program-admin", "staff"},
+	"deleteSkillAssessment": {"admin", "program-admin"},
+
+	// Camp clones (season rollover) - admin only
+	"cloneCamp":           {"admin"},
+	"listCampCloneJobs":   {"admin"},
+	"getCampCloneJobById": {"admin"},
+
 	// Sessions - admin only for CUD, all for read
 	"listSessions":        {"admin", "program-admin", "viewer"},
 	"createSession":       {"admin"},
@@ -145,6 +365,9 @@ var OperationResourceTypes = map[string]ResourceType{
 	"getEventById":        ResourceTypeEvent,
 	"updateEventById":     ResourceTypeEvent,
 	"deleteEventById":     ResourceTypeEvent,
+	"getEventEligibility": ResourceTypeEvent,
+
+	"getEligibilityReport": ResourceTypeOther,
 
 	// All other resources - program-admin read-only
 	"listCampers":         ResourceTypeOther,
@@ -152,12 +375,141 @@ var OperationResourceTypes = map[string]ResourceType{
 	"getCamperById":       ResourceTypeOther,
 	"updateCamperById":    ResourceTypeOther,
 	"deleteCamperById":    ResourceTypeOther,
+	"getCamperContacts":   ResourceTypeOther,
+	"getCamperFamily":     ResourceTypeOther,
+	"listCamperEnrollments":  ResourceTypeOther,
+	"createCamperEnrollment": ResourceTypeOther,
+	"updateCamperEnrollment": ResourceTypeOther,
+	"deleteCamperEnrollment": ResourceTypeOther,
+	"listCamperDuplicates":   ResourceTypeOther,
+	"mergeCamper":            ResourceTypeOther,
+	"listCamperMerges":       ResourceTypeOther,
+	"getCamperTimeline":      ResourceTypeOther,
+
+	"listApplications":           ResourceTypeOther,
+	"createApplication":          ResourceTypeOther,
+	"getApplicationById":         ResourceTypeOther,
+	"updateApplicationById":      ResourceTypeOther,
+	"deleteApplicationById":      ResourceTypeOther,
+	"listApplicationTransitions": ResourceTypeOther,
+	"transitionApplication":      ResourceTypeOther,
+
+	"listGuardians":       ResourceTypeOther,
+	"createGuardian":      ResourceTypeOther,
+	"getGuardianById":     ResourceTypeOther,
+	"updateGuardianById":  ResourceTypeOther,
+	"deleteGuardianById":  ResourceTypeOther,
+
+	"listNotes":      ResourceTypeOther,
+	"createNote":     ResourceTypeOther,
+	"getNoteById":    ResourceTypeOther,
+	"updateNoteById": ResourceTypeOther,
+	"deleteNoteById": ResourceTypeOther,
+
+	"listMealPeriods":      ResourceTypeOther,
+	"createMealPeriod":     ResourceTypeOther,
+	"getMealPeriodById":    ResourceTypeOther,
+	"updateMealPeriodById": ResourceTypeOther,
+	"deleteMealPeriodById": ResourceTypeOther,
+	"listMenus":            ResourceTypeOther,
+	"createMenu":           ResourceTypeOther,
+	"getMenuById":          ResourceTypeOther,
+	"updateMenuById":       ResourceTypeOther,
+	"deleteMenuById":       ResourceTypeOther,
+	"getMealHeadcounts":    ResourceTypeOther,
+
+	"listDutyTypes":              ResourceTypeOther,
+	"createDutyType":             ResourceTypeOther,
+	"getDutyTypeById":            ResourceTypeOther,
+	"updateDutyTypeById":         ResourceTypeOther,
+	"deleteDutyTypeById":         ResourceTypeOther,
+	"listDutyRotations":          ResourceTypeOther,
+	"createDutyRotation":         ResourceTypeOther,
+	"getDutyRotationById":        ResourceTypeOther,
+	"updateDutyRotationById":     ResourceTypeOther,
+	"deleteDutyRotationById":     ResourceTypeOther,
+	"generateDutyRotationShifts": ResourceTypeOther,
+	"listDutyShifts":             ResourceTypeOther,
+	"createDutyShift":            ResourceTypeOther,
+	"getDutyShiftById":           ResourceTypeOther,
+	"updateDutyShiftById":        ResourceTypeOther,
+	"deleteDutyShiftById":        ResourceTypeOther,
+	"autoAssignDutyShifts":       ResourceTypeOther,
+	"getDutyShiftConflicts":      ResourceTypeOther,
+
+	"listRatioPolicies":     ResourceTypeOther,
+	"createRatioPolicy":     ResourceTypeOther,
+	"getRatioPolicyById":    ResourceTypeOther,
+	"updateRatioPolicyById": ResourceTypeOther,
+	"deleteRatioPolicyById": ResourceTypeOther,
+	"getRatioCompliance":    ResourceTypeOther,
+
+	"listTimeEntries":     ResourceTypeOther,
+	"createTimeEntry":     ResourceTypeOther,
+	"getTimeEntryById":    ResourceTypeOther,
+	"updateTimeEntryById": ResourceTypeOther,
+	"deleteTimeEntryById": ResourceTypeOther,
+	"reviewTimeEntry":     ResourceTypeOther,
+	"clockInStaffMember":  ResourceTypeOther,
+	"clockOutStaffMember": ResourceTypeOther,
+	"getTimesheets":       ResourceTypeOther,
+	"exportTimesheets":    ResourceTypeOther,
+
+	"getMyStaffMember":           ResourceTypeOther,
+	"getMySchedule":              ResourceTypeOther,
+	"listMyGroups":               ResourceTypeOther,
+	"listMyCampers":              ResourceTypeOther,
+	"getMyAvailability":          ResourceTypeOther,
+	"updateMyAvailability":       ResourceTypeOther,
+	"listMyTimeOffRequests":      ResourceTypeOther,
+	"createMyTimeOffRequest":     ResourceTypeOther,
+	"cancelMyTimeOffRequest":     ResourceTypeOther,
+	"getStaffMemberAvailability": ResourceTypeOther,
+	"listTimeOffRequests":        ResourceTypeOther,
+	"reviewTimeOffRequest":       ResourceTypeOther,
+
+	"listOnboardingTemplates":             ResourceTypeOther,
+	"createOnboardingTemplate":            ResourceTypeOther,
+	"getOnboardingTemplateById":           ResourceTypeOther,
+	"updateOnboardingTemplateById":        ResourceTypeOther,
+	"deleteOnboardingTemplateById":        ResourceTypeOther,
+	"getStaffMemberOnboarding":            ResourceTypeOther,
+	"completeStaffMemberOnboardingItem":   ResourceTypeOther,
+	"uncompleteStaffMemberOnboardingItem": ResourceTypeOther,
+	"getOnboardingReport":                 ResourceTypeOther,
+	"getMyOnboarding":                     ResourceTypeOther,
+
+	"listIncidents":      ResourceTypeOther,
+	"createIncident":     ResourceTypeOther,
+	"getIncidentById":    ResourceTypeOther,
+	"updateIncidentById": ResourceTypeOther,
+	"deleteIncidentById": ResourceTypeOther,
+	"submitIncident":     ResourceTypeOther,
+	"reviewIncident":     ResourceTypeOther,
+	"getIncidentReport":  ResourceTypeOther,
+
+	"listAttachments":             ResourceTypeOther,
+	"uploadAttachment":            ResourceTypeOther,
+	"getAttachmentById":           ResourceTypeOther,
+	"deleteAttachmentById":        ResourceTypeOther,
+	"createAttachmentDownloadUrl": ResourceTypeOther,
+
+	"listMedications":      ResourceTypeOther,
+	"createMedication":     ResourceTypeOther,
+	"getMedicationById":    ResourceTypeOther,
+	"updateMedicationById": ResourceTypeOther,
+	"deleteMedicationById": ResourceTypeOther,
+	"listDueDoses":         ResourceTypeOther,
+	"recordMedicationDose": ResourceTypeOther,
+	"updateMedicationDose": ResourceTypeOther,
+	"getMarReport":         ResourceTypeOther,
 
 	"listStaffMembers":    ResourceTypeOther,
 	"createStaffMember":   ResourceTypeOther,
 	"getStaffMemberById":  ResourceTypeOther,
 	"updateStaffMemberById": ResourceTypeOther,
 	"deleteStaffMemberById": ResourceTypeOther,
+	"getStaffMemberTimeline": ResourceTypeOther,
 
 	"listAreas":           ResourceTypeOther,
 	"createArea":          ResourceTypeOther,
@@ -165,6 +517,12 @@ var OperationResourceTypes = map[string]ResourceType{
 	"updateAreaById":      ResourceTypeOther,
 	"deleteAreaById":      ResourceTypeOther,
 
+	"listAttendanceRecords":  ResourceTypeOther,
+	"checkInCamper":          ResourceTypeOther,
+	"checkOutCamper":         ResourceTypeOther,
+	"deleteAttendanceRecord": ResourceTypeOther,
+	"getOnSiteReport":        ResourceTypeOther,
+
 	"listLocations":       ResourceTypeOther,
 	"createLocation":      ResourceTypeOther,
 	"getLocationById":     ResourceTypeOther,
@@ -177,6 +535,12 @@ var OperationResourceTypes = map[string]ResourceType{
 	"updateColorById":     ResourceTypeOther,
 	"deleteColorById":     ResourceTypeOther,
 
+	"listCustomFields":      ResourceTypeOther,
+	"createCustomField":     ResourceTypeOther,
+	"getCustomFieldById":    ResourceTypeOther,
+	"updateCustomFieldById": ResourceTypeOther,
+	"deleteCustomFieldById": ResourceTypeOther,
+
 	"listRoles":           ResourceTypeOther,
 	"createRole":          ResourceTypeOther,
 	"getRoleById":         ResourceTypeOther,
@@ -188,12 +552,59 @@ var OperationResourceTypes = map[string]ResourceType{
 	"getCertificationById": ResourceTypeOther,
 	"updateCertificationById": ResourceTypeOther,
 	"deleteCertificationById": ResourceTypeOther,
+	"getCertificationCompliance": ResourceTypeOther,
+	"verifyStaffMemberCertification": ResourceTypeOther,
+
+	"listBunkRequests":  ResourceTypeOther,
+	"createBunkRequest": ResourceTypeOther,
+	"deleteBunkRequest": ResourceTypeOther,
+
+	"listHousingAssignments":   ResourceTypeOther,
+	"createHousingAssignment":  ResourceTypeOther,
+	"getHousingAssignmentById": ResourceTypeOther,
+	"acceptHousingAssignment":  ResourceTypeOther,
 
 	"listHousingRooms":    ResourceTypeOther,
 	"createHousingRoom":   ResourceTypeOther,
 	"getHousingRoomById":  ResourceTypeOther,
 	"updateHousingRoomById": ResourceTypeOther,
 	"deleteHousingRoomById": ResourceTypeOther,
+	"listBeds":                ResourceTypeOther,
+	"createBed":               ResourceTypeOther,
+	"getBedById":              ResourceTypeOther,
+	"updateBedById":           ResourceTypeOther,
+	"deleteBedById":           ResourceTypeOther,
+	"listBedAssignments":      ResourceTypeOther,
+	"createBedAssignment":     ResourceTypeOther,
+	"updateBedAssignmentById": ResourceTypeOther,
+	"deleteBedAssignmentById": ResourceTypeOther,
+	"getHousingOccupancy":     ResourceTypeOther,
+	"listMaintenanceTickets":      ResourceTypeOther,
+	"createMaintenanceTicket":     ResourceTypeOther,
+	"getMaintenanceTicketById":    ResourceTypeOther,
+	"updateMaintenanceTicketById": ResourceTypeOther,
+	"deleteMaintenanceTicketById": ResourceTypeOther,
+	"listOutOfServicePeriods":     ResourceTypeOther,
+	"listEquipmentItems":          ResourceTypeOther,
+	"createEquipmentItem":         ResourceTypeOther,
+	"getEquipmentItemById":        ResourceTypeOther,
+	"updateEquipmentItemById":     ResourceTypeOther,
+	"deleteEquipmentItemById":     ResourceTypeOther,
+	"listEquipmentCheckouts":      ResourceTypeOther,
+	"checkOutEquipment":           ResourceTypeOther,
+	"returnEquipment":             ResourceTypeOther,
+	"getEquipmentConflicts":       ResourceTypeOther,
+	"listSkillTracks":             ResourceTypeOther,
+	"createSkillTrack":            ResourceTypeOther,
+	"getSkillTrackById":           ResourceTypeOther,
+	"updateSkillTrackById":        ResourceTypeOther,
+	"deleteSkillTrackById":        ResourceTypeOther,
+	"getCamperSkills":             ResourceTypeOther,
+	"createSkillAssessment":       ResourceTypeOther,
+	"deleteSkillAssessment":       ResourceTypeOther,
+	"cloneCamp":                   ResourceTypeOther,
+	"listCampCloneJobs":           ResourceTypeOther,
+	"getCampCloneJobById":         ResourceTypeOther,
 
 	"listSessions":        ResourceTypeOther,
 	"createSession":       ResourceTypeOther,
@@ -118,6 +118,11 @@ func mapRouteToOperationID(method, path string) string {
 		return "logout"
 	}
 
+	// Signed attachment downloads are authorized by the token in the URL
+	if path == "/api/v1/attachments/download/{token}" && method == "GET" {
+		return "downloadAttachment"
+	}
+
 	// Tenant endpoints
 	if path == "/api/v1/tenants" && method == "GET" {
 		return "getTenants"
@@ -156,6 +161,33 @@ func mapCampScopedRoute(method, path string) string {
 	// Extract resource type and check if it's a detail route
 	isDetailRoute := strings.HasSuffix(path, "/{id}")
 
+	// Staff portal - checked first as its routes end in other resources' names (e.g. /me/campers)
+	if strings.Contains(path, "/me/") {
+		switch {
+		case strings.HasSuffix(path, "/me/staff-member") && method == "GET":
+			return "getMyStaffMember"
+		case strings.HasSuffix(path, "/me/schedule") && method == "GET":
+			return "getMySchedule"
+		case strings.HasSuffix(path, "/me/groups") && method == "GET":
+			return "listMyGroups"
+		case strings.HasSuffix(path, "/me/campers") && method == "GET":
+			return "listMyCampers"
+		case strings.HasSuffix(path, "/me/availability") && method == "GET":
+			return "getMyAvailability"
+		case strings.HasSuffix(path, "/me/availability") && method == "PUT":
+			return "updateMyAvailability"
+		case strings.HasSuffix(path, "/me/time-off-requests") && method == "GET":
+			return "listMyTimeOffRequests"
+		case strings.HasSuffix(path, "/me/time-off-requests") && method == "POST":
+			return "createMyTimeOffRequest"
+		case strings.HasSuffix(path, "/me/time-off-requests/{id}") && method == "DELETE":
+			return "cancelMyTimeOffRequest"
+		case strings.HasSuffix(path, "/me/onboarding") && method == "GET":
+			return "getMyOnboarding"
+		}
+		return ""
+	}
+
 	// Programs
 	if strings.Contains(path, "/programs") {
 		if isDetailRoute {
@@ -198,6 +230,14 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Event eligibility - checked before events as it is a sub-route of an event
+	if strings.HasSuffix(path, "/events/{id}/eligibility") && method == "GET" {
+		return "getEventEligibility"
+	}
+	if strings.HasSuffix(path, "/activity-eligibility") && method == "GET" {
+		return "getEligibilityReport"
+	}
+
 	// Events
 	if strings.Contains(path, "/events") {
 		if isDetailRoute {
@@ -219,6 +259,135 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Camper enrollments (sub-routes of campers)
+	if strings.HasSuffix(path, "/campers/{id}/enrollments") {
+		switch method {
+		case "GET":
+			return "listCamperEnrollments"
+		case "POST":
+			return "createCamperEnrollment"
+		}
+	}
+	if strings.HasSuffix(path, "/campers/{id}/enrollments/{enrollment_id}") {
+		switch method {
+		case "PUT":
+			return "updateCamperEnrollment"
+		case "DELETE":
+			return "deleteCamperEnrollment"
+		}
+	}
+
+	// Camper contacts and family (sub-routes of campers)
+	if strings.HasSuffix(path, "/campers/{id}/contacts") && method == "GET" {
+		return "getCamperContacts"
+	}
+	if strings.HasSuffix(path, "/campers/{id}/family") && method == "GET" {
+		return "getCamperFamily"
+	}
+
+	// Duplicate detection and merging (sub-routes of campers)
+	if strings.HasSuffix(path, "/campers/duplicates") && method == "GET" {
+		return "listCamperDuplicates"
+	}
+	if strings.HasSuffix(path, "/campers/{id}/merge") && method == "POST" {
+		return "mergeCamper"
+	}
+	if strings.HasSuffix(path, "/camper-merges") && method == "GET" {
+		return "listCamperMerges"
+	}
+
+	// Person timelines (sub-routes of campers and staff members)
+	if strings.HasSuffix(path, "/campers/{id}/timeline") && method == "GET" {
+		return "getCamperTimeline"
+	}
+	if strings.HasSuffix(path, "/staff-members/{id}/timeline") && method == "GET" {
+		return "getStaffMemberTimeline"
+	}
+
+	// Camper skills and skill assessments (sub-routes of campers)
+	if strings.HasSuffix(path, "/campers/{id}/skills") && method == "GET" {
+		return "getCamperSkills"
+	}
+	if strings.HasSuffix(path, "/campers/{id}/skill-assessments/{assessment_id}") && method == "DELETE" {
+		return "deleteSkillAssessment"
+	}
+	if strings.HasSuffix(path, "/campers/{id}/skill-assessments") && method == "POST" {
+		return "createSkillAssessment"
+	}
+
+	// Certificate verification and compliance (sub-routes of staff members and certifications)
+	if strings.HasSuffix(path, "/staff-members/{id}/certifications/verify") && method == "POST" {
+		return "verifyStaffMemberCertification"
+	}
+	if strings.HasSuffix(path, "/certifications/compliance") && method == "GET" {
+		return "getCertificationCompliance"
+	}
+
+	// Duty rotation generation, automatic assignment and conflicts (sub-routes of duty rotations and shifts)
+	if strings.HasSuffix(path, "/duty-rotations/{id}/generate") && method == "POST" {
+		return "generateDutyRotationShifts"
+	}
+	if strings.HasSuffix(path, "/duty-shifts/auto-assign") && method == "POST" {
+		return "autoAssignDutyShifts"
+	}
+	if strings.HasSuffix(path, "/duty-shifts/conflicts") && method == "GET" {
+		return "getDutyShiftConflicts"
+	}
+
+	// Staff clock-in and clock-out, time entry review and timesheets
+	if strings.HasSuffix(path, "/staff-members/{id}/clock-in") && method == "POST" {
+		return "clockInStaffMember"
+	}
+	if strings.HasSuffix(path, "/staff-members/{id}/clock-out") && method == "POST" {
+		return "clockOutStaffMember"
+	}
+	if strings.HasSuffix(path, "/time-entries/{id}/review") && method == "POST" {
+		return "reviewTimeEntry"
+	}
+	if strings.HasSuffix(path, "/timesheets/export") && method == "GET" {
+		return "exportTimesheets"
+	}
+	if strings.HasSuffix(path, "/timesheets") && method == "GET" {
+		return "getTimesheets"
+	}
+
+	// Staff availability and time-off requests
+	if strings.HasSuffix(path, "/staff-members/{id}/availability") && method == "GET" {
+		return "getStaffMemberAvailability"
+	}
+	if strings.HasSuffix(path, "/time-off-requests") && method == "GET" {
+		return "listTimeOffRequests"
+	}
+	if strings.HasSuffix(path, "/time-off-requests/{id}/review") && method == "POST" {
+		return "reviewTimeOffRequest"
+	}
+
+	// Staff onboarding progress and readiness
+	if strings.HasSuffix(path, "/staff-members/{id}/onboarding") && method == "GET" {
+		return "getStaffMemberOnboarding"
+	}
+	if strings.HasSuffix(path, "/staff-members/{id}/onboarding/items/{item_id}") {
+		switch method {
+		case "PUT":
+			return "completeStaffMemberOnboardingItem"
+		case "DELETE":
+			return "uncompleteStaffMemberOnboardingItem"
+		}
+	}
+	if strings.HasSuffix(path, "/onboarding-report") && method == "GET" {
+		return "getOnboardingReport"
+	}
+
+	// Ratio compliance report (sub-route of ratio policies)
+	if strings.HasSuffix(path, "/ratio-policies/compliance") && method == "GET" {
+		return "getRatioCompliance"
+	}
+
+	// Meal headcounts
+	if strings.HasSuffix(path, "/meals/headcounts") && method == "GET" {
+		return "getMealHeadcounts"
+	}
+
 	// Campers
 	if strings.Contains(path, "/campers") {
 		if isDetailRoute {
@@ -240,6 +409,149 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Application transitions (sub-route of applications)
+	if strings.HasSuffix(path, "/applications/{id}/transitions") {
+		switch method {
+		case "GET":
+			return "listApplicationTransitions"
+		case "POST":
+			return "transitionApplication"
+		}
+	}
+
+	// Applications
+	if strings.Contains(path, "/applications") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getApplicationById"
+			case "PUT":
+				return "updateApplicationById"
+			case "DELETE":
+				return "deleteApplicationById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listApplications"
+			case "POST":
+				return "createApplication"
+			}
+		}
+	}
+
+	// Guardians
+	if strings.Contains(path, "/guardians") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getGuardianById"
+			case "PUT":
+				return "updateGuardianById"
+			case "DELETE":
+				return "deleteGuardianById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listGuardians"
+			case "POST":
+				return "createGuardian"
+			}
+		}
+	}
+
+	// Incident review steps and reports (sub-routes of incidents)
+	if strings.HasSuffix(path, "/incidents/{id}/submit") && method == "POST" {
+		return "submitIncident"
+	}
+	if strings.HasSuffix(path, "/incidents/{id}/review") && method == "POST" {
+		return "reviewIncident"
+	}
+	if strings.HasSuffix(path, "/incidents/report") && method == "GET" {
+		return "getIncidentReport"
+	}
+
+	// Incidents
+	if strings.Contains(path, "/incidents") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getIncidentById"
+			case "PUT":
+				return "updateIncidentById"
+			case "DELETE":
+				return "deleteIncidentById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listIncidents"
+			case "POST":
+				return "createIncident"
+			}
+		}
+	}
+
+	// Attachment download URLs (sub-route of attachments)
+	if strings.HasSuffix(path, "/attachments/{id}/download-url") && method == "POST" {
+		return "createAttachmentDownloadUrl"
+	}
+
+	// Attachments
+	if strings.Contains(path, "/attachments") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getAttachmentById"
+			case "DELETE":
+				return "deleteAttachmentById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listAttachments"
+			case "POST":
+				return "uploadAttachment"
+			}
+		}
+	}
+
+	// Medications
+	if strings.Contains(path, "/medications") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getMedicationById"
+			case "PUT":
+				return "updateMedicationById"
+			case "DELETE":
+				return "deleteMedicationById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listMedications"
+			case "POST":
+				return "createMedication"
+			}
+		}
+	}
+
+	// Medication administration record
+	if strings.Contains(path, "/mar/") {
+		switch {
+		case strings.HasSuffix(path, "/mar/due-doses") && method == "GET":
+			return "listDueDoses"
+		case strings.HasSuffix(path, "/mar/report") && method == "GET":
+			return "getMarReport"
+		case strings.HasSuffix(path, "/mar/doses") && method == "POST":
+			return "recordMedicationDose"
+		case strings.HasSuffix(path, "/mar/doses/{id}") && method == "PUT":
+			return "updateMedicationDose"
+		}
+	}
+
 	// Staff Members
 	if strings.Contains(path, "/staff-members") {
 		if isDetailRoute {
@@ -282,6 +594,22 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Attendance
+	if strings.Contains(path, "/attendance") {
+		switch {
+		case strings.HasSuffix(path, "/attendance") && method == "GET":
+			return "listAttendanceRecords"
+		case strings.HasSuffix(path, "/attendance/check-in") && method == "POST":
+			return "checkInCamper"
+		case strings.HasSuffix(path, "/attendance/check-out") && method == "POST":
+			return "checkOutCamper"
+		case strings.HasSuffix(path, "/attendance/on-site") && method == "GET":
+			return "getOnSiteReport"
+		case strings.HasSuffix(path, "/attendance/{id}") && method == "DELETE":
+			return "deleteAttendanceRecord"
+		}
+	}
+
 	// Locations
 	if strings.Contains(path, "/locations") {
 		if isDetailRoute {
@@ -324,6 +652,216 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Custom Fields
+	if strings.Contains(path, "/custom-fields") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getCustomFieldById"
+			case "PUT":
+				return "updateCustomFieldById"
+			case "DELETE":
+				return "deleteCustomFieldById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listCustomFields"
+			case "POST":
+				return "createCustomField"
+			}
+		}
+	}
+
+	// Notes
+	if strings.Contains(path, "/notes") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getNoteById"
+			case "PUT":
+				return "updateNoteById"
+			case "DELETE":
+				return "deleteNoteById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listNotes"
+			case "POST":
+				return "createNote"
+			}
+		}
+	}
+
+	// Onboarding templates
+	if strings.Contains(path, "/onboarding-templates") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getOnboardingTemplateById"
+			case "PUT":
+				return "updateOnboardingTemplateById"
+			case "DELETE":
+				return "deleteOnboardingTemplateById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listOnboardingTemplates"
+			case "POST":
+				return "createOnboardingTemplate"
+			}
+		}
+	}
+
+	// Duty types
+	if strings.Contains(path, "/duty-types") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getDutyTypeById"
+			case "PUT":
+				return "updateDutyTypeById"
+			case "DELETE":
+				return "deleteDutyTypeById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listDutyTypes"
+			case "POST":
+				return "createDutyType"
+			}
+		}
+	}
+
+	// Duty rotations
+	if strings.Contains(path, "/duty-rotations") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getDutyRotationById"
+			case "PUT":
+				return "updateDutyRotationById"
+			case "DELETE":
+				return "deleteDutyRotationById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listDutyRotations"
+			case "POST":
+				return "createDutyRotation"
+			}
+		}
+	}
+
+	// Duty shifts
+	if strings.Contains(path, "/duty-shifts") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getDutyShiftById"
+			case "PUT":
+				return "updateDutyShiftById"
+			case "DELETE":
+				return "deleteDutyShiftById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listDutyShifts"
+			case "POST":
+				return "createDutyShift"
+			}
+		}
+	}
+
+	// Ratio policies
+	if strings.Contains(path, "/ratio-policies") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getRatioPolicyById"
+			case "PUT":
+				return "updateRatioPolicyById"
+			case "DELETE":
+				return "deleteRatioPolicyById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listRatioPolicies"
+			case "POST":
+				return "createRatioPolicy"
+			}
+		}
+	}
+
+	// Time entries
+	if strings.Contains(path, "/time-entries") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getTimeEntryById"
+			case "PUT":
+				return "updateTimeEntryById"
+			case "DELETE":
+				return "deleteTimeEntryById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listTimeEntries"
+			case "POST":
+				return "createTimeEntry"
+			}
+		}
+	}
+
+	// Meal periods
+	if strings.Contains(path, "/meal-periods") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getMealPeriodById"
+			case "PUT":
+				return "updateMealPeriodById"
+			case "DELETE":
+				return "deleteMealPeriodById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listMealPeriods"
+			case "POST":
+				return "createMealPeriod"
+			}
+		}
+	}
+
+	// Menus
+	if strings.Contains(path, "/menus") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getMenuById"
+			case "PUT":
+				return "updateMenuById"
+			case "DELETE":
+				return "deleteMenuById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listMenus"
+			case "POST":
+				return "createMenu"
+			}
+		}
+	}
+
 	// Roles
 	if strings.Contains(path, "/roles") {
 		if isDetailRoute {
@@ -366,6 +904,37 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Bunk Requests
+	if strings.Contains(path, "/bunk-requests") {
+		if isDetailRoute {
+			switch method {
+			case "DELETE":
+				return "deleteBunkRequest"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listBunkRequests"
+			case "POST":
+				return "createBunkRequest"
+			}
+		}
+	}
+
+	// Housing Assignments
+	if strings.Contains(path, "/housing-assignments") {
+		switch {
+		case strings.HasSuffix(path, "/housing-assignments/{id}/accept") && method == "POST":
+			return "acceptHousingAssignment"
+		case isDetailRoute && method == "GET":
+			return "getHousingAssignmentById"
+		case strings.HasSuffix(path, "/housing-assignments") && method == "GET":
+			return "listHousingAssignments"
+		case strings.HasSuffix(path, "/housing-assignments") && method == "POST":
+			return "createHousingAssignment"
+		}
+	}
+
 	// Housing Rooms
 	if strings.Contains(path, "/housing-rooms") {
 		if isDetailRoute {
@@ -387,6 +956,148 @@ func mapCampScopedRoute(method, path string) string {
 		}
 	}
 
+	// Beds
+	if strings.Contains(path, "/beds") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getBedById"
+			case "PUT":
+				return "updateBedById"
+			case "DELETE":
+				return "deleteBedById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listBeds"
+			case "POST":
+				return "createBed"
+			}
+		}
+	}
+
+	// Bed assignments
+	if strings.Contains(path, "/bed-assignments") {
+		if isDetailRoute {
+			switch method {
+			case "PUT":
+				return "updateBedAssignmentById"
+			case "DELETE":
+				return "deleteBedAssignmentById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listBedAssignments"
+			case "POST":
+				return "createBedAssignment"
+			}
+		}
+	}
+
+	// Housing occupancy report
+	if strings.HasSuffix(path, "/housing-occupancy") && method == "GET" {
+		return "getHousingOccupancy"
+	}
+
+	// Maintenance tickets
+	if strings.Contains(path, "/maintenance-tickets") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getMaintenanceTicketById"
+			case "PUT":
+				return "updateMaintenanceTicketById"
+			case "DELETE":
+				return "deleteMaintenanceTicketById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listMaintenanceTickets"
+			case "POST":
+				return "createMaintenanceTicket"
+			}
+		}
+	}
+
+	// Out-of-service periods of facilities under maintenance
+	if strings.HasSuffix(path, "/out-of-service") && method == "GET" {
+		return "listOutOfServicePeriods"
+	}
+
+	// Equipment checkouts - checked before equipment items as they are sub-routes of an item
+	if strings.HasSuffix(path, "/equipment/{id}/checkouts/{checkout_id}/return") && method == "POST" {
+		return "returnEquipment"
+	}
+	if strings.HasSuffix(path, "/equipment/{id}/checkouts") {
+		switch method {
+		case "GET":
+			return "listEquipmentCheckouts"
+		case "POST":
+			return "checkOutEquipment"
+		}
+	}
+
+	// Equipment shortages of concurrent events
+	if strings.HasSuffix(path, "/equipment-conflicts") && method == "GET" {
+		return "getEquipmentConflicts"
+	}
+
+	// Equipment items
+	if strings.Contains(path, "/equipment") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getEquipmentItemById"
+			case "PUT":
+				return "updateEquipmentItemById"
+			case "DELETE":
+				return "deleteEquipmentItemById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listEquipmentItems"
+			case "POST":
+				return "createEquipmentItem"
+			}
+		}
+	}
+
+	// Skill tracks
+	if strings.Contains(path, "/skill-tracks") {
+		if isDetailRoute {
+			switch method {
+			case "GET":
+				return "getSkillTrackById"
+			case "PUT":
+				return "updateSkillTrackById"
+			case "DELETE":
+				return "deleteSkillTrackById"
+			}
+		} else {
+			switch method {
+			case "GET":
+				return "listSkillTracks"
+			case "POST":
+				return "createSkillTrack"
+			}
+		}
+	}
+
+	// Camp clones (season rollover)
+	if strings.HasSuffix(path, "/clone") && method == "POST" {
+		return "cloneCamp"
+	}
+	if strings.Contains(path, "/clone-jobs") && method == "GET" {
+		if isDetailRoute {
+			return "getCampCloneJobById"
+		}
+		return "listCampCloneJobs"
+	}
+
 	// Sessions
 	if strings.Contains(path, "/sessions") {
 		if isDetailRoute {
@@ -435,8 +1146,9 @@ func mapCampScopedRoute(method, path string) string {
 // isPublicEndpoint checks if an operation ID is for a public endpoint
 func isPublicEndpoint(operationID string) bool {
 	publicEndpoints := map[string]bool{
-		"login":  true,
-		"signup": true,
+		"login":              true,
+		"signup":             true,
+		"downloadAttachment": true,
 	}
 	return publicEndpoints[operationID]
 }
@@ -534,11 +1246,12 @@ func hasRoleInScope(userRoles []RoleScope, role, campID, tenantID string) bool {
 	return false
 }
 
-// isCampManagementOperation checks if the operation is camp creation or deletion
+// isCampManagementOperation checks if the operation is camp creation (including cloning) or deletion
 func isCampManagementOperation(operationID string) bool {
 	campManagementOps := map[string]bool{
 		"createCamp":     true,
 		"deleteCampById": true,
+		"cloneCamp":      true,
 	}
 	return campManagementOps[operationID]
 }
@@ -130,6 +130,8 @@ func (r *ActivitiesRepository) Update(ctx context.Context, tenantID, campID uuid
 			"time_block_id":         activity.TimeBlockID,
 			"required_staff":        activity.RequiredStaff,
 			"activity_conflicts":    activity.ActivityConflicts,
+			"eligibility":           activity.Eligibility,
+			"equipment_needs":       activity.EquipmentNeeds,
 		})
 
 	if result.Error != nil {
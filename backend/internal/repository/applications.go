@@ -0,0 +1,368 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ApplicationsRepository handles database operations for camper applications
+type ApplicationsRepository struct {
+	db *database.Database
+}
+
+// NewApplicationsRepository creates a new applications repository
+func NewApplicationsRepository(db *database.Database) *ApplicationsRepository {
+	return &ApplicationsRepository{db: db}
+}
+
+// applicationFields defines the filterable fields and their types for applications (API field names)
+var applicationFields = map[string]domain.FieldType{
+	"name":             domain.FieldTypeText,
+	"birthday":         domain.FieldTypeDate,
+	"gender":           domain.FieldTypeText,
+	"sessionId":        domain.FieldTypeUUID,
+	"status":           domain.FieldTypeText,
+	"waitlistPosition": domain.FieldTypeNumber,
+	"submittedAt":      domain.FieldTypeDate,
+}
+
+// applicationFieldToColumn maps API field names to database column names
+var applicationFieldToColumn = map[string]string{
+	"name":             "name",
+	"birthday":         "birthday",
+	"gender":           "gender",
+	"sessionId":        "session_id",
+	"status":           "status",
+	"waitlistPosition": "waitlist_position",
+	"submittedAt":      "submitted_at",
+}
+
+// applicationSortableFields defines the sortable fields for applications (API field names)
+var applicationSortableFields = []string{"name", "birthday", "status", "sessionId", "waitlistPosition", "submittedAt"}
+
+// List retrieves a paginated list of applications
+func (r *ApplicationsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Application, int64, error) {
+	var applications []domain.Application
+	var total int64
+
+	// Build the base query with tenant and camp filtering
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+
+	// Add search filter if provided
+	query = ApplySearchFilter(query, search, "name")
+
+	// Parse and apply filters
+	filters, err := ParseFilterStrings(filterStrings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	query, err = ApplyFilters(query, filters, applicationFields, applicationFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
+	}
+
+	// Get total count
+	if err := query.Model(&domain.Application{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count applications: %w", err)
+	}
+
+	// Apply sorting
+	query, err = ApplySorting(query, sortBy, sortOrder, applicationSortableFields, applicationFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
+	}
+
+	// If no sorting was specified, use default
+	if sortBy == nil || *sortBy == "" {
+		query = query.Order("created_at DESC")
+	}
+
+	if err := query.
+		Limit(limit).
+		Offset(offset).
+		Find(&applications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	return applications, total, nil
+}
+
+// GetByID retrieves a single application by ID with tenant and camp validation
+func (r *ApplicationsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Application, error) {
+	var application domain.Application
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&application).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+// ListTransitions retrieves the status history of an application in chronological order
+func (r *ApplicationsRepository) ListTransitions(ctx context.Context, tenantID, campID, applicationID uuid.UUID) ([]domain.ApplicationTransition, error) {
+	var transitions []domain.ApplicationTransition
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("application_id = ?", applicationID).
+		Order("created_at ASC").
+		Find(&transitions).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list application transitions: %w", err)
+	}
+
+	return transitions, nil
+}
+
+// NextWaitlistPosition returns the position the next waitlisted application of a session gets
+func (r *ApplicationsRepository) NextWaitlistPosition(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (int, error) {
+	var maxPosition *int
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Application{}).
+		Where("session_id = ? AND status = ?", sessionID, domain.ApplicationStatusWaitlisted).
+		Select("MAX(waitlist_position)").
+		Scan(&maxPosition).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get waitlist position: %w", err)
+	}
+
+	if maxPosition == nil {
+		return 1, nil
+	}
+	return *maxPosition + 1, nil
+}
+
+// FirstWaitlisted returns the waitlisted application of a session with the lowest waitlist position
+func (r *ApplicationsRepository) FirstWaitlisted(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (*domain.Application, error) {
+	var application domain.Application
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("session_id = ? AND status = ?", sessionID, domain.ApplicationStatusWaitlisted).
+		Order("waitlist_position ASC, created_at ASC").
+		First(&application).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &application, nil
+}
+
+// Create inserts a new application along with its initial transition
+func (r *ApplicationsRepository) Create(ctx context.Context, application *domain.Application, transition *domain.ApplicationTransition) error {
+	// Start a transaction
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(application).Error; err != nil {
+			return fmt.Errorf("failed to create application: %w", err)
+		}
+
+		transition.ApplicationID = application.ID
+		if err := tx.Create(transition).Error; err != nil {
+			return fmt.Errorf("failed to create application transition: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Update updates the details of an existing application with tenant and camp validation
+func (r *ApplicationsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, application *domain.Application) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Application{}).
+		Where("id = ?", application.ID).
+		Updates(map[string]interface{}{
+			"name":         application.Name,
+			"description":  application.Description,
+			"birthday":     application.Birthday,
+			"gender":       application.Gender,
+			"session_id":   application.SessionID,
+			"camper_id":    application.CamperID,
+			"guardian_ids": application.GuardianIDs,
+			"notes":        application.Notes,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update application: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("application not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Transition moves an application to a new status and records the transition.
+// The transition fails with ErrApplicationStatusChanged when the application no longer has
+// the transition's from status. When takesPlace is set the session capacity is checked under
+// a lock on the session, failing with a SessionFullError when it has no place left.
+// When newCamper is set it is created and linked to the application. Accepted and
+// enrolled applications get an active session enrollment for their camper, while
+// cancelled and withdrawn ones cancel it. Waitlist positions behind an application
+// leaving the waitlist move up by one.
+func (r *ApplicationsRepository) Transition(ctx context.Context, tenantID, campID uuid.UUID, application *domain.Application, transition *domain.ApplicationTransition, newCamper *domain.Camper, takesPlace bool) error {
+	// Start a transaction
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing domain.Application
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", application.ID).
+			First(&existing).Error; err != nil {
+			return fmt.Errorf("application not found or unauthorized: %w", err)
+		}
+
+		// Another request moved the application since it was read
+		if transition.FromStatus != nil && existing.Status != *transition.FromStatus {
+			return domain.ErrApplicationStatusChanged
+		}
+
+		if takesPlace {
+			if err := checkSessionCapacity(tx, tenantID, campID, application.SessionID); err != nil {
+				return err
+			}
+		}
+
+		if newCamper != nil {
+			if err := tx.Omit("GroupCampers", "Enrollments").Create(newCamper).Error; err != nil {
+				return fmt.Errorf("failed to create camper: %w", err)
+			}
+			application.CamperID = &newCamper.ID
+		}
+
+		if application.CamperID != nil {
+			if application.Status.HasCamper() {
+				if err := activateEnrollment(tx, tenantID, campID, *application.CamperID, application.SessionID); err != nil {
+					return err
+				}
+				if err := linkGuardians(tx, *application.CamperID, application.GuardianIDs); err != nil {
+					return err
+				}
+			} else if existing.Status.HasCamper() {
+				if err := tx.Model(&domain.CamperEnrollment{}).
+					Where("camper_id = ? AND session_id = ?", *application.CamperID, application.SessionID).
+					Update("status", domain.EnrollmentStatusCancelled).Error; err != nil {
+					return fmt.Errorf("failed to cancel enrollment: %w", err)
+				}
+			}
+		}
+
+		// Close the gap left on the waitlist
+		if existing.Status == domain.ApplicationStatusWaitlisted && existing.WaitlistPosition != nil {
+			if err := ScopedTxQuery(tx, tenantID, campID).
+				Model(&domain.Application{}).
+				Where("session_id = ? AND status = ? AND waitlist_position > ?", existing.SessionID, domain.ApplicationStatusWaitlisted, *existing.WaitlistPosition).
+				Update("waitlist_position", gorm.Expr("waitlist_position - 1")).Error; err != nil {
+				return fmt.Errorf("failed to reorder waitlist: %w", err)
+			}
+		}
+
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Application{}).
+			Where("id = ?", application.ID).
+			Updates(map[string]interface{}{
+				"status":            application.Status,
+				"waitlist_position": application.WaitlistPosition,
+				"camper_id":         application.CamperID,
+				"submitted_at":      application.SubmittedAt,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to update application status: %w", err)
+		}
+
+		transition.ApplicationID = application.ID
+		if err := tx.Create(transition).Error; err != nil {
+			return fmt.Errorf("failed to create application transition: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Delete soft deletes an application by ID with tenant and camp validation
+func (r *ApplicationsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Application{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete application: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("application not found or unauthorized")
+	}
+
+	return nil
+}
+
+// checkSessionCapacity locks the session and verifies it has room for one more active enrollment,
+// so concurrent transactions cannot take the same last place
+func checkSessionCapacity(tx *gorm.DB, tenantID, campID, sessionID uuid.UUID) error {
+	var session domain.Session
+	if err := ScopedTxQuery(tx, tenantID, campID).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", sessionID).
+		First(&session).Error; err != nil {
+		return fmt.Errorf("failed to lock session: %w", err)
+	}
+
+	if session.Capacity == nil {
+		return nil
+	}
+
+	var enrolled int64
+	if err := ScopedTxQuery(tx, tenantID, campID).
+		Model(&domain.CamperEnrollment{}).
+		Where("session_id = ? AND status <> ?", sessionID, domain.EnrollmentStatusCancelled).
+		Count(&enrolled).Error; err != nil {
+		return fmt.Errorf("failed to count enrollments: %w", err)
+	}
+
+	if enrolled >= int64(*session.Capacity) {
+		return &domain.SessionFullError{Enrolled: enrolled, Capacity: *session.Capacity}
+	}
+
+	return nil
+}
+
+// activateEnrollment makes sure the camper has an active enrollment in the session,
+// re-enrolling a previously cancelled one
+func activateEnrollment(tx *gorm.DB, tenantID, campID, camperID, sessionID uuid.UUID) error {
+	if err := ensureEnrollment(tx, tenantID, campID, camperID, sessionID, nil); err != nil {
+		return err
+	}
+
+	if err := tx.Model(&domain.CamperEnrollment{}).
+		Where("camper_id = ? AND session_id = ? AND status = ?", camperID, sessionID, domain.EnrollmentStatusCancelled).
+		Update("status", domain.EnrollmentStatusEnrolled).Error; err != nil {
+		return fmt.Errorf("failed to reactivate enrollment: %w", err)
+	}
+
+	return nil
+}
+
+// linkGuardians links the guardians of an application to its camper, keeping existing links
+func linkGuardians(tx *gorm.DB, camperID uuid.UUID, guardianIDs []uuid.UUID) error {
+	for _, guardianID := range guardianIDs {
+		guardianCamper := domain.GuardianCamper{
+			GuardianID: guardianID,
+			CamperID:   camperID,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&guardianCamper).Error; err != nil {
+			return fmt.Errorf("failed to link guardian: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// AttachmentsRepository handles database operations for attachment metadata
+type AttachmentsRepository struct {
+	db *database.Database
+}
+
+// NewAttachmentsRepository creates a new attachments repository
+func NewAttachmentsRepository(db *database.Database) *AttachmentsRepository {
+	return &AttachmentsRepository{db: db}
+}
+
+// List retrieves attachments, most recent first, optionally limited to a kind of record and to one record
+func (r *AttachmentsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.AttachmentEntityType, entityID *uuid.UUID) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+	if entityID != nil {
+		query = query.Where("entity_id = ?", *entityID)
+	}
+
+	if err := query.Order("created_at DESC").Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// GetByID retrieves a single attachment by ID with tenant and camp validation
+func (r *AttachmentsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Attachment, error) {
+	var attachment domain.Attachment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&attachment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// Create inserts a new attachment
+func (r *AttachmentsRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
+	if err := r.db.WithContext(ctx).Create(attachment).Error; err != nil {
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an attachment by ID with tenant and camp validation
+func (r *AttachmentsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Attachment{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete attachment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("attachment not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// AttendanceRepository handles database operations for daily camper sign-ins and sign-outs
+type AttendanceRepository struct {
+	db *database.Database
+}
+
+// NewAttendanceRepository creates a new attendance repository
+func NewAttendanceRepository(db *database.Database) *AttendanceRepository {
+	return &AttendanceRepository{db: db}
+}
+
+// ListByDate retrieves the attendance records of a day in the order they happened,
+// optionally limited to a single camper
+func (r *AttendanceRepository) ListByDate(ctx context.Context, tenantID, campID uuid.UUID, date time.Time, camperID *uuid.UUID) ([]domain.AttendanceRecord, error) {
+	var records []domain.AttendanceRecord
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("date = ?", date.Format("2006-01-02"))
+	if camperID != nil {
+		query = query.Where("camper_id = ?", *camperID)
+	}
+
+	if err := query.Order("occurred_at ASC, created_at ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attendance records: %w", err)
+	}
+
+	return records, nil
+}
+
+// ListForCamper retrieves the attendance records of a camper across days, most recent first,
+// optionally limited to the records that happened within [from, to)
+func (r *AttendanceRepository) ListForCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID, from, to *time.Time) ([]domain.AttendanceRecord, error) {
+	var records []domain.AttendanceRecord
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ?", camperID)
+	if from != nil {
+		query = query.Where("occurred_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("occurred_at < ?", *to)
+	}
+
+	if err := query.Order("occurred_at DESC, created_at DESC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attendance records: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetLatestForCamper retrieves the most recent attendance record of a camper on a day
+func (r *AttendanceRepository) GetLatestForCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID, date time.Time) (*domain.AttendanceRecord, error) {
+	var record domain.AttendanceRecord
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ? AND date = ?", camperID, date.Format("2006-01-02")).
+		Order("occurred_at DESC, created_at DESC").
+		First(&record).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// GetByID retrieves a single attendance record by ID with tenant and camp validation
+func (r *AttendanceRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.AttendanceRecord, error) {
+	var record domain.AttendanceRecord
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&record).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// Create inserts a new attendance record
+func (r *AttendanceRepository) Create(ctx context.Context, record *domain.AttendanceRecord) error {
+	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to create attendance record: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an attendance record by ID with tenant and camp validation
+func (r *AttendanceRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.AttendanceRecord{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete attendance record: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("attendance record not found or unauthorized")
+	}
+
+	return nil
+}
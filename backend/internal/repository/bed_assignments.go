@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// BedAssignmentsRepository handles database operations for bed assignments
+type BedAssignmentsRepository struct {
+	db *database.Database
+}
+
+// NewBedAssignmentsRepository creates a new bed assignments repository
+func NewBedAssignmentsRepository(db *database.Database) *BedAssignmentsRepository {
+	return &BedAssignmentsRepository{db: db}
+}
+
+// List retrieves bed assignments with their beds by start, optionally limited to a session and the beds of a housing room
+func (r *BedAssignmentsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, sessionID, housingRoomID *uuid.UUID) ([]domain.BedAssignment, error) {
+	var assignments []domain.BedAssignment
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).Preload("Bed")
+	if sessionID != nil {
+		query = query.Where("session_id = ?", *sessionID)
+	}
+	if housingRoomID != nil {
+		query = query.Where("bed_id IN (SELECT id FROM beds WHERE housing_room_id = ?)", *housingRoomID)
+	}
+
+	if err := query.Order("start_date ASC, created_at ASC").Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list bed assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// ListOverlapping retrieves the bed assignments with their beds that share a night with the nights from start until end,
+// skipping assignments of deleted campers and staff members
+func (r *BedAssignmentsRepository) ListOverlapping(ctx context.Context, tenantID, campID uuid.UUID, start, end time.Time) ([]domain.BedAssignment, error) {
+	var assignments []domain.BedAssignment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("Bed").
+		Where("start_date < ? AND end_date > ?", end, start).
+		Where("camper_id IS NULL OR camper_id IN (SELECT id FROM campers WHERE deleted_at IS NULL)").
+		Where("staff_member_id IS NULL OR staff_member_id IN (SELECT id FROM staff_members WHERE deleted_at IS NULL)").
+		Order("start_date ASC").
+		Find(&assignments).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bed assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// GetByID retrieves a single bed assignment with its bed by ID with tenant and camp validation
+func (r *BedAssignmentsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.BedAssignment, error) {
+	var assignment domain.BedAssignment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("Bed").
+		Where("id = ?", id).
+		First(&assignment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+// Create inserts a new bed assignment
+func (r *BedAssignmentsRepository) Create(ctx context.Context, assignment *domain.BedAssignment) error {
+	if err := r.db.WithContext(ctx).Omit("Bed").Create(assignment).Error; err != nil {
+		return fmt.Errorf("failed to create bed assignment: %w", err)
+	}
+	return nil
+}
+
+// Update saves the bed, nights and notes of a bed assignment
+func (r *BedAssignmentsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.BedAssignment) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.BedAssignment{}).
+		Where("id = ?", assignment.ID).
+		Select("bed_id", "start_date", "end_date", "notes").
+		Updates(assignment)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update bed assignment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("bed assignment not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a bed assignment by ID with tenant and camp validation
+func (r *BedAssignmentsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.BedAssignment{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete bed assignment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("bed assignment not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// BedsRepository handles database operations for beds
+type BedsRepository struct {
+	db *database.Database
+}
+
+// NewBedsRepository creates a new beds repository
+func NewBedsRepository(db *database.Database) *BedsRepository {
+	return &BedsRepository{db: db}
+}
+
+// List retrieves beds by room and label, optionally limited to the beds of a housing room
+func (r *BedsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, housingRoomID *uuid.UUID) ([]domain.Bed, error) {
+	var beds []domain.Bed
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if housingRoomID != nil {
+		query = query.Where("housing_room_id = ?", *housingRoomID)
+	}
+
+	if err := query.Order("housing_room_id ASC, label ASC").Find(&beds).Error; err != nil {
+		return nil, fmt.Errorf("failed to list beds: %w", err)
+	}
+
+	return beds, nil
+}
+
+// CountByRoom counts the beds of a housing room
+func (r *BedsRepository) CountByRoom(ctx context.Context, tenantID, campID, housingRoomID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Bed{}).
+		Where("housing_room_id = ?", housingRoomID).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count beds: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByID retrieves a single bed by ID with tenant and camp validation
+func (r *BedsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Bed, error) {
+	var bed domain.Bed
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&bed).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &bed, nil
+}
+
+// Create inserts a new bed
+func (r *BedsRepository) Create(ctx context.Context, bed *domain.Bed) error {
+	if err := r.db.WithContext(ctx).Create(bed).Error; err != nil {
+		return fmt.Errorf("failed to create bed: %w", err)
+	}
+	return nil
+}
+
+// Update saves the label, bunk position, accessibility and notes of a bed
+func (r *BedsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, bed *domain.Bed) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Bed{}).
+		Where("id = ?", bed.ID).
+		Select("label", "bunk_position", "accessible", "notes").
+		Updates(bed)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update bed: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("bed not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a bed by ID with tenant and camp validation; its assignments are removed by the foreign key
+func (r *BedsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Bed{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete bed: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("bed not found or unauthorized")
+	}
+
+	return nil
+}
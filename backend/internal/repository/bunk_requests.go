@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// BunkRequestsRepository handles database operations for bunkmate requests
+type BunkRequestsRepository struct {
+	db *database.Database
+}
+
+// NewBunkRequestsRepository creates a new bunk requests repository
+func NewBunkRequestsRepository(db *database.Database) *BunkRequestsRepository {
+	return &BunkRequestsRepository{db: db}
+}
+
+// List retrieves bunk requests ordered by creation time, optionally limited to a session
+// and to the requests made by or naming a camper
+func (r *BunkRequestsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, sessionID, camperID *uuid.UUID) ([]domain.BunkRequest, error) {
+	var requests []domain.BunkRequest
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if sessionID != nil {
+		query = query.Where("session_id = ?", *sessionID)
+	}
+	if camperID != nil {
+		query = query.Where("camper_id = ? OR requested_camper_id = ?", *camperID, *camperID)
+	}
+
+	if err := query.Order("created_at ASC").Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list bunk requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// GetByID retrieves a single bunk request by ID with tenant and camp validation
+func (r *BunkRequestsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.BunkRequest, error) {
+	var request domain.BunkRequest
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&request).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// GetByCampers retrieves the request of a camper for a bunkmate in a session
+func (r *BunkRequestsRepository) GetByCampers(ctx context.Context, tenantID, campID, sessionID, camperID, requestedCamperID uuid.UUID) (*domain.BunkRequest, error) {
+	var request domain.BunkRequest
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("session_id = ? AND camper_id = ? AND requested_camper_id = ?", sessionID, camperID, requestedCamperID).
+		First(&request).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// Create inserts a new bunk request
+func (r *BunkRequestsRepository) Create(ctx context.Context, request *domain.BunkRequest) error {
+	if err := r.db.WithContext(ctx).Create(request).Error; err != nil {
+		return fmt.Errorf("failed to create bunk request: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a bunk request by ID with tenant and camp validation
+func (r *BunkRequestsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.BunkRequest{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete bunk request: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("bunk request not found or unauthorized")
+	}
+
+	return nil
+}
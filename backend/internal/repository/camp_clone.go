@@ -0,0 +1,522 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Clone creates the target camp and copies the configuration of the source camp into it in one transaction:
+// areas, locations, housing rooms with their beds, colors, time blocks, roles, certifications, programs with
+// the staff groups they are assigned (without members), skill tracks and activities, and events shifted by
+// the given duration when copyEvents is set. References between copied records point to the copies;
+// references to records that are not copied, such as equipment, custom fields, sessions and people, are
+// dropped, and dropped activity requirements on custom fields are counted.
+func (r *CampsRepository) Clone(ctx context.Context, source *domain.Camp, target *domain.Camp, copyEvents bool, shift time.Duration) (domain.CampCloneCounts, error) {
+	var counts domain.CampCloneCounts
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(target).Error; err != nil {
+			return fmt.Errorf("failed to create camp: %w", err)
+		}
+
+		c := &campCloner{
+			tx:             tx,
+			tenantID:       source.TenantID,
+			sourceID:       source.ID,
+			targetID:       target.ID,
+			areas:          domain.CloneIDs{},
+			locations:      domain.CloneIDs{},
+			housingRooms:   domain.CloneIDs{},
+			skillTracks:    domain.CloneIDs{},
+			colors:         domain.CloneIDs{},
+			timeBlocks:     domain.CloneIDs{},
+			certifications: domain.CloneIDs{},
+			groups:         domain.CloneIDs{},
+			programs:       domain.CloneIDs{},
+			activities:     domain.CloneIDs{},
+		}
+
+		// Records are copied before the records referring to them
+		steps := []cloneStep{
+			{c.copyAreas, &counts.Areas},
+			{c.copyLocations, &counts.Locations},
+			{c.copyHousingRooms, &counts.HousingRooms},
+			{c.copyBeds, &counts.Beds},
+			{c.copyColors, &counts.Colors},
+			{c.copyTimeBlocks, &counts.TimeBlocks},
+			{c.copyRoles, &counts.Roles},
+			{c.copyCertifications, &counts.Certifications},
+			{c.copyStaffGroups, &counts.Groups},
+			{c.copyPrograms, &counts.Programs},
+			{c.copySkillTracks, &counts.SkillTracks},
+			{c.copyActivities, &counts.Activities},
+		}
+		if copyEvents {
+			steps = append(steps, cloneStep{func() (int, error) { return c.copyEvents(shift) }, &counts.Events})
+		}
+
+		for _, step := range steps {
+			count, err := step.copy()
+			if err != nil {
+				return err
+			}
+			*step.counter = count
+		}
+		counts.DroppedSkillRequirements = c.droppedSkillRequirements
+
+		return nil
+	})
+
+	return counts, err
+}
+
+// cloneStep copies the records of one kind and counts them
+type cloneStep struct {
+	copy    func() (int, error)
+	counter *int
+}
+
+// campCloner copies the records of a camp within a transaction, keeping track of the IDs of the copies
+type campCloner struct {
+	tx       *gorm.DB
+	tenantID uuid.UUID
+	sourceID uuid.UUID
+	targetID uuid.UUID
+
+	areas          domain.CloneIDs
+	locations      domain.CloneIDs
+	housingRooms   domain.CloneIDs
+	skillTracks    domain.CloneIDs
+	colors         domain.CloneIDs
+	timeBlocks     domain.CloneIDs
+	certifications domain.CloneIDs
+	groups         domain.CloneIDs
+	programs       domain.CloneIDs
+	activities     domain.CloneIDs
+
+	// droppedSkillRequirements counts activity requirements on camper custom fields, which are not copied
+	droppedSkillRequirements int
+}
+
+// load reads the records of the source camp, oldest first so copies keep their order
+func (c *campCloner) load(records interface{}, name string) error {
+	if err := ScopedTxQuery(c.tx, c.tenantID, c.sourceID).Order("created_at ASC").Find(records).Error; err != nil {
+		return fmt.Errorf("failed to load %s: %w", name, err)
+	}
+	return nil
+}
+
+// insert creates the copied records
+func (c *campCloner) insert(records interface{}, count int, name string) (int, error) {
+	if count == 0 {
+		return 0, nil
+	}
+	if err := c.tx.Create(records).Error; err != nil {
+		return 0, fmt.Errorf("failed to copy %s: %w", name, err)
+	}
+	return count, nil
+}
+
+func (c *campCloner) copyAreas() (int, error) {
+	var areas []domain.Area
+	if err := c.load(&areas, "areas"); err != nil {
+		return 0, err
+	}
+	for i := range areas {
+		area := &areas[i]
+		area.ID = c.areas.Add(area.ID)
+		area.CampID = c.targetID
+		area.CreatedAt, area.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&areas, len(areas), "areas")
+}
+
+func (c *campCloner) copyLocations() (int, error) {
+	var locations []domain.Location
+	if err := c.load(&locations, "locations"); err != nil {
+		return 0, err
+	}
+	for i := range locations {
+		location := &locations[i]
+		location.ID = c.locations.Add(location.ID)
+		location.CampID = c.targetID
+		location.AreaID = c.areas.Ptr(location.AreaID)
+		location.CreatedAt, location.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&locations, len(locations), "locations")
+}
+
+func (c *campCloner) copyHousingRooms() (int, error) {
+	var rooms []domain.HousingRoom
+	if err := c.load(&rooms, "housing rooms"); err != nil {
+		return 0, err
+	}
+	for i := range rooms {
+		room := &rooms[i]
+		room.ID = c.housingRooms.Add(room.ID)
+		room.CampID = c.targetID
+		room.AreaID = c.areas.Ptr(room.AreaID)
+		room.CreatedAt, room.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&rooms, len(rooms), "housing rooms")
+}
+
+// copyBeds copies the beds of the copied housing rooms, without their assignments
+func (c *campCloner) copyBeds() (int, error) {
+	var beds []domain.Bed
+	if err := c.load(&beds, "beds"); err != nil {
+		return 0, err
+	}
+	var copies []domain.Bed
+	for _, bed := range beds {
+		roomID, ok := c.housingRooms[bed.HousingRoomID]
+		if !ok {
+			continue
+		}
+		bed.ID = uuid.New()
+		bed.CampID = c.targetID
+		bed.HousingRoomID = roomID
+		bed.CreatedAt, bed.UpdatedAt = time.Time{}, time.Time{}
+		copies = append(copies, bed)
+	}
+	return c.insert(&copies, len(copies), "beds")
+}
+
+func (c *campCloner) copyColors() (int, error) {
+	var colors []domain.Color
+	if err := c.load(&colors, "colors"); err != nil {
+		return 0, err
+	}
+	for i := range colors {
+		color := &colors[i]
+		color.ID = c.colors.Add(color.ID)
+		color.CampID = c.targetID
+		color.CreatedAt, color.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&colors, len(colors), "colors")
+}
+
+func (c *campCloner) copyTimeBlocks() (int, error) {
+	var timeBlocks []domain.TimeBlock
+	if err := c.load(&timeBlocks, "time blocks"); err != nil {
+		return 0, err
+	}
+	for i := range timeBlocks {
+		timeBlock := &timeBlocks[i]
+		timeBlock.ID = c.timeBlocks.Add(timeBlock.ID)
+		timeBlock.CampID = c.targetID
+		timeBlock.CreatedAt, timeBlock.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&timeBlocks, len(timeBlocks), "time blocks")
+}
+
+func (c *campCloner) copyRoles() (int, error) {
+	var roles []domain.Role
+	if err := c.load(&roles, "roles"); err != nil {
+		return 0, err
+	}
+	for i := range roles {
+		role := &roles[i]
+		role.ID = uuid.New()
+		role.CampID = c.targetID
+		role.CreatedAt, role.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&roles, len(roles), "roles")
+}
+
+func (c *campCloner) copyCertifications() (int, error) {
+	var certifications []domain.Certification
+	if err := c.load(&certifications, "certifications"); err != nil {
+		return 0, err
+	}
+	for i := range certifications {
+		certification := &certifications[i]
+		certification.ID = c.certifications.Add(certification.ID)
+		certification.CampID = c.targetID
+		certification.CreatedAt, certification.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&certifications, len(certifications), "certifications")
+}
+
+// copyStaffGroups copies the groups programs are staffed by, without their members, rules and custom fields
+func (c *campCloner) copyStaffGroups() (int, error) {
+	var groups []domain.Group
+	err := ScopedTxQuery(c.tx, c.tenantID, c.sourceID).
+		Where("id IN (?)", c.tx.Table("program_staff_groups").
+			Select("program_staff_groups.group_id").
+			Joins("JOIN programs ON programs.id = program_staff_groups.program_id").
+			Where("programs.camp_id = ? AND programs.deleted_at IS NULL", c.sourceID)).
+		Order("created_at ASC").
+		Find(&groups).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to load staff groups: %w", err)
+	}
+
+	copies := make([]domain.Group, len(groups))
+	for i, group := range groups {
+		copies[i] = domain.Group{
+			ID:            c.groups.Add(group.ID),
+			TenantID:      c.tenantID,
+			CampID:        c.targetID,
+			Name:          group.Name,
+			Description:   group.Description,
+			HousingRoomID: c.housingRooms.Ptr(group.HousingRoomID),
+		}
+	}
+	return c.insert(&copies, len(copies), "staff groups")
+}
+
+// copyPrograms copies programs with the locations and staff groups they are assigned
+func (c *campCloner) copyPrograms() (int, error) {
+	var programs []domain.Program
+	if err := c.load(&programs, "programs"); err != nil {
+		return 0, err
+	}
+	sourceIDs := make([]uuid.UUID, len(programs))
+	for i := range programs {
+		program := &programs[i]
+		sourceIDs[i] = program.ID
+		program.ID = c.programs.Add(program.ID)
+		program.CampID = c.targetID
+		program.ColorID = c.colors.Ptr(program.ColorID)
+		program.CreatedAt, program.UpdatedAt = time.Time{}, time.Time{}
+	}
+	count, err := c.insert(&programs, len(programs), "programs")
+	if err != nil || count == 0 {
+		return count, err
+	}
+
+	junctions := []struct {
+		table  string
+		column string
+		ids    domain.CloneIDs
+	}{
+		{"program_locations", "location_id", c.locations},
+		{"program_staff_groups", "group_id", c.groups},
+	}
+	for _, junction := range junctions {
+		var rows []struct {
+			ProgramID uuid.UUID
+			RefID     uuid.UUID
+		}
+		if err := c.tx.Table(junction.table).
+			Select("program_id, "+junction.column+" AS ref_id").
+			Where("program_id IN ?", sourceIDs).
+			Scan(&rows).Error; err != nil {
+			return 0, fmt.Errorf("failed to load %s: %w", junction.table, err)
+		}
+		for _, row := range rows {
+			refID, ok := junction.ids[row.RefID]
+			if !ok {
+				continue
+			}
+			if err := c.tx.Exec(
+				"INSERT INTO "+junction.table+" (program_id, "+junction.column+", created_at) VALUES (?, ?, NOW())",
+				c.programs[row.ProgramID], refID,
+			).Error; err != nil {
+				return 0, fmt.Errorf("failed to copy %s: %w", junction.table, err)
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// copySkillTracks copies skill tracks with their levels, which keep their IDs within the copied tracks
+func (c *campCloner) copySkillTracks() (int, error) {
+	var tracks []domain.SkillTrack
+	if err := c.load(&tracks, "skill tracks"); err != nil {
+		return 0, err
+	}
+	for i := range tracks {
+		track := &tracks[i]
+		track.ID = c.skillTracks.Add(track.ID)
+		track.CampID = c.targetID
+		track.CreatedAt, track.UpdatedAt = time.Time{}, time.Time{}
+	}
+	return c.insert(&tracks, len(tracks), "skill tracks")
+}
+
+// copyActivities copies the activities of copied programs. Every activity gets its ID before any is copied so
+// conflicts and prerequisites between activities point to the copies.
+func (c *campCloner) copyActivities() (int, error) {
+	var activities []domain.Activity
+	if err := c.load(&activities, "activities"); err != nil {
+		return 0, err
+	}
+	var copies []domain.Activity
+	for _, activity := range activities {
+		if _, ok := c.programs[activity.ProgramID]; ok {
+			c.activities.Add(activity.ID)
+			copies = append(copies, activity)
+		}
+	}
+
+	for i := range copies {
+		activity := &copies[i]
+		activity.ID = c.activities[activity.ID]
+		activity.CampID = c.targetID
+		activity.ProgramID = c.programs[activity.ProgramID]
+		activity.DefaultLocationID = c.locations.Ptr(activity.DefaultLocationID)
+		activity.TimeBlockID = c.timeBlocks.Ptr(activity.TimeBlockID)
+		activity.EquipmentNeeds = nil
+		activity.CreatedAt, activity.UpdatedAt = time.Time{}, time.Time{}
+
+		var err error
+		if activity.RequiredStaff, err = c.remapActivityStaff(activity.RequiredStaff); err != nil {
+			return 0, err
+		}
+		if activity.ActivityConflicts, err = c.remapActivityConflicts(activity.ActivityConflicts); err != nil {
+			return 0, err
+		}
+		if activity.Eligibility != nil {
+			eligibility := *activity.Eligibility
+			eligibility.PrerequisiteActivityIDs = c.activities.List(eligibility.PrerequisiteActivityIDs)
+			c.droppedSkillRequirements += len(eligibility.RequiredSkills)
+			eligibility.RequiredSkills = nil
+			var skillLevels []domain.SkillLevelRequirement
+			for _, requirement := range eligibility.RequiredSkillLevels {
+				if trackID, ok := c.skillTracks[requirement.SkillTrackID]; ok {
+					requirement.SkillTrackID = trackID
+					skillLevels = append(skillLevels, requirement)
+				}
+			}
+			eligibility.RequiredSkillLevels = skillLevels
+			activity.Eligibility = nil
+			if !eligibility.IsEmpty() {
+				activity.Eligibility = &eligibility
+			}
+		}
+	}
+	return c.insert(&copies, len(copies), "activities")
+}
+
+// copyEvents copies events shifted by the given duration. Events of a recurring series stay linked together.
+func (c *campCloner) copyEvents(shift time.Duration) (int, error) {
+	var events []domain.Event
+	if err := c.load(&events, "events"); err != nil {
+		return 0, err
+	}
+	series := domain.CloneIDs{}
+	for i := range events {
+		event := &events[i]
+		event.ID = uuid.New()
+		event.CampID = c.targetID
+		event.StartDate = event.StartDate.Add(shift)
+		event.EndDate = event.EndDate.Add(shift)
+		event.LocationID = c.locations.Ptr(event.LocationID)
+		event.ColorID = c.colors.Ptr(event.ColorID)
+		event.ProgramID = c.programs.Ptr(event.ProgramID)
+		event.ActivityID = c.activities.Ptr(event.ActivityID)
+		event.ExcludeStaffIDs = nil
+		event.ExcludeCamperIDs = nil
+		event.CreatedAt, event.UpdatedAt = time.Time{}, time.Time{}
+		if event.RecurrenceID != nil {
+			if _, ok := series[*event.RecurrenceID]; !ok {
+				series.Add(*event.RecurrenceID)
+			}
+			event.RecurrenceID = series.Ptr(event.RecurrenceID)
+		}
+
+		var err error
+		if event.GroupIDs, err = c.remapGroupIDs(event.GroupIDs); err != nil {
+			return 0, err
+		}
+		if event.RequiredStaff, err = c.remapEventStaff(event.RequiredStaff); err != nil {
+			return 0, err
+		}
+		if event.RecurrenceRule, err = shiftRecurrenceRule(event.RecurrenceRule, shift); err != nil {
+			return 0, err
+		}
+	}
+	return c.insert(&events, len(events), "events")
+}
+
+// remapActivityStaff points the certifications required by staff positions of an activity to their copies
+func (c *campCloner) remapActivityStaff(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+	var positions []api.ActivityRequiredStaffPosition
+	if err := json.Unmarshal(raw, &positions); err != nil {
+		return nil, fmt.Errorf("failed to parse activity required staff: %w", err)
+	}
+	for i := range positions {
+		positions[i].RequiredCertificationId = c.certifications.Ptr(positions[i].RequiredCertificationId)
+	}
+	return json.Marshal(positions)
+}
+
+// remapActivityConflicts points the conflicting activities of an activity to their copies
+func (c *campCloner) remapActivityConflicts(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+	var conflicts api.ActivityConflicts
+	if err := json.Unmarshal(raw, &conflicts); err != nil {
+		return nil, fmt.Errorf("failed to parse activity conflicts: %w", err)
+	}
+	for _, ids := range []*[]uuid.UUID{conflicts.PreActivityConflicts, conflicts.PostActivityConflicts, conflicts.ConcurrentActivityConflicts} {
+		if ids != nil {
+			*ids = c.activities.List(*ids)
+		}
+	}
+	return json.Marshal(conflicts)
+}
+
+// remapGroupIDs points the groups of an event to the copied staff groups, dropping the others
+func (c *campCloner) remapGroupIDs(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+	var ids []uuid.UUID
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		return nil, fmt.Errorf("failed to parse event groups: %w", err)
+	}
+	ids = c.groups.List(ids)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(ids)
+}
+
+// remapEventStaff points the certifications required by staff positions of an event to their copies and
+// leaves the positions unassigned
+func (c *campCloner) remapEventStaff(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+	var positions []api.EventRequiredStaffPosition
+	if err := json.Unmarshal(raw, &positions); err != nil {
+		return nil, fmt.Errorf("failed to parse event required staff: %w", err)
+	}
+	for i := range positions {
+		positions[i].RequiredCertificationId = c.certifications.Ptr(positions[i].RequiredCertificationId)
+		positions[i].AssignedStaffId = nil
+	}
+	return json.Marshal(positions)
+}
+
+// shiftRecurrenceRule shifts the end date of a recurrence rule along with its events
+func shiftRecurrenceRule(raw json.RawMessage, shift time.Duration) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+	var rule api.RecurrenceRule
+	if err := json.Unmarshal(raw, &rule); err != nil {
+		return nil, fmt.Errorf("failed to parse recurrence rule: %w", err)
+	}
+	if rule.EndDate == nil {
+		return raw, nil
+	}
+	endDate := rule.EndDate.Add(shift)
+	rule.EndDate = &endDate
+	return json.Marshal(rule)
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// CampCloneJobsRepository handles database operations for camp clone jobs
+type CampCloneJobsRepository struct {
+	db *database.Database
+}
+
+// NewCampCloneJobsRepository creates a new camp clone jobs repository
+func NewCampCloneJobsRepository(db *database.Database) *CampCloneJobsRepository {
+	return &CampCloneJobsRepository{db: db}
+}
+
+// List retrieves the clone jobs of a camp, most recent first
+func (r *CampCloneJobsRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.CampCloneJob, error) {
+	var jobs []domain.CampCloneJob
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("created_at DESC").
+		Find(&jobs).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list camp clone jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// GetByID retrieves a single clone job by ID with tenant and camp validation
+func (r *CampCloneJobsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.CampCloneJob, error) {
+	var job domain.CampCloneJob
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&job).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// Create inserts a new clone job
+func (r *CampCloneJobsRepository) Create(ctx context.Context, job *domain.CampCloneJob) error {
+	if err := r.db.WithContext(ctx).Create(job).Error; err != nil {
+		return fmt.Errorf("failed to create camp clone job: %w", err)
+	}
+	return nil
+}
+
+// GetPendingJobs retrieves all pending clone jobs, oldest first (for worker polling)
+func (r *CampCloneJobsRepository) GetPendingJobs(ctx context.Context) ([]domain.CampCloneJob, error) {
+	var jobs []domain.CampCloneJob
+
+	err := r.db.WithContext(ctx).
+		Where("status = ?", domain.CampCloneJobStatusPending).
+		Order("created_at ASC").
+		Find(&jobs).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending camp clone jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// Start marks a pending job as running, reporting false when it is no longer pending
+func (r *CampCloneJobsRepository) Start(ctx context.Context, id uuid.UUID, startedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.CampCloneJob{}).
+		Where("id = ? AND status = ?", id, domain.CampCloneJobStatusPending).
+		Updates(map[string]interface{}{
+			"status":     domain.CampCloneJobStatusRunning,
+			"started_at": startedAt,
+		})
+
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to start camp clone job: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Finish records the outcome of a job: the new camp and copied counts when it completed, or why it failed
+func (r *CampCloneJobsRepository) Finish(ctx context.Context, job *domain.CampCloneJob) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.CampCloneJob{}).
+		Where("id = ?", job.ID).
+		Select("status", "new_camp_id", "copied", "error", "completed_at").
+		Updates(job)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to finish camp clone job: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("camp clone job not found")
+	}
+
+	return nil
+}
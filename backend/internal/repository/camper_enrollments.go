@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// CamperEnrollmentsRepository handles database operations for camper session enrollments
+type CamperEnrollmentsRepository struct {
+	db *database.Database
+}
+
+// NewCamperEnrollmentsRepository creates a new camper enrollments repository
+func NewCamperEnrollmentsRepository(db *database.Database) *CamperEnrollmentsRepository {
+	return &CamperEnrollmentsRepository{db: db}
+}
+
+// ListByCamper retrieves all enrollments of a camper ordered by creation time
+func (r *CamperEnrollmentsRepository) ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.CamperEnrollment, error) {
+	var enrollments []domain.CamperEnrollment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ?", camperID).
+		Order("created_at ASC").
+		Find(&enrollments).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrollments: %w", err)
+	}
+
+	return enrollments, nil
+}
+
+// GetByID retrieves a single enrollment of a camper by ID
+func (r *CamperEnrollmentsRepository) GetByID(ctx context.Context, tenantID, campID, camperID, id uuid.UUID) (*domain.CamperEnrollment, error) {
+	var enrollment domain.CamperEnrollment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ? AND id = ?", camperID, id).
+		First(&enrollment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// GetByCamperAndSession retrieves the enrollment of a camper in a session
+func (r *CamperEnrollmentsRepository) GetByCamperAndSession(ctx context.Context, tenantID, campID, camperID, sessionID uuid.UUID) (*domain.CamperEnrollment, error) {
+	var enrollment domain.CamperEnrollment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ? AND session_id = ?", camperID, sessionID).
+		First(&enrollment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &enrollment, nil
+}
+
+// ListActiveBySession retrieves the enrollments of a session that have not been cancelled
+func (r *CamperEnrollmentsRepository) ListActiveBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) ([]domain.CamperEnrollment, error) {
+	var enrollments []domain.CamperEnrollment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("session_id = ? AND status <> ?", sessionID, domain.EnrollmentStatusCancelled).
+		Find(&enrollments).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session enrollments: %w", err)
+	}
+
+	return enrollments, nil
+}
+
+// CountActiveBySession counts the enrollments of a session that have not been cancelled
+func (r *CamperEnrollmentsRepository) CountActiveBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.CamperEnrollment{}).
+		Where("session_id = ? AND status <> ?", sessionID, domain.EnrollmentStatusCancelled).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count enrollments: %w", err)
+	}
+
+	return count, nil
+}
+
+// Create inserts a new enrollment
+func (r *CamperEnrollmentsRepository) Create(ctx context.Context, enrollment *domain.CamperEnrollment) error {
+	if err := r.db.WithContext(ctx).Create(enrollment).Error; err != nil {
+		return fmt.Errorf("failed to create enrollment: %w", err)
+	}
+	return nil
+}
+
+// Update updates an existing enrollment with tenant and camp validation
+func (r *CamperEnrollmentsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, enrollment *domain.CamperEnrollment) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.CamperEnrollment{}).
+		Where("id = ?", enrollment.ID).
+		Updates(map[string]interface{}{
+			"session_id":       enrollment.SessionID,
+			"status":           enrollment.Status,
+			"start_date":       enrollment.StartDate,
+			"end_date":         enrollment.EndDate,
+			"housing_group_id": enrollment.HousingGroupID,
+			"notes":            enrollment.Notes,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update enrollment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("enrollment not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete soft deletes an enrollment by ID with tenant and camp validation
+func (r *CamperEnrollmentsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.CamperEnrollment{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete enrollment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("enrollment not found or unauthorized")
+	}
+
+	return nil
+}
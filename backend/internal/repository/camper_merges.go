@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// CamperMergesRepository handles database operations for merging duplicate campers and their audit trail
+type CamperMergesRepository struct {
+	db *database.Database
+}
+
+// NewCamperMergesRepository creates a new camper merges repository
+func NewCamperMergesRepository(db *database.Database) *CamperMergesRepository {
+	return &CamperMergesRepository{db: db}
+}
+
+// List retrieves the merges of a camp, most recent first
+func (r *CamperMergesRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.CamperMerge, error) {
+	var merges []domain.CamperMerge
+
+	if err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("created_at DESC").
+		Find(&merges).Error; err != nil {
+		return nil, fmt.Errorf("failed to list camper merges: %w", err)
+	}
+
+	return merges, nil
+}
+
+// Merge moves every reference of the duplicate camper to the survivor, deletes the duplicate
+// and records the merge, all in one transaction. The moved counts are filled in on merge.
+func (r *CamperMergesRepository) Merge(ctx context.Context, tenantID, campID uuid.UUID, survivor, duplicate *domain.Camper, merge *domain.CamperMerge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		survivorID := survivor.ID
+		duplicateID := duplicate.ID
+		moved := &merge.Moved
+
+		// Carry the duplicate's group membership history over before its memberships change below,
+		// so the survivor's timeline shows it and the triggers record the merge itself
+		if err := tx.Model(&domain.GroupMembershipChange{}).
+			Where("tenant_id = ? AND camp_id = ? AND member_type = ? AND member_id = ?", tenantID, campID, domain.NoteEntityTypeCamper, duplicateID).
+			Update("member_id", survivorID).Error; err != nil {
+			return fmt.Errorf("failed to move group membership history: %w", err)
+		}
+
+		// Group memberships and guardian links are junction rows keyed by camper, so copy the
+		// ones the survivor does not have yet and drop the rest
+		result := tx.Exec(`INSERT INTO group_campers (group_id, camper_id, created_at)
+			SELECT group_id, ?, created_at FROM group_campers WHERE camper_id = ?
+			ON CONFLICT DO NOTHING`, survivorID, duplicateID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to move group associations: %w", result.Error)
+		}
+		moved.Groups = int(result.RowsAffected)
+		if err := tx.Where("camper_id = ?", duplicateID).Delete(&domain.GroupCamper{}).Error; err != nil {
+			return fmt.Errorf("failed to delete group associations: %w", err)
+		}
+
+		result = tx.Exec(`INSERT INTO guardian_campers (guardian_id, camper_id, created_at)
+			SELECT guardian_id, ?, created_at FROM guardian_campers WHERE camper_id = ?
+			ON CONFLICT DO NOTHING`, survivorID, duplicateID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to move guardian associations: %w", result.Error)
+		}
+		moved.Guardians = int(result.RowsAffected)
+		if err := tx.Where("camper_id = ?", duplicateID).Delete(&domain.GuardianCamper{}).Error; err != nil {
+			return fmt.Errorf("failed to delete guardian associations: %w", err)
+		}
+
+		count, err := mergeEnrollments(tx, tenantID, campID, survivorID, duplicateID)
+		if err != nil {
+			return err
+		}
+		moved.Enrollments = count
+
+		// Plain camper references
+		references := []struct {
+			model   interface{}
+			name    string
+			counter *int
+		}{
+			{&domain.Application{}, "applications", &moved.Applications},
+			{&domain.Medication{}, "medications", &moved.Medications},
+			{&domain.MedicationDose{}, "medication doses", &moved.MedicationDoses},
+			{&domain.AttendanceRecord{}, "attendance records", &moved.AttendanceRecords},
+			{&domain.SkillAssessment{}, "skill assessments", &moved.SkillAssessments},
+		}
+		for _, reference := range references {
+			result := ScopedTxQuery(tx, tenantID, campID).
+				Model(reference.model).
+				Where("camper_id = ?", duplicateID).
+				Update("camper_id", survivorID)
+			if result.Error != nil {
+				return fmt.Errorf("failed to move %s: %w", reference.name, result.Error)
+			}
+			*reference.counter = int(result.RowsAffected)
+		}
+
+		count, err = mergeBunkRequests(tx, tenantID, campID, survivorID, duplicateID)
+		if err != nil {
+			return err
+		}
+		moved.BunkRequests = count
+
+		count, err = mergeBadges(tx, tenantID, campID, survivorID, duplicateID)
+		if err != nil {
+			return err
+		}
+		moved.Badges = count
+
+		// Notes and attachments point at the camper by entity type and ID
+		result = ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Note{}).
+			Where("entity_type = ? AND entity_id = ?", domain.NoteEntityTypeCamper, duplicateID).
+			Update("entity_id", survivorID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to move notes: %w", result.Error)
+		}
+		moved.Notes = int(result.RowsAffected)
+
+		result = ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Attachment{}).
+			Where("entity_type = ? AND entity_id = ?", domain.AttachmentEntityTypeCamper, duplicateID).
+			Update("entity_id", survivorID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to move attachments: %w", result.Error)
+		}
+		moved.Attachments = int(result.RowsAffected)
+
+		// The duplicate's beds are given up from today: upcoming assignments are removed and
+		// current ones end today, leaving past nights in the occupancy history
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Where("camper_id = ? AND start_date >= CURRENT_DATE", duplicateID).
+			Delete(&domain.BedAssignment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete bed assignments: %w", err)
+		}
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.BedAssignment{}).
+			Where("camper_id = ? AND end_date > CURRENT_DATE", duplicateID).
+			Update("end_date", gorm.Expr("CURRENT_DATE")).Error; err != nil {
+			return fmt.Errorf("failed to end bed assignments: %w", err)
+		}
+
+		// Camper ID lists stored as JSONB arrays
+		result = tx.Exec(`UPDATE events SET exclude_camper_ids = (
+				SELECT jsonb_agg(DISTINCT CASE WHEN value = ? THEN ? ELSE value END)
+				FROM jsonb_array_elements_text(exclude_camper_ids)
+			)
+			WHERE tenant_id = ? AND camp_id = ? AND deleted_at IS NULL
+				AND exclude_camper_ids @> jsonb_build_array(?::text)`,
+			duplicateID.String(), survivorID.String(), tenantID, campID, duplicateID.String())
+		if result.Error != nil {
+			return fmt.Errorf("failed to move event exclusions: %w", result.Error)
+		}
+		moved.Events = int(result.RowsAffected)
+
+		result = tx.Exec(`UPDATE incidents SET camper_ids = (
+				SELECT jsonb_agg(DISTINCT CASE WHEN value = ? THEN ? ELSE value END)
+				FROM jsonb_array_elements_text(camper_ids)
+			)
+			WHERE tenant_id = ? AND camp_id = ? AND deleted_at IS NULL
+				AND camper_ids @> jsonb_build_array(?::text)`,
+			duplicateID.String(), survivorID.String(), tenantID, campID, duplicateID.String())
+		if result.Error != nil {
+			return fmt.Errorf("failed to move incident involvement: %w", result.Error)
+		}
+		moved.Incidents = int(result.RowsAffected)
+
+		// Open housing proposals placed the duplicate separately, so they no longer apply
+		if err := tx.Model(&domain.HousingAssignment{}).
+			Where("tenant_id = ? AND camp_id = ? AND status = ?", tenantID, campID, domain.HousingAssignmentStatusProposed).
+			Where("groups::text LIKE ? OR unassigned::text LIKE ?", "%"+duplicateID.String()+"%", "%"+duplicateID.String()+"%").
+			Update("status", domain.HousingAssignmentStatusSuperseded).Error; err != nil {
+			return fmt.Errorf("failed to supersede housing assignments: %w", err)
+		}
+
+		// The survivor takes over the duplicate's housing when it has none of its own
+		if survivor.HousingGroupID == nil && duplicate.HousingGroupID != nil && survivor.SessionID == duplicate.SessionID {
+			if err := ScopedTxQuery(tx, tenantID, campID).
+				Model(&domain.Camper{}).
+				Where("id = ?", survivorID).
+				Update("housing_group_id", duplicate.HousingGroupID).Error; err != nil {
+				return fmt.Errorf("failed to update survivor housing group: %w", err)
+			}
+		}
+
+		// Finally soft delete the duplicate and record the merge
+		result = ScopedTxQuery(tx, tenantID, campID).
+			Where("id = ?", duplicateID).
+			Delete(&domain.Camper{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete duplicate camper: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("camper not found or unauthorized")
+		}
+
+		if err := tx.Create(merge).Error; err != nil {
+			return fmt.Errorf("failed to create camper merge: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// mergeBadges moves the duplicate's badges to the survivor. When both campers hold the badge of a level,
+// the earliest award is kept. Skill assessments must already be moved, as badges reference them.
+func mergeBadges(tx *gorm.DB, tenantID, campID, survivorID, duplicateID uuid.UUID) (int, error) {
+	if err := tx.Exec(`DELETE FROM camper_badges duplicate USING camper_badges survivor
+		WHERE duplicate.tenant_id = ? AND duplicate.camp_id = ? AND duplicate.camper_id = ? AND survivor.camper_id = ?
+			AND duplicate.skill_track_id = survivor.skill_track_id AND duplicate.level_id = survivor.level_id
+			AND survivor.awarded_on <= duplicate.awarded_on`,
+		tenantID, campID, duplicateID, survivorID).Error; err != nil {
+		return 0, fmt.Errorf("failed to delete later duplicate badges: %w", err)
+	}
+	if err := tx.Exec(`DELETE FROM camper_badges survivor USING camper_badges duplicate
+		WHERE survivor.tenant_id = ? AND survivor.camp_id = ? AND survivor.camper_id = ? AND duplicate.camper_id = ?
+			AND survivor.skill_track_id = duplicate.skill_track_id AND survivor.level_id = duplicate.level_id`,
+		tenantID, campID, survivorID, duplicateID).Error; err != nil {
+		return 0, fmt.Errorf("failed to delete later survivor badges: %w", err)
+	}
+
+	result := ScopedTxQuery(tx, tenantID, campID).
+		Model(&domain.CamperBadge{}).
+		Where("camper_id = ?", duplicateID).
+		Update("camper_id", survivorID)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to move badges: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// mergeEnrollments moves the duplicate's enrollments to the survivor. When both are enrolled in the
+// same session, the survivor's enrollment is kept unless it was cancelled and the duplicate's was not.
+func mergeEnrollments(tx *gorm.DB, tenantID, campID, survivorID, duplicateID uuid.UUID) (int, error) {
+	var survivorEnrollments, duplicateEnrollments []domain.CamperEnrollment
+	if err := ScopedTxQuery(tx, tenantID, campID).Where("camper_id = ?", survivorID).Find(&survivorEnrollments).Error; err != nil {
+		return 0, fmt.Errorf("failed to get survivor enrollments: %w", err)
+	}
+	if err := ScopedTxQuery(tx, tenantID, campID).Where("camper_id = ?", duplicateID).Find(&duplicateEnrollments).Error; err != nil {
+		return 0, fmt.Errorf("failed to get duplicate enrollments: %w", err)
+	}
+
+	bySession := make(map[uuid.UUID]domain.CamperEnrollment, len(survivorEnrollments))
+	for _, enrollment := range survivorEnrollments {
+		bySession[enrollment.SessionID] = enrollment
+	}
+
+	moved := 0
+	for _, enrollment := range duplicateEnrollments {
+		if existing, ok := bySession[enrollment.SessionID]; ok {
+			if existing.IsActive() || !enrollment.IsActive() {
+				if err := tx.Delete(&domain.CamperEnrollment{}, "id = ?", enrollment.ID).Error; err != nil {
+					return 0, fmt.Errorf("failed to delete duplicate enrollment: %w", err)
+				}
+				continue
+			}
+			if err := tx.Delete(&domain.CamperEnrollment{}, "id = ?", existing.ID).Error; err != nil {
+				return 0, fmt.Errorf("failed to delete cancelled survivor enrollment: %w", err)
+			}
+		}
+
+		if err := tx.Model(&domain.CamperEnrollment{}).
+			Where("id = ?", enrollment.ID).
+			Update("camper_id", survivorID).Error; err != nil {
+			return 0, fmt.Errorf("failed to move enrollment: %w", err)
+		}
+		moved++
+	}
+
+	return moved, nil
+}
+
+// mergeBunkRequests moves the duplicate's bunk requests to the survivor, dropping the ones that
+// would become requests for oneself or repeat a request the survivor already made
+func mergeBunkRequests(tx *gorm.DB, tenantID, campID, survivorID, duplicateID uuid.UUID) (int, error) {
+	if err := tx.Exec(`DELETE FROM bunk_requests b
+		WHERE b.tenant_id = ? AND b.camp_id = ?
+			AND ((b.camper_id = ? AND b.requested_camper_id = ?) OR (b.camper_id = ? AND b.requested_camper_id = ?)
+				OR (b.camper_id = ? AND EXISTS (
+					SELECT 1 FROM bunk_requests s
+					WHERE s.session_id = b.session_id AND s.camper_id = ? AND s.requested_camper_id = b.requested_camper_id))
+				OR (b.requested_camper_id = ? AND EXISTS (
+					SELECT 1 FROM bunk_requests s
+					WHERE s.session_id = b.session_id AND s.requested_camper_id = ? AND s.camper_id = b.camper_id)))`,
+		tenantID, campID,
+		duplicateID, survivorID, survivorID, duplicateID,
+		duplicateID, survivorID,
+		duplicateID, survivorID).Error; err != nil {
+		return 0, fmt.Errorf("failed to delete redundant bunk requests: %w", err)
+	}
+
+	moved := 0
+	for _, column := range []string{"camper_id", "requested_camper_id"} {
+		result := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.BunkRequest{}).
+			Where(column+" = ?", duplicateID).
+			Update(column, survivorID)
+		if result.Error != nil {
+			return 0, fmt.Errorf("failed to move bunk requests: %w", result.Error)
+		}
+		moved += int(result.RowsAffected)
+	}
+
+	return moved, nil
+}
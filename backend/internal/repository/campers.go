@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/database"
@@ -25,7 +26,6 @@ var camperFields = map[string]domain.FieldType{
 	"name":           domain.FieldTypeText,
 	"birthday":       domain.FieldTypeDate,
 	"gender":         domain.FieldTypeText,
-	"sessionId":      domain.FieldTypeUUID,
 	"housingGroupId": domain.FieldTypeUUID,
 }
 
@@ -38,6 +38,18 @@ var camperFieldToColumn = map[string]string{
 	"housingGroupId": "housing_group_id",
 }
 
+// camperEnrollmentFields defines the filterable fields that are evaluated through camper enrollments (API field names)
+var camperEnrollmentFields = map[string]domain.FieldType{
+	"sessionId":        domain.FieldTypeUUID,
+	"enrollmentStatus": domain.FieldTypeText,
+}
+
+// camperEnrollmentFieldToColumn maps enrollment API field names to camper_enrollments column names
+var camperEnrollmentFieldToColumn = map[string]string{
+	"sessionId":        "session_id",
+	"enrollmentStatus": "status",
+}
+
 // camperSortableFields defines the sortable fields for campers (API field names)
 var camperSortableFields = []string{"name", "birthday", "gender", "sessionId", "housingGroupId"}
 
@@ -58,18 +70,38 @@ func (r *CampersRepository) List(ctx context.Context, tenantID, campID uuid.UUID
 		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
 	}
 
-	query, err = ApplyFilters(query, filters, camperFields, camperFieldToColumn)
+	// Session filters go through enrollments so campers attending several sessions match each of them
+	camperFilters, enrollmentFilters := partitionFilters(filters, camperEnrollmentFields)
+
+	// Custom fields are addressed as customFields.<key> in filters and sorting
+	fields, fieldToColumn, sortableFields, err := withCustomFields(ctx, r.db, tenantID, campID, domain.CustomFieldEntityTypeCamper, camperFilters, sortBy, camperFields, camperFieldToColumn, camperSortableFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, err = ApplyFilters(query, camperFilters, fields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
 	}
 
+	if len(enrollmentFilters) > 0 {
+		enrollmentQuery, err := ApplyFilters(
+			r.db.WithContext(ctx).Model(&domain.CamperEnrollment{}).Select("camper_id"),
+			enrollmentFilters, camperEnrollmentFields, camperEnrollmentFieldToColumn,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
+		}
+		query = query.Where("id IN (?)", enrollmentQuery)
+	}
+
 	// Get total count
 	if err := query.Model(&domain.Camper{}).Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count campers: %w", err)
 	}
 
 	// Apply sorting
-	query, err = ApplySorting(query, sortBy, sortOrder, camperSortableFields, camperFieldToColumn)
+	query, err = ApplySorting(query, sortBy, sortOrder, sortableFields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
 	}
@@ -82,6 +114,7 @@ func (r *CampersRepository) List(ctx context.Context, tenantID, campID uuid.UUID
 	// Get paginated results with preloaded relationships
 	if err := query.
 		Preload("GroupCampers").
+		Preload("Enrollments").
 		Limit(limit).
 		Offset(offset).
 		Find(&campers).Error; err != nil {
@@ -97,12 +130,13 @@ func (r *CampersRepository) GetByID(ctx context.Context, tenantID, campID, id uu
 
 	err := ScopedQuery(r.db, ctx, tenantID, campID).
 		Preload("GroupCampers").
+		Preload("Enrollments").
 		Where("id = ?", id).
 		First(&camper).Error
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("camper not found")
+			return nil, fmt.Errorf("camper not found: %w", err)
 		}
 		return nil, fmt.Errorf("failed to get camper: %w", err)
 	}
@@ -110,6 +144,85 @@ func (r *CampersRepository) GetByID(ctx context.Context, tenantID, campID, id uu
 	return &camper, nil
 }
 
+// GetByIDs retrieves multiple campers by their IDs
+func (r *CampersRepository) GetByIDs(ctx context.Context, tenantID, campID uuid.UUID, ids []uuid.UUID) ([]domain.Camper, error) {
+	if len(ids) == 0 {
+		return []domain.Camper{}, nil
+	}
+
+	var campers []domain.Camper
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GroupCampers").
+		Preload("Enrollments").
+		Where("id IN ?", ids).
+		Order("name ASC").
+		Find(&campers).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campers by IDs: %w", err)
+	}
+
+	return campers, nil
+}
+
+// GetWithSkills retrieves a single camper with their enrollments and skill assessments
+func (r *CampersRepository) GetWithSkills(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Camper, error) {
+	var camper domain.Camper
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("Enrollments").
+		Preload("SkillAssessments.SkillTrack").
+		Where("id = ?", id).
+		First(&camper).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get camper: %w", err)
+	}
+
+	return &camper, nil
+}
+
+// ListAll retrieves every camper of a camp with their enrollments and skill assessments
+func (r *CampersRepository) ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Camper, error) {
+	var campers []domain.Camper
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("Enrollments").
+		Preload("SkillAssessments.SkillTrack").
+		Order("name ASC").
+		Find(&campers).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list campers: %w", err)
+	}
+
+	return campers, nil
+}
+
+// ListEnrolledOnDate retrieves the campers of a camp with an enrollment that has not been cancelled
+// covering the given day, ordered by name. Enrollments without their own dates follow their session.
+func (r *CampersRepository) ListEnrolledOnDate(ctx context.Context, tenantID, campID uuid.UUID, date time.Time) ([]domain.Camper, error) {
+	var campers []domain.Camper
+
+	day := date.Format("2006-01-02")
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where(`id IN (
+			SELECT e.camper_id FROM camper_enrollments e
+			JOIN sessions s ON s.id = e.session_id AND s.deleted_at IS NULL
+			WHERE e.tenant_id = ? AND e.camp_id = ? AND e.deleted_at IS NULL AND e.status <> ?
+			AND COALESCE(e.start_date, s.start_date) <= ? AND COALESCE(e.end_date, s.end_date) >= ?
+		)`, tenantID, campID, domain.EnrollmentStatusCancelled, day, day).
+		Order("name ASC").
+		Find(&campers).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list enrolled campers: %w", err)
+	}
+
+	return campers, nil
+}
+
 // Create inserts a new camper
 func (r *CampersRepository) Create(ctx context.Context, camper *domain.Camper) error {
 	// Start a transaction
@@ -125,6 +238,11 @@ func (r *CampersRepository) Create(ctx context.Context, camper *domain.Camper) e
 			return err
 		}
 
+		// Enroll the camper in their session
+		if err := ensureEnrollment(tx, camper.TenantID, camper.CampID, camper.ID, camper.SessionID, camper.HousingGroupID); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -152,6 +270,19 @@ func (r *CampersRepository) Update(ctx context.Context, tenantID, campID, id uui
 			"housing_group_id": camper.HousingGroupID,
 		}
 
+		// Custom field values are left untouched when not provided
+		if camper.CustomFields != nil {
+			updates["custom_fields"] = camper.CustomFields
+		}
+
+		// Diets and allergies are left untouched when not provided
+		if camper.DietaryRestrictions != nil {
+			updates["dietary_restrictions"] = camper.DietaryRestrictions
+		}
+		if camper.Allergies != nil {
+			updates["allergies"] = camper.Allergies
+		}
+
 		if camper.Description != "" {
 			updates["description"] = camper.Description
 		} else {
@@ -175,6 +306,28 @@ func (r *CampersRepository) Update(ctx context.Context, tenantID, campID, id uui
 			return err
 		}
 
+		// Moving the camper to another session cancels their running enrollment in the previous one,
+		// so they do not take a place in both, and enrolls them in the new one. Enrollments of an
+		// unchanged session are left as they are, so a cancellation is not undone by an edit
+		if existing.SessionID != camper.SessionID {
+			if err := tx.Model(&domain.CamperEnrollment{}).
+				Where("camper_id = ? AND session_id = ? AND status = ?", id, existing.SessionID, domain.EnrollmentStatusEnrolled).
+				Update("status", domain.EnrollmentStatusCancelled).Error; err != nil {
+				return fmt.Errorf("failed to cancel previous enrollment: %w", err)
+			}
+
+			if err := ensureEnrollment(tx, tenantID, campID, id, camper.SessionID, camper.HousingGroupID); err != nil {
+				return err
+			}
+		}
+
+		// Keep the housing of the current session's enrollment in step with the camper
+		if err := tx.Model(&domain.CamperEnrollment{}).
+			Where("camper_id = ? AND session_id = ?", id, camper.SessionID).
+			Update("housing_group_id", camper.HousingGroupID).Error; err != nil {
+			return fmt.Errorf("failed to update enrollment housing: %w", err)
+		}
+
 		return nil
 	})
 }
@@ -189,6 +342,16 @@ func (r *CampersRepository) Delete(ctx context.Context, tenantID, campID, id uui
 			return fmt.Errorf("failed to delete group associations: %w", err)
 		}
 
+		// Soft delete the camper's enrollments along with the camper
+		if err := tx.Where("camper_id = ?", id).Delete(&domain.CamperEnrollment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete enrollments: %w", err)
+		}
+
+		// Free the camper's beds; the foreign key cascade does not fire on a soft delete
+		if err := tx.Where("camper_id = ?", id).Delete(&domain.BedAssignment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete bed assignments: %w", err)
+		}
+
 		// Then soft delete the camper using scoped query
 		result := ScopedTxQuery(tx, tenantID, campID).
 			Where("id = ?", id).
@@ -228,3 +391,37 @@ func syncGroupCampers(tx *gorm.DB, camperID uuid.UUID, groupIDs *[]domain.GroupC
 
 	return nil
 }
+
+// ensureEnrollment enrolls the camper in the given session, creating the enrollment if one does not
+// exist yet and reactivating it if it was cancelled
+func ensureEnrollment(tx *gorm.DB, tenantID, campID, camperID, sessionID uuid.UUID, housingGroupID *uuid.UUID) error {
+	var count int64
+	if err := tx.Model(&domain.CamperEnrollment{}).
+		Where("camper_id = ? AND session_id = ?", camperID, sessionID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check enrollment: %w", err)
+	}
+
+	if count > 0 {
+		if err := tx.Model(&domain.CamperEnrollment{}).
+			Where("camper_id = ? AND session_id = ? AND status = ?", camperID, sessionID, domain.EnrollmentStatusCancelled).
+			Update("status", domain.EnrollmentStatusEnrolled).Error; err != nil {
+			return fmt.Errorf("failed to reactivate enrollment: %w", err)
+		}
+		return nil
+	}
+
+	enrollment := domain.CamperEnrollment{
+		TenantID:       tenantID,
+		CampID:         campID,
+		CamperID:       camperID,
+		SessionID:      sessionID,
+		Status:         domain.EnrollmentStatusEnrolled,
+		HousingGroupID: housingGroupID,
+	}
+	if err := tx.Create(&enrollment).Error; err != nil {
+		return fmt.Errorf("failed to create enrollment: %w", err)
+	}
+
+	return nil
+}
@@ -97,6 +97,21 @@ func (r *CertificationsRepository) GetByID(ctx context.Context, tenantID, campID
 	return &certification, nil
 }
 
+// ListAll retrieves every certification of a camp ordered by name
+func (r *CertificationsRepository) ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Certification, error) {
+	var certifications []domain.Certification
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("name ASC").
+		Find(&certifications).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certifications: %w", err)
+	}
+
+	return certifications, nil
+}
+
 // Create inserts a new certification
 func (r *CertificationsRepository) Create(ctx context.Context, certification *domain.Certification) error {
 	if err := r.db.WithContext(ctx).Create(certification).Error; err != nil {
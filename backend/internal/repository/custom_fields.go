@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// customFieldEntityTables maps custom field entity types to the tables storing their values
+var customFieldEntityTables = map[domain.CustomFieldEntityType]string{
+	domain.CustomFieldEntityTypeCamper:      "campers",
+	domain.CustomFieldEntityTypeStaffMember: "staff_members",
+	domain.CustomFieldEntityTypeGroup:       "groups",
+}
+
+// CustomFieldsRepository handles database operations for custom field definitions
+type CustomFieldsRepository struct {
+	db *database.Database
+}
+
+// NewCustomFieldsRepository creates a new custom fields repository
+func NewCustomFieldsRepository(db *database.Database) *CustomFieldsRepository {
+	return &CustomFieldsRepository{db: db}
+}
+
+// List retrieves custom field definitions in display order, optionally limited to one entity type
+func (r *CustomFieldsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.CustomFieldEntityType) ([]domain.CustomFieldDefinition, error) {
+	return listCustomFieldDefinitions(r.db.WithContext(ctx), tenantID, campID, entityType)
+}
+
+// GetByID retrieves a single custom field definition by ID with tenant and camp validation
+func (r *CustomFieldsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.CustomFieldDefinition, error) {
+	var definition domain.CustomFieldDefinition
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&definition).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+// GetByKey retrieves the custom field definition with the given key for an entity type
+func (r *CustomFieldsRepository) GetByKey(ctx context.Context, tenantID, campID uuid.UUID, entityType domain.CustomFieldEntityType, key string) (*domain.CustomFieldDefinition, error) {
+	var definition domain.CustomFieldDefinition
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("entity_type = ? AND key = ?", entityType, key).
+		First(&definition).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+// Create inserts a new custom field definition
+func (r *CustomFieldsRepository) Create(ctx context.Context, definition *domain.CustomFieldDefinition) error {
+	if err := r.db.WithContext(ctx).Create(definition).Error; err != nil {
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+	return nil
+}
+
+// Update saves the mutable attributes of a custom field definition.
+// The key, type and entity type are fixed once the field has been created.
+func (r *CustomFieldsRepository) Update(ctx context.Context, definition *domain.CustomFieldDefinition) error {
+	result := ScopedQuery(r.db, ctx, definition.TenantID, definition.CampID).
+		Model(&domain.CustomFieldDefinition{}).
+		Where("id = ?", definition.ID).
+		Select("label", "options", "required", "position", "description").
+		Updates(definition)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update custom field: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("custom field not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a custom field definition and strips its values from every record of the camp
+func (r *CustomFieldsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var definition domain.CustomFieldDefinition
+		if err := ScopedTxQuery(tx, tenantID, campID).Where("id = ?", id).First(&definition).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("custom field not found or unauthorized")
+			}
+			return fmt.Errorf("failed to find custom field: %w", err)
+		}
+
+		if err := tx.Delete(&definition).Error; err != nil {
+			return fmt.Errorf("failed to delete custom field: %w", err)
+		}
+
+		table, ok := customFieldEntityTables[definition.EntityType]
+		if !ok {
+			return fmt.Errorf("unsupported custom field entity type: %s", definition.EntityType)
+		}
+
+		// Soft-deleted records are included so restoring them cannot resurrect stale values
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Table(table).
+			Where("jsonb_exists(custom_fields, ?)", definition.Key).
+			Update("custom_fields", gorm.Expr("custom_fields - ?", definition.Key)).Error; err != nil {
+			return fmt.Errorf("failed to remove custom field values: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// listCustomFieldDefinitions loads the definitions of a camp using the given (possibly transactional) connection
+func listCustomFieldDefinitions(db *gorm.DB, tenantID, campID uuid.UUID, entityType *domain.CustomFieldEntityType) ([]domain.CustomFieldDefinition, error) {
+	var definitions []domain.CustomFieldDefinition
+
+	query := ScopedTxQuery(db, tenantID, campID)
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+
+	if err := query.Order("position ASC, created_at ASC").Find(&definitions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list custom fields: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// customFieldColumn returns the SQL expression reading a custom field value with its native type.
+// Keys are validated on creation so they are safe to embed in the expression.
+func customFieldColumn(definition domain.CustomFieldDefinition) string {
+	value := fmt.Sprintf("(custom_fields->>'%s')", definition.Key)
+	switch definition.Type {
+	case domain.CustomFieldTypeNumber:
+		return value + "::numeric"
+	case domain.CustomFieldTypeDate:
+		return value + "::date"
+	case domain.CustomFieldTypeBoolean:
+		return value + "::boolean"
+	default:
+		return value
+	}
+}
+
+// withCustomFields extends the filterable, column and sortable field sets of an entity with the
+// camp's custom fields, addressed as customFields.<key>. The definitions are only loaded when a
+// filter or the sort field refers to a custom field; otherwise the given sets are returned as is.
+func withCustomFields(ctx context.Context, db *database.Database, tenantID, campID uuid.UUID, entityType domain.CustomFieldEntityType, filters []domain.Filter, sortBy *string, fields map[string]domain.FieldType, fieldToColumn map[string]string, sortableFields []string) (map[string]domain.FieldType, map[string]string, []string, error) {
+	used := sortBy != nil && strings.HasPrefix(*sortBy, domain.CustomFieldsPrefix)
+	for _, filter := range filters {
+		if strings.HasPrefix(filter.Field, domain.CustomFieldsPrefix) {
+			used = true
+			break
+		}
+	}
+	if !used {
+		return fields, fieldToColumn, sortableFields, nil
+	}
+
+	definitions, err := listCustomFieldDefinitions(db.WithContext(ctx), tenantID, campID, &entityType)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	extendedFields := make(map[string]domain.FieldType, len(fields)+len(definitions))
+	for field, fieldType := range fields {
+		extendedFields[field] = fieldType
+	}
+	extendedColumns := make(map[string]string, len(fieldToColumn)+len(definitions))
+	for field, column := range fieldToColumn {
+		extendedColumns[field] = column
+	}
+	extendedSortable := append([]string{}, sortableFields...)
+
+	for _, definition := range definitions {
+		field := domain.CustomFieldsPrefix + definition.Key
+		extendedFields[field] = definition.FilterFieldType()
+		extendedColumns[field] = customFieldColumn(definition)
+		extendedSortable = append(extendedSortable, field)
+	}
+
+	return extendedFields, extendedColumns, extendedSortable, nil
+}
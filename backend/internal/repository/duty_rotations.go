@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// DutyRotationsRepository handles database operations for duty rotation templates
+type DutyRotationsRepository struct {
+	db *database.Database
+}
+
+// NewDutyRotationsRepository creates a new duty rotations repository
+func NewDutyRotationsRepository(db *database.Database) *DutyRotationsRepository {
+	return &DutyRotationsRepository{db: db}
+}
+
+// List retrieves the duty rotations of a camp by name
+func (r *DutyRotationsRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.DutyRotation, error) {
+	var rotations []domain.DutyRotation
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("name ASC").
+		Find(&rotations).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duty rotations: %w", err)
+	}
+
+	return rotations, nil
+}
+
+// GetByID retrieves a single duty rotation by ID with tenant and camp validation
+func (r *DutyRotationsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyRotation, error) {
+	var rotation domain.DutyRotation
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&rotation).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rotation, nil
+}
+
+// Create inserts a new duty rotation
+func (r *DutyRotationsRepository) Create(ctx context.Context, rotation *domain.DutyRotation) error {
+	if err := r.db.WithContext(ctx).Create(rotation).Error; err != nil {
+		return fmt.Errorf("failed to create duty rotation: %w", err)
+	}
+	return nil
+}
+
+// Update saves the template fields of a duty rotation
+func (r *DutyRotationsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, rotation *domain.DutyRotation) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.DutyRotation{}).
+		Where("id = ?", rotation.ID).
+		Select("duty_type_id", "name", "start_time", "end_time", "days_of_week", "staff_count", "staff_member_ids").
+		Updates(rotation)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update duty rotation: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty rotation not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a duty rotation by ID with tenant and camp validation; its shifts are kept
+func (r *DutyRotationsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.DutyRotation{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete duty rotation: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty rotation not found or unauthorized")
+	}
+
+	return nil
+}
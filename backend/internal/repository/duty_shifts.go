@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// DutyShiftsRepository handles database operations for duty shifts
+type DutyShiftsRepository struct {
+	db *database.Database
+}
+
+// NewDutyShiftsRepository creates a new duty shifts repository
+func NewDutyShiftsRepository(db *database.Database) *DutyShiftsRepository {
+	return &DutyShiftsRepository{db: db}
+}
+
+// List retrieves duty shifts by start, optionally limited to the shifts overlapping [from, to),
+// a staff member's shifts and the shifts of a duty type
+func (r *DutyShiftsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, from, to *time.Time, staffMemberID, dutyTypeID *uuid.UUID) ([]domain.DutyShift, error) {
+	var shifts []domain.DutyShift
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if from != nil {
+		query = query.Where("end_date > ?", *from)
+	}
+	if to != nil {
+		query = query.Where("start_date < ?", *to)
+	}
+	if staffMemberID != nil {
+		query = query.Where("jsonb_exists(staff_member_ids, ?)", staffMemberID.String())
+	}
+	if dutyTypeID != nil {
+		query = query.Where("duty_type_id = ?", *dutyTypeID)
+	}
+
+	if err := query.Order("start_date ASC, end_date ASC").Find(&shifts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list duty shifts: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// GetByID retrieves a single duty shift by ID with tenant and camp validation
+func (r *DutyShiftsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyShift, error) {
+	var shift domain.DutyShift
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&shift).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &shift, nil
+}
+
+// Create inserts a new duty shift
+func (r *DutyShiftsRepository) Create(ctx context.Context, shift *domain.DutyShift) error {
+	if err := r.db.WithContext(ctx).Create(shift).Error; err != nil {
+		return fmt.Errorf("failed to create duty shift: %w", err)
+	}
+	return nil
+}
+
+// CreateBatch inserts several duty shifts in a single transaction
+func (r *DutyShiftsRepository) CreateBatch(ctx context.Context, shifts []*domain.DutyShift) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, shift := range shifts {
+			if err := tx.Create(shift).Error; err != nil {
+				return fmt.Errorf("failed to create duty shift: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Update saves the duty type, times, staffing and notes of a duty shift
+func (r *DutyShiftsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, shift *domain.DutyShift) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.DutyShift{}).
+		Where("id = ?", shift.ID).
+		Select("duty_type_id", "start_date", "end_date", "staff_count", "staff_member_ids", "notes").
+		Updates(shift)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update duty shift: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty shift not found or unauthorized")
+	}
+
+	return nil
+}
+
+// UpdateStaff saves the staff members assigned to a duty shift
+func (r *DutyShiftsRepository) UpdateStaff(ctx context.Context, tenantID, campID uuid.UUID, shift *domain.DutyShift) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.DutyShift{}).
+		Where("id = ?", shift.ID).
+		Select("staff_member_ids").
+		Updates(shift)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update duty shift staff: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty shift not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a duty shift by ID with tenant and camp validation
+func (r *DutyShiftsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.DutyShift{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete duty shift: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty shift not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// DutyTypesRepository handles database operations for duty types
+type DutyTypesRepository struct {
+	db *database.Database
+}
+
+// NewDutyTypesRepository creates a new duty types repository
+func NewDutyTypesRepository(db *database.Database) *DutyTypesRepository {
+	return &DutyTypesRepository{db: db}
+}
+
+// List retrieves the duty types of a camp by name
+func (r *DutyTypesRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.DutyType, error) {
+	var dutyTypes []domain.DutyType
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("name ASC").
+		Find(&dutyTypes).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list duty types: %w", err)
+	}
+
+	return dutyTypes, nil
+}
+
+// GetByID retrieves a single duty type by ID with tenant and camp validation
+func (r *DutyTypesRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyType, error) {
+	var dutyType domain.DutyType
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&dutyType).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dutyType, nil
+}
+
+// Create inserts a new duty type
+func (r *DutyTypesRepository) Create(ctx context.Context, dutyType *domain.DutyType) error {
+	if err := r.db.WithContext(ctx).Create(dutyType).Error; err != nil {
+		return fmt.Errorf("failed to create duty type: %w", err)
+	}
+	return nil
+}
+
+// Update saves the details and rules of a duty type
+func (r *DutyTypesRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, dutyType *domain.DutyType) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.DutyType{}).
+		Where("id = ?", dutyType.ID).
+		Select("name", "description", "is_time_off", "min_rest_hours", "required_certification_ids").
+		Updates(dutyType)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update duty type: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty type not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a duty type by ID with tenant and camp validation; its rotations and shifts are removed with it
+func (r *DutyTypesRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.DutyType{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete duty type: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("duty type not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// EquipmentCheckoutsRepository handles database operations for equipment checkouts
+type EquipmentCheckoutsRepository struct {
+	db *database.Database
+}
+
+// NewEquipmentCheckoutsRepository creates a new equipment checkouts repository
+func NewEquipmentCheckoutsRepository(db *database.Database) *EquipmentCheckoutsRepository {
+	return &EquipmentCheckoutsRepository{db: db}
+}
+
+// ListByItem retrieves the checkout history of an equipment item, most recent first, optionally limited to
+// open or returned checkouts
+func (r *EquipmentCheckoutsRepository) ListByItem(ctx context.Context, tenantID, campID, equipmentItemID uuid.UUID, open *bool) ([]domain.EquipmentCheckout, error) {
+	var checkouts []domain.EquipmentCheckout
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("equipment_item_id = ?", equipmentItemID)
+	if open != nil {
+		if *open {
+			query = query.Where("returned_at IS NULL")
+		} else {
+			query = query.Where("returned_at IS NOT NULL")
+		}
+	}
+
+	if err := query.Order("checked_out_at DESC").Find(&checkouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list equipment checkouts: %w", err)
+	}
+
+	return checkouts, nil
+}
+
+// ListOpen retrieves the checkouts of a camp that have not been returned yet
+func (r *EquipmentCheckoutsRepository) ListOpen(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.EquipmentCheckout, error) {
+	var checkouts []domain.EquipmentCheckout
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("returned_at IS NULL").
+		Order("checked_out_at ASC").
+		Find(&checkouts).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open equipment checkouts: %w", err)
+	}
+
+	return checkouts, nil
+}
+
+// GetByID retrieves a single checkout of an equipment item by ID with tenant and camp validation
+func (r *EquipmentCheckoutsRepository) GetByID(ctx context.Context, tenantID, campID, equipmentItemID, id uuid.UUID) (*domain.EquipmentCheckout, error) {
+	var checkout domain.EquipmentCheckout
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("equipment_item_id = ? AND id = ?", equipmentItemID, id).
+		First(&checkout).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkout, nil
+}
+
+// Create inserts a new equipment checkout
+func (r *EquipmentCheckoutsRepository) Create(ctx context.Context, checkout *domain.EquipmentCheckout) error {
+	if err := r.db.WithContext(ctx).Create(checkout).Error; err != nil {
+		return fmt.Errorf("failed to create equipment checkout: %w", err)
+	}
+	return nil
+}
+
+// MarkReturned records the return of an open checkout. It fails when the checkout was returned in the meantime.
+func (r *EquipmentCheckoutsRepository) MarkReturned(ctx context.Context, tenantID, campID uuid.UUID, checkout *domain.EquipmentCheckout, returnedAt time.Time) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.EquipmentCheckout{}).
+		Where("id = ? AND returned_at IS NULL", checkout.ID).
+		Updates(map[string]interface{}{
+			"returned_at":       returnedAt,
+			"return_condition":  checkout.ReturnCondition,
+			"returned_by":       checkout.ReturnedBy,
+			"returned_by_email": checkout.ReturnedByEmail,
+			"notes":             checkout.Notes,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to return equipment checkout: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("equipment checkout not found or already returned")
+	}
+
+	checkout.ReturnedAt = &returnedAt
+	return nil
+}
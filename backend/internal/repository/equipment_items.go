@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// EquipmentItemsRepository handles database operations for equipment items
+type EquipmentItemsRepository struct {
+	db *database.Database
+}
+
+// NewEquipmentItemsRepository creates a new equipment items repository
+func NewEquipmentItemsRepository(db *database.Database) *EquipmentItemsRepository {
+	return &EquipmentItemsRepository{db: db}
+}
+
+// List retrieves equipment items by name, optionally limited to a category, a home location and a condition
+func (r *EquipmentItemsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, category *string, homeLocationID *uuid.UUID, condition *domain.EquipmentCondition) ([]domain.EquipmentItem, error) {
+	var items []domain.EquipmentItem
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if category != nil {
+		query = query.Where("category = ?", *category)
+	}
+	if homeLocationID != nil {
+		query = query.Where("home_location_id = ?", *homeLocationID)
+	}
+	if condition != nil {
+		query = query.Where("condition = ?", *condition)
+	}
+
+	if err := query.Order("name ASC").Find(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to list equipment items: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetByID retrieves a single equipment item by ID with tenant and camp validation
+func (r *EquipmentItemsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.EquipmentItem, error) {
+	var item domain.EquipmentItem
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&item).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// Create inserts a new equipment item
+func (r *EquipmentItemsRepository) Create(ctx context.Context, item *domain.EquipmentItem) error {
+	if err := r.db.WithContext(ctx).Create(item).Error; err != nil {
+		return fmt.Errorf("failed to create equipment item: %w", err)
+	}
+	return nil
+}
+
+// Update saves the details, quantity, home location and condition of an equipment item
+func (r *EquipmentItemsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, item *domain.EquipmentItem) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.EquipmentItem{}).
+		Where("id = ?", item.ID).
+		Select("name", "description", "category", "quantity", "home_location_id", "condition", "notes").
+		Updates(item)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update equipment item: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("equipment item not found or unauthorized")
+	}
+
+	return nil
+}
+
+// UpdateCondition saves the condition of an equipment item
+func (r *EquipmentItemsRepository) UpdateCondition(ctx context.Context, tenantID, campID, id uuid.UUID, condition domain.EquipmentCondition) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.EquipmentItem{}).
+		Where("id = ?", id).
+		Update("condition", condition)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update equipment item condition: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("equipment item not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes an equipment item by ID with tenant and camp validation
+func (r *EquipmentItemsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.EquipmentItem{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete equipment item: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("equipment item not found or unauthorized")
+	}
+
+	return nil
+}
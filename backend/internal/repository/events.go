@@ -103,6 +103,58 @@ func (r *EventsRepository) GetByID(ctx context.Context, tenantID, campID, id uui
 	return &event, nil
 }
 
+// ListStaffedFrom retrieves the events with required staff positions that end at or after the given time,
+// ordered by start date
+func (r *EventsRepository) ListStaffedFrom(ctx context.Context, tenantID, campID uuid.UUID, from time.Time) ([]domain.Event, error) {
+	var events []domain.Event
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("end_date >= ?", from).
+		Where("required_staff IS NOT NULL AND jsonb_typeof(required_staff) = 'array' AND jsonb_array_length(required_staff) > 0").
+		Order("start_date ASC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staffed events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListStaffedBetween retrieves the events with required staff positions that overlap [from, to),
+// ordered by start date
+func (r *EventsRepository) ListStaffedBetween(ctx context.Context, tenantID, campID uuid.UUID, from, to time.Time) ([]domain.Event, error) {
+	var events []domain.Event
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("end_date > ? AND start_date < ?", from, to).
+		Where("required_staff IS NOT NULL AND jsonb_typeof(required_staff) = 'array' AND jsonb_array_length(required_staff) > 0").
+		Order("start_date ASC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staffed events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListBetween retrieves the events that overlap [from, to), ordered by start date
+func (r *EventsRepository) ListBetween(ctx context.Context, tenantID, campID uuid.UUID, from, to time.Time) ([]domain.Event, error) {
+	var events []domain.Event
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("end_date > ? AND start_date < ?", from, to).
+		Order("start_date ASC").
+		Find(&events).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return events, nil
+}
+
 // Create inserts a new event
 func (r *EventsRepository) Create(ctx context.Context, event *domain.Event) error {
 	if err := r.validateAndSerializeJSONB(event); err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/database"
@@ -66,7 +67,13 @@ func (r *GroupsRepository) List(ctx context.Context, tenantID, campID uuid.UUID,
 		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
 	}
 
-	query, err = ApplyFilters(query, filters, groupFields, groupFieldToColumn)
+	// Custom fields are addressed as customFields.<key> in filters and sorting
+	fields, fieldToColumn, sortableFields, err := withCustomFields(ctx, r.db, tenantID, campID, domain.CustomFieldEntityTypeGroup, filters, sortBy, groupFields, groupFieldToColumn, groupSortableFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, err = ApplyFilters(query, filters, fields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
 	}
@@ -77,7 +84,7 @@ func (r *GroupsRepository) List(ctx context.Context, tenantID, campID uuid.UUID,
 	}
 
 	// Apply sorting
-	query, err = ApplySorting(query, sortBy, sortOrder, groupSortableFields, groupFieldToColumn)
+	query, err = ApplySorting(query, sortBy, sortOrder, sortableFields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
 	}
@@ -177,6 +184,163 @@ func (r *GroupsRepository) FindByHousingRoomAndSession(ctx context.Context, tena
 	return &group, nil
 }
 
+// ListHousingBySession retrieves the housing groups of a session with their members, ordered by name
+func (r *GroupsRepository) ListHousingBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) ([]domain.Group, error) {
+	var groups []domain.Group
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GroupCampers").
+		Preload("GroupStaffMembers").
+		Where("session_id = ? AND housing_room_id IS NOT NULL", sessionID).
+		Order("name ASC").
+		Find(&groups).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list housing groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ListWithMembers retrieves all groups of a camp with their campers, staff members and nested groups
+func (r *GroupsRepository) ListWithMembers(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Group, error) {
+	var groups []domain.Group
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GroupCampers").
+		Preload("GroupStaffMembers").
+		Preload("ChildGroups").
+		Order("name ASC").
+		Find(&groups).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ListRuleBased retrieves all groups of a camp whose camper membership is derived from membership rules
+func (r *GroupsRepository) ListRuleBased(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Group, error) {
+	var groups []domain.Group
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("jsonb_array_length(membership_rules) > 0").
+		Order("name ASC").
+		Find(&groups).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rule-based groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ListMembershipChanges retrieves the group membership history of a camper or staff member,
+// most recent first. Removals immediately followed by a re-add in the same transaction, as
+// written when a group's members are replaced wholesale, cancel out and are left out.
+func (r *GroupsRepository) ListMembershipChanges(ctx context.Context, tenantID, campID uuid.UUID, memberType domain.NoteEntityType, memberID uuid.UUID, from, to *time.Time) ([]domain.GroupMembershipChange, error) {
+	var changes []domain.GroupMembershipChange
+
+	query := r.db.WithContext(ctx).
+		Table("group_membership_changes c").
+		Select("c.*, g.name AS group_name").
+		Joins("JOIN groups g ON g.id = c.group_id").
+		Where("c.tenant_id = ? AND c.camp_id = ?", tenantID, campID).
+		Where("c.member_type = ? AND c.member_id = ?", memberType, memberID).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM group_membership_changes o
+			WHERE o.group_id = c.group_id
+			  AND o.member_type = c.member_type
+			  AND o.member_id = c.member_id
+			  AND o.created_at = c.created_at
+			  AND o.change <> c.change
+		)`)
+	if from != nil {
+		query = query.Where("c.created_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("c.created_at < ?", *to)
+	}
+
+	if err := query.Order("c.created_at DESC").Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list group membership changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// ReplaceCampers replaces the camper members of a group with the given campers
+func (r *GroupsRepository) ReplaceCampers(ctx context.Context, tenantID, campID, id uuid.UUID, camperIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Make sure the group belongs to tenant/camp before touching its members
+		var count int64
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Group{}).
+			Where("id = ?", id).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to find group: %w", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("group not found or unauthorized")
+		}
+
+		if err := tx.Where("group_id = ?", id).Delete(&domain.GroupCamper{}).Error; err != nil {
+			return fmt.Errorf("failed to delete existing camper associations: %w", err)
+		}
+
+		for _, camperID := range camperIDs {
+			groupCamper := domain.GroupCamper{
+				GroupID:  id,
+				CamperID: camperID,
+			}
+			if err := tx.Create(&groupCamper).Error; err != nil {
+				return fmt.Errorf("failed to create camper association: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReplaceCamperGroups replaces the memberships of a single camper among the given groups with
+// the groups in memberOf, leaving the camper's other groups and the groups' other members untouched
+func (r *GroupsRepository) ReplaceCamperGroups(ctx context.Context, tenantID, campID, camperID uuid.UUID, groupIDs, memberOf []uuid.UUID) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Make sure the groups belong to tenant/camp before touching their members
+		var count int64
+		if err := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Group{}).
+			Where("id IN ?", groupIDs).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to find groups: %w", err)
+		}
+		if count != int64(len(groupIDs)) {
+			return fmt.Errorf("group not found or unauthorized")
+		}
+
+		if err := tx.Where("camper_id = ? AND group_id IN ?", camperID, groupIDs).Delete(&domain.GroupCamper{}).Error; err != nil {
+			return fmt.Errorf("failed to delete existing camper associations: %w", err)
+		}
+
+		for _, groupID := range memberOf {
+			groupCamper := domain.GroupCamper{
+				GroupID:  groupID,
+				CamperID: camperID,
+			}
+			if err := tx.Create(&groupCamper).Error; err != nil {
+				return fmt.Errorf("failed to create camper association: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
 // Create inserts a new group
 func (r *GroupsRepository) Create(ctx context.Context, group *domain.Group) error {
 	// Validate mutual exclusivity
@@ -187,12 +351,13 @@ func (r *GroupsRepository) Create(ctx context.Context, group *domain.Group) erro
 	// Start a transaction
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		domainGroup := domain.Group{
-			TenantID:      group.TenantID,
-			CampID:        group.CampID,
-			Name:          group.Name,
-			Description:   "",
-			SessionID:     group.SessionID,
-			HousingRoomID: group.HousingRoomID,
+			TenantID:        group.TenantID,
+			CampID:          group.CampID,
+			Name:            group.Name,
+			Description:     "",
+			SessionID:       group.SessionID,
+			HousingRoomID:   group.HousingRoomID,
+			MembershipRules: group.MembershipRules,
 		}
 
 		if group.Description != "" {
@@ -242,9 +407,15 @@ func (r *GroupsRepository) Update(ctx context.Context, tenantID, campID, id uuid
 
 		// Update the group fields
 		updates := map[string]interface{}{
-			"name":            group.Name,
-			"session_id":      group.SessionID,
-			"housing_room_id": group.HousingRoomID,
+			"name":             group.Name,
+			"session_id":       group.SessionID,
+			"housing_room_id":  group.HousingRoomID,
+			"membership_rules": group.MembershipRules,
+		}
+
+		// Custom field values are left untouched when not provided
+		if group.CustomFields != nil {
+			updates["custom_fields"] = group.CustomFields
 		}
 
 		if group.Description != "" {
@@ -328,6 +499,10 @@ func validateGroupType(group *domain.Group) error {
 		return fmt.Errorf("group cannot have both nested groups (groupIds) and manual member selection (camperIds/staffIds)")
 	}
 
+	if group.IsRuleBased() && (hasNestedGroups || len(group.GroupCampers) > 0) {
+		return fmt.Errorf("group with membership rules cannot have nested groups (groupIds) or manually selected campers (camperIds)")
+	}
+
 	return nil
 }
 
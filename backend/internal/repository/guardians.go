@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// GuardiansRepository handles database operations for guardians
+type GuardiansRepository struct {
+	db *database.Database
+}
+
+// NewGuardiansRepository creates a new guardians repository
+func NewGuardiansRepository(db *database.Database) *GuardiansRepository {
+	return &GuardiansRepository{db: db}
+}
+
+// guardianFields defines the filterable fields and their types for guardians (API field names)
+var guardianFields = map[string]domain.FieldType{
+	"name":              domain.FieldTypeText,
+	"relationship":      domain.FieldTypeText,
+	"email":             domain.FieldTypeText,
+	"emergencyPriority": domain.FieldTypeNumber,
+}
+
+// guardianFieldToColumn maps API field names to database column names
+var guardianFieldToColumn = map[string]string{
+	"name":              "name",
+	"relationship":      "relationship",
+	"email":             "email",
+	"emergencyPriority": "emergency_priority",
+}
+
+// guardianSortableFields defines the sortable fields for guardians (API field names)
+var guardianSortableFields = []string{"name", "relationship", "email", "emergencyPriority"}
+
+// List retrieves a paginated list of guardians
+func (r *GuardiansRepository) List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Guardian, int64, error) {
+	var guardians []domain.Guardian
+	var total int64
+
+	// Build the base query with tenant and camp filtering
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+
+	// Add search filter if provided
+	query = ApplySearchFilter(query, search, "name", "email")
+
+	// Parse and apply filters
+	filters, err := ParseFilterStrings(filterStrings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	query, err = ApplyFilters(query, filters, guardianFields, guardianFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
+	}
+
+	// Get total count
+	if err := query.Model(&domain.Guardian{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count guardians: %w", err)
+	}
+
+	// Apply sorting
+	query, err = ApplySorting(query, sortBy, sortOrder, guardianSortableFields, guardianFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
+	}
+
+	// If no sorting was specified, use default
+	if sortBy == nil || *sortBy == "" {
+		query = query.Order("created_at DESC")
+	}
+
+	// Get paginated results with preloaded relationships
+	if err := query.
+		Preload("GuardianCampers").
+		Limit(limit).
+		Offset(offset).
+		Find(&guardians).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list guardians: %w", err)
+	}
+
+	return guardians, total, nil
+}
+
+// GetByID retrieves a single guardian by ID with tenant and camp validation
+func (r *GuardiansRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Guardian, error) {
+	var guardian domain.Guardian
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GuardianCampers").
+		Where("id = ?", id).
+		First(&guardian).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &guardian, nil
+}
+
+// ListAll retrieves every guardian of a camp with their camper links
+func (r *GuardiansRepository) ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Guardian, error) {
+	var guardians []domain.Guardian
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GuardianCampers").
+		Order("name ASC").
+		Find(&guardians).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guardians: %w", err)
+	}
+
+	return guardians, nil
+}
+
+// ListByCamper retrieves all guardians linked to a camper, ordered by emergency priority
+func (r *GuardiansRepository) ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.Guardian, error) {
+	var guardians []domain.Guardian
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GuardianCampers").
+		Where("id IN (?)", r.db.WithContext(ctx).Model(&domain.GuardianCamper{}).Select("guardian_id").Where("camper_id = ?", camperID)).
+		Order("emergency_priority ASC NULLS LAST").
+		Order("name ASC").
+		Find(&guardians).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guardians for camper: %w", err)
+	}
+
+	return guardians, nil
+}
+
+// Create inserts a new guardian along with its camper links
+func (r *GuardiansRepository) Create(ctx context.Context, guardian *domain.Guardian) error {
+	// Start a transaction
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Insert the guardian
+		if err := tx.Omit("GuardianCampers").Create(guardian).Error; err != nil {
+			return fmt.Errorf("failed to create guardian: %w", err)
+		}
+
+		// Sync camper relationships
+		if err := syncGuardianCampers(tx, guardian.ID, guardian.GuardianCampers); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Update updates an existing guardian and its camper links with tenant and camp validation
+func (r *GuardiansRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, guardian *domain.Guardian) error {
+	// Start a transaction
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := ScopedTxQuery(tx, tenantID, campID).
+			Model(&domain.Guardian{}).
+			Where("id = ?", guardian.ID).
+			Updates(map[string]interface{}{
+				"name":                 guardian.Name,
+				"description":          guardian.Description,
+				"relationship":         guardian.Relationship,
+				"phones":               guardian.Phones,
+				"email":                guardian.Email,
+				"address":              guardian.Address,
+				"is_emergency_contact": guardian.IsEmergencyContact,
+				"emergency_priority":   guardian.EmergencyPriority,
+				"custody_notes":        guardian.CustodyNotes,
+				"can_pick_up":          guardian.CanPickUp,
+				"authorized_pickups":   guardian.AuthorizedPickups,
+			})
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to update guardian: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("guardian not found or unauthorized")
+		}
+
+		// Sync camper relationships using delete-all-and-recreate strategy
+		if err := syncGuardianCampers(tx, guardian.ID, guardian.GuardianCampers); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Delete soft deletes a guardian by ID with tenant and camp validation
+func (r *GuardiansRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	// Start a transaction to handle soft delete and junction table cleanup
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Hard delete the camper links (junction tables don't use soft delete)
+		if err := tx.Where("guardian_id = ?", id).Delete(&domain.GuardianCamper{}).Error; err != nil {
+			return fmt.Errorf("failed to delete camper associations: %w", err)
+		}
+
+		// Then soft delete the guardian using scoped query
+		result := ScopedTxQuery(tx, tenantID, campID).
+			Where("id = ?", id).
+			Delete(&domain.Guardian{})
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete guardian: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("guardian not found or unauthorized")
+		}
+
+		return nil
+	})
+}
+
+// syncGuardianCampers syncs the guardian_campers junction table using delete-all-and-recreate strategy
+func syncGuardianCampers(tx *gorm.DB, guardianID uuid.UUID, guardianCampers []domain.GuardianCamper) error {
+	// Delete all existing camper associations
+	if err := tx.Where("guardian_id = ?", guardianID).Delete(&domain.GuardianCamper{}).Error; err != nil {
+		return fmt.Errorf("failed to delete existing camper associations: %w", err)
+	}
+
+	// Insert new associations
+	for _, gc := range guardianCampers {
+		guardianCamper := domain.GuardianCamper{
+			GuardianID: guardianID,
+			CamperID:   gc.CamperID,
+		}
+		if err := tx.Create(&guardianCamper).Error; err != nil {
+			return fmt.Errorf("failed to create camper association: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -138,6 +138,20 @@ func ApplyFilters(query *gorm.DB, filters []domain.Filter, allowedFields map[str
 	return query, nil
 }
 
+// partitionFilters splits filters into those whose field is in the given set and the rest
+// It returns the remaining filters first and the matching filters second
+func partitionFilters(filters []domain.Filter, fields map[string]domain.FieldType) ([]domain.Filter, []domain.Filter) {
+	var rest, matching []domain.Filter
+	for _, filter := range filters {
+		if _, ok := fields[filter.Field]; ok {
+			matching = append(matching, filter)
+		} else {
+			rest = append(rest, filter)
+		}
+	}
+	return rest, matching
+}
+
 // ApplySorting applies sorting to a GORM query with validation
 // allowedFields is a slice of API field names that are allowed for sorting
 // fieldToColumn is a map of API field name to database column name
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// HousingAssignmentsRepository handles database operations for housing assignment proposals
+type HousingAssignmentsRepository struct {
+	db *database.Database
+}
+
+// NewHousingAssignmentsRepository creates a new housing assignments repository
+func NewHousingAssignmentsRepository(db *database.Database) *HousingAssignmentsRepository {
+	return &HousingAssignmentsRepository{db: db}
+}
+
+// List retrieves housing assignment proposals, newest first, optionally limited to a session
+func (r *HousingAssignmentsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, sessionID *uuid.UUID) ([]domain.HousingAssignment, error) {
+	var assignments []domain.HousingAssignment
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if sessionID != nil {
+		query = query.Where("session_id = ?", *sessionID)
+	}
+
+	if err := query.Order("created_at DESC").Find(&assignments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list housing assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// GetByID retrieves a single housing assignment proposal by ID with tenant and camp validation
+func (r *HousingAssignmentsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.HousingAssignment, error) {
+	var assignment domain.HousingAssignment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&assignment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &assignment, nil
+}
+
+// Create inserts a new housing assignment proposal
+func (r *HousingAssignmentsRepository) Create(ctx context.Context, assignment *domain.HousingAssignment) error {
+	if err := r.db.WithContext(ctx).Create(assignment).Error; err != nil {
+		return fmt.Errorf("failed to create housing assignment: %w", err)
+	}
+	return nil
+}
+
+// Accept moves the campers of a proposal into their housing groups and marks the proposal
+// accepted, superseding the other open proposals of the session. placements maps each camper
+// to their new housing group (nil to leave the camper without one); memberships in the other
+// housing groups of the session are removed.
+func (r *HousingAssignmentsRepository) Accept(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.HousingAssignment, placements map[uuid.UUID]*uuid.UUID, housingGroupIDs []uuid.UUID, acceptedBy *uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for camperID, groupID := range placements {
+			// The enrollment holds the housing group of the session
+			if err := tx.Model(&domain.CamperEnrollment{}).
+				Where("tenant_id = ? AND camp_id = ? AND camper_id = ? AND session_id = ?", tenantID, campID, camperID, assignment.SessionID).
+				Update("housing_group_id", groupID).Error; err != nil {
+				return fmt.Errorf("failed to update enrollment housing group: %w", err)
+			}
+
+			// Campers whose primary session this is also carry it on the camper
+			if err := tx.Model(&domain.Camper{}).
+				Where("tenant_id = ? AND camp_id = ? AND id = ? AND session_id = ?", tenantID, campID, camperID, assignment.SessionID).
+				Update("housing_group_id", groupID).Error; err != nil {
+				return fmt.Errorf("failed to update camper housing group: %w", err)
+			}
+
+			if len(housingGroupIDs) > 0 {
+				if err := tx.Where("camper_id = ? AND group_id IN ?", camperID, housingGroupIDs).
+					Delete(&domain.GroupCamper{}).Error; err != nil {
+					return fmt.Errorf("failed to delete housing group associations: %w", err)
+				}
+			}
+
+			if groupID != nil {
+				groupCamper := domain.GroupCamper{
+					GroupID:  *groupID,
+					CamperID: camperID,
+				}
+				if err := tx.Create(&groupCamper).Error; err != nil {
+					return fmt.Errorf("failed to create housing group association: %w", err)
+				}
+			}
+		}
+
+		now := time.Now().UTC()
+		result := tx.Model(&domain.HousingAssignment{}).
+			Where("id = ? AND tenant_id = ? AND camp_id = ? AND status = ?", assignment.ID, tenantID, campID, domain.HousingAssignmentStatusProposed).
+			Updates(map[string]interface{}{
+				"status":      domain.HousingAssignmentStatusAccepted,
+				"accepted_by": acceptedBy,
+				"accepted_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to accept housing assignment: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("housing assignment not found or no longer proposed")
+		}
+
+		if err := tx.Model(&domain.HousingAssignment{}).
+			Where("tenant_id = ? AND camp_id = ? AND session_id = ? AND status = ? AND id <> ?", tenantID, campID, assignment.SessionID, domain.HousingAssignmentStatusProposed, assignment.ID).
+			Update("status", domain.HousingAssignmentStatusSuperseded).Error; err != nil {
+			return fmt.Errorf("failed to supersede housing assignments: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -87,6 +87,17 @@ func (r *HousingRoomsRepository) List(ctx context.Context, tenantID, campID uuid
 	return housingRooms, total, nil
 }
 
+// ListAll retrieves all housing rooms of a camp by name
+func (r *HousingRoomsRepository) ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.HousingRoom, error) {
+	var housingRooms []domain.HousingRoom
+
+	if err := ScopedQuery(r.db, ctx, tenantID, campID).Order("name ASC").Find(&housingRooms).Error; err != nil {
+		return nil, fmt.Errorf("failed to list housing rooms: %w", err)
+	}
+
+	return housingRooms, nil
+}
+
 // GetByID retrieves a single housing room by ID with tenant and camp validation
 func (r *HousingRoomsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.HousingRoom, error) {
 	var housingRoom domain.HousingRoom
@@ -116,11 +127,12 @@ func (r *HousingRoomsRepository) Update(ctx context.Context, tenantID, campID uu
 		Model(&domain.HousingRoom{}).
 		Where("id = ?", room.ID).
 		Updates(map[string]interface{}{
-			"area_id":     room.AreaID,
-			"name":        room.Name,
-			"description": room.Description,
-			"beds":        room.Beds,
-			"bathroom":    room.Bathroom,
+			"area_id":       room.AreaID,
+			"name":          room.Name,
+			"description":   room.Description,
+			"beds":          room.Beds,
+			"bathroom":      room.Bathroom,
+			"gender_policy": room.GenderPolicy,
 		})
 
 	if result.Error != nil {
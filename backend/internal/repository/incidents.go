@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// IncidentsRepository handles database operations for incident reports
+type IncidentsRepository struct {
+	db *database.Database
+}
+
+// NewIncidentsRepository creates a new incidents repository
+func NewIncidentsRepository(db *database.Database) *IncidentsRepository {
+	return &IncidentsRepository{db: db}
+}
+
+// incidentFields defines the filterable fields and their types for incidents (API field names)
+var incidentFields = map[string]domain.FieldType{
+	"name":                       domain.FieldTypeText,
+	"occurredAt":                 domain.FieldTypeDate,
+	"type":                       domain.FieldTypeText,
+	"severity":                   domain.FieldTypeText,
+	"status":                     domain.FieldTypeText,
+	"locationId":                 domain.FieldTypeUUID,
+	"eventId":                    domain.FieldTypeUUID,
+	"activityId":                 domain.FieldTypeUUID,
+	"guardianNotificationStatus": domain.FieldTypeText,
+}
+
+// incidentFieldToColumn maps API field names to database column names
+var incidentFieldToColumn = map[string]string{
+	"name":                       "name",
+	"occurredAt":                 "occurred_at",
+	"type":                       "type",
+	"severity":                   "severity",
+	"status":                     "status",
+	"locationId":                 "location_id",
+	"eventId":                    "event_id",
+	"activityId":                 "activity_id",
+	"guardianNotificationStatus": "guardian_notification_status",
+}
+
+// incidentSortableFields defines the sortable fields for incidents (API field names)
+var incidentSortableFields = []string{"name", "occurredAt", "type", "severity", "status", "guardianNotificationStatus"}
+
+// incidentReportGroupings maps report groupings to their bucket key and label expressions
+var incidentReportGroupings = map[string]struct {
+	key   string
+	label string
+	join  string
+}{
+	"location": {
+		key:   "COALESCE(CAST(i.location_id AS TEXT), '')",
+		label: "COALESCE(l.name, '')",
+		join:  "LEFT JOIN locations l ON l.id = i.location_id",
+	},
+	"activity": {
+		key:   "COALESCE(CAST(i.activity_id AS TEXT), '')",
+		label: "COALESCE(a.name, '')",
+		join:  "LEFT JOIN activities a ON a.id = i.activity_id",
+	},
+	"week": {
+		key:   "TO_CHAR(DATE_TRUNC('week', i.occurred_at AT TIME ZONE 'UTC' AT TIME ZONE ?), 'YYYY-MM-DD')",
+		label: "TO_CHAR(DATE_TRUNC('week', i.occurred_at AT TIME ZONE 'UTC' AT TIME ZONE ?), 'YYYY-MM-DD')",
+	},
+}
+
+// List retrieves a paginated list of incidents
+func (r *IncidentsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Incident, int64, error) {
+	var incidents []domain.Incident
+	var total int64
+
+	// Build the base query with tenant and camp filtering
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+
+	// Add search filter if provided
+	query = ApplySearchFilter(query, search, "name")
+
+	// Parse and apply filters
+	filters, err := ParseFilterStrings(filterStrings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	query, err = ApplyFilters(query, filters, incidentFields, incidentFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
+	}
+
+	// Get total count
+	if err := query.Model(&domain.Incident{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count incidents: %w", err)
+	}
+
+	// Apply sorting
+	query, err = ApplySorting(query, sortBy, sortOrder, incidentSortableFields, incidentFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
+	}
+
+	// If no sorting was specified, use default
+	if sortBy == nil || *sortBy == "" {
+		query = query.Order("occurred_at DESC")
+	}
+
+	if err := query.
+		Limit(limit).
+		Offset(offset).
+		Find(&incidents).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	return incidents, total, nil
+}
+
+// GetByID retrieves a single incident by ID with tenant and camp validation
+func (r *IncidentsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Incident, error) {
+	var incident domain.Incident
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&incident).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// ListInvolving retrieves the incidents a camper or staff member was involved in, most recent first,
+// optionally limited to the incidents that occurred within [from, to)
+func (r *IncidentsRepository) ListInvolving(ctx context.Context, tenantID, campID uuid.UUID, camperID, staffMemberID *uuid.UUID, from, to *time.Time) ([]domain.Incident, error) {
+	var incidents []domain.Incident
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if camperID != nil {
+		query = query.Where("jsonb_exists(camper_ids, ?)", camperID.String())
+	}
+	if staffMemberID != nil {
+		query = query.Where("jsonb_exists(staff_member_ids, ?)", staffMemberID.String())
+	}
+	if from != nil {
+		query = query.Where("occurred_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("occurred_at < ?", *to)
+	}
+
+	if err := query.Order("occurred_at DESC").Find(&incidents).Error; err != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// Create inserts a new incident
+func (r *IncidentsRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	if err := r.db.WithContext(ctx).Create(incident).Error; err != nil {
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+	return nil
+}
+
+// Update updates the details of an existing incident with tenant and camp validation
+func (r *IncidentsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, incident *domain.Incident) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Incident{}).
+		Where("id = ?", incident.ID).
+		Updates(map[string]interface{}{
+			"name":                         incident.Name,
+			"description":                  incident.Description,
+			"occurred_at":                  incident.OccurredAt,
+			"type":                         incident.Type,
+			"severity":                     incident.Severity,
+			"camper_ids":                   incident.CamperIDs,
+			"staff_member_ids":             incident.StaffMemberIDs,
+			"location_id":                  incident.LocationID,
+			"event_id":                     incident.EventID,
+			"activity_id":                  incident.ActivityID,
+			"narrative":                    incident.Narrative,
+			"actions_taken":                incident.ActionsTaken,
+			"guardian_notification_status": incident.GuardianNotificationStatus,
+			"guardians_notified_at":        incident.GuardiansNotifiedAt,
+			"guardian_notification_notes":  incident.GuardianNotificationNotes,
+			"follow_up_tasks":              incident.FollowUpTasks,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update incident: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("incident not found or unauthorized")
+	}
+
+	return nil
+}
+
+// UpdateReview updates the review state of an existing incident with tenant and camp validation
+func (r *IncidentsRepository) UpdateReview(ctx context.Context, tenantID, campID uuid.UUID, incident *domain.Incident) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Incident{}).
+		Where("id = ?", incident.ID).
+		Updates(map[string]interface{}{
+			"status":            incident.Status,
+			"submitted_at":      incident.SubmittedAt,
+			"reviewed_by":       incident.ReviewedBy,
+			"reviewed_by_email": incident.ReviewedByEmail,
+			"reviewed_at":       incident.ReviewedAt,
+			"review_comment":    incident.ReviewComment,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update incident review: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("incident not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete soft deletes an incident by ID with tenant and camp validation
+func (r *IncidentsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Incident{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete incident: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("incident not found or unauthorized")
+	}
+
+	return nil
+}
+
+// CountBy counts incidents per report bucket and severity. Incidents are grouped by
+// location, activity or week (starting Monday in the given time zone), optionally
+// limited to those that occurred within [from, to).
+func (r *IncidentsRepository) CountBy(ctx context.Context, tenantID, campID uuid.UUID, groupBy string, from, to *time.Time, timezone string) ([]domain.IncidentCount, error) {
+	grouping, ok := incidentReportGroupings[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid incident report grouping: %s", groupBy)
+	}
+
+	var counts []domain.IncidentCount
+
+	// Week buckets are computed in the camp's time zone
+	var selectArgs []interface{}
+	if groupBy == "week" {
+		selectArgs = []interface{}{timezone, timezone}
+	}
+
+	query := r.db.WithContext(ctx).
+		Table("incidents AS i").
+		Select(fmt.Sprintf("%s AS key, %s AS label, i.severity AS severity, COUNT(*) AS count", grouping.key, grouping.label), selectArgs...).
+		Where("i.tenant_id = ? AND i.camp_id = ? AND i.deleted_at IS NULL", tenantID, campID)
+
+	if grouping.join != "" {
+		query = query.Joins(grouping.join)
+	}
+	if from != nil {
+		query = query.Where("i.occurred_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("i.occurred_at < ?", *to)
+	}
+
+	if err := query.
+		Group("1, 2, 3").
+		Order("1 ASC").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count incidents: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// maintenancePriorityOrder sorts tickets from the most to the least urgent priority
+const maintenancePriorityOrder = "CASE priority WHEN 'urgent' THEN 0 WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END"
+
+// MaintenanceTicketsRepository handles database operations for maintenance tickets
+type MaintenanceTicketsRepository struct {
+	db *database.Database
+}
+
+// NewMaintenanceTicketsRepository creates a new maintenance tickets repository
+func NewMaintenanceTicketsRepository(db *database.Database) *MaintenanceTicketsRepository {
+	return &MaintenanceTicketsRepository{db: db}
+}
+
+// List retrieves maintenance tickets, most urgent and oldest first, optionally limited to a facility,
+// a status, a priority and an assignee
+func (r *MaintenanceTicketsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.MaintenanceEntityType, entityID *uuid.UUID, status *domain.MaintenanceStatus, priority *domain.MaintenancePriority, assigneeID *uuid.UUID) ([]domain.MaintenanceTicket, error) {
+	var tickets []domain.MaintenanceTicket
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+	if entityID != nil {
+		query = query.Where("entity_id = ?", *entityID)
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+	if priority != nil {
+		query = query.Where("priority = ?", *priority)
+	}
+	if assigneeID != nil {
+		query = query.Where("assignee_id = ?", *assigneeID)
+	}
+
+	if err := query.Order(maintenancePriorityOrder).Order("created_at ASC").Find(&tickets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// ListOutOfService retrieves the tickets that are not done and take their facility out of service after from
+// and, when given, before to, by start of the period
+func (r *MaintenanceTicketsRepository) ListOutOfService(ctx context.Context, tenantID, campID uuid.UUID, from time.Time, to *time.Time) ([]domain.MaintenanceTicket, error) {
+	var tickets []domain.MaintenanceTicket
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("status NOT IN ?", []domain.MaintenanceStatus{domain.MaintenanceStatusResolved, domain.MaintenanceStatusClosed}).
+		Where("out_of_service_from IS NOT NULL").
+		Where("out_of_service_until IS NULL OR out_of_service_until > ?", from)
+	if to != nil {
+		query = query.Where("out_of_service_from < ?", *to)
+	}
+
+	if err := query.Order("out_of_service_from ASC").Find(&tickets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list out-of-service tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetByID retrieves a single maintenance ticket by ID with tenant and camp validation
+func (r *MaintenanceTicketsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	var ticket domain.MaintenanceTicket
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&ticket).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticket, nil
+}
+
+// Create inserts a new maintenance ticket
+func (r *MaintenanceTicketsRepository) Create(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	if err := r.db.WithContext(ctx).Create(ticket).Error; err != nil {
+		return fmt.Errorf("failed to create maintenance ticket: %w", err)
+	}
+	return nil
+}
+
+// Update saves the details, status, assignee and out-of-service period of a maintenance ticket
+func (r *MaintenanceTicketsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, ticket *domain.MaintenanceTicket) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.MaintenanceTicket{}).
+		Where("id = ?", ticket.ID).
+		Select("title", "description", "priority", "status", "assignee_id", "out_of_service_from", "out_of_service_until", "resolved_at").
+		Updates(ticket)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update maintenance ticket: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("maintenance ticket not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a maintenance ticket by ID with tenant and camp validation
+func (r *MaintenanceTicketsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.MaintenanceTicket{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete maintenance ticket: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("maintenance ticket not found or unauthorized")
+	}
+
+	return nil
+}
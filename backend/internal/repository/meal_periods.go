@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// MealPeriodsRepository handles database operations for meal periods
+type MealPeriodsRepository struct {
+	db *database.Database
+}
+
+// NewMealPeriodsRepository creates a new meal periods repository
+func NewMealPeriodsRepository(db *database.Database) *MealPeriodsRepository {
+	return &MealPeriodsRepository{db: db}
+}
+
+// List retrieves the meal periods of a camp in the order of the day
+func (r *MealPeriodsRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.MealPeriod, error) {
+	var periods []domain.MealPeriod
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("position ASC, start_time ASC, name ASC").
+		Find(&periods).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list meal periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// GetByID retrieves a single meal period by ID with tenant and camp validation
+func (r *MealPeriodsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MealPeriod, error) {
+	var period domain.MealPeriod
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&period).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &period, nil
+}
+
+// Create inserts a new meal period
+func (r *MealPeriodsRepository) Create(ctx context.Context, period *domain.MealPeriod) error {
+	if err := r.db.WithContext(ctx).Create(period).Error; err != nil {
+		return fmt.Errorf("failed to create meal period: %w", err)
+	}
+	return nil
+}
+
+// Update saves the name, times and position of a meal period
+func (r *MealPeriodsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, period *domain.MealPeriod) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.MealPeriod{}).
+		Where("id = ?", period.ID).
+		Select("name", "start_time", "end_time", "position").
+		Updates(period)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update meal period: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("meal period not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a meal period by ID with tenant and camp validation; its menus are removed with it
+func (r *MealPeriodsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.MealPeriod{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete meal period: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("meal period not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// MedicationsRepository handles database operations for camper medications and dose records
+type MedicationsRepository struct {
+	db *database.Database
+}
+
+// NewMedicationsRepository creates a new medications repository
+func NewMedicationsRepository(db *database.Database) *MedicationsRepository {
+	return &MedicationsRepository{db: db}
+}
+
+// medicationFields defines the filterable fields and their types for medications (API field names)
+var medicationFields = map[string]domain.FieldType{
+	"name":      domain.FieldTypeText,
+	"camperId":  domain.FieldTypeUUID,
+	"route":     domain.FieldTypeText,
+	"startDate": domain.FieldTypeDate,
+	"endDate":   domain.FieldTypeDate,
+}
+
+// medicationFieldToColumn maps API field names to database column names
+var medicationFieldToColumn = map[string]string{
+	"name":      "name",
+	"camperId":  "camper_id",
+	"route":     "route",
+	"startDate": "start_date",
+	"endDate":   "end_date",
+}
+
+// medicationSortableFields defines the sortable fields for medications (API field names)
+var medicationSortableFields = []string{"name", "camperId", "route", "startDate", "endDate"}
+
+// List retrieves a paginated list of medications
+func (r *MedicationsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Medication, int64, error) {
+	var medications []domain.Medication
+	var total int64
+
+	// Build the base query with tenant and camp filtering
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+
+	// Add search filter if provided
+	query = ApplySearchFilter(query, search, "name")
+
+	// Parse and apply filters
+	filters, err := ParseFilterStrings(filterStrings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
+	}
+
+	query, err = ApplyFilters(query, filters, medicationFields, medicationFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
+	}
+
+	// Get total count
+	if err := query.Model(&domain.Medication{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count medications: %w", err)
+	}
+
+	// Apply sorting
+	query, err = ApplySorting(query, sortBy, sortOrder, medicationSortableFields, medicationFieldToColumn)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
+	}
+
+	// If no sorting was specified, use default
+	if sortBy == nil || *sortBy == "" {
+		query = query.Order("created_at DESC")
+	}
+
+	if err := query.
+		Limit(limit).
+		Offset(offset).
+		Find(&medications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list medications: %w", err)
+	}
+
+	return medications, total, nil
+}
+
+// GetByID retrieves a single medication by ID with tenant and camp validation
+func (r *MedicationsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Medication, error) {
+	var medication domain.Medication
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&medication).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &medication, nil
+}
+
+// ListByCampers retrieves the medications of the given campers, or of every camper when camperIDs is nil
+func (r *MedicationsRepository) ListByCampers(ctx context.Context, tenantID, campID uuid.UUID, camperIDs []uuid.UUID) ([]domain.Medication, error) {
+	var medications []domain.Medication
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if camperIDs != nil {
+		if len(camperIDs) == 0 {
+			return []domain.Medication{}, nil
+		}
+		query = query.Where("camper_id IN ?", camperIDs)
+	}
+
+	if err := query.Order("name ASC").Find(&medications).Error; err != nil {
+		return nil, fmt.Errorf("failed to list medications: %w", err)
+	}
+
+	return medications, nil
+}
+
+// GetByIDs retrieves medications by ID, including deleted ones so that recorded
+// doses keep their medication details
+func (r *MedicationsRepository) GetByIDs(ctx context.Context, tenantID, campID uuid.UUID, ids []uuid.UUID) ([]domain.Medication, error) {
+	if len(ids) == 0 {
+		return []domain.Medication{}, nil
+	}
+
+	var medications []domain.Medication
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Unscoped().
+		Where("id IN ?", ids).
+		Find(&medications).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get medications: %w", err)
+	}
+
+	return medications, nil
+}
+
+// Create inserts a new medication
+func (r *MedicationsRepository) Create(ctx context.Context, medication *domain.Medication) error {
+	if err := r.db.WithContext(ctx).Create(medication).Error; err != nil {
+		return fmt.Errorf("failed to create medication: %w", err)
+	}
+	return nil
+}
+
+// Update updates an existing medication with tenant and camp validation
+func (r *MedicationsRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, medication *domain.Medication) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Medication{}).
+		Where("id = ?", medication.ID).
+		Updates(map[string]interface{}{
+			"name":           medication.Name,
+			"description":    medication.Description,
+			"camper_id":      medication.CamperID,
+			"dosage":         medication.Dosage,
+			"route":          medication.Route,
+			"instructions":   medication.Instructions,
+			"schedule_times": medication.ScheduleTimes,
+			"days_of_week":   medication.DaysOfWeek,
+			"start_date":     medication.StartDate,
+			"end_date":       medication.EndDate,
+			"as_needed":      medication.AsNeeded,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update medication: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("medication not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete soft deletes a medication by ID with tenant and camp validation
+func (r *MedicationsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Medication{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete medication: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("medication not found or unauthorized")
+	}
+
+	return nil
+}
+
+// GetDoseByID retrieves a single dose record by ID with tenant and camp validation
+func (r *MedicationsRepository) GetDoseByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MedicationDose, error) {
+	var dose domain.MedicationDose
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&dose).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dose, nil
+}
+
+// GetScheduledDose retrieves the dose record of a medication for a scheduled time
+func (r *MedicationsRepository) GetScheduledDose(ctx context.Context, tenantID, campID, medicationID uuid.UUID, scheduledFor time.Time) (*domain.MedicationDose, error) {
+	var dose domain.MedicationDose
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("medication_id = ? AND scheduled_for = ?", medicationID, scheduledFor).
+		First(&dose).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dose, nil
+}
+
+// ListDosesBetween retrieves the dose records of the given campers scheduled (or, for
+// as-needed doses, administered) within [from, to). A nil camperIDs includes every camper.
+func (r *MedicationsRepository) ListDosesBetween(ctx context.Context, tenantID, campID uuid.UUID, camperIDs []uuid.UUID, from, to time.Time) ([]domain.MedicationDose, error) {
+	var doses []domain.MedicationDose
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("(scheduled_for >= ? AND scheduled_for < ?) OR (scheduled_for IS NULL AND administered_at >= ? AND administered_at < ?)", from, to, from, to)
+	if camperIDs != nil {
+		if len(camperIDs) == 0 {
+			return []domain.MedicationDose{}, nil
+		}
+		query = query.Where("camper_id IN ?", camperIDs)
+	}
+
+	if err := query.Order("recorded_at ASC").Find(&doses).Error; err != nil {
+		return nil, fmt.Errorf("failed to list medication doses: %w", err)
+	}
+
+	return doses, nil
+}
+
+// CreateDose inserts a new dose record
+func (r *MedicationsRepository) CreateDose(ctx context.Context, dose *domain.MedicationDose) error {
+	if err := r.db.WithContext(ctx).Create(dose).Error; err != nil {
+		return fmt.Errorf("failed to record medication dose: %w", err)
+	}
+	return nil
+}
+
+// UpdateDose updates an existing dose record with tenant and camp validation
+func (r *MedicationsRepository) UpdateDose(ctx context.Context, tenantID, campID uuid.UUID, dose *domain.MedicationDose) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.MedicationDose{}).
+		Where("id = ?", dose.ID).
+		Updates(map[string]interface{}{
+			"medication_id":   dose.MedicationID,
+			"camper_id":       dose.CamperID,
+			"scheduled_for":   dose.ScheduledFor,
+			"status":          dose.Status,
+			"administered_at": dose.AdministeredAt,
+			"initials":        dose.Initials,
+			"notes":           dose.Notes,
+			"recorded_by":     dose.RecordedBy,
+			"recorded_at":     dose.RecordedAt,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update medication dose: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("medication dose not found or unauthorized")
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// MenusRepository handles database operations for menus
+type MenusRepository struct {
+	db *database.Database
+}
+
+// NewMenusRepository creates a new menus repository
+func NewMenusRepository(db *database.Database) *MenusRepository {
+	return &MenusRepository{db: db}
+}
+
+// List retrieves the menus of a camp by date, optionally limited to the days within [from, to]
+func (r *MenusRepository) List(ctx context.Context, tenantID, campID uuid.UUID, from, to *time.Time) ([]domain.Menu, error) {
+	var menus []domain.Menu
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if from != nil {
+		query = query.Where("date >= ?", from.Format("2006-01-02"))
+	}
+	if to != nil {
+		query = query.Where("date <= ?", to.Format("2006-01-02"))
+	}
+
+	if err := query.Order("date ASC, created_at ASC").Find(&menus).Error; err != nil {
+		return nil, fmt.Errorf("failed to list menus: %w", err)
+	}
+
+	return menus, nil
+}
+
+// GetByID retrieves a single menu by ID with tenant and camp validation
+func (r *MenusRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Menu, error) {
+	var menu domain.Menu
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&menu).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &menu, nil
+}
+
+// GetByDateAndMealPeriod retrieves the menu served at a meal period on a day
+func (r *MenusRepository) GetByDateAndMealPeriod(ctx context.Context, tenantID, campID uuid.UUID, date time.Time, mealPeriodID uuid.UUID) (*domain.Menu, error) {
+	var menu domain.Menu
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("date = ? AND meal_period_id = ?", date.Format("2006-01-02"), mealPeriodID).
+		First(&menu).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &menu, nil
+}
+
+// Create inserts a new menu
+func (r *MenusRepository) Create(ctx context.Context, menu *domain.Menu) error {
+	if err := r.db.WithContext(ctx).Create(menu).Error; err != nil {
+		return fmt.Errorf("failed to create menu: %w", err)
+	}
+	return nil
+}
+
+// Update saves the items and notes of a menu
+func (r *MenusRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, menu *domain.Menu) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Menu{}).
+		Where("id = ?", menu.ID).
+		Select("items", "notes").
+		Updates(menu)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update menu: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("menu not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a menu by ID with tenant and camp validation
+func (r *MenusRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Menu{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete menu: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("menu not found or unauthorized")
+	}
+
+	return nil
+}
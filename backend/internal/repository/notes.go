@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// NotesRepository handles database operations for camper and staff member notes
+type NotesRepository struct {
+	db *database.Database
+}
+
+// NewNotesRepository creates a new notes repository
+func NewNotesRepository(db *database.Database) *NotesRepository {
+	return &NotesRepository{db: db}
+}
+
+// List retrieves the notes of a camp that have one of the given visibility levels or were written
+// by the given author, pinned notes first and then most recent first. Entity type and ID optionally
+// limit the notes to a single person.
+func (r *NotesRepository) List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.NoteEntityType, entityID *uuid.UUID, visibilities []domain.NoteVisibility, authorID *uuid.UUID) ([]domain.Note, error) {
+	var notes []domain.Note
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+	if entityID != nil {
+		query = query.Where("entity_id = ?", *entityID)
+	}
+
+	switch {
+	case len(visibilities) > 0 && authorID != nil:
+		query = query.Where("(visibility IN ? OR author_id = ?)", visibilities, *authorID)
+	case len(visibilities) > 0:
+		query = query.Where("visibility IN ?", visibilities)
+	case authorID != nil:
+		query = query.Where("author_id = ?", *authorID)
+	default:
+		return []domain.Note{}, nil
+	}
+
+	if err := query.Order("pinned DESC, created_at DESC").Find(&notes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// GetByID retrieves a single note by ID with tenant and camp validation
+func (r *NotesRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Note, error) {
+	var note domain.Note
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&note).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+// Create inserts a new note
+func (r *NotesRepository) Create(ctx context.Context, note *domain.Note) error {
+	if err := r.db.WithContext(ctx).Create(note).Error; err != nil {
+		return fmt.Errorf("failed to create note: %w", err)
+	}
+	return nil
+}
+
+// Update saves the category, visibility, body and pinned flag of a note
+func (r *NotesRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, note *domain.Note) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.Note{}).
+		Where("id = ?", note.ID).
+		Select("category", "visibility", "body", "pinned").
+		Updates(note)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update note: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("note not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete soft deletes a note by ID with tenant and camp validation
+func (r *NotesRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.Note{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete note: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("note not found or unauthorized")
+	}
+
+	return nil
+}
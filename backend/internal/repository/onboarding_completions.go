@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm/clause"
+)
+
+// OnboardingCompletionsRepository handles database operations for completed onboarding checklist items
+type OnboardingCompletionsRepository struct {
+	db *database.Database
+}
+
+// NewOnboardingCompletionsRepository creates a new onboarding completions repository
+func NewOnboardingCompletionsRepository(db *database.Database) *OnboardingCompletionsRepository {
+	return &OnboardingCompletionsRepository{db: db}
+}
+
+// List retrieves the completed checklist items of a camp, optionally only those of a staff member
+func (r *OnboardingCompletionsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID) ([]domain.OnboardingCompletion, error) {
+	var completions []domain.OnboardingCompletion
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if staffMemberID != nil {
+		query = query.Where("staff_member_id = ?", *staffMemberID)
+	}
+
+	if err := query.Order("completed_at ASC").Find(&completions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list onboarding completions: %w", err)
+	}
+
+	return completions, nil
+}
+
+// Save records a checklist item as completed by a staff member, replacing an earlier completion of it
+func (r *OnboardingCompletionsRepository) Save(ctx context.Context, completion *domain.OnboardingCompletion) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "staff_member_id"}, {Name: "item_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"attachment_id", "notes", "completed_by", "completed_by_email", "completed_at"}),
+		}).
+		Create(completion).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to save onboarding completion: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the completion of a checklist item by a staff member
+func (r *OnboardingCompletionsRepository) Delete(ctx context.Context, tenantID, campID, staffMemberID, itemID uuid.UUID) error {
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("staff_member_id = ? AND item_id = ?", staffMemberID, itemID).
+		Delete(&domain.OnboardingCompletion{}).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to delete onboarding completion: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// OnboardingTemplatesRepository handles database operations for staff onboarding templates
+type OnboardingTemplatesRepository struct {
+	db *database.Database
+}
+
+// NewOnboardingTemplatesRepository creates a new onboarding templates repository
+func NewOnboardingTemplatesRepository(db *database.Database) *OnboardingTemplatesRepository {
+	return &OnboardingTemplatesRepository{db: db}
+}
+
+// List retrieves the onboarding templates of a camp by name
+func (r *OnboardingTemplatesRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.OnboardingTemplate, error) {
+	var templates []domain.OnboardingTemplate
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("name ASC").
+		Find(&templates).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list onboarding templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetByID retrieves a single onboarding template by ID with tenant and camp validation
+func (r *OnboardingTemplatesRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.OnboardingTemplate, error) {
+	var template domain.OnboardingTemplate
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&template).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// Create inserts a new onboarding template, taking over as the camp's default when it is one
+func (r *OnboardingTemplatesRepository) Create(ctx context.Context, template *domain.OnboardingTemplate) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if template.IsDefault {
+			if err := clearDefaultOnboardingTemplate(tx, template.TenantID, template.CampID, template.ID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(template).Error; err != nil {
+			return fmt.Errorf("failed to create onboarding template: %w", err)
+		}
+		return nil
+	})
+}
+
+// Update saves the details, checklist and certifications of an onboarding template, taking over as the
+// camp's default when it is one
+func (r *OnboardingTemplatesRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, template *domain.OnboardingTemplate) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if template.IsDefault {
+			if err := clearDefaultOnboardingTemplate(tx, tenantID, campID, template.ID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&domain.OnboardingTemplate{}).
+			Where("id = ? AND tenant_id = ? AND camp_id = ?", template.ID, tenantID, campID).
+			Select("name", "description", "is_default", "items", "required_certification_ids").
+			Updates(template)
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to update onboarding template: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("onboarding template not found or unauthorized")
+		}
+
+		return nil
+	})
+}
+
+// Delete removes an onboarding template by ID with tenant and camp validation; staff members following it
+// fall back to the camp's default template
+func (r *OnboardingTemplatesRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.OnboardingTemplate{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete onboarding template: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("onboarding template not found or unauthorized")
+	}
+
+	return nil
+}
+
+// clearDefaultOnboardingTemplate unmarks the camp's default template unless it is the given one
+func clearDefaultOnboardingTemplate(tx *gorm.DB, tenantID, campID, keepID uuid.UUID) error {
+	err := tx.Model(&domain.OnboardingTemplate{}).
+		Where("tenant_id = ? AND camp_id = ? AND is_default AND id <> ?", tenantID, campID, keepID).
+		Update("is_default", false).Error
+
+	if err != nil {
+		return fmt.Errorf("failed to clear default onboarding template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// RatioPoliciesRepository handles database operations for ratio policies
+type RatioPoliciesRepository struct {
+	db *database.Database
+}
+
+// NewRatioPoliciesRepository creates a new ratio policies repository
+func NewRatioPoliciesRepository(db *database.Database) *RatioPoliciesRepository {
+	return &RatioPoliciesRepository{db: db}
+}
+
+// List retrieves the ratio policies of a camp by name
+func (r *RatioPoliciesRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.RatioPolicy, error) {
+	var policies []domain.RatioPolicy
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Order("name ASC").
+		Find(&policies).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ratio policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// GetByID retrieves a single ratio policy by ID with tenant and camp validation
+func (r *RatioPoliciesRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.RatioPolicy, error) {
+	var policy domain.RatioPolicy
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&policy).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Create inserts a new ratio policy
+func (r *RatioPoliciesRepository) Create(ctx context.Context, policy *domain.RatioPolicy) error {
+	if err := r.db.WithContext(ctx).Create(policy).Error; err != nil {
+		return fmt.Errorf("failed to create ratio policy: %w", err)
+	}
+	return nil
+}
+
+// Update saves the age band, ratio and scope of a ratio policy
+func (r *RatioPoliciesRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, policy *domain.RatioPolicy) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.RatioPolicy{}).
+		Where("id = ?", policy.ID).
+		Select("name", "description", "min_age", "max_age", "campers_per_staff", "applies_to_events", "applies_to_overnight", "activity_ids", "program_ids").
+		Updates(policy)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update ratio policy: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("ratio policy not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a ratio policy by ID with tenant and camp validation
+func (r *RatioPoliciesRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.RatioPolicy{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete ratio policy: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("ratio policy not found or unauthorized")
+	}
+
+	return nil
+}
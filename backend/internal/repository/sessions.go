@@ -133,6 +133,7 @@ func (r *SessionsRepository) Update(ctx context.Context, tenantID, campID uuid.U
 			"description": session.Description,
 			"start_date":  session.StartDate,
 			"end_date":    session.EndDate,
+			"capacity":    session.Capacity,
 		})
 
 	if result.Error != nil {
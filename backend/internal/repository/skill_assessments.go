@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SkillAssessmentsRepository handles database operations for skill assessments and the badges they award
+type SkillAssessmentsRepository struct {
+	db *database.Database
+}
+
+// NewSkillAssessmentsRepository creates a new skill assessments repository
+func NewSkillAssessmentsRepository(db *database.Database) *SkillAssessmentsRepository {
+	return &SkillAssessmentsRepository{db: db}
+}
+
+// ListByCamper retrieves the assessment history of a camper, most recent first
+func (r *SkillAssessmentsRepository) ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.SkillAssessment, error) {
+	var assessments []domain.SkillAssessment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ?", camperID).
+		Order("assessed_on DESC, created_at DESC").
+		Find(&assessments).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list skill assessments: %w", err)
+	}
+
+	return assessments, nil
+}
+
+// CountByLevels counts the assessments of a skill track at any of the given levels
+func (r *SkillAssessmentsRepository) CountByLevels(ctx context.Context, tenantID, campID, skillTrackID uuid.UUID, levelIDs []uuid.UUID) (int64, error) {
+	var count int64
+	if len(levelIDs) == 0 {
+		return 0, nil
+	}
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.SkillAssessment{}).
+		Where("skill_track_id = ? AND level_id IN ?", skillTrackID, levelIDs).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count skill assessments: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByID retrieves a single assessment of a camper by ID with tenant and camp validation
+func (r *SkillAssessmentsRepository) GetByID(ctx context.Context, tenantID, campID, camperID, id uuid.UUID) (*domain.SkillAssessment, error) {
+	var assessment domain.SkillAssessment
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ? AND id = ?", camperID, id).
+		First(&assessment).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &assessment, nil
+}
+
+// Create inserts a new assessment together with the badge it awards, if any. A badge the camper already
+// holds for the level is not awarded again.
+func (r *SkillAssessmentsRepository) Create(ctx context.Context, assessment *domain.SkillAssessment, badge *domain.CamperBadge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(assessment).Error; err != nil {
+			return fmt.Errorf("failed to create skill assessment: %w", err)
+		}
+
+		if badge != nil {
+			badge.AssessmentID = assessment.ID
+			if err := awardBadge(tx, badge); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Delete removes an assessment by ID with tenant and camp validation. The badge it awarded is removed with it
+// and, when the replacement is given, awarded again for another assessment passing the same level.
+func (r *SkillAssessmentsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID, replacement *domain.CamperBadge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := ScopedTxQuery(tx, tenantID, campID).
+			Where("id = ?", id).
+			Delete(&domain.SkillAssessment{})
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete skill assessment: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("skill assessment not found or unauthorized")
+		}
+
+		if replacement != nil {
+			return awardBadge(tx, replacement)
+		}
+		return nil
+	})
+}
+
+// ListBadgesByCamper retrieves the badges of a camper, most recently awarded first
+func (r *SkillAssessmentsRepository) ListBadgesByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.CamperBadge, error) {
+	var badges []domain.CamperBadge
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("camper_id = ?", camperID).
+		Order("awarded_on DESC, created_at DESC").
+		Find(&badges).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list camper badges: %w", err)
+	}
+
+	return badges, nil
+}
+
+// awardBadge inserts a badge unless the camper already holds the badge of its level
+func awardBadge(tx *gorm.DB, badge *domain.CamperBadge) error {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(badge).Error; err != nil {
+		return fmt.Errorf("failed to award camper badge: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// SkillTracksRepository handles database operations for skill tracks
+type SkillTracksRepository struct {
+	db *database.Database
+}
+
+// NewSkillTracksRepository creates a new skill tracks repository
+func NewSkillTracksRepository(db *database.Database) *SkillTracksRepository {
+	return &SkillTracksRepository{db: db}
+}
+
+// List retrieves the skill tracks of a camp by name
+func (r *SkillTracksRepository) List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.SkillTrack, error) {
+	var tracks []domain.SkillTrack
+
+	if err := ScopedQuery(r.db, ctx, tenantID, campID).Order("name ASC").Find(&tracks).Error; err != nil {
+		return nil, fmt.Errorf("failed to list skill tracks: %w", err)
+	}
+
+	return tracks, nil
+}
+
+// GetByID retrieves a single skill track by ID with tenant and camp validation
+func (r *SkillTracksRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.SkillTrack, error) {
+	var track domain.SkillTrack
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&track).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &track, nil
+}
+
+// Create inserts a new skill track
+func (r *SkillTracksRepository) Create(ctx context.Context, track *domain.SkillTrack) error {
+	if err := r.db.WithContext(ctx).Create(track).Error; err != nil {
+		return fmt.Errorf("failed to create skill track: %w", err)
+	}
+	return nil
+}
+
+// Update saves the name, description and levels of a skill track
+func (r *SkillTracksRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, track *domain.SkillTrack) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.SkillTrack{}).
+		Where("id = ?", track.ID).
+		Select("name", "description", "levels").
+		Updates(track)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update skill track: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("skill track not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a skill track by ID with tenant and camp validation; its assessments and badges are
+// removed by the database
+func (r *SkillTracksRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.SkillTrack{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete skill track: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("skill track not found or unauthorized")
+	}
+
+	return nil
+}
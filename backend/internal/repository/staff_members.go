@@ -59,7 +59,13 @@ func (r *StaffMembersRepository) List(ctx context.Context, tenantID, campID uuid
 		return nil, 0, fmt.Errorf("failed to parse filters: %w", err)
 	}
 
-	query, err = ApplyFilters(query, filters, staffMemberFields, staffMemberFieldToColumn)
+	// Custom fields are addressed as customFields.<key> in filters and sorting
+	fields, fieldToColumn, sortableFields, err := withCustomFields(ctx, r.db, tenantID, campID, domain.CustomFieldEntityTypeStaffMember, filters, sortBy, staffMemberFields, staffMemberFieldToColumn, staffMemberSortableFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, err = ApplyFilters(query, filters, fields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply filters: %w", err)
 	}
@@ -70,7 +76,7 @@ func (r *StaffMembersRepository) List(ctx context.Context, tenantID, campID uuid
 	}
 
 	// Apply sorting
-	query, err = ApplySorting(query, sortBy, sortOrder, staffMemberSortableFields, staffMemberFieldToColumn)
+	query, err = ApplySorting(query, sortBy, sortOrder, sortableFields, fieldToColumn)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to apply sorting: %w", err)
 	}
@@ -105,7 +111,7 @@ func (r *StaffMembersRepository) GetByID(ctx context.Context, tenantID, campID,
 
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("staff member not found")
+			return nil, fmt.Errorf("staff member not found: %w", err)
 		}
 		return nil, fmt.Errorf("failed to get staff member: %w", err)
 	}
@@ -113,22 +119,58 @@ func (r *StaffMembersRepository) GetByID(ctx context.Context, tenantID, campID,
 	return &staffMember, nil
 }
 
+// GetByUserID retrieves the staff member linked to a user account, with their groups
+func (r *StaffMembersRepository) GetByUserID(ctx context.Context, tenantID, campID, userID uuid.UUID) (*domain.StaffMember, error) {
+	var staffMember domain.StaffMember
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("GroupStaffMembers").
+		Preload("StaffCertifications").
+		Where("user_id = ?", userID).
+		First(&staffMember).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &staffMember, nil
+}
+
+// ListAll retrieves every staff member of a camp with their certifications, ordered by name
+func (r *StaffMembersRepository) ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.StaffMember, error) {
+	var staffMembers []domain.StaffMember
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Preload("StaffCertifications").
+		Order("name ASC").
+		Find(&staffMembers).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staff members: %w", err)
+	}
+
+	return staffMembers, nil
+}
+
 // Create inserts a new staff member
 func (r *StaffMembersRepository) Create(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, staffMember *domain.StaffMember) error {
 	// Start a transaction
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Convert API staff member to domain staff member
 		domainStaffMember := domain.StaffMember{
-			TenantID:       tenantId,
-			CampID:         campId,
-			Name:           staffMember.Name,
-			Description:    "",
-			Birthday:       staffMember.Birthday,
-			Gender:         staffMember.Gender,
-			RoleID:         staffMember.RoleID,
-			Phone:          staffMember.Phone,
-			HousingGroupID: staffMember.HousingGroupID,
+			TenantID:             tenantId,
+			CampID:               campId,
+			Name:                 staffMember.Name,
+			Description:          "",
+			Birthday:             staffMember.Birthday,
+			Gender:               staffMember.Gender,
+			RoleID:               staffMember.RoleID,
+			Phone:                staffMember.Phone,
+			HousingGroupID:       staffMember.HousingGroupID,
+			UserID:               staffMember.UserID,
+			OnboardingTemplateID: staffMember.OnboardingTemplateID,
 		}
+		domainStaffMember.UnavailableWindows = []domain.UnavailableWindow{}
 
 		// Insert the staff member
 		if err := tx.Create(&domainStaffMember).Error; err != nil {
@@ -169,11 +211,26 @@ func (r *StaffMembersRepository) Update(ctx context.Context, tenantID, campID, i
 
 		// Update the staff member fields
 		updates := map[string]interface{}{
-			"name":             staffMember.Name,
-			"birthday":         staffMember.Birthday,
-			"gender":           staffMember.Gender,
-			"role_id":          staffMember.RoleID,
-			"housing_group_id": staffMember.HousingGroupID,
+			"name":                   staffMember.Name,
+			"birthday":               staffMember.Birthday,
+			"gender":                 staffMember.Gender,
+			"role_id":                staffMember.RoleID,
+			"housing_group_id":       staffMember.HousingGroupID,
+			"user_id":                staffMember.UserID,
+			"onboarding_template_id": staffMember.OnboardingTemplateID,
+		}
+
+		// Custom field values are left untouched when not provided
+		if staffMember.CustomFields != nil {
+			updates["custom_fields"] = staffMember.CustomFields
+		}
+
+		// Diets and allergies are left untouched when not provided
+		if staffMember.DietaryRestrictions != nil {
+			updates["dietary_restrictions"] = staffMember.DietaryRestrictions
+		}
+		if staffMember.Allergies != nil {
+			updates["allergies"] = staffMember.Allergies
 		}
 
 		if staffMember.Description != "" {
@@ -216,6 +273,48 @@ func (r *StaffMembersRepository) Update(ctx context.Context, tenantID, campID, i
 	})
 }
 
+// UpdateUnavailableWindows replaces the weekly times a staff member cannot work
+func (r *StaffMembersRepository) UpdateUnavailableWindows(ctx context.Context, tenantID, campID, id uuid.UUID, windows []domain.UnavailableWindow) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.StaffMember{}).
+		Where("id = ?", id).
+		Select("unavailable_windows").
+		Updates(&domain.StaffMember{UnavailableWindows: windows})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update staff member availability: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("staff member not found or unauthorized")
+	}
+
+	return nil
+}
+
+// UpdateCertificationVerification records who verified a certificate of a staff member.
+// The staff member must have been checked to belong to the tenant and camp.
+func (r *StaffMembersRepository) UpdateCertificationVerification(ctx context.Context, cert *domain.StaffCertification) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.StaffCertification{}).
+		Where("staff_member_id = ? AND certification_id = ?", cert.StaffMemberID, cert.CertificationID).
+		Updates(map[string]interface{}{
+			"verified_by":       cert.VerifiedBy,
+			"verified_by_email": cert.VerifiedByEmail,
+			"verified_at":       cert.VerifiedAt,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update certification verification: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("staff certification not found")
+	}
+
+	return nil
+}
+
 // Delete soft deletes a staff member by ID
 func (r *StaffMembersRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
 	// Start a transaction to handle soft delete and junction table cleanup
@@ -230,6 +329,11 @@ func (r *StaffMembersRepository) Delete(ctx context.Context, tenantID, campID, i
 			return fmt.Errorf("failed to delete certification associations: %w", err)
 		}
 
+		// Free the staff member's beds; the foreign key cascade does not fire on a soft delete
+		if err := tx.Where("staff_member_id = ?", id).Delete(&domain.BedAssignment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete bed assignments: %w", err)
+		}
+
 		// Then soft delete the staff member using scoped query
 		result := ScopedTxQuery(tx, tenantID, campID).
 			Where("id = ?", id).
@@ -280,10 +384,9 @@ func syncStaffCertifications(tx *gorm.DB, staffMemberID uuid.UUID, certification
 	// Insert new associations if provided
 	if certificationIDs != nil && len(*certificationIDs) > 0 {
 		for _, staffCertification := range *certificationIDs {
-			staffCert := domain.StaffCertification{
-				StaffMemberID:   staffMemberID,
-				CertificationID: staffCertification.CertificationID,
-			}
+			// Certificate details and verification are carried over with the association
+			staffCert := staffCertification
+			staffCert.StaffMemberID = staffMemberID
 			if err := tx.Create(&staffCert).Error; err != nil {
 				return fmt.Errorf("failed to create certification association: %w", err)
 			}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// TimeEntriesRepository handles database operations for staff time entries
+type TimeEntriesRepository struct {
+	db *database.Database
+}
+
+// NewTimeEntriesRepository creates a new time entries repository
+func NewTimeEntriesRepository(db *database.Database) *TimeEntriesRepository {
+	return &TimeEntriesRepository{db: db}
+}
+
+// List retrieves the time entries of a camp clocked in within [from, to) by clock-in time,
+// optionally only those of a staff member or in a review state
+func (r *TimeEntriesRepository) List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to *time.Time, status *domain.TimeEntryStatus) ([]domain.TimeEntry, error) {
+	var entries []domain.TimeEntry
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if staffMemberID != nil {
+		query = query.Where("staff_member_id = ?", *staffMemberID)
+	}
+	if from != nil {
+		query = query.Where("clock_in_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("clock_in_at < ?", *to)
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Order("clock_in_at ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetByID retrieves a single time entry by ID with tenant and camp validation
+func (r *TimeEntriesRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&entry).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// GetOpen retrieves the entry a staff member has not clocked out of yet
+func (r *TimeEntriesRepository) GetOpen(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID) (*domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("staff_member_id = ? AND clock_out_at IS NULL", staffMemberID).
+		Order("clock_in_at DESC").
+		First(&entry).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// HasOverlap reports whether another entry of the staff member overlaps [start, end);
+// open entries and a nil end extend indefinitely
+func (r *TimeEntriesRepository) HasOverlap(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID, excludeID *uuid.UUID, start time.Time, end *time.Time) (bool, error) {
+	var count int64
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.TimeEntry{}).
+		Where("staff_member_id = ?", staffMemberID).
+		Where("clock_out_at IS NULL OR clock_out_at > ?", start)
+	if end != nil {
+		query = query.Where("clock_in_at < ?", *end)
+	}
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check overlapping time entries: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Create inserts a new time entry
+func (r *TimeEntriesRepository) Create(ctx context.Context, entry *domain.TimeEntry) error {
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to create time entry: %w", err)
+	}
+	return nil
+}
+
+// Update saves the times and notes of a time entry, together with its review state
+func (r *TimeEntriesRepository) Update(ctx context.Context, tenantID, campID uuid.UUID, entry *domain.TimeEntry) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.TimeEntry{}).
+		Where("id = ?", entry.ID).
+		Select("staff_member_id", "clock_in_at", "clock_out_at", "notes", "status", "reviewed_by", "reviewed_by_email", "reviewed_at", "review_comment").
+		Updates(entry)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update time entry: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("time entry not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a time entry by ID with tenant and camp validation
+func (r *TimeEntriesRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.TimeEntry{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete time entry: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("time entry not found or unauthorized")
+	}
+
+	return nil
+}
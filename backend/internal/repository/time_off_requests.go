@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/database"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// TimeOffRequestsRepository handles database operations for staff time-off requests
+type TimeOffRequestsRepository struct {
+	db *database.Database
+}
+
+// NewTimeOffRequestsRepository creates a new time-off requests repository
+func NewTimeOffRequestsRepository(db *database.Database) *TimeOffRequestsRepository {
+	return &TimeOffRequestsRepository{db: db}
+}
+
+// List retrieves the time-off requests of a camp by start, optionally only those of a staff member
+// or in a review state
+func (r *TimeOffRequestsRepository) List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, status *domain.TimeOffRequestStatus) ([]domain.TimeOffRequest, error) {
+	var requests []domain.TimeOffRequest
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID)
+	if staffMemberID != nil {
+		query = query.Where("staff_member_id = ?", *staffMemberID)
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	if err := query.Order("start_date ASC").Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list time-off requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ListApprovedBetween retrieves the approved time off of a camp overlapping [from, to), optionally
+// only that of a staff member
+func (r *TimeOffRequestsRepository) ListApprovedBetween(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to time.Time) ([]domain.TimeOffRequest, error) {
+	var requests []domain.TimeOffRequest
+
+	query := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("status = ?", domain.TimeOffRequestStatusApproved).
+		Where("start_date < ? AND end_date > ?", to, from)
+	if staffMemberID != nil {
+		query = query.Where("staff_member_id = ?", *staffMemberID)
+	}
+
+	if err := query.Order("start_date ASC").Find(&requests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list approved time off: %w", err)
+	}
+
+	return requests, nil
+}
+
+// GetByID retrieves a single time-off request by ID with tenant and camp validation
+func (r *TimeOffRequestsRepository) GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeOffRequest, error) {
+	var request domain.TimeOffRequest
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		First(&request).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// HasOverlap reports whether a pending or approved request of the staff member overlaps [start, end)
+func (r *TimeOffRequestsRepository) HasOverlap(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID, start, end time.Time) (bool, error) {
+	var count int64
+
+	err := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.TimeOffRequest{}).
+		Where("staff_member_id = ?", staffMemberID).
+		Where("status <> ?", domain.TimeOffRequestStatusRejected).
+		Where("start_date < ? AND end_date > ?", end, start).
+		Count(&count).Error
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check overlapping time-off requests: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Create inserts a new time-off request
+func (r *TimeOffRequestsRepository) Create(ctx context.Context, request *domain.TimeOffRequest) error {
+	if err := r.db.WithContext(ctx).Create(request).Error; err != nil {
+		return fmt.Errorf("failed to create time-off request: %w", err)
+	}
+	return nil
+}
+
+// UpdateReview saves the review state of a time-off request
+func (r *TimeOffRequestsRepository) UpdateReview(ctx context.Context, tenantID, campID uuid.UUID, request *domain.TimeOffRequest) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Model(&domain.TimeOffRequest{}).
+		Where("id = ?", request.ID).
+		Select("status", "reviewed_by", "reviewed_by_email", "reviewed_at", "review_comment").
+		Updates(request)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to update time-off request: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("time-off request not found or unauthorized")
+	}
+
+	return nil
+}
+
+// Delete removes a time-off request by ID with tenant and camp validation
+func (r *TimeOffRequestsRepository) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
+	result := ScopedQuery(r.db, ctx, tenantID, campID).
+		Where("id = ?", id).
+		Delete(&domain.TimeOffRequest{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete time-off request: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("time-off request not found or unauthorized")
+	}
+
+	return nil
+}
@@ -40,10 +40,13 @@ type activitiesService struct {
 	timeBlocksRepo     TimeBlocksRepository
 	certificationsRepo CertificationsRepository
 	eventsRepo         EventsRepository
+	customFieldsRepo   CustomFieldsRepository
+	equipmentItemsRepo EquipmentItemsRepository
+	skillTracksRepo    SkillTracksRepository
 }
 
 // NewActivitiesService creates a new activities service
-func NewActivitiesService(repo ActivitiesRepository, programsRepo ProgramsRepository, locationsRepo LocationsRepository, timeBlocksRepo TimeBlocksRepository, certificationsRepo CertificationsRepository, eventsRepo EventsRepository) ActivitiesService {
+func NewActivitiesService(repo ActivitiesRepository, programsRepo ProgramsRepository, locationsRepo LocationsRepository, timeBlocksRepo TimeBlocksRepository, certificationsRepo CertificationsRepository, eventsRepo EventsRepository, customFieldsRepo CustomFieldsRepository, equipmentItemsRepo EquipmentItemsRepository, skillTracksRepo SkillTracksRepository) ActivitiesService {
 	return &activitiesService{
 		repo:               repo,
 		programsRepo:       programsRepo,
@@ -51,6 +54,9 @@ func NewActivitiesService(repo ActivitiesRepository, programsRepo ProgramsReposi
 		timeBlocksRepo:     timeBlocksRepo,
 		certificationsRepo: certificationsRepo,
 		eventsRepo:         eventsRepo,
+		customFieldsRepo:   customFieldsRepo,
+		equipmentItemsRepo: equipmentItemsRepo,
+		skillTracksRepo:    skillTracksRepo,
 	}
 }
 
@@ -171,6 +177,18 @@ func (s *activitiesService) Create(ctx context.Context, tenantID uuid.UUID, camp
 		}
 	}
 
+	// Validate eligibility rules if provided
+	eligibility, err := s.parseEligibility(ctx, tenantID, campID, nil, req.Spec.Eligibility)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate equipment needs if provided
+	equipmentNeeds, err := s.parseEquipmentNeeds(ctx, tenantID, campID, req.Spec.EquipmentNeeds)
+	if err != nil {
+		return nil, err
+	}
+
 	// Serialize JSONB fields
 	var fixedTimeJSON json.RawMessage
 	if req.Spec.FixedTime != nil {
@@ -212,6 +230,8 @@ func (s *activitiesService) Create(ctx context.Context, tenantID uuid.UUID, camp
 		TimeBlockID:       req.Spec.TimeBlockId,
 		RequiredStaff:     requiredStaffJSON,
 		ActivityConflicts: activityConflictsJSON,
+		Eligibility:       eligibility,
+		EquipmentNeeds:    equipmentNeeds,
 	}
 
 	// Save to database
@@ -318,6 +338,18 @@ func (s *activitiesService) Update(ctx context.Context, tenantID, campID, id uui
 		}
 	}
 
+	// Validate eligibility rules if provided
+	eligibility, err := s.parseEligibility(ctx, tenantID, campID, &id, req.Spec.Eligibility)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate equipment needs if provided
+	equipmentNeeds, err := s.parseEquipmentNeeds(ctx, tenantID, campID, req.Spec.EquipmentNeeds)
+	if err != nil {
+		return nil, err
+	}
+
 	// Serialize JSONB fields
 	var fixedTimeJSON json.RawMessage
 	if req.Spec.FixedTime != nil {
@@ -356,6 +388,8 @@ func (s *activitiesService) Update(ctx context.Context, tenantID, campID, id uui
 	existingActivity.TimeBlockID = req.Spec.TimeBlockId
 	existingActivity.RequiredStaff = requiredStaffJSON
 	existingActivity.ActivityConflicts = activityConflictsJSON
+	existingActivity.Eligibility = eligibility
+	existingActivity.EquipmentNeeds = equipmentNeeds
 
 	// Save updates
 	if err := s.repo.Update(ctx, tenantID, campID, existingActivity); err != nil {
@@ -407,3 +441,78 @@ func (s *activitiesService) Delete(ctx context.Context, tenantID, campID, id uui
 
 	return nil
 }
+
+// parseEligibility validates eligibility rules: prerequisites must be other activities of the camp and
+// required skills must be camper custom fields with levels, starting from one of their levels, and required skill
+// levels must be levels of skill tracks of the camp
+func (s *activitiesService) parseEligibility(ctx context.Context, tenantID, campID uuid.UUID, activityID *uuid.UUID, req *api.ActivityEligibility) (*domain.ActivityEligibility, error) {
+	eligibility, err := domain.ParseActivityEligibility(req)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid eligibility: %v", err), err)
+	}
+	if eligibility == nil {
+		return nil, nil
+	}
+
+	for _, prerequisiteID := range eligibility.PrerequisiteActivityIDs {
+		if activityID != nil && prerequisiteID == *activityID {
+			return nil, pkgerrors.BadRequest("An activity cannot be its own prerequisite", nil)
+		}
+		if _, err := s.repo.GetByID(ctx, tenantID, campID, prerequisiteID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest(fmt.Sprintf("Prerequisite activity %s not found", prerequisiteID), err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to validate prerequisite activity", err)
+		}
+	}
+
+	for _, skill := range eligibility.RequiredSkills {
+		field, err := s.customFieldsRepo.GetByID(ctx, tenantID, campID, skill.CustomFieldID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest(fmt.Sprintf("Custom field %s not found", skill.CustomFieldID), err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to validate custom field", err)
+		}
+		if field.EntityType != domain.CustomFieldEntityTypeCamper || !field.IsLevelScale() {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Custom field %s must be a camper field of type enum or number to be used as a skill", field.Label), nil)
+		}
+		if _, err := field.ParseValue(skill.MinimumLevel); err != nil {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid minimum level for %s: %v", field.Label, err), err)
+		}
+	}
+
+	for _, skill := range eligibility.RequiredSkillLevels {
+		track, err := s.skillTracksRepo.GetByID(ctx, tenantID, campID, skill.SkillTrackID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest(fmt.Sprintf("Skill track %s not found", skill.SkillTrackID), err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to validate skill track", err)
+		}
+		if level, _ := track.Level(skill.MinimumLevelID); level == nil {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Level %s is not a level of %s", skill.MinimumLevelID, track.Name), nil)
+		}
+	}
+
+	return eligibility, nil
+}
+
+// parseEquipmentNeeds validates equipment needs: every needed item must be equipment of the camp
+func (s *activitiesService) parseEquipmentNeeds(ctx context.Context, tenantID, campID uuid.UUID, req *[]api.ActivityEquipmentNeed) (domain.EquipmentNeeds, error) {
+	needs, err := domain.ParseEquipmentNeeds(req)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid equipment needs: %v", err), err)
+	}
+
+	for _, need := range needs {
+		if _, err := s.equipmentItemsRepo.GetByID(ctx, tenantID, campID, need.EquipmentItemID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest(fmt.Sprintf("Equipment item %s not found", need.EquipmentItemID), err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to validate equipment item", err)
+		}
+	}
+
+	return needs, nil
+}
@@ -0,0 +1,417 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// ApplicationsService defines the interface for camper application business logic
+type ApplicationsService interface {
+	// List retrieves applications with pagination and optional search
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.ApplicationsListResponse, error)
+
+	// GetByID retrieves a single application by ID
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Application, error)
+
+	// Create creates a new draft application
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.ApplicationCreationRequest) (*api.Application, error)
+
+	// Update updates the details of an application that has not been accepted yet
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.ApplicationUpdateRequest) (*api.Application, error)
+
+	// Delete deletes an application by ID
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListTransitions retrieves the status history of an application
+	ListTransitions(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.ApplicationTransitionsListResponse, error)
+
+	// Transition moves an application to a new status
+	Transition(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.ApplicationTransitionRequest) (*api.Application, error)
+}
+
+// applicationsService implements ApplicationsService
+type applicationsService struct {
+	repo            ApplicationsRepository
+	campersRepo     CampersRepository
+	enrollmentsRepo CamperEnrollmentsRepository
+	guardiansRepo   GuardiansRepository
+	sessionsRepo    SessionsRepository
+	customFields    CustomFieldsRepository
+	membership      *groupMembership
+}
+
+// NewApplicationsService creates a new applications service
+func NewApplicationsService(repo ApplicationsRepository, campersRepo CampersRepository, enrollmentsRepo CamperEnrollmentsRepository, guardiansRepo GuardiansRepository, sessionsRepo SessionsRepository, groupsRepo GroupsRepository, customFieldsRepo CustomFieldsRepository) ApplicationsService {
+	return &applicationsService{
+		repo:            repo,
+		campersRepo:     campersRepo,
+		enrollmentsRepo: enrollmentsRepo,
+		guardiansRepo:   guardiansRepo,
+		sessionsRepo:    sessionsRepo,
+		customFields:    customFieldsRepo,
+		membership:      newGroupMembership(groupsRepo, campersRepo, sessionsRepo),
+	}
+}
+
+// List retrieves applications with pagination and optional search
+func (s *applicationsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.ApplicationsListResponse, error) {
+	applications, total, err := s.repo.List(ctx, tenantID, campID, limit, offset, search, filterStrings, sortBy, sortOrder)
+	if err != nil {
+		return nil, pkgerrors.BadRequest("Failed to list applications", err)
+	}
+
+	// Convert domain applications to API applications
+	apiApplications := make([]api.Application, len(applications))
+	for i, application := range applications {
+		apiApplications[i] = application.ToAPI()
+	}
+
+	return &api.ApplicationsListResponse{
+		Items:  apiApplications,
+		Limit:  limit,
+		Offset: offset,
+		Total:  int(total),
+	}, nil
+}
+
+// GetByID retrieves a single application by ID
+func (s *applicationsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Application, error) {
+	application, err := s.getApplication(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiApplication := application.ToAPI()
+	return &apiApplication, nil
+}
+
+// Create creates a new draft application
+func (s *applicationsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.ApplicationCreationRequest) (*api.Application, error) {
+	application := &domain.Application{
+		TenantID: tenantID,
+		CampID:   campID,
+		Status:   domain.ApplicationStatusDraft,
+	}
+
+	if err := s.applySpec(ctx, application, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	transition := s.newTransition(ctx, application, nil, domain.ApplicationStatusDraft, "")
+
+	// Save to database
+	if err := s.repo.Create(ctx, application, transition); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create application", err)
+	}
+
+	apiApplication := application.ToAPI()
+	return &apiApplication, nil
+}
+
+// Update updates the details of an application that has not been accepted yet
+func (s *applicationsService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.ApplicationUpdateRequest) (*api.Application, error) {
+	existingApplication, err := s.getApplication(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !existingApplication.Status.IsEditable() {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Cannot update an application that is %s", existingApplication.Status), nil)
+	}
+
+	// Moving a waitlisted application to another session would break the waitlist order
+	if existingApplication.Status == domain.ApplicationStatusWaitlisted && req.Spec.SessionId != existingApplication.SessionID {
+		return nil, pkgerrors.BadRequest("Cannot change the session of a waitlisted application", nil)
+	}
+
+	if err := s.applySpec(ctx, existingApplication, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, existingApplication); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update application", err)
+	}
+
+	// Fetch updated application to get latest timestamps
+	updatedApplication, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated application", err)
+	}
+
+	apiApplication := updatedApplication.ToAPI()
+	return &apiApplication, nil
+}
+
+// Delete deletes an application by ID
+func (s *applicationsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	application, err := s.getApplication(ctx, tenantID, campID, id)
+	if err != nil {
+		return err
+	}
+
+	if application.Status.HasCamper() {
+		return pkgerrors.BadRequest("Cannot delete an accepted or enrolled application; cancel or withdraw it instead", nil)
+	}
+
+	if application.Status == domain.ApplicationStatusWaitlisted {
+		return pkgerrors.BadRequest("Cannot delete a waitlisted application; cancel or withdraw it instead", nil)
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete application", err)
+	}
+
+	return nil
+}
+
+// ListTransitions retrieves the status history of an application
+func (s *applicationsService) ListTransitions(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.ApplicationTransitionsListResponse, error) {
+	if _, err := s.getApplication(ctx, tenantID, campID, id); err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.repo.ListTransitions(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list application transitions", err)
+	}
+
+	apiTransitions := make([]api.ApplicationTransition, len(transitions))
+	for i, transition := range transitions {
+		apiTransitions[i] = transition.ToAPI()
+	}
+
+	return &api.ApplicationTransitionsListResponse{
+		Items: apiTransitions,
+	}, nil
+}
+
+// Transition moves an application to a new status
+func (s *applicationsService) Transition(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.ApplicationTransitionRequest) (*api.Application, error) {
+	application, err := s.getApplication(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fromStatus := application.Status
+	toStatus := domain.ApplicationStatus(req.Status)
+
+	if !fromStatus.CanTransitionTo(toStatus) {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Cannot move an application from %s to %s", fromStatus, toStatus), nil)
+	}
+
+	var newCamper *domain.Camper
+	var session *domain.Session
+
+	switch toStatus {
+	case domain.ApplicationStatusSubmitted:
+		now := time.Now()
+		application.SubmittedAt = &now
+
+	case domain.ApplicationStatusWaitlisted:
+		position, err := s.repo.NextWaitlistPosition(ctx, tenantID, campID, application.SessionID)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to get waitlist position", err)
+		}
+		application.WaitlistPosition = &position
+
+	case domain.ApplicationStatusAccepted:
+		if err := s.checkWaitlistOrder(ctx, tenantID, campID, application, req); err != nil {
+			return nil, err
+		}
+
+		if session, err = s.getSession(ctx, tenantID, campID, application.SessionID); err != nil {
+			return nil, err
+		}
+
+		// New campers are created on acceptance, returning campers only get a new enrollment
+		if application.CamperID == nil {
+			customFields, err := resolveCustomFields(ctx, s.customFields, tenantID, campID, domain.CustomFieldEntityTypeCamper, req.CustomFields)
+			if err != nil {
+				return nil, err
+			}
+
+			newCamper = &domain.Camper{
+				TenantID:     tenantID,
+				CampID:       campID,
+				Name:         application.Name,
+				Description:  application.Description,
+				Birthday:     application.Birthday,
+				Gender:       application.Gender,
+				SessionID:    application.SessionID,
+				CustomFields: customFields,
+			}
+		}
+	}
+
+	if toStatus != domain.ApplicationStatusWaitlisted {
+		application.WaitlistPosition = nil
+	}
+	application.Status = toStatus
+
+	transition := s.newTransition(ctx, application, &fromStatus, toStatus, utils.PtrToString(req.Reason))
+
+	// Accepting takes a place in the session, checked in the same transaction as the enrollment
+	if err := s.repo.Transition(ctx, tenantID, campID, application, transition, newCamper, session != nil); err != nil {
+		var full *domain.SessionFullError
+		if errors.As(err, &full) {
+			return nil, pkgerrors.Conflict(fmt.Sprintf("Session '%s' is full (%d of %d places taken); waitlist the application instead", session.Name, full.Enrolled, full.Capacity), err)
+		}
+		if errors.Is(err, domain.ErrApplicationStatusChanged) {
+			return nil, pkgerrors.Conflict("Application was changed by another request; reload it and try again", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to update application status", err)
+	}
+
+	// Accepting or cancelling an application creates or changes the camper and their enrollments
+	if application.CamperID != nil {
+		if err := s.membership.refreshCamper(ctx, tenantID, campID, *application.CamperID); err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+		}
+	}
+
+	// Fetch updated application to get latest timestamps and camper link
+	updatedApplication, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated application", err)
+	}
+
+	apiApplication := updatedApplication.ToAPI()
+	return &apiApplication, nil
+}
+
+// getApplication retrieves an application, mapping a missing record to a not found error
+func (s *applicationsService) getApplication(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*domain.Application, error) {
+	application, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Application not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get application", err)
+	}
+	return application, nil
+}
+
+// checkWaitlistOrder verifies no other application is waiting for the session ahead of the one
+// being accepted, whether it comes from the waitlist or straight from submission, unless the
+// request explicitly accepts it out of order with a reason
+func (s *applicationsService) checkWaitlistOrder(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, application *domain.Application, req *api.ApplicationTransitionRequest) error {
+	first, err := s.repo.FirstWaitlisted(ctx, tenantID, campID, application.SessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return pkgerrors.InternalServerError("Failed to get waitlist", err)
+	}
+
+	if first.ID == application.ID {
+		return nil
+	}
+
+	if !utils.PtrToBool(req.OutOfOrder) {
+		return pkgerrors.Conflict(fmt.Sprintf("%s is ahead on the waitlist; accept it first or set outOfOrder with a reason", first.Name), nil)
+	}
+	if strings.TrimSpace(utils.PtrToString(req.Reason)) == "" {
+		return pkgerrors.BadRequest("A reason is required to accept an application out of waitlist order", nil)
+	}
+	return nil
+}
+
+// getSession retrieves the session of an application, mapping a missing record to a bad request
+func (s *applicationsService) getSession(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID uuid.UUID) (*domain.Session, error) {
+	session, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Session not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get session", err)
+	}
+	return session, nil
+}
+
+// newTransition builds the audit record for a status change, attributed to the current user
+func (s *applicationsService) newTransition(ctx context.Context, application *domain.Application, fromStatus *domain.ApplicationStatus, toStatus domain.ApplicationStatus, reason string) *domain.ApplicationTransition {
+	transition := &domain.ApplicationTransition{
+		TenantID:      application.TenantID,
+		CampID:        application.CampID,
+		ApplicationID: application.ID,
+		FromStatus:    fromStatus,
+		ToStatus:      toStatus,
+		Reason:        reason,
+	}
+
+	if userIDStr, err := pkgcontext.ExtractUserID(ctx); err == nil {
+		if userID, err := uuid.Parse(userIDStr); err == nil {
+			transition.ChangedBy = &userID
+		}
+	}
+	if email, err := pkgcontext.ExtractEmail(ctx); err == nil {
+		transition.ChangedByEmail = email
+	}
+
+	return transition
+}
+
+// applySpec validates the request and copies its meta and spec onto the domain application
+func (s *applicationsService) applySpec(ctx context.Context, application *domain.Application, meta api.EntityCreationRequestMeta, spec api.ApplicationMutationSpec) error {
+	tenantID, campID := application.TenantID, application.CampID
+
+	if _, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, spec.SessionId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest(fmt.Sprintf("Session %s not found", spec.SessionId), err)
+		}
+		return pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	if spec.CamperId != nil {
+		if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, *spec.CamperId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest(fmt.Sprintf("Camper %s not found", *spec.CamperId), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get camper", err)
+		}
+
+		enrollment, err := s.enrollmentsRepo.GetByCamperAndSession(ctx, tenantID, campID, *spec.CamperId, spec.SessionId)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.InternalServerError("Failed to check existing enrollments", err)
+		}
+		if enrollment != nil && enrollment.IsActive() {
+			return pkgerrors.Conflict("Camper is already enrolled in this session", nil)
+		}
+	}
+
+	var guardianIDs []uuid.UUID
+	if spec.GuardianIds != nil {
+		for _, guardianID := range *spec.GuardianIds {
+			if _, err := s.guardiansRepo.GetByID(ctx, tenantID, campID, guardianID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return pkgerrors.BadRequest(fmt.Sprintf("Guardian %s not found", guardianID), err)
+				}
+				return pkgerrors.InternalServerError("Failed to get guardian", err)
+			}
+		}
+		guardianIDs = *spec.GuardianIds
+	}
+
+	application.Name = meta.Name
+	application.Description = utils.PtrToString(meta.Description)
+	application.Birthday = spec.Birthday.Time
+	application.Gender = string(spec.Gender)
+	application.SessionID = spec.SessionId
+	application.CamperID = spec.CamperId
+	application.GuardianIDs = guardianIDs
+	application.Notes = utils.PtrToString(spec.Notes)
+
+	return nil
+}
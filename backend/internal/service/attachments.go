@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/storage"
+	"gorm.io/gorm"
+)
+
+const (
+	// attachmentDownloadPath is where signed attachment downloads are served, followed by the token
+	attachmentDownloadPath = "/api/v1/attachments/download/"
+
+	// maxAttachmentFileNameLength matches the file_name column
+	maxAttachmentFileNameLength = 255
+)
+
+// allowedAttachmentContentTypes lists the file types accepted as attachments, detected from the contents
+var allowedAttachmentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+}
+
+// AttachmentScanner is the hook for checking uploaded files for malware before they are stored
+type AttachmentScanner interface {
+	// Scan reads the file and reports whether it is free of malware
+	Scan(ctx context.Context, file io.Reader) (bool, error)
+}
+
+// AttachmentsServiceConfig holds configuration for the attachments service
+type AttachmentsServiceConfig struct {
+	MaxSizeBytes   int64             // Largest file accepted
+	DownloadURLTTL time.Duration     // How long signed download URLs stay valid
+	Scanner        AttachmentScanner // Optional, files are stored unscanned without one
+}
+
+// AttachmentUpload describes an uploaded file and the record it belongs to
+type AttachmentUpload struct {
+	File        io.ReadSeeker
+	FileName    string
+	Size        int64
+	EntityType  api.AttachmentEntityType
+	EntityID    uuid.UUID
+	Category    api.AttachmentCategory
+	Description *string
+}
+
+// AttachmentsService defines the interface for attachment business logic
+type AttachmentsService interface {
+	// List retrieves attachments, optionally limited to a kind of record and to one record
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, entityType *api.AttachmentEntityType, entityID *uuid.UUID) (*api.AttachmentsListResponse, error)
+
+	// Upload validates and stores a file and links it to a camper, staff member, certification or incident
+	Upload(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, upload *AttachmentUpload) (*api.Attachment, error)
+
+	// GetByID retrieves a single attachment
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Attachment, error)
+
+	// Delete removes an attachment and its stored file
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// CreateDownloadURL creates a short-lived signed URL to download an attachment
+	CreateDownloadURL(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.AttachmentDownloadUrl, error)
+
+	// Open returns the attachment a signed download token was issued for together with its contents
+	Open(ctx context.Context, token string) (*api.Attachment, io.ReadCloser, error)
+}
+
+// attachmentsService implements AttachmentsService
+type attachmentsService struct {
+	repo               AttachmentsRepository
+	storage            storage.Storage
+	jwtService         *domain.JWTService
+	campersRepo        CampersRepository
+	staffMembersRepo   StaffMembersRepository
+	certificationsRepo CertificationsRepository
+	incidentsRepo      IncidentsRepository
+	ticketsRepo        MaintenanceTicketsRepository
+	config             AttachmentsServiceConfig
+}
+
+// NewAttachmentsService creates a new attachments service
+func NewAttachmentsService(
+	repo AttachmentsRepository,
+	storage storage.Storage,
+	jwtService *domain.JWTService,
+	campersRepo CampersRepository,
+	staffMembersRepo StaffMembersRepository,
+	certificationsRepo CertificationsRepository,
+	incidentsRepo IncidentsRepository,
+	ticketsRepo MaintenanceTicketsRepository,
+	config AttachmentsServiceConfig,
+) AttachmentsService {
+	if config.MaxSizeBytes <= 0 {
+		config.MaxSizeBytes = 10 << 20
+	}
+	if config.DownloadURLTTL <= 0 {
+		config.DownloadURLTTL = 5 * time.Minute
+	}
+
+	return &attachmentsService{
+		repo:               repo,
+		storage:            storage,
+		jwtService:         jwtService,
+		campersRepo:        campersRepo,
+		staffMembersRepo:   staffMembersRepo,
+		certificationsRepo: certificationsRepo,
+		incidentsRepo:      incidentsRepo,
+		ticketsRepo:        ticketsRepo,
+		config:             config,
+	}
+}
+
+// List retrieves attachments, optionally limited to a kind of record and to one record
+func (s *attachmentsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, entityType *api.AttachmentEntityType, entityID *uuid.UUID) (*api.AttachmentsListResponse, error) {
+	var domainEntityType *domain.AttachmentEntityType
+	if entityType != nil {
+		if !isValidAttachmentEntityType(*entityType) {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid entity type: %s", *entityType), nil)
+		}
+		t := domain.AttachmentEntityType(*entityType)
+		domainEntityType = &t
+	}
+
+	attachments, err := s.repo.List(ctx, tenantID, campID, domainEntityType, entityID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list attachments", err)
+	}
+
+	items := make([]api.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		items[i] = attachment.ToAPI()
+	}
+
+	return &api.AttachmentsListResponse{Items: items}, nil
+}
+
+// Upload validates and stores a file and links it to a camper, staff member, certification or incident
+func (s *attachmentsService) Upload(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, upload *AttachmentUpload) (*api.Attachment, error) {
+	if !isValidAttachmentEntityType(upload.EntityType) {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid entity type: %s", upload.EntityType), nil)
+	}
+	if !isValidAttachmentCategory(upload.Category) {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid category: %s", upload.Category), nil)
+	}
+	if upload.Size == 0 {
+		return nil, pkgerrors.BadRequest("File is empty", nil)
+	}
+	if upload.Size > s.config.MaxSizeBytes {
+		return nil, attachmentTooLarge(s.config.MaxSizeBytes)
+	}
+
+	if err := s.validateEntity(ctx, tenantID, campID, upload.EntityType, upload.EntityID); err != nil {
+		return nil, err
+	}
+
+	// The content type is detected from the file itself, the name and client headers are not trusted
+	contentType, err := detectContentType(upload.File)
+	if err != nil {
+		return nil, pkgerrors.BadRequest("Failed to read file", err)
+	}
+	if !allowedAttachmentContentTypes[contentType] {
+		return nil, pkgerrors.NewAppError(http.StatusUnsupportedMediaType, "Only PDF documents and JPEG, PNG, GIF or WebP images can be attached", nil)
+	}
+	if upload.Category == api.AttachmentCategoryPhoto && !strings.HasPrefix(contentType, "image/") {
+		return nil, pkgerrors.NewAppError(http.StatusUnsupportedMediaType, "Photos must be JPEG, PNG, GIF or WebP images", nil)
+	}
+
+	scanStatus := domain.AttachmentScanStatusNotScanned
+	if s.config.Scanner != nil {
+		if _, err := upload.File.Seek(0, io.SeekStart); err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to read file", err)
+		}
+		clean, err := s.config.Scanner.Scan(ctx, upload.File)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to scan file", err)
+		}
+		if !clean {
+			return nil, pkgerrors.NewAppError(http.StatusUnprocessableEntity, "File was rejected by the virus scanner", nil)
+		}
+		scanStatus = domain.AttachmentScanStatusClean
+	}
+
+	if _, err := upload.File.Seek(0, io.SeekStart); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to read file", err)
+	}
+
+	attachment := &domain.Attachment{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		CampID:      campID,
+		EntityType:  domain.AttachmentEntityType(upload.EntityType),
+		EntityID:    upload.EntityID,
+		Category:    domain.AttachmentCategory(upload.Category),
+		FileName:    sanitizeAttachmentFileName(upload.FileName),
+		ContentType: contentType,
+		ScanStatus:  scanStatus,
+	}
+	attachment.StorageKey = fmt.Sprintf("%s/%s/%s", tenantID, campID, attachment.ID)
+	attachment.UploadedBy, attachment.UploadedByEmail = currentUser(ctx)
+	if upload.Description != nil {
+		attachment.Description = *upload.Description
+	}
+
+	// Hash and measure the contents while storing them; the reader is capped one byte past the
+	// limit so an understated size is still caught
+	hash := sha256.New()
+	counter := &countingReader{r: io.LimitReader(upload.File, s.config.MaxSizeBytes+1)}
+	if err := s.storage.Put(ctx, attachment.StorageKey, io.TeeReader(counter, hash)); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to store file", err)
+	}
+	if counter.n > s.config.MaxSizeBytes {
+		s.storage.Delete(ctx, attachment.StorageKey)
+		return nil, attachmentTooLarge(s.config.MaxSizeBytes)
+	}
+	attachment.Size = counter.n
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.repo.Create(ctx, attachment); err != nil {
+		// Clean up the file if the metadata cannot be saved
+		s.storage.Delete(ctx, attachment.StorageKey)
+		return nil, pkgerrors.InternalServerError("Failed to create attachment", err)
+	}
+
+	apiAttachment := attachment.ToAPI()
+	return &apiAttachment, nil
+}
+
+// GetByID retrieves a single attachment
+func (s *attachmentsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Attachment, error) {
+	attachment, err := s.getAttachment(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAttachment := attachment.ToAPI()
+	return &apiAttachment, nil
+}
+
+// Delete removes an attachment and its stored file
+func (s *attachmentsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	attachment, err := s.getAttachment(ctx, tenantID, campID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete attachment", err)
+	}
+
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return pkgerrors.InternalServerError("Failed to delete attachment file", err)
+	}
+
+	return nil
+}
+
+// CreateDownloadURL creates a short-lived signed URL to download an attachment
+func (s *attachmentsService) CreateDownloadURL(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.AttachmentDownloadUrl, error) {
+	attachment, err := s.getAttachment(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.config.DownloadURLTTL).Truncate(time.Second)
+	token, err := s.jwtService.GenerateAttachmentDownloadToken(tenantID.String(), campID.String(), attachment.ID.String(), expiresAt)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create download URL", err)
+	}
+
+	return &api.AttachmentDownloadUrl{
+		Url:       attachmentDownloadPath + token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Open returns the attachment a signed download token was issued for together with its contents
+func (s *attachmentsService) Open(ctx context.Context, token string) (*api.Attachment, io.ReadCloser, error) {
+	claims, err := s.jwtService.ValidateAttachmentDownloadToken(token)
+	if err != nil {
+		return nil, nil, pkgerrors.Unauthorized("Invalid or expired download link", err)
+	}
+
+	tenantID, err := uuid.Parse(claims.TenantID)
+	if err != nil {
+		return nil, nil, pkgerrors.Unauthorized("Invalid or expired download link", err)
+	}
+	campID, err := uuid.Parse(claims.CampID)
+	if err != nil {
+		return nil, nil, pkgerrors.Unauthorized("Invalid or expired download link", err)
+	}
+	id, err := uuid.Parse(claims.AttachmentID)
+	if err != nil {
+		return nil, nil, pkgerrors.Unauthorized("Invalid or expired download link", err)
+	}
+
+	attachment, err := s.getAttachment(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents, err := s.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, pkgerrors.NotFound("Attachment file not found", err)
+		}
+		return nil, nil, pkgerrors.InternalServerError("Failed to open attachment file", err)
+	}
+
+	apiAttachment := attachment.ToAPI()
+	return &apiAttachment, contents, nil
+}
+
+// getAttachment loads an attachment, mapping a missing record to a not found error
+func (s *attachmentsService) getAttachment(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Attachment, error) {
+	attachment, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Attachment not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get attachment", err)
+	}
+	return attachment, nil
+}
+
+// validateEntity checks that the record an attachment is linked to exists in the camp
+func (s *attachmentsService) validateEntity(ctx context.Context, tenantID, campID uuid.UUID, entityType api.AttachmentEntityType, entityID uuid.UUID) error {
+	var err error
+	var notFound string
+	switch entityType {
+	case api.AttachmentEntityTypeCamper:
+		_, err = s.campersRepo.GetByID(ctx, tenantID, campID, entityID)
+		notFound = "Camper not found"
+	case api.AttachmentEntityTypeStaffMember:
+		_, err = s.staffMembersRepo.GetByID(ctx, tenantID, campID, entityID)
+		notFound = "Staff member not found"
+	case api.AttachmentEntityTypeCertification:
+		_, err = s.certificationsRepo.GetByID(ctx, tenantID, campID, entityID)
+		notFound = "Certification not found"
+	case api.AttachmentEntityTypeIncident:
+		_, err = s.incidentsRepo.GetByID(ctx, tenantID, campID, entityID)
+		notFound = "Incident not found"
+	case api.AttachmentEntityTypeMaintenanceTicket:
+		_, err = s.ticketsRepo.GetByID(ctx, tenantID, campID, entityID)
+		notFound = "Maintenance ticket not found"
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest(notFound, err)
+		}
+		return pkgerrors.InternalServerError("Failed to validate attachment record", err)
+	}
+	return nil
+}
+
+// isValidAttachmentEntityType checks if the kind of record can have attachments
+func isValidAttachmentEntityType(entityType api.AttachmentEntityType) bool {
+	switch entityType {
+	case api.AttachmentEntityTypeCamper, api.AttachmentEntityTypeStaffMember, api.AttachmentEntityTypeCertification, api.AttachmentEntityTypeIncident, api.AttachmentEntityTypeMaintenanceTicket:
+		return true
+	}
+	return false
+}
+
+// isValidAttachmentCategory checks if the category is known
+func isValidAttachmentCategory(category api.AttachmentCategory) bool {
+	switch category {
+	case api.AttachmentCategoryWaiver, api.AttachmentCategoryMedicalForm, api.AttachmentCategoryPhoto, api.AttachmentCategoryCertificate, api.AttachmentCategoryOther:
+		return true
+	}
+	return false
+}
+
+// detectContentType sniffs the content type from the start of the file
+func detectContentType(file io.ReadSeeker) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "", err
+	}
+	return mediaType, nil
+}
+
+// sanitizeAttachmentFileName keeps only the base name of an uploaded file, so it is safe to show and download
+func sanitizeAttachmentFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	if runes := []rune(name); len(runes) > maxAttachmentFileNameLength {
+		name = string(runes[:maxAttachmentFileNameLength])
+	}
+	return name
+}
+
+// attachmentTooLarge returns the error for files over the size limit
+func attachmentTooLarge(maxSizeBytes int64) error {
+	return pkgerrors.NewAppError(http.StatusRequestEntityTooLarge, fmt.Sprintf("File exceeds the maximum attachment size of %d MB", maxSizeBytes>>20), nil)
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
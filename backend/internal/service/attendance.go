@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// AttendanceService defines the interface for daily camper check-in and check-out
+type AttendanceService interface {
+	// ListRecords retrieves the sign-in and sign-out records of a day (today when date is nil)
+	ListRecords(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time, camperID *uuid.UUID) (*api.AttendanceRecordsListResponse, error)
+
+	// CheckIn signs a camper in on arrival
+	CheckIn(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.AttendanceRequest) (*api.AttendanceRecord, error)
+
+	// CheckOut signs a camper out, verifying the pickup person against the camper's authorized pickup list
+	CheckOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.AttendanceRequest) (*api.AttendanceRecord, error)
+
+	// DeleteRecord deletes the latest record of a camper that was entered by mistake
+	DeleteRecord(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetOnSiteReport counts the campers on site per group and housing room (today when date is nil)
+	GetOnSiteReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time) (*api.OnSiteReport, error)
+}
+
+// attendanceService implements AttendanceService
+type attendanceService struct {
+	repo             AttendanceRepository
+	campsRepo        CampsRepository
+	campersRepo      CampersRepository
+	guardiansRepo    GuardiansRepository
+	staffMembersRepo StaffMembersRepository
+	groupsRepo       GroupsRepository
+	housingRoomsRepo HousingRoomsRepository
+}
+
+// NewAttendanceService creates a new attendance service
+func NewAttendanceService(repo AttendanceRepository, campsRepo CampsRepository, campersRepo CampersRepository, guardiansRepo GuardiansRepository, staffMembersRepo StaffMembersRepository, groupsRepo GroupsRepository, housingRoomsRepo HousingRoomsRepository) AttendanceService {
+	return &attendanceService{
+		repo:             repo,
+		campsRepo:        campsRepo,
+		campersRepo:      campersRepo,
+		guardiansRepo:    guardiansRepo,
+		staffMembersRepo: staffMembersRepo,
+		groupsRepo:       groupsRepo,
+		housingRoomsRepo: housingRoomsRepo,
+	}
+}
+
+// ListRecords retrieves the sign-in and sign-out records of a day (today when date is nil)
+func (s *attendanceService) ListRecords(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time, camperID *uuid.UUID) (*api.AttendanceRecordsListResponse, error) {
+	day, err := s.resolveDate(ctx, tenantID, campID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.repo.ListByDate(ctx, tenantID, campID, day, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list attendance records", err)
+	}
+
+	items := make([]api.AttendanceRecord, len(records))
+	for i, record := range records {
+		items[i] = record.ToAPI()
+	}
+
+	return &api.AttendanceRecordsListResponse{
+		Date:  openapi_types.Date{Time: day},
+		Items: items,
+	}, nil
+}
+
+// CheckIn signs a camper in on arrival
+func (s *attendanceService) CheckIn(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.AttendanceRequest) (*api.AttendanceRecord, error) {
+	record, latest, err := s.newRecord(ctx, tenantID, campID, domain.AttendanceTypeCheckIn, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil && latest.Type == domain.AttendanceTypeCheckIn {
+		return nil, pkgerrors.Conflict("Camper is already checked in", nil)
+	}
+	if latest != nil && record.OccurredAt.Before(latest.OccurredAt) {
+		return nil, pkgerrors.BadRequest("Check-in time cannot be before the previous check-out time", nil)
+	}
+
+	record.PersonName = strings.TrimSpace(utils.PtrToString(req.PersonName))
+	record.GuardianID = req.GuardianId
+
+	// Save to database
+	if err := s.repo.Create(ctx, record); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to check in camper", err)
+	}
+
+	apiRecord := record.ToAPI()
+	return &apiRecord, nil
+}
+
+// CheckOut signs a camper out, verifying the pickup person against the camper's authorized pickup list
+func (s *attendanceService) CheckOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.AttendanceRequest) (*api.AttendanceRecord, error) {
+	record, latest, err := s.newRecord(ctx, tenantID, campID, domain.AttendanceTypeCheckOut, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest == nil || latest.Type != domain.AttendanceTypeCheckIn {
+		return nil, pkgerrors.Conflict("Camper is not checked in", nil)
+	}
+	if record.OccurredAt.Before(latest.OccurredAt) {
+		return nil, pkgerrors.BadRequest("Check-out time cannot be before the check-in time", nil)
+	}
+
+	personName := strings.TrimSpace(utils.PtrToString(req.PersonName))
+	if record.Method.RequiresPickupPerson() {
+		if personName == "" {
+			return nil, pkgerrors.BadRequest("Pickup person is required", nil)
+		}
+
+		pickup, err := s.findAuthorizedPickup(ctx, tenantID, campID, record.CamperID, personName, req.GuardianId)
+		if err != nil {
+			return nil, err
+		}
+		personName = pickup.Name
+		record.GuardianID = pickup.GuardianId
+	} else {
+		record.GuardianID = req.GuardianId
+	}
+	record.PersonName = personName
+
+	// Save to database
+	if err := s.repo.Create(ctx, record); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to check out camper", err)
+	}
+
+	apiRecord := record.ToAPI()
+	return &apiRecord, nil
+}
+
+// DeleteRecord deletes the latest record of a camper that was entered by mistake
+func (s *attendanceService) DeleteRecord(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	// Check if record exists and belongs to tenant/camp
+	record, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Attendance record not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get attendance record", err)
+	}
+
+	// Removing an earlier record would leave the sign-ins and sign-outs of the day out of order
+	latest, err := s.repo.GetLatestForCamper(ctx, tenantID, campID, record.CamperID, record.Date)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to get attendance record", err)
+	}
+	if latest.ID != record.ID {
+		return pkgerrors.Conflict("Only the latest attendance record of a camper can be deleted", nil)
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete attendance record", err)
+	}
+
+	return nil
+}
+
+// GetOnSiteReport counts the campers on site per group and housing room (today when date is nil)
+func (s *attendanceService) GetOnSiteReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time) (*api.OnSiteReport, error) {
+	day, err := s.resolveDate(ctx, tenantID, campID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.repo.ListByDate(ctx, tenantID, campID, day, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list attendance records", err)
+	}
+
+	// Records are ordered by time, so the last record of each camper is their current state
+	latest := make(map[uuid.UUID]domain.AttendanceType)
+	for _, record := range records {
+		latest[record.CamperID] = record.Type
+	}
+
+	report := &api.OnSiteReport{
+		Date:         openapi_types.Date{Time: day},
+		CamperIds:    []uuid.UUID{},
+		Groups:       []api.OnSiteCount{},
+		HousingRooms: []api.OnSiteCount{},
+	}
+
+	for camperID, recordType := range latest {
+		if recordType == domain.AttendanceTypeCheckIn {
+			report.CamperIds = append(report.CamperIds, camperID)
+		} else {
+			report.CheckedOut++
+		}
+	}
+
+	campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, report.CamperIds)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get campers", err)
+	}
+
+	// Campers are ordered by name; deleted campers drop out of the count
+	groupCounts := make(map[uuid.UUID]int)
+	groupIDs := []uuid.UUID{}
+	report.CamperIds = report.CamperIds[:0]
+	for _, camper := range campers {
+		report.CamperIds = append(report.CamperIds, camper.ID)
+		for _, gc := range camper.GroupCampers {
+			if _, ok := groupCounts[gc.GroupID]; !ok {
+				groupIDs = append(groupIDs, gc.GroupID)
+			}
+			groupCounts[gc.GroupID]++
+		}
+	}
+	report.OnSite = len(report.CamperIds)
+
+	groups, err := s.groupsRepo.GetByIDs(ctx, tenantID, campID, groupIDs)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get groups", err)
+	}
+
+	roomCounts := make(map[uuid.UUID]int)
+	roomIDs := []uuid.UUID{}
+	for _, group := range groups {
+		report.Groups = append(report.Groups, api.OnSiteCount{
+			Id:     group.ID,
+			Name:   group.Name,
+			OnSite: groupCounts[group.ID],
+		})
+
+		// Housing groups of different sessions can share a room
+		if group.HousingRoomID != nil {
+			if _, ok := roomCounts[*group.HousingRoomID]; !ok {
+				roomIDs = append(roomIDs, *group.HousingRoomID)
+			}
+			roomCounts[*group.HousingRoomID] += groupCounts[group.ID]
+		}
+	}
+
+	for _, roomID := range roomIDs {
+		room, err := s.housingRoomsRepo.GetByID(ctx, tenantID, campID, roomID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, pkgerrors.InternalServerError("Failed to get housing room", err)
+		}
+		report.HousingRooms = append(report.HousingRooms, api.OnSiteCount{
+			Id:     room.ID,
+			Name:   room.Name,
+			OnSite: roomCounts[roomID],
+		})
+	}
+
+	sortOnSiteCounts(report.Groups)
+	sortOnSiteCounts(report.HousingRooms)
+
+	return report, nil
+}
+
+// newRecord validates the request and builds an attendance record for it, returning it
+// together with the camper's latest record of the same day (nil if there is none)
+func (s *attendanceService) newRecord(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, recordType domain.AttendanceType, req *api.AttendanceRequest) (*domain.AttendanceRecord, *domain.AttendanceRecord, error) {
+	method := domain.AttendanceMethod(req.Method)
+	if !method.IsValid() {
+		return nil, nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid attendance method '%s'", req.Method), nil)
+	}
+
+	if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, req.CamperId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, pkgerrors.BadRequest("Camper not found", err)
+		}
+		return nil, nil, pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	if req.StaffMemberId != nil {
+		if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, *req.StaffMemberId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, pkgerrors.BadRequest("Staff member not found", err)
+			}
+			return nil, nil, pkgerrors.InternalServerError("Failed to get staff member", err)
+		}
+	}
+
+	if req.GuardianId != nil {
+		if _, err := s.guardiansRepo.GetByID(ctx, tenantID, campID, *req.GuardianId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, pkgerrors.BadRequest("Guardian not found", err)
+			}
+			return nil, nil, pkgerrors.InternalServerError("Failed to get guardian", err)
+		}
+	}
+
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Times are stored in UTC
+	now := time.Now().UTC()
+	occurredAt := now
+	if req.OccurredAt != nil {
+		occurredAt = req.OccurredAt.UTC()
+		if occurredAt.After(now) {
+			return nil, nil, pkgerrors.BadRequest("Attendance time cannot be in the future", nil)
+		}
+	}
+
+	record := &domain.AttendanceRecord{
+		TenantID:      tenantID,
+		CampID:        campID,
+		CamperID:      req.CamperId,
+		Date:          localDate(occurredAt, loc),
+		Type:          recordType,
+		Method:        method,
+		OccurredAt:    occurredAt,
+		StaffMemberID: req.StaffMemberId,
+		Notes:         utils.PtrToString(req.Notes),
+	}
+	record.RecordedBy, _ = currentUser(ctx)
+
+	latest, err := s.repo.GetLatestForCamper(ctx, tenantID, campID, record.CamperID, record.Date)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, pkgerrors.InternalServerError("Failed to get attendance records", err)
+		}
+		latest = nil
+	}
+
+	return record, latest, nil
+}
+
+// findAuthorizedPickup looks up a person on the authorized pickup list of a camper by name,
+// optionally limited to the list of one guardian
+func (s *attendanceService) findAuthorizedPickup(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, personName string, guardianID *uuid.UUID) (*api.AuthorizedPickup, error) {
+	guardians, err := s.guardiansRepo.ListByCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list guardians", err)
+	}
+
+	name := normalizePersonName(personName)
+	for _, guardian := range guardians {
+		if guardianID != nil && guardian.ID != *guardianID {
+			continue
+		}
+		for _, pickup := range guardian.PickupList() {
+			if normalizePersonName(pickup.Name) == name {
+				return &pickup, nil
+			}
+		}
+	}
+
+	return nil, pkgerrors.Forbidden(fmt.Sprintf("%s is not on the authorized pickup list of this camper", personName), nil)
+}
+
+// resolveDate returns the requested day, or today in the camp time zone when none is given
+func (s *attendanceService) resolveDate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time) (time.Time, error) {
+	if date != nil {
+		return *date, nil
+	}
+
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return localDate(time.Now(), loc), nil
+}
+
+// campLocation returns the time zone attendance days of the camp are counted in
+func (s *attendanceService) campLocation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*time.Location, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	return camp.TimeLocation(), nil
+}
+
+// localDate returns the calendar day of t in loc as a UTC midnight date
+func localDate(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// normalizePersonName lowercases a name and collapses its whitespace for comparison
+func normalizePersonName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// sortOnSiteCounts orders on-site counts by name
+func sortOnSiteCounts(counts []api.OnSiteCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultOccupancyNights is how many nights the occupancy report covers when neither a session nor a last night is given
+const defaultOccupancyNights = 7
+
+// BedsService defines the interface for beds, bed assignments and room occupancy business logic
+type BedsService interface {
+	// ListBeds retrieves beds by room and label, optionally limited to a housing room
+	ListBeds(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, housingRoomID *uuid.UUID) (*api.BedsListResponse, error)
+
+	// GetBed retrieves a single bed
+	GetBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Bed, error)
+
+	// CreateBed adds a bed to a housing room that has fewer bed records than beds
+	CreateBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BedCreationRequest) (*api.Bed, error)
+
+	// UpdateBed changes the label, bunk position, accessibility and notes of a bed
+	UpdateBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.BedUpdateRequest) (*api.Bed, error)
+
+	// DeleteBed removes a bed together with its assignments
+	DeleteBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListAssignments retrieves bed assignments by start, optionally limited to a session and a housing room
+	ListAssignments(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID, housingRoomID *uuid.UUID) (*api.BedAssignmentsListResponse, error)
+
+	// CreateAssignment assigns a camper or staff member to a bed for nights of a session
+	CreateAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BedAssignmentCreationRequest) (*api.BedAssignment, error)
+
+	// UpdateAssignment moves a bed assignment to another bed or other nights
+	UpdateAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.BedAssignmentUpdateRequest) (*api.BedAssignment, error)
+
+	// DeleteAssignment removes a bed assignment
+	DeleteAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetOccupancy reports the occupied and available beds of every housing room per night
+	GetOccupancy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID, from, to *time.Time) (*api.HousingOccupancyReport, error)
+}
+
+// bedsService implements BedsService
+type bedsService struct {
+	bedsRepo         BedsRepository
+	assignmentsRepo  BedAssignmentsRepository
+	housingRoomsRepo HousingRoomsRepository
+	ticketsRepo      MaintenanceTicketsRepository
+	sessionsRepo     SessionsRepository
+	campersRepo      CampersRepository
+	enrollmentsRepo  CamperEnrollmentsRepository
+	staffMembersRepo StaffMembersRepository
+	campsRepo        CampsRepository
+}
+
+// NewBedsService creates a new beds service
+func NewBedsService(bedsRepo BedsRepository, assignmentsRepo BedAssignmentsRepository, housingRoomsRepo HousingRoomsRepository, ticketsRepo MaintenanceTicketsRepository, sessionsRepo SessionsRepository, campersRepo CampersRepository, enrollmentsRepo CamperEnrollmentsRepository, staffMembersRepo StaffMembersRepository, campsRepo CampsRepository) BedsService {
+	return &bedsService{
+		bedsRepo:         bedsRepo,
+		assignmentsRepo:  assignmentsRepo,
+		housingRoomsRepo: housingRoomsRepo,
+		ticketsRepo:      ticketsRepo,
+		sessionsRepo:     sessionsRepo,
+		campersRepo:      campersRepo,
+		enrollmentsRepo:  enrollmentsRepo,
+		staffMembersRepo: staffMembersRepo,
+		campsRepo:        campsRepo,
+	}
+}
+
+// ListBeds retrieves beds by room and label
+func (s *bedsService) ListBeds(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, housingRoomID *uuid.UUID) (*api.BedsListResponse, error) {
+	beds, err := s.bedsRepo.List(ctx, tenantID, campID, housingRoomID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list beds", err)
+	}
+
+	items := make([]api.Bed, len(beds))
+	for i := range beds {
+		items[i] = beds[i].ToAPI()
+	}
+
+	return &api.BedsListResponse{Items: items}, nil
+}
+
+// GetBed retrieves a single bed
+func (s *bedsService) GetBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Bed, error) {
+	bed, err := s.getBed(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBed := bed.ToAPI()
+	return &apiBed, nil
+}
+
+// CreateBed adds a bed to a housing room
+func (s *bedsService) CreateBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BedCreationRequest) (*api.Bed, error) {
+	room, err := s.getRoom(ctx, tenantID, campID, req.HousingRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.bedsRepo.CountByRoom(ctx, tenantID, campID, room.ID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to count beds", err)
+	}
+	if int(count) >= room.Beds {
+		return nil, pkgerrors.Conflict(fmt.Sprintf("%s already has all of its %d beds", room.Name, room.Beds), nil)
+	}
+
+	bed := &domain.Bed{
+		TenantID:      tenantID,
+		CampID:        campID,
+		HousingRoomID: room.ID,
+	}
+	applyBed(bed, api.BedUpdateRequest{
+		Label:        req.Label,
+		BunkPosition: req.BunkPosition,
+		Accessible:   req.Accessible,
+		Notes:        req.Notes,
+	})
+	if err := bed.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save to database
+	if err := s.bedsRepo.Create(ctx, bed); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create bed", err)
+	}
+
+	apiBed := bed.ToAPI()
+	return &apiBed, nil
+}
+
+// UpdateBed changes the details of a bed; beds cannot move to another room
+func (s *bedsService) UpdateBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.BedUpdateRequest) (*api.Bed, error) {
+	bed, err := s.getBed(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applyBed(bed, *req)
+	if err := bed.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save updates
+	if err := s.bedsRepo.Update(ctx, tenantID, campID, bed); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update bed", err)
+	}
+
+	return s.GetBed(ctx, tenantID, campID, id)
+}
+
+// DeleteBed removes a bed together with its assignments
+func (s *bedsService) DeleteBed(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getBed(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.bedsRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete bed", err)
+	}
+
+	return nil
+}
+
+// ListAssignments retrieves bed assignments by start
+func (s *bedsService) ListAssignments(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID, housingRoomID *uuid.UUID) (*api.BedAssignmentsListResponse, error) {
+	assignments, err := s.assignmentsRepo.List(ctx, tenantID, campID, sessionID, housingRoomID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list bed assignments", err)
+	}
+
+	items := make([]api.BedAssignment, len(assignments))
+	for i := range assignments {
+		items[i] = assignments[i].ToAPI()
+	}
+
+	return &api.BedAssignmentsListResponse{Items: items}, nil
+}
+
+// CreateAssignment assigns a camper or staff member to a bed
+func (s *bedsService) CreateAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BedAssignmentCreationRequest) (*api.BedAssignment, error) {
+	session, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, req.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Session not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	assignment := &domain.BedAssignment{
+		TenantID:      tenantID,
+		CampID:        campID,
+		SessionID:     session.ID,
+		CamperID:      req.CamperId,
+		StaffMemberID: req.StaffMemberId,
+	}
+	applyBedAssignment(assignment, session, api.BedAssignmentUpdateRequest{
+		BedId:     req.BedId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Notes:     req.Notes,
+	})
+
+	if assignment.CamperID != nil {
+		enrollment, err := s.enrollmentsRepo.GetByCamperAndSession(ctx, tenantID, campID, *assignment.CamperID, session.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.InternalServerError("Failed to get enrollment", err)
+		}
+		if enrollment == nil || !enrollment.IsActive() {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("The camper is not enrolled in %s", session.Name), err)
+		}
+	}
+
+	if err := s.checkAssignment(ctx, tenantID, campID, assignment, session); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.assignmentsRepo.Create(ctx, assignment); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create bed assignment", err)
+	}
+
+	apiAssignment := assignment.ToAPI()
+	return &apiAssignment, nil
+}
+
+// UpdateAssignment moves a bed assignment; its session and occupant stay the same
+func (s *bedsService) UpdateAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.BedAssignmentUpdateRequest) (*api.BedAssignment, error) {
+	assignment, err := s.getAssignment(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, assignment.SessionID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	applyBedAssignment(assignment, session, *req)
+	if err := s.checkAssignment(ctx, tenantID, campID, assignment, session); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.assignmentsRepo.Update(ctx, tenantID, campID, assignment); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update bed assignment", err)
+	}
+
+	updated, err := s.getAssignment(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAssignment := updated.ToAPI()
+	return &apiAssignment, nil
+}
+
+// DeleteAssignment removes a bed assignment
+func (s *bedsService) DeleteAssignment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getAssignment(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.assignmentsRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete bed assignment", err)
+	}
+
+	return nil
+}
+
+// GetOccupancy reports the occupied and available beds of every housing room for each night from the first
+// until the last. The nights default to those of the session, or to a week from today in the camp's time zone.
+func (s *bedsService) GetOccupancy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID, from, to *time.Time) (*api.HousingOccupancyReport, error) {
+	var first, last time.Time
+	if sessionID != nil {
+		session, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, *sessionID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest("Session not found", err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to get session", err)
+		}
+		first, last = session.StartDate, session.EndDate.AddDate(0, 0, -1)
+	} else {
+		camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.NotFound("Camp not found", err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+		}
+		first = localDate(time.Now(), camp.TimeLocation())
+		last = first.AddDate(0, 0, defaultOccupancyNights-1)
+	}
+	if from != nil {
+		first = *from
+		if to == nil && sessionID == nil {
+			last = first.AddDate(0, 0, defaultOccupancyNights-1)
+		}
+	}
+	if to != nil {
+		last = *to
+	}
+	if err := checkRosterRange(first, last); err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.housingRoomsRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list housing rooms", err)
+	}
+
+	assignments, err := s.assignmentsRepo.ListOverlapping(ctx, tenantID, campID, first, last.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list bed assignments", err)
+	}
+
+	nights := []api.HousingNightOccupancy{}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		occupancy := make(map[uuid.UUID]*api.HousingRoomOccupancy, len(rooms))
+		night := api.HousingNightOccupancy{
+			Date:  openapi_types.Date{Time: day},
+			Rooms: make([]api.HousingRoomOccupancy, len(rooms)),
+		}
+		for i, room := range rooms {
+			night.Rooms[i] = api.HousingRoomOccupancy{
+				HousingRoomId:   room.ID,
+				HousingRoomName: room.Name,
+				Beds:            room.Beds,
+			}
+			occupancy[room.ID] = &night.Rooms[i]
+		}
+
+		for i := range assignments {
+			a := &assignments[i]
+			if a.Bed == nil || !a.CoversNight(day) || (sessionID != nil && a.SessionID != *sessionID) {
+				continue
+			}
+			room, ok := occupancy[a.Bed.HousingRoomID]
+			if !ok {
+				continue
+			}
+			room.Occupied++
+			if a.CamperID != nil {
+				room.CamperCount++
+			} else {
+				room.StaffCount++
+			}
+		}
+
+		for i := range night.Rooms {
+			night.Rooms[i].Available = max(night.Rooms[i].Beds-night.Rooms[i].Occupied, 0)
+		}
+		nights = append(nights, night)
+	}
+
+	return &api.HousingOccupancyReport{
+		From:   openapi_types.Date{Time: first},
+		To:     openapi_types.Date{Time: last},
+		Nights: nights,
+	}, nil
+}
+
+// checkAssignment validates the nights of an assignment against its session and checks that the room is in service,
+// that neither its bed nor its occupant is taken on those nights and that the occupant's gender is allowed in the room
+func (s *bedsService) checkAssignment(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.BedAssignment, session *domain.Session) error {
+	if err := assignment.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	if assignment.StartDate.Before(session.StartDate) || assignment.EndDate.After(session.EndDate) {
+		return pkgerrors.BadRequest(fmt.Sprintf("The nights must lie within %s", session.Name), nil)
+	}
+
+	// The occupant must belong to this camp, whatever the room's gender policy
+	gender, found, err := s.occupantGender(ctx, tenantID, campID, assignment)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if assignment.CamperID != nil {
+			return pkgerrors.BadRequest("Camper not found", nil)
+		}
+		return pkgerrors.BadRequest("Staff member not found", nil)
+	}
+
+	bed, err := s.bedsRepo.GetByID(ctx, tenantID, campID, assignment.BedID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Bed not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get bed", err)
+	}
+	assignment.Bed = bed
+
+	room, err := s.getRoom(ctx, tenantID, campID, bed.HousingRoomID)
+	if err != nil {
+		return err
+	}
+
+	period := usePeriod{start: assignment.StartDate, end: assignment.EndDate}
+	if err := checkInService(ctx, s.ticketsRepo, tenantID, campID, domain.MaintenanceEntityTypeHousingRoom, room.ID, room.AreaID, room.Name, period); err != nil {
+		return err
+	}
+
+	overlapping, err := s.assignmentsRepo.ListOverlapping(ctx, tenantID, campID, assignment.StartDate, assignment.EndDate)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to list bed assignments", err)
+	}
+
+	var roommates []domain.BedAssignment
+	for _, other := range overlapping {
+		if other.ID == assignment.ID {
+			continue
+		}
+		if other.BedID == assignment.BedID {
+			return pkgerrors.Conflict(fmt.Sprintf("Bed %s in %s is already taken on some of these nights", bed.Label, room.Name), nil)
+		}
+		if other.SameOccupant(assignment) {
+			return pkgerrors.Conflict("The occupant already has a bed on some of these nights", nil)
+		}
+		if other.Bed != nil && other.Bed.HousingRoomID == room.ID {
+			roommates = append(roommates, other)
+		}
+	}
+
+	if room.GenderPolicy == "" || room.GenderPolicy == domain.GenderPolicyMixed {
+		return nil
+	}
+	var roommateGenders []string
+	if room.GenderPolicy == domain.GenderPolicySingleGender {
+		for i := range roommates {
+			roommateGender, found, err := s.occupantGender(ctx, tenantID, campID, &roommates[i])
+			if err != nil {
+				return err
+			}
+			// Roommates deleted since they were assigned no longer take part in the room's gender
+			if !found {
+				continue
+			}
+			roommateGenders = append(roommateGenders, roommateGender)
+		}
+	}
+	if err := room.AdmitsGender(gender, roommateGenders); err != nil {
+		return pkgerrors.Conflict(err.Error(), err)
+	}
+
+	return nil
+}
+
+// occupantGender returns the gender of the camper or staff member of an assignment, reporting false when the
+// occupant no longer exists
+func (s *bedsService) occupantGender(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.BedAssignment) (string, bool, error) {
+	if assignment.CamperID != nil {
+		camper, err := s.campersRepo.GetByID(ctx, tenantID, campID, *assignment.CamperID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", false, nil
+			}
+			return "", false, pkgerrors.InternalServerError("Failed to get camper", err)
+		}
+		return camper.Gender, true, nil
+	}
+
+	staffMember, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, *assignment.StaffMemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", false, nil
+		}
+		return "", false, pkgerrors.InternalServerError("Failed to get staff member", err)
+	}
+	return staffMember.Gender, true, nil
+}
+
+// getBed loads a bed, mapping a missing bed to a not found error
+func (s *bedsService) getBed(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Bed, error) {
+	bed, err := s.bedsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Bed not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get bed", err)
+	}
+	return bed, nil
+}
+
+// getRoom loads a housing room, mapping a missing room to a not found error
+func (s *bedsService) getRoom(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.HousingRoom, error) {
+	room, err := s.housingRoomsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Housing room not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get housing room", err)
+	}
+	return room, nil
+}
+
+// getAssignment loads a bed assignment, mapping a missing assignment to a not found error
+func (s *bedsService) getAssignment(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.BedAssignment, error) {
+	assignment, err := s.assignmentsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Bed assignment not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get bed assignment", err)
+	}
+	return assignment, nil
+}
+
+// applyBed copies the editable fields of a bed request onto a bed
+func applyBed(bed *domain.Bed, req api.BedUpdateRequest) {
+	bed.Label = strings.TrimSpace(req.Label)
+	bed.BunkPosition = domain.BunkPositionNone
+	if req.BunkPosition != nil {
+		bed.BunkPosition = domain.BunkPosition(*req.BunkPosition)
+	}
+	bed.Accessible = req.Accessible != nil && *req.Accessible
+	bed.Notes = utils.PtrToString(req.Notes)
+}
+
+// applyBedAssignment copies the bed, nights and notes of a request onto an assignment,
+// defaulting the nights to those of the session
+func applyBedAssignment(assignment *domain.BedAssignment, session *domain.Session, req api.BedAssignmentUpdateRequest) {
+	if assignment.BedID != req.BedId {
+		assignment.BedID = req.BedId
+		assignment.Bed = nil
+	}
+	assignment.StartDate = session.StartDate
+	if req.StartDate != nil {
+		assignment.StartDate = req.StartDate.Time
+	}
+	assignment.EndDate = session.EndDate
+	if req.EndDate != nil {
+		assignment.EndDate = req.EndDate.Time
+	}
+	assignment.Notes = utils.PtrToString(req.Notes)
+}
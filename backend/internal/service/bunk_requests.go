@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// BunkRequestsService defines the interface for bunkmate request business logic
+type BunkRequestsService interface {
+	// List retrieves bunk requests, optionally limited to a session and to the requests made by or naming a camper
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID, camperID *uuid.UUID) (*api.BunkRequestsListResponse, error)
+
+	// Create records a camper's request to share a housing room with another camper
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BunkRequestCreationRequest) (*api.BunkRequest, error)
+
+	// Delete withdraws a bunk request
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+}
+
+// bunkRequestsService implements BunkRequestsService
+type bunkRequestsService struct {
+	repo            BunkRequestsRepository
+	campersRepo     CampersRepository
+	enrollmentsRepo CamperEnrollmentsRepository
+	sessionsRepo    SessionsRepository
+}
+
+// NewBunkRequestsService creates a new bunk requests service
+func NewBunkRequestsService(repo BunkRequestsRepository, campersRepo CampersRepository, enrollmentsRepo CamperEnrollmentsRepository, sessionsRepo SessionsRepository) BunkRequestsService {
+	return &bunkRequestsService{
+		repo:            repo,
+		campersRepo:     campersRepo,
+		enrollmentsRepo: enrollmentsRepo,
+		sessionsRepo:    sessionsRepo,
+	}
+}
+
+// List retrieves bunk requests, optionally limited to a session and to the requests made by or naming a camper
+func (s *bunkRequestsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID, camperID *uuid.UUID) (*api.BunkRequestsListResponse, error) {
+	requests, err := s.repo.List(ctx, tenantID, campID, sessionID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list bunk requests", err)
+	}
+
+	// The camper filter keeps both directions of a pair, so mutual requests can be matched within the list
+	markMutualRequests(requests)
+
+	items := make([]api.BunkRequest, len(requests))
+	for i, request := range requests {
+		items[i] = request.ToAPI()
+	}
+
+	return &api.BunkRequestsListResponse{Items: items}, nil
+}
+
+// Create records a camper's request to share a housing room with another camper
+func (s *bunkRequestsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.BunkRequestCreationRequest) (*api.BunkRequest, error) {
+	if req.CamperId == req.RequestedCamperId {
+		return nil, pkgerrors.BadRequest("A camper cannot request themselves as bunkmate", nil)
+	}
+
+	if _, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, req.SessionId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Session not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	for _, camperID := range []uuid.UUID{req.CamperId, req.RequestedCamperId} {
+		if err := s.checkEnrolled(ctx, tenantID, campID, camperID, req.SessionId); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.repo.GetByCampers(ctx, tenantID, campID, req.SessionId, req.CamperId, req.RequestedCamperId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.InternalServerError("Failed to check bunk requests", err)
+	}
+	if existing != nil {
+		return nil, pkgerrors.Conflict("Camper has already requested this bunkmate for the session", nil)
+	}
+
+	request := &domain.BunkRequest{
+		TenantID:          tenantID,
+		CampID:            campID,
+		SessionID:         req.SessionId,
+		CamperID:          req.CamperId,
+		RequestedCamperID: req.RequestedCamperId,
+		Notes:             utils.PtrToString(req.Notes),
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, request); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create bunk request", err)
+	}
+
+	reverse, err := s.repo.GetByCampers(ctx, tenantID, campID, req.SessionId, req.RequestedCamperId, req.CamperId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.InternalServerError("Failed to check bunk requests", err)
+	}
+	request.Mutual = reverse != nil
+
+	apiRequest := request.ToAPI()
+	return &apiRequest, nil
+}
+
+// Delete withdraws a bunk request
+func (s *bunkRequestsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	// Check if bunk request exists and belongs to tenant/camp
+	_, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Bunk request not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get bunk request", err)
+	}
+
+	// Delete the bunk request
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete bunk request", err)
+	}
+
+	return nil
+}
+
+// checkEnrolled verifies the camper exists and has an active enrollment in the session
+func (s *bunkRequestsService) checkEnrolled(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, sessionID uuid.UUID) error {
+	if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, camperID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Camper not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	enrollment, err := s.enrollmentsRepo.GetByCamperAndSession(ctx, tenantID, campID, camperID, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Both campers must be enrolled in the session", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get enrollment", err)
+	}
+	if !enrollment.IsActive() {
+		return pkgerrors.BadRequest("Both campers must be enrolled in the session", nil)
+	}
+
+	return nil
+}
+
+// markMutualRequests flags the requests whose requested camper asked for the requester in the same session
+func markMutualRequests(requests []domain.BunkRequest) {
+	type pair struct {
+		sessionID, camperID, requestedCamperID uuid.UUID
+	}
+
+	made := make(map[pair]bool, len(requests))
+	for _, request := range requests {
+		made[pair{request.SessionID, request.CamperID, request.RequestedCamperID}] = true
+	}
+	for i := range requests {
+		requests[i].Mutual = made[pair{requests[i].SessionID, requests[i].RequestedCamperID, requests[i].CamperID}]
+	}
+}
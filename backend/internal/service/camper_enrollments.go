@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CamperEnrollmentsService defines the interface for camper session enrollment business logic
+type CamperEnrollmentsService interface {
+	// List retrieves all session enrollments of a camper
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperEnrollmentsListResponse, error)
+
+	// Create enrolls a camper in an additional session
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, req *api.CamperEnrollmentRequest) (*api.CamperEnrollment, error)
+
+	// Update updates an existing enrollment of a camper
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID, req *api.CamperEnrollmentRequest) (*api.CamperEnrollment, error)
+
+	// Delete removes an enrollment of a camper
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID) error
+}
+
+// camperEnrollmentsService implements CamperEnrollmentsService
+type camperEnrollmentsService struct {
+	repo         CamperEnrollmentsRepository
+	campersRepo  CampersRepository
+	sessionsRepo SessionsRepository
+	groupsRepo   GroupsRepository
+	membership   *groupMembership
+}
+
+// NewCamperEnrollmentsService creates a new camper enrollments service
+func NewCamperEnrollmentsService(repo CamperEnrollmentsRepository, campersRepo CampersRepository, sessionsRepo SessionsRepository, groupsRepo GroupsRepository) CamperEnrollmentsService {
+	return &camperEnrollmentsService{
+		repo:         repo,
+		campersRepo:  campersRepo,
+		sessionsRepo: sessionsRepo,
+		groupsRepo:   groupsRepo,
+		membership:   newGroupMembership(groupsRepo, campersRepo, sessionsRepo),
+	}
+}
+
+// List retrieves all session enrollments of a camper
+func (s *camperEnrollmentsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperEnrollmentsListResponse, error) {
+	if _, err := s.getCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.repo.ListByCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list enrollments", err)
+	}
+
+	apiEnrollments := make([]api.CamperEnrollment, len(enrollments))
+	for i, enrollment := range enrollments {
+		apiEnrollments[i] = enrollment.ToAPI()
+	}
+
+	return &api.CamperEnrollmentsListResponse{
+		Items: apiEnrollments,
+	}, nil
+}
+
+// Create enrolls a camper in an additional session
+func (s *camperEnrollmentsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, req *api.CamperEnrollmentRequest) (*api.CamperEnrollment, error) {
+	if _, err := s.getCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, err
+	}
+
+	enrollment := &domain.CamperEnrollment{
+		TenantID: tenantID,
+		CampID:   campID,
+		CamperID: camperID,
+	}
+
+	if err := s.applyRequest(ctx, enrollment, req); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, enrollment); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create enrollment", err)
+	}
+
+	// Session-bound rule-based groups depend on enrollments
+	if err := s.membership.refreshCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	apiEnrollment := enrollment.ToAPI()
+	return &apiEnrollment, nil
+}
+
+// Update updates an existing enrollment of a camper
+func (s *camperEnrollmentsService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID, req *api.CamperEnrollmentRequest) (*api.CamperEnrollment, error) {
+	camper, err := s.getCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingEnrollment, err := s.getEnrollment(ctx, tenantID, campID, camperID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The enrollment for the registration session anchors the camper record
+	if existingEnrollment.SessionID == camper.SessionID && req.SessionId != camper.SessionID {
+		return nil, pkgerrors.BadRequest("Cannot move the enrollment for the camper's registration session; update the camper instead", nil)
+	}
+
+	if err := s.applyRequest(ctx, existingEnrollment, req); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, existingEnrollment); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update enrollment", err)
+	}
+
+	// Session-bound rule-based groups depend on enrollments
+	if err := s.membership.refreshCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	// Fetch updated enrollment to get latest timestamps
+	updatedEnrollment, err := s.repo.GetByID(ctx, tenantID, campID, camperID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated enrollment", err)
+	}
+
+	apiEnrollment := updatedEnrollment.ToAPI()
+	return &apiEnrollment, nil
+}
+
+// Delete removes an enrollment of a camper
+func (s *camperEnrollmentsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID) error {
+	camper, err := s.getCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := s.getEnrollment(ctx, tenantID, campID, camperID, id)
+	if err != nil {
+		return err
+	}
+
+	if enrollment.SessionID == camper.SessionID {
+		return pkgerrors.BadRequest("Cannot delete the enrollment for the camper's registration session; cancel it instead", nil)
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete enrollment", err)
+	}
+
+	// Session-bound rule-based groups depend on enrollments
+	if err := s.membership.refreshCamper(ctx, tenantID, campID, camperID); err != nil {
+		return pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	return nil
+}
+
+// getCamper retrieves the camper that owns the enrollments
+func (s *camperEnrollmentsService) getCamper(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*domain.Camper, error) {
+	camper, err := s.campersRepo.GetByID(ctx, tenantID, campID, camperID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camper not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+	return camper, nil
+}
+
+// getEnrollment retrieves a single enrollment of the camper
+func (s *camperEnrollmentsService) getEnrollment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID) (*domain.CamperEnrollment, error) {
+	enrollment, err := s.repo.GetByID(ctx, tenantID, campID, camperID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Enrollment not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get enrollment", err)
+	}
+	return enrollment, nil
+}
+
+// checkSessionCapacity verifies the session has room for one more active enrollment
+func checkSessionCapacity(ctx context.Context, repo CamperEnrollmentsRepository, session *domain.Session) error {
+	if session.Capacity == nil {
+		return nil
+	}
+
+	enrolled, err := repo.CountActiveBySession(ctx, session.TenantID, session.CampID, session.ID)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to check session capacity", err)
+	}
+	if enrolled >= int64(*session.Capacity) {
+		return pkgerrors.Conflict(fmt.Sprintf("Session '%s' is full (%d of %d places taken)", session.Name, enrolled, *session.Capacity), nil)
+	}
+	return nil
+}
+
+// applyRequest validates the request and copies it onto the domain enrollment
+func (s *camperEnrollmentsService) applyRequest(ctx context.Context, enrollment *domain.CamperEnrollment, req *api.CamperEnrollmentRequest) error {
+	tenantID, campID := enrollment.TenantID, enrollment.CampID
+
+	session, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, req.SessionId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Session not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	// A camper can only be enrolled once per session
+	existing, err := s.repo.GetByCamperAndSession(ctx, tenantID, campID, enrollment.CamperID, req.SessionId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return pkgerrors.InternalServerError("Failed to check existing enrollments", err)
+	}
+	if existing != nil && existing.ID != enrollment.ID {
+		return pkgerrors.Conflict("Camper is already enrolled in this session", nil)
+	}
+
+	var startDate, endDate *time.Time
+	if req.StartDate != nil {
+		startDate = &req.StartDate.Time
+	}
+	if req.EndDate != nil {
+		endDate = &req.EndDate.Time
+	}
+
+	if startDate != nil && startDate.Before(session.StartDate) {
+		return pkgerrors.BadRequest("Start date must be within the session dates", nil)
+	}
+	if endDate != nil && endDate.After(session.EndDate) {
+		return pkgerrors.BadRequest("End date must be within the session dates", nil)
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		return pkgerrors.BadRequest("End date must be after or equal to start date", nil)
+	}
+
+	if req.HousingGroupId != nil {
+		groups, err := s.groupsRepo.GetByIDs(ctx, tenantID, campID, []uuid.UUID{*req.HousingGroupId})
+		if err != nil {
+			return pkgerrors.InternalServerError("Failed to get housing group", err)
+		}
+		if len(groups) == 0 {
+			return pkgerrors.BadRequest("Housing group not found", nil)
+		}
+		if groups[0].HousingRoomID == nil {
+			return pkgerrors.BadRequest("Group is not a housing group", nil)
+		}
+		if groups[0].SessionID != nil && *groups[0].SessionID != session.ID {
+			return pkgerrors.BadRequest("Housing group belongs to another session", nil)
+		}
+	}
+
+	status := domain.EnrollmentStatusEnrolled
+	if req.Status != nil {
+		status = domain.EnrollmentStatus(*req.Status)
+		if !status.IsValid() {
+			return pkgerrors.BadRequest(fmt.Sprintf("Invalid enrollment status '%s'", status), nil)
+		}
+	}
+
+	// Enrollments take a new place in the session when they are created, reactivated after a
+	// cancellation or moved to another session
+	takesPlace := enrollment.ID == uuid.Nil || !enrollment.IsActive() || enrollment.SessionID != session.ID
+	if takesPlace && status != domain.EnrollmentStatusCancelled {
+		if err := checkSessionCapacity(ctx, s.repo, session); err != nil {
+			return err
+		}
+	}
+
+	enrollment.SessionID = req.SessionId
+	enrollment.Status = status
+	enrollment.StartDate = startDate
+	enrollment.EndDate = endDate
+	enrollment.HousingGroupID = req.HousingGroupId
+	enrollment.Notes = utils.PtrToString(req.Notes)
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	// defaultDuplicateMinScore is the score pairs need to be reported as duplicates when no minimum is given
+	defaultDuplicateMinScore = 70
+
+	// minDuplicateNameSimilarity keeps siblings sharing a birthday (twins) and guardians out of the results
+	minDuplicateNameSimilarity = 0.8
+)
+
+// CamperMergesService defines the interface for duplicate camper detection and merging
+type CamperMergesService interface {
+	// ListDuplicates finds pairs of campers that likely describe the same child
+	ListDuplicates(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, minScore *int) (*api.CamperDuplicatesListResponse, error)
+
+	// Merge moves all references of a duplicate camper to the survivor and deletes the duplicate
+	Merge(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, survivorID uuid.UUID, req *api.CamperMergeRequest) (*api.CamperMerge, error)
+
+	// List retrieves the audit trail of camper merges
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.CamperMergesListResponse, error)
+}
+
+// camperMergesService implements CamperMergesService
+type camperMergesService struct {
+	repo          CamperMergesRepository
+	campersRepo   CampersRepository
+	guardiansRepo GuardiansRepository
+	membership    *groupMembership
+}
+
+// NewCamperMergesService creates a new camper merges service
+func NewCamperMergesService(repo CamperMergesRepository, campersRepo CampersRepository, guardiansRepo GuardiansRepository, groupsRepo GroupsRepository, sessionsRepo SessionsRepository) CamperMergesService {
+	return &camperMergesService{
+		repo:          repo,
+		campersRepo:   campersRepo,
+		guardiansRepo: guardiansRepo,
+		membership:    newGroupMembership(groupsRepo, campersRepo, sessionsRepo),
+	}
+}
+
+// ListDuplicates finds pairs of campers that likely describe the same child
+func (s *camperMergesService) ListDuplicates(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, minScore *int) (*api.CamperDuplicatesListResponse, error) {
+	threshold := defaultDuplicateMinScore
+	if minScore != nil {
+		if *minScore < 0 || *minScore > 100 {
+			return nil, pkgerrors.BadRequest("minScore must be between 0 and 100", nil)
+		}
+		threshold = *minScore
+	}
+
+	campers, err := s.campersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list campers", err)
+	}
+
+	guardians, err := s.guardiansRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list guardians", err)
+	}
+
+	// Guardians are compared by record and by contact details, since imported duplicates
+	// usually come with duplicated guardians too
+	guardianKeys := make(map[uuid.UUID]map[string]bool)
+	for _, guardian := range guardians {
+		keys := []string{"id:" + guardian.ID.String(), "name:" + duplicateNameKey(guardian.Name)}
+		if email := strings.ToLower(strings.TrimSpace(guardian.Email)); email != "" {
+			keys = append(keys, "email:"+email)
+		}
+		for _, link := range guardian.GuardianCampers {
+			if guardianKeys[link.CamperID] == nil {
+				guardianKeys[link.CamperID] = make(map[string]bool)
+			}
+			for _, key := range keys {
+				guardianKeys[link.CamperID][key] = true
+			}
+		}
+	}
+
+	// The survivor suggestion is the camper registered first
+	sort.Slice(campers, func(i, j int) bool {
+		return campers[i].CreatedAt.Before(campers[j].CreatedAt)
+	})
+
+	nameKeys := make([][]rune, len(campers))
+	for i, camper := range campers {
+		nameKeys[i] = []rune(duplicateNameKey(camper.Name))
+	}
+
+	items := []api.CamperDuplicate{}
+	for i := range campers {
+		for j := i + 1; j < len(campers); j++ {
+			similarity := nameSimilarity(nameKeys[i], nameKeys[j])
+			if similarity < minDuplicateNameSimilarity {
+				continue
+			}
+
+			var reasons []api.CamperDuplicateReason
+			score := 50 * similarity
+			if similarity == 1 {
+				reasons = append(reasons, api.CamperDuplicateReasonSameName)
+			} else {
+				reasons = append(reasons, api.CamperDuplicateReasonSimilarName)
+			}
+			if campers[i].Birthday.Format("2006-01-02") == campers[j].Birthday.Format("2006-01-02") {
+				reasons = append(reasons, api.CamperDuplicateReasonSameBirthday)
+				score += 30
+			}
+			if sharesKey(guardianKeys[campers[i].ID], guardianKeys[campers[j].ID]) {
+				reasons = append(reasons, api.CamperDuplicateReasonSharedGuardian)
+				score += 20
+			}
+
+			rounded := int(math.Round(score))
+			if rounded < threshold {
+				continue
+			}
+
+			items = append(items, api.CamperDuplicate{
+				CamperId:          campers[i].ID,
+				DuplicateCamperId: campers[j].ID,
+				Score:             rounded,
+				Reasons:           reasons,
+			})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+
+	return &api.CamperDuplicatesListResponse{Items: items}, nil
+}
+
+// Merge moves all references of a duplicate camper to the survivor and deletes the duplicate
+func (s *camperMergesService) Merge(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, survivorID uuid.UUID, req *api.CamperMergeRequest) (*api.CamperMerge, error) {
+	if req.DuplicateCamperId == survivorID {
+		return nil, pkgerrors.BadRequest("A camper cannot be merged into itself", nil)
+	}
+
+	survivor, err := s.campersRepo.GetByID(ctx, tenantID, campID, survivorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camper not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	duplicate, err := s.campersRepo.GetByID(ctx, tenantID, campID, req.DuplicateCamperId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Duplicate camper not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get duplicate camper", err)
+	}
+
+	// Keep the duplicate as it was for the audit trail
+	snapshot, err := json.Marshal(duplicate.ToAPI())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to record duplicate camper", err)
+	}
+
+	mergedBy, mergedByEmail := currentUser(ctx)
+	merge := &domain.CamperMerge{
+		TenantID:          tenantID,
+		CampID:            campID,
+		SurvivorCamperID:  survivor.ID,
+		DuplicateCamperID: duplicate.ID,
+		DuplicateName:     duplicate.Name,
+		DuplicateBirthday: duplicate.Birthday,
+		DuplicateSnapshot: snapshot,
+		MergedBy:          mergedBy,
+		MergedByEmail:     mergedByEmail,
+	}
+
+	if err := s.repo.Merge(ctx, tenantID, campID, survivor, duplicate, merge); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to merge campers", err)
+	}
+
+	// The survivor may now match rule-based groups it did not match before
+	if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	apiMerge := merge.ToAPI()
+	return &apiMerge, nil
+}
+
+// List retrieves the audit trail of camper merges
+func (s *camperMergesService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.CamperMergesListResponse, error) {
+	merges, err := s.repo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list camper merges", err)
+	}
+
+	items := make([]api.CamperMerge, len(merges))
+	for i, merge := range merges {
+		items[i] = merge.ToAPI()
+	}
+
+	return &api.CamperMergesListResponse{Items: items}, nil
+}
+
+// duplicateNameKey normalizes a name for duplicate detection, ignoring case, punctuation and word order
+func duplicateNameKey(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// nameSimilarity returns how similar two normalized names are, from 0 (nothing in common) to 1 (equal)
+func nameSimilarity(a, b []rune) float64 {
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if longest == 0 {
+		return 0
+	}
+
+	// The length difference alone bounds the similarity, which skips most pairs cheaply
+	lengthDiff := len(a) - len(b)
+	if lengthDiff < 0 {
+		lengthDiff = -lengthDiff
+	}
+	if 1-float64(lengthDiff)/float64(longest) < minDuplicateNameSimilarity {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+// levenshtein returns the number of single character edits needed to turn a into b
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// sharesKey reports whether two key sets have a key in common
+func sharesKey(a, b map[string]bool) bool {
+	for key := range a {
+		if b[key] {
+			return true
+		}
+	}
+	return false
+}
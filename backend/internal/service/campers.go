@@ -33,17 +33,23 @@ type CampersService interface {
 
 // campersService implements CampersService
 type campersService struct {
-	repo         CampersRepository
-	sessionsRepo SessionsRepository
-	groupsRepo   GroupsRepository
+	repo            CampersRepository
+	sessionsRepo    SessionsRepository
+	groupsRepo      GroupsRepository
+	enrollmentsRepo CamperEnrollmentsRepository
+	customFields    CustomFieldsRepository
+	membership      *groupMembership
 }
 
 // NewCampersService creates a new campers service
-func NewCampersService(repo CampersRepository, sessionsRepo SessionsRepository, groupsRepo GroupsRepository) CampersService {
+func NewCampersService(repo CampersRepository, sessionsRepo SessionsRepository, groupsRepo GroupsRepository, enrollmentsRepo CamperEnrollmentsRepository, customFields CustomFieldsRepository) CampersService {
 	return &campersService{
-		repo:         repo,
-		sessionsRepo: sessionsRepo,
-		groupsRepo:   groupsRepo,
+		repo:            repo,
+		sessionsRepo:    sessionsRepo,
+		groupsRepo:      groupsRepo,
+		enrollmentsRepo: enrollmentsRepo,
+		customFields:    customFields,
+		membership:      newGroupMembership(groupsRepo, repo, sessionsRepo),
 	}
 }
 
@@ -96,15 +102,35 @@ func (s *campersService) Create(ctx context.Context, tenantId uuid.UUID, campId
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	// The new camper takes a place in the session
+	if err := s.checkSessionPlace(ctx, tenantId, campId, uuid.Nil, req.Spec.SessionId); err != nil {
+		return nil, err
+	}
+
+	// Validate custom field values
+	customFields, err := resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeCamper, req.Spec.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate diets and allergies
+	dietaryRestrictions, allergies, err := parseDietAndAllergies(req.Spec.DietaryRestrictions, req.Spec.Allergies)
+	if err != nil {
+		return nil, err
+	}
+
 	domainCamper := domain.Camper{
-		TenantID:       tenantId,
-		CampID:         campId,
-		Name:           req.Meta.Name,
-		Description:    utils.PtrToString(req.Meta.Description),
-		Birthday:       req.Spec.Birthday.Time,
-		Gender:         string(req.Spec.Gender),
-		SessionID:      req.Spec.SessionId,
-		HousingGroupID: housingGroupId,
+		TenantID:            tenantId,
+		CampID:              campId,
+		Name:                req.Meta.Name,
+		Description:         utils.PtrToString(req.Meta.Description),
+		Birthday:            req.Spec.Birthday.Time,
+		Gender:              string(req.Spec.Gender),
+		SessionID:           req.Spec.SessionId,
+		HousingGroupID:      housingGroupId,
+		CustomFields:        customFields,
+		DietaryRestrictions: dietaryRestrictions,
+		Allergies:           allergies,
 	}
 	domainCamper.GroupCampers = []domain.GroupCamper{}
 	if req.Spec.GroupIds != nil {
@@ -118,8 +144,7 @@ func (s *campersService) Create(ctx context.Context, tenantId uuid.UUID, campId
 		return nil, pkgerrors.InternalServerError("Failed to create camper", err)
 	}
 
-	apiCamper := domainCamper.ToAPI()
-	return &apiCamper, nil
+	return s.refreshMembership(ctx, tenantId, campId, domainCamper.ID)
 }
 
 // Update updates an existing camper
@@ -145,12 +170,30 @@ func (s *campersService) Update(ctx context.Context, tenantId uuid.UUID, campId
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	// Moving the camper to another session takes a place there unless they are already enrolled in it
+	if req.Spec.SessionId != existingCamper.SessionID {
+		if err := s.checkSessionPlace(ctx, tenantId, campId, id, req.Spec.SessionId); err != nil {
+			return nil, err
+		}
+	}
+
 	existingCamper.Name = req.Meta.Name
 	existingCamper.Description = utils.PtrToString(req.Meta.Description)
 	existingCamper.Birthday = req.Spec.Birthday.Time
 	existingCamper.Gender = string(req.Spec.Gender)
 	existingCamper.SessionID = req.Spec.SessionId
 	existingCamper.HousingGroupID = housingGroupId
+	// Custom field values are only replaced when provided
+	if req.Spec.CustomFields != nil {
+		existingCamper.CustomFields, err = resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeCamper, req.Spec.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Diets and allergies are only replaced when provided
+	if err := applyDietAndAllergies(&existingCamper.DietaryRestrictions, &existingCamper.Allergies, req.Spec.DietaryRestrictions, req.Spec.Allergies); err != nil {
+		return nil, err
+	}
 	existingCamper.GroupCampers = []domain.GroupCamper{}
 	if req.Spec.GroupIds != nil {
 		for _, groupId := range *req.Spec.GroupIds {
@@ -163,8 +206,7 @@ func (s *campersService) Update(ctx context.Context, tenantId uuid.UUID, campId
 		return nil, pkgerrors.InternalServerError("Failed to update camper", err)
 	}
 
-	apiCamper := existingCamper.ToAPI()
-	return &apiCamper, nil
+	return s.refreshMembership(ctx, tenantId, campId, id)
 }
 
 // Delete deletes a camper by ID
@@ -186,6 +228,43 @@ func (s *campersService) Delete(ctx context.Context, tenantId uuid.UUID, campId
 	return nil
 }
 
+// refreshMembership re-evaluates the rule-based group memberships of a camper after it changed and
+// returns the camper with its resulting group memberships
+func (s *campersService) refreshMembership(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) (*api.Camper, error) {
+	if err := s.membership.refreshCamper(ctx, tenantId, campId, id); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	camper, err := s.repo.GetByID(ctx, tenantId, campId, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated camper", err)
+	}
+
+	apiCamper := camper.ToAPI()
+	return &apiCamper, nil
+}
+
+// checkSessionPlace verifies the session has room for the camper, skipping the check when the
+// camper already holds an active enrollment in it
+func (s *campersService) checkSessionPlace(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, camperId uuid.UUID, sessionId uuid.UUID) error {
+	if camperId != uuid.Nil {
+		enrollment, err := s.enrollmentsRepo.GetByCamperAndSession(ctx, tenantId, campId, camperId, sessionId)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.InternalServerError("Failed to check existing enrollments", err)
+		}
+		if enrollment != nil && enrollment.IsActive() {
+			return nil
+		}
+	}
+
+	session, err := s.sessionsRepo.GetByID(ctx, tenantId, campId, sessionId)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	return checkSessionCapacity(ctx, s.enrollmentsRepo, session)
+}
+
 // validateAndExtractHousingGroup validates that at most one housing group exists in groupIds
 // and returns the housing group ID if found
 func (s *campersService) validateAndExtractHousingGroup(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, groupIds *[]uuid.UUID) (*uuid.UUID, error) {
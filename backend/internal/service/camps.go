@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/api"
@@ -29,17 +32,31 @@ type CampsService interface {
 
 	// Delete deletes a camp by ID
 	Delete(ctx context.Context, tenantID, campID uuid.UUID) error
+
+	// Clone queues a job copying the configuration of a camp into a new camp with new dates
+	Clone(ctx context.Context, tenantID, campID uuid.UUID, req *api.CampCloneRequest) (*api.CampCloneJob, error)
+
+	// ListCloneJobs retrieves the clone jobs of a camp, most recent first
+	ListCloneJobs(ctx context.Context, tenantID, campID uuid.UUID) (*api.CampCloneJobsListResponse, error)
+
+	// GetCloneJob retrieves the status of a clone job
+	GetCloneJob(ctx context.Context, tenantID, campID, id uuid.UUID) (*api.CampCloneJob, error)
+
+	// RunCloneJob runs a pending clone job; it is called by the clone worker
+	RunCloneJob(ctx context.Context, job *domain.CampCloneJob) error
 }
 
 // campsService implements CampsService
 type campsService struct {
-	repo CampsRepository
+	repo          CampsRepository
+	cloneJobsRepo CampCloneJobsRepository
 }
 
 // NewCampsService creates a new camps service
-func NewCampsService(repo CampsRepository) CampsService {
+func NewCampsService(repo CampsRepository, cloneJobsRepo CampCloneJobsRepository) CampsService {
 	return &campsService{
-		repo: repo,
+		repo:          repo,
+		cloneJobsRepo: cloneJobsRepo,
 	}
 }
 
@@ -252,6 +269,147 @@ func (s *campsService) Delete(ctx context.Context, tenantID, campID uuid.UUID) e
 	return nil
 }
 
+// Clone queues a job copying the configuration of a camp into a new camp with new dates
+func (s *campsService) Clone(ctx context.Context, tenantID, campID uuid.UUID, req *api.CampCloneRequest) (*api.CampCloneJob, error) {
+	// Extract user's access rules from context to verify access
+	accessRules, err := pkgcontext.ExtractAccessRules(ctx)
+	if err != nil {
+		return nil, pkgerrors.Unauthorized("Authentication required", err)
+	}
+
+	// Check if user has access to the camp being copied
+	if !hasAccessToCamp(accessRules, tenantID, campID) {
+		return nil, pkgerrors.Forbidden("No access to this camp", nil)
+	}
+
+	if _, err := s.repo.GetByID(ctx, tenantID, campID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+
+	job := &domain.CampCloneJob{
+		TenantID:    tenantID,
+		CampID:      campID,
+		Status:      domain.CampCloneJobStatusPending,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		StartDate:   req.StartDate.Time,
+		EndDate:     req.EndDate.Time,
+		CopyEvents:  req.CopyEvents != nil && *req.CopyEvents,
+	}
+	job.RequestedBy, job.RequestedByEmail = currentUser(ctx)
+	if err := job.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Persist job; the clone worker picks it up
+	if err := s.cloneJobsRepo.Create(ctx, job); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create camp clone job", err)
+	}
+
+	apiJob := job.ToAPI()
+	return &apiJob, nil
+}
+
+// ListCloneJobs retrieves the clone jobs of a camp, most recent first
+func (s *campsService) ListCloneJobs(ctx context.Context, tenantID, campID uuid.UUID) (*api.CampCloneJobsListResponse, error) {
+	jobs, err := s.cloneJobsRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list camp clone jobs", err)
+	}
+
+	items := make([]api.CampCloneJob, len(jobs))
+	for i := range jobs {
+		items[i] = jobs[i].ToAPI()
+	}
+
+	return &api.CampCloneJobsListResponse{Items: items}, nil
+}
+
+// GetCloneJob retrieves the status of a clone job
+func (s *campsService) GetCloneJob(ctx context.Context, tenantID, campID, id uuid.UUID) (*api.CampCloneJob, error) {
+	job, err := s.cloneJobsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp clone job not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp clone job", err)
+	}
+
+	apiJob := job.ToAPI()
+	return &apiJob, nil
+}
+
+// RunCloneJob creates the new camp of a pending clone job with the settings of the copied camp and copies
+// its configuration, shifting events by the days between the start dates of the two camps. The outcome is
+// recorded on the job; jobs already picked up by another worker are skipped.
+func (s *campsService) RunCloneJob(ctx context.Context, job *domain.CampCloneJob) error {
+	started, err := s.cloneJobsRepo.Start(ctx, job.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !started {
+		return nil
+	}
+
+	copied, newCampID, cloneErr := s.cloneCamp(ctx, job)
+
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	if cloneErr != nil {
+		job.Status = domain.CampCloneJobStatusFailed
+		job.Error = cloneErr.Error()
+	} else {
+		job.Status = domain.CampCloneJobStatusCompleted
+		job.NewCampID = newCampID
+		job.Copied = copied
+	}
+	if err := s.cloneJobsRepo.Finish(ctx, job); err != nil {
+		return err
+	}
+
+	return cloneErr
+}
+
+// cloneCamp copies the camp of a clone job into a new camp, returning the copied counts and the new camp
+func (s *campsService) cloneCamp(ctx context.Context, job *domain.CampCloneJob) (*domain.CampCloneCounts, *uuid.UUID, error) {
+	source, err := s.repo.GetByID(ctx, job.TenantID, job.CampID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, fmt.Errorf("camp to copy no longer exists")
+		}
+		return nil, nil, fmt.Errorf("failed to get camp: %w", err)
+	}
+
+	target := &domain.Camp{
+		ID:             uuid.New(),
+		TenantID:       source.TenantID,
+		Name:           job.Name,
+		Description:    source.Description,
+		StartDate:      job.StartDate,
+		EndDate:        job.EndDate,
+		DailyStartTime: source.DailyStartTime,
+		DailyEndTime:   source.DailyEndTime,
+		Address:        source.Address,
+		ContactInfo:    source.ContactInfo,
+		LogoURL:        source.LogoURL,
+		Timezone:       source.Timezone,
+		Settings:       source.Settings,
+	}
+	if job.Description != nil {
+		target.Description = *job.Description
+	}
+
+	copied, err := s.repo.Clone(ctx, source, target, job.CopyEvents, job.StartDate.Sub(source.StartDate))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &copied, &target.ID, nil
+}
+
 // extractAccessibleCampIDs extracts the camp IDs that a user has access to based on their access rules
 // Returns nil for system and tenant-scope users (meaning all camps in the tenant)
 // Returns specific camp IDs for camp-scope users
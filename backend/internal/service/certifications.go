@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/tbechar/camp-manager-backend/internal/api"
 	"github.com/tbechar/camp-manager-backend/internal/domain"
 	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
 	"gorm.io/gorm"
 )
 
@@ -27,17 +32,29 @@ type CertificationsService interface {
 
 	// Delete deletes a certification by ID
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+
+	// GetComplianceReport lists expired and expiring staff certificates and certificates missing for upcoming events
+	GetComplianceReport(ctx context.Context, tenantID, campID uuid.UUID, before *time.Time) (*api.CertificationComplianceReport, error)
 }
 
+// complianceWindow is how far ahead certificates are reported as expiring when no cutoff day is given
+const complianceWindow = 30 * 24 * time.Hour
+
 // certificationsService implements CertificationsService
 type certificationsService struct {
-	repo CertificationsRepository
+	repo             CertificationsRepository
+	staffMembersRepo StaffMembersRepository
+	eventsRepo       EventsRepository
+	campsRepo        CampsRepository
 }
 
 // NewCertificationsService creates a new certifications service
-func NewCertificationsService(repo CertificationsRepository) CertificationsService {
+func NewCertificationsService(repo CertificationsRepository, staffMembersRepo StaffMembersRepository, eventsRepo EventsRepository, campsRepo CampsRepository) CertificationsService {
 	return &certificationsService{
-		repo: repo,
+		repo:             repo,
+		staffMembersRepo: staffMembersRepo,
+		eventsRepo:       eventsRepo,
+		campsRepo:        campsRepo,
 	}
 }
 
@@ -152,3 +169,130 @@ func (s *certificationsService) Delete(ctx context.Context, tenantID, campID, id
 
 	return nil
 }
+
+// GetComplianceReport lists the staff certificates that expired or expire before the cutoff day, followed by
+// the staff members assigned to upcoming event positions whose required certificate they do not hold or that
+// will have expired by the day of the event
+func (s *certificationsService) GetComplianceReport(ctx context.Context, tenantID, campID uuid.UUID, before *time.Time) (*api.CertificationComplianceReport, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	now := time.Now()
+	today := localDate(now, loc)
+	cutoff := localDate(now.Add(complianceWindow), loc)
+	if before != nil {
+		cutoff = *before
+	}
+
+	certifications, err := s.repo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list certifications", err)
+	}
+	certificationNames := make(map[uuid.UUID]string, len(certifications))
+	for _, certification := range certifications {
+		certificationNames[certification.ID] = certification.Name
+	}
+
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+
+	report := &api.CertificationComplianceReport{
+		Before: openapi_types.Date{Time: cutoff},
+		Items:  []api.CertificationComplianceIssue{},
+	}
+
+	// Certificates of deleted certifications are not reported
+	staffByID := make(map[uuid.UUID]*domain.StaffMember, len(staffMembers))
+	for i := range staffMembers {
+		staffMember := &staffMembers[i]
+		staffByID[staffMember.ID] = staffMember
+
+		var issues []api.CertificationComplianceIssue
+		for j := range staffMember.StaffCertifications {
+			cert := &staffMember.StaffCertifications[j]
+			name, ok := certificationNames[cert.CertificationID]
+			if !ok {
+				continue
+			}
+
+			issueType := api.CertificationComplianceIssueTypeExpiring
+			if cert.ExpiredOn(today) {
+				issueType = api.CertificationComplianceIssueTypeExpired
+			} else if !cert.ExpiredOn(cutoff) {
+				continue
+			}
+			issues = append(issues, complianceIssue(issueType, staffMember, cert.CertificationID, name, cert))
+		}
+
+		sort.SliceStable(issues, func(a, b int) bool {
+			return issues[a].CertificationName < issues[b].CertificationName
+		})
+		report.Items = append(report.Items, issues...)
+	}
+
+	events, err := s.eventsRepo.ListStaffedFrom(ctx, tenantID, campID, now.UTC())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+
+	// An expired certificate counts as missing for the events it is needed for
+	for _, event := range events {
+		var positions []api.EventRequiredStaffPosition
+		if err := json.Unmarshal(event.RequiredStaff, &positions); err != nil {
+			continue
+		}
+
+		eventDay := localDate(event.StartDate, loc)
+		for _, position := range positions {
+			if position.AssignedStaffId == nil || position.RequiredCertificationId == nil {
+				continue
+			}
+			staffMember, ok := staffByID[*position.AssignedStaffId]
+			if !ok {
+				continue
+			}
+			name, ok := certificationNames[*position.RequiredCertificationId]
+			if !ok {
+				continue
+			}
+
+			cert := staffMember.Certification(*position.RequiredCertificationId)
+			if cert != nil && !cert.ExpiredOn(eventDay) {
+				continue
+			}
+
+			issue := complianceIssue(api.CertificationComplianceIssueTypeMissingCertification, staffMember, *position.RequiredCertificationId, name, cert)
+			issue.EventId = &event.ID
+			issue.EventName = utils.StringToPtr(event.Name)
+			issue.EventStartDate = &event.StartDate
+			issue.PositionName = utils.StringToPtr(position.PositionName)
+			report.Items = append(report.Items, issue)
+		}
+	}
+
+	return report, nil
+}
+
+// complianceIssue describes a compliance issue of a staff member's certification; cert is nil when
+// the staff member does not hold the certification
+func complianceIssue(issueType api.CertificationComplianceIssueType, staffMember *domain.StaffMember, certificationID uuid.UUID, certificationName string, cert *domain.StaffCertification) api.CertificationComplianceIssue {
+	issue := api.CertificationComplianceIssue{
+		Type:              issueType,
+		StaffMemberId:     staffMember.ID,
+		StaffMemberName:   staffMember.Name,
+		CertificationId:   certificationID,
+		CertificationName: certificationName,
+	}
+	if cert != nil && cert.ExpiresOn != nil {
+		issue.ExpiresOn = &openapi_types.Date{Time: *cert.ExpiresOn}
+	}
+	return issue
+}
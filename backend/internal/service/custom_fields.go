@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CustomFieldsService defines the interface for custom field definition business logic
+type CustomFieldsService interface {
+	// List retrieves the custom field definitions of a camp, optionally limited to one entity type
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, entityType *api.CustomFieldEntityType) (*api.CustomFieldDefinitionsListResponse, error)
+
+	// GetByID retrieves a single custom field definition
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.CustomFieldDefinition, error)
+
+	// Create defines a new custom field for campers, staff members or groups
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.CustomFieldDefinitionCreationRequest) (*api.CustomFieldDefinition, error)
+
+	// Update changes the label, options, required flag, position and description of a custom field
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.CustomFieldDefinitionUpdateRequest) (*api.CustomFieldDefinition, error)
+
+	// Delete removes a custom field and its values from all records
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+}
+
+// customFieldsService implements CustomFieldsService
+type customFieldsService struct {
+	repo CustomFieldsRepository
+}
+
+// NewCustomFieldsService creates a new custom fields service
+func NewCustomFieldsService(repo CustomFieldsRepository) CustomFieldsService {
+	return &customFieldsService{
+		repo: repo,
+	}
+}
+
+// List retrieves the custom field definitions of a camp
+func (s *customFieldsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, entityType *api.CustomFieldEntityType) (*api.CustomFieldDefinitionsListResponse, error) {
+	var domainEntityType *domain.CustomFieldEntityType
+	if entityType != nil {
+		value := domain.CustomFieldEntityType(*entityType)
+		domainEntityType = &value
+	}
+
+	definitions, err := s.repo.List(ctx, tenantID, campID, domainEntityType)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list custom fields", err)
+	}
+
+	items := make([]api.CustomFieldDefinition, len(definitions))
+	for i := range definitions {
+		items[i] = definitions[i].ToAPI()
+	}
+
+	return &api.CustomFieldDefinitionsListResponse{Items: items}, nil
+}
+
+// GetByID retrieves a single custom field definition
+func (s *customFieldsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.CustomFieldDefinition, error) {
+	definition, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Custom field not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get custom field", err)
+	}
+
+	apiDefinition := definition.ToAPI()
+	return &apiDefinition, nil
+}
+
+// Create defines a new custom field, rejecting keys already used for the entity type
+func (s *customFieldsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.CustomFieldDefinitionCreationRequest) (*api.CustomFieldDefinition, error) {
+	definition := &domain.CustomFieldDefinition{
+		TenantID:    tenantID,
+		CampID:      campID,
+		EntityType:  domain.CustomFieldEntityType(req.EntityType),
+		Key:         req.Key,
+		Label:       req.Label,
+		Type:        domain.CustomFieldType(req.Type),
+		Required:    req.Required != nil && *req.Required,
+		Description: utils.PtrToString(req.Description),
+	}
+	if req.Options != nil {
+		definition.Options = *req.Options
+	}
+	if req.Position != nil {
+		definition.Position = *req.Position
+	}
+
+	if err := definition.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	existing, err := s.repo.GetByKey(ctx, tenantID, campID, definition.EntityType, definition.Key)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.InternalServerError("Failed to check custom fields", err)
+	}
+	if existing != nil {
+		return nil, pkgerrors.Conflict("A custom field with this key already exists", nil)
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, definition); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create custom field", err)
+	}
+
+	apiDefinition := definition.ToAPI()
+	return &apiDefinition, nil
+}
+
+// Update changes the mutable attributes of a custom field.
+// Making a field required or narrowing enum options only applies to values saved from now on.
+func (s *customFieldsService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.CustomFieldDefinitionUpdateRequest) (*api.CustomFieldDefinition, error) {
+	definition, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Custom field not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get custom field", err)
+	}
+
+	definition.Label = req.Label
+	definition.Description = utils.PtrToString(req.Description)
+	definition.Options = nil
+	if req.Options != nil {
+		definition.Options = *req.Options
+	}
+	if req.Required != nil {
+		definition.Required = *req.Required
+	}
+	if req.Position != nil {
+		definition.Position = *req.Position
+	}
+
+	if err := definition.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, definition); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update custom field", err)
+	}
+
+	return s.GetByID(ctx, tenantID, campID, id)
+}
+
+// Delete removes a custom field and its values from all records
+func (s *customFieldsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.repo.GetByID(ctx, tenantID, campID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Custom field not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get custom field", err)
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete custom field", err)
+	}
+
+	return nil
+}
+
+// resolveCustomFields validates the custom field values of a camper, staff member or group against
+// the camp's definitions for the entity type and returns them in their stored form
+func resolveCustomFields(ctx context.Context, repo CustomFieldsRepository, tenantID, campID uuid.UUID, entityType domain.CustomFieldEntityType, values *api.CustomFieldValues) (domain.CustomFieldValues, error) {
+	definitions, err := repo.List(ctx, tenantID, campID, &entityType)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to load custom fields", err)
+	}
+
+	var raw map[string]interface{}
+	if values != nil {
+		raw = *values
+	}
+
+	normalized, err := domain.NormalizeCustomFieldValues(definitions, raw)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,1080 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// maxRosterDays bounds the number of days shifts are generated or filled for at once
+const maxRosterDays = 92
+
+// defaultConflictDays is how many days after the first day conflicts are checked when no last day is given
+const defaultConflictDays = 7
+
+// DutyRosterService defines the interface for duty types, rotations and shifts business logic
+type DutyRosterService interface {
+	// ListDutyTypes retrieves the duty types of a camp by name
+	ListDutyTypes(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.DutyTypesListResponse, error)
+
+	// GetDutyType retrieves a single duty type
+	GetDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyType, error)
+
+	// CreateDutyType adds a kind of duty staff members can be rostered on
+	CreateDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyTypeCreationRequest) (*api.DutyType, error)
+
+	// UpdateDutyType changes the details and rules of a duty type
+	UpdateDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyTypeUpdateRequest) (*api.DutyType, error)
+
+	// DeleteDutyType removes a duty type together with its rotations and shifts
+	DeleteDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListRotations retrieves the duty rotation templates of a camp by name
+	ListRotations(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.DutyRotationsListResponse, error)
+
+	// GetRotation retrieves a single duty rotation
+	GetRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyRotation, error)
+
+	// CreateRotation adds a template generating shifts of a duty type
+	CreateRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyRotationCreationRequest) (*api.DutyRotation, error)
+
+	// UpdateRotation changes a rotation template; shifts already generated are kept as they are
+	UpdateRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyRotationUpdateRequest) (*api.DutyRotation, error)
+
+	// DeleteRotation removes a rotation template, keeping the shifts generated from it
+	DeleteRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GenerateRotationShifts creates the shifts of a rotation for a range of days, optionally assigning staff
+	GenerateRotationShifts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyRotationGenerationRequest) (*api.DutyShiftAssignmentResult, error)
+
+	// ListShifts retrieves duty shifts by start, optionally limited to a range of days, a staff member and a duty type
+	ListShifts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time, staffMemberID, dutyTypeID *uuid.UUID) (*api.DutyShiftsListResponse, error)
+
+	// GetShift retrieves a single duty shift
+	GetShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyShift, error)
+
+	// CreateShift adds a duty shift
+	CreateShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyShiftCreationRequest) (*api.DutyShift, error)
+
+	// UpdateShift changes the duty type, times, staffing and notes of a duty shift
+	UpdateShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyShiftUpdateRequest) (*api.DutyShift, error)
+
+	// DeleteShift removes a duty shift
+	DeleteShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// AutoAssign fills the open positions of the shifts in a range of days following the assignment rules
+	AutoAssign(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyShiftAssignmentRequest) (*api.DutyShiftAssignmentResult, error)
+
+	// GetConflicts lists the roster conflicts of the shifts in a range of days (a week from today by default)
+	GetConflicts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.DutyConflictReport, error)
+}
+
+// dutyRosterService implements DutyRosterService
+type dutyRosterService struct {
+	typesRepo          DutyTypesRepository
+	rotationsRepo      DutyRotationsRepository
+	shiftsRepo         DutyShiftsRepository
+	staffMembersRepo   StaffMembersRepository
+	certificationsRepo CertificationsRepository
+	eventsRepo         EventsRepository
+	timeOffRepo        TimeOffRequestsRepository
+	campsRepo          CampsRepository
+}
+
+// NewDutyRosterService creates a new duty roster service
+func NewDutyRosterService(typesRepo DutyTypesRepository, rotationsRepo DutyRotationsRepository, shiftsRepo DutyShiftsRepository, staffMembersRepo StaffMembersRepository, certificationsRepo CertificationsRepository, eventsRepo EventsRepository, timeOffRepo TimeOffRequestsRepository, campsRepo CampsRepository) DutyRosterService {
+	return &dutyRosterService{
+		typesRepo:          typesRepo,
+		rotationsRepo:      rotationsRepo,
+		shiftsRepo:         shiftsRepo,
+		staffMembersRepo:   staffMembersRepo,
+		certificationsRepo: certificationsRepo,
+		eventsRepo:         eventsRepo,
+		timeOffRepo:        timeOffRepo,
+		campsRepo:          campsRepo,
+	}
+}
+
+// ListDutyTypes retrieves the duty types of a camp
+func (s *dutyRosterService) ListDutyTypes(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.DutyTypesListResponse, error) {
+	dutyTypes, err := s.typesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty types", err)
+	}
+
+	items := make([]api.DutyType, len(dutyTypes))
+	for i := range dutyTypes {
+		items[i] = dutyTypes[i].ToAPI()
+	}
+
+	return &api.DutyTypesListResponse{Items: items}, nil
+}
+
+// GetDutyType retrieves a single duty type
+func (s *dutyRosterService) GetDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyType, error) {
+	dutyType, err := s.getDutyType(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDutyType := dutyType.ToAPI()
+	return &apiDutyType, nil
+}
+
+// CreateDutyType adds a kind of duty staff members can be rostered on
+func (s *dutyRosterService) CreateDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyTypeCreationRequest) (*api.DutyType, error) {
+	dutyType := &domain.DutyType{
+		TenantID:    tenantID,
+		CampID:      campID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: utils.PtrToString(req.Description),
+		IsTimeOff:   req.IsTimeOff != nil && *req.IsTimeOff,
+	}
+	if req.MinRestHours != nil {
+		dutyType.MinRestHours = *req.MinRestHours
+	}
+
+	certificationIDs, err := s.checkCertifications(ctx, tenantID, campID, req.RequiredCertificationIds)
+	if err != nil {
+		return nil, err
+	}
+	dutyType.RequiredCertificationIDs = certificationIDs
+
+	if err := dutyType.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save to database
+	if err := s.typesRepo.Create(ctx, dutyType); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create duty type", err)
+	}
+
+	apiDutyType := dutyType.ToAPI()
+	return &apiDutyType, nil
+}
+
+// UpdateDutyType changes the details and rules of a duty type
+func (s *dutyRosterService) UpdateDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyTypeUpdateRequest) (*api.DutyType, error) {
+	dutyType, err := s.getDutyType(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dutyType.Name = strings.TrimSpace(req.Name)
+	dutyType.Description = utils.PtrToString(req.Description)
+	dutyType.IsTimeOff = req.IsTimeOff != nil && *req.IsTimeOff
+	dutyType.MinRestHours = 0
+	if req.MinRestHours != nil {
+		dutyType.MinRestHours = *req.MinRestHours
+	}
+
+	certificationIDs, err := s.checkCertifications(ctx, tenantID, campID, req.RequiredCertificationIds)
+	if err != nil {
+		return nil, err
+	}
+	dutyType.RequiredCertificationIDs = certificationIDs
+
+	if err := dutyType.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save updates
+	if err := s.typesRepo.Update(ctx, tenantID, campID, dutyType); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update duty type", err)
+	}
+
+	return s.GetDutyType(ctx, tenantID, campID, id)
+}
+
+// DeleteDutyType removes a duty type together with its rotations and shifts
+func (s *dutyRosterService) DeleteDutyType(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getDutyType(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.typesRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete duty type", err)
+	}
+
+	return nil
+}
+
+// ListRotations retrieves the duty rotation templates of a camp
+func (s *dutyRosterService) ListRotations(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.DutyRotationsListResponse, error) {
+	rotations, err := s.rotationsRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty rotations", err)
+	}
+
+	items := make([]api.DutyRotation, len(rotations))
+	for i := range rotations {
+		items[i] = rotations[i].ToAPI()
+	}
+
+	return &api.DutyRotationsListResponse{Items: items}, nil
+}
+
+// GetRotation retrieves a single duty rotation
+func (s *dutyRosterService) GetRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyRotation, error) {
+	rotation, err := s.getRotation(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRotation := rotation.ToAPI()
+	return &apiRotation, nil
+}
+
+// CreateRotation adds a template generating shifts of a duty type
+func (s *dutyRosterService) CreateRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyRotationCreationRequest) (*api.DutyRotation, error) {
+	rotation := &domain.DutyRotation{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applyRotation(ctx, tenantID, campID, rotation, api.DutyRotationUpdateRequest(*req)); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.rotationsRepo.Create(ctx, rotation); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create duty rotation", err)
+	}
+
+	apiRotation := rotation.ToAPI()
+	return &apiRotation, nil
+}
+
+// UpdateRotation changes a rotation template
+func (s *dutyRosterService) UpdateRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyRotationUpdateRequest) (*api.DutyRotation, error) {
+	rotation, err := s.getRotation(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyRotation(ctx, tenantID, campID, rotation, *req); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.rotationsRepo.Update(ctx, tenantID, campID, rotation); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update duty rotation", err)
+	}
+
+	return s.GetRotation(ctx, tenantID, campID, id)
+}
+
+// DeleteRotation removes a rotation template
+func (s *dutyRosterService) DeleteRotation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getRotation(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.rotationsRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete duty rotation", err)
+	}
+
+	return nil
+}
+
+// GenerateRotationShifts creates a shift on every day of the range the rotation runs on, skipping days
+// that already have a shift of the rotation starting at the same time
+func (s *dutyRosterService) GenerateRotationShifts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyRotationGenerationRequest) (*api.DutyShiftAssignmentResult, error) {
+	rotation, err := s.getRotation(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkRosterRange(req.From.Time, req.To.Time); err != nil {
+		return nil, err
+	}
+
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shifts starting on the last day may end the next day
+	start, end := dayRange(req.From.Time, req.To.Time, loc)
+	r, err := s.loadRoster(ctx, tenantID, campID, start, end.AddDate(0, 0, 1), loc)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[int64]bool)
+	for _, shift := range r.shifts {
+		if shift.RotationID != nil && *shift.RotationID == rotation.ID {
+			existing[shift.StartDate.Unix()] = true
+		}
+	}
+
+	var shifts []*domain.DutyShift
+	for day := req.From.Time; !day.After(req.To.Time); day = day.AddDate(0, 0, 1) {
+		if !rotation.RunsOn(day.Weekday()) {
+			continue
+		}
+
+		shiftStart, shiftEnd := rotation.ShiftWindow(day, loc)
+		if existing[shiftStart.Unix()] {
+			continue
+		}
+
+		shifts = append(shifts, &domain.DutyShift{
+			TenantID:       tenantID,
+			CampID:         campID,
+			DutyTypeID:     rotation.DutyTypeID,
+			RotationID:     &rotation.ID,
+			StartDate:      shiftStart.UTC(),
+			EndDate:        shiftEnd.UTC(),
+			StaffCount:     rotation.StaffCount,
+			StaffMemberIDs: []uuid.UUID{},
+		})
+	}
+
+	result := &api.DutyShiftAssignmentResult{Shifts: []api.DutyShift{}}
+	if req.AssignStaff != nil && *req.AssignStaff {
+		r.add(shifts)
+		result.Assigned, result.OpenSlots = r.fill(shifts, start, end)
+	} else {
+		for _, shift := range shifts {
+			result.OpenSlots += shift.OpenSlots()
+		}
+	}
+
+	// Save to database
+	if err := s.shiftsRepo.CreateBatch(ctx, shifts); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create duty shifts", err)
+	}
+
+	for _, shift := range shifts {
+		result.Shifts = append(result.Shifts, shift.ToAPI())
+	}
+
+	return result, nil
+}
+
+// ListShifts retrieves duty shifts by start
+func (s *dutyRosterService) ListShifts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time, staffMemberID, dutyTypeID *uuid.UUID) (*api.DutyShiftsListResponse, error) {
+	// Days are converted to instants in the camp's time zone
+	var start, end *time.Time
+	if from != nil || to != nil {
+		loc, err := s.campLocation(ctx, tenantID, campID)
+		if err != nil {
+			return nil, err
+		}
+		if from != nil {
+			t := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc).UTC()
+			start = &t
+		}
+		if to != nil {
+			t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).UTC()
+			end = &t
+		}
+	}
+
+	shifts, err := s.shiftsRepo.List(ctx, tenantID, campID, start, end, staffMemberID, dutyTypeID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty shifts", err)
+	}
+
+	items := make([]api.DutyShift, len(shifts))
+	for i := range shifts {
+		items[i] = shifts[i].ToAPI()
+	}
+
+	return &api.DutyShiftsListResponse{Items: items}, nil
+}
+
+// GetShift retrieves a single duty shift
+func (s *dutyRosterService) GetShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.DutyShift, error) {
+	shift, err := s.getShift(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiShift := shift.ToAPI()
+	return &apiShift, nil
+}
+
+// CreateShift adds a duty shift. Conflicts are not rejected; they are reported by GetConflicts.
+func (s *dutyRosterService) CreateShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyShiftCreationRequest) (*api.DutyShift, error) {
+	shift := &domain.DutyShift{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applyShift(ctx, tenantID, campID, shift, api.DutyShiftUpdateRequest(*req)); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.shiftsRepo.Create(ctx, shift); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create duty shift", err)
+	}
+
+	apiShift := shift.ToAPI()
+	return &apiShift, nil
+}
+
+// UpdateShift changes the duty type, times, staffing and notes of a duty shift
+func (s *dutyRosterService) UpdateShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.DutyShiftUpdateRequest) (*api.DutyShift, error) {
+	shift, err := s.getShift(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyShift(ctx, tenantID, campID, shift, *req); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.shiftsRepo.Update(ctx, tenantID, campID, shift); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update duty shift", err)
+	}
+
+	return s.GetShift(ctx, tenantID, campID, id)
+}
+
+// DeleteShift removes a duty shift
+func (s *dutyRosterService) DeleteShift(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getShift(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.shiftsRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete duty shift", err)
+	}
+
+	return nil
+}
+
+// AutoAssign fills the open positions of the shifts starting within a range of days
+func (s *dutyRosterService) AutoAssign(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.DutyShiftAssignmentRequest) (*api.DutyShiftAssignmentResult, error) {
+	if err := checkRosterRange(req.From.Time, req.To.Time); err != nil {
+		return nil, err
+	}
+
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := dayRange(req.From.Time, req.To.Time, loc)
+	r, err := s.loadRoster(ctx, tenantID, campID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var open []*domain.DutyShift
+	for _, shift := range r.shifts {
+		if shift.StartDate.Before(start) || !shift.StartDate.Before(end) || shift.OpenSlots() == 0 {
+			continue
+		}
+		if req.DutyTypeId != nil && shift.DutyTypeID != *req.DutyTypeId {
+			continue
+		}
+		open = append(open, shift)
+	}
+
+	before := make(map[uuid.UUID]int, len(open))
+	for _, shift := range open {
+		before[shift.ID] = len(shift.StaffMemberIDs)
+	}
+
+	result := &api.DutyShiftAssignmentResult{Shifts: []api.DutyShift{}}
+	result.Assigned, result.OpenSlots = r.fill(open, start, end)
+
+	// Save the shifts that got staff members
+	for _, shift := range open {
+		if len(shift.StaffMemberIDs) == before[shift.ID] {
+			continue
+		}
+		if err := s.shiftsRepo.UpdateStaff(ctx, tenantID, campID, shift); err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to assign duty shift", err)
+		}
+		result.Shifts = append(result.Shifts, shift.ToAPI())
+	}
+
+	return result, nil
+}
+
+// GetConflicts lists the roster conflicts of the shifts overlapping a range of days
+func (s *dutyRosterService) GetConflicts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.DutyConflictReport, error) {
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	first := localDate(time.Now(), loc)
+	if from != nil {
+		first = *from
+	}
+	last := first.AddDate(0, 0, defaultConflictDays)
+	if to != nil {
+		last = *to
+	}
+	if last.Before(first) {
+		return nil, pkgerrors.BadRequest("The last day must not be before the first day", nil)
+	}
+
+	start, end := dayRange(first, last, loc)
+	r, err := s.loadRoster(ctx, tenantID, campID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DutyConflictReport{
+		From:  openapi_types.Date{Time: first},
+		To:    openapi_types.Date{Time: last},
+		Items: r.conflicts(start, end),
+	}, nil
+}
+
+// applyRotation validates a rotation request and copies it onto the rotation
+func (s *dutyRosterService) applyRotation(ctx context.Context, tenantID, campID uuid.UUID, rotation *domain.DutyRotation, req api.DutyRotationUpdateRequest) error {
+	if _, err := s.typesRepo.GetByID(ctx, tenantID, campID, req.DutyTypeId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Duty type not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get duty type", err)
+	}
+
+	staffMemberIDs, err := s.checkStaffMembers(ctx, tenantID, campID, req.StaffMemberIds)
+	if err != nil {
+		return err
+	}
+
+	rotation.DutyTypeID = req.DutyTypeId
+	rotation.Name = strings.TrimSpace(req.Name)
+	rotation.StartTime = req.StartTime
+	rotation.EndTime = req.EndTime
+	rotation.DaysOfWeek = []int{}
+	if req.DaysOfWeek != nil {
+		rotation.DaysOfWeek = *req.DaysOfWeek
+	}
+	rotation.StaffCount = 1
+	if req.StaffCount != nil {
+		rotation.StaffCount = *req.StaffCount
+	}
+	rotation.StaffMemberIDs = staffMemberIDs
+
+	if err := rotation.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	return nil
+}
+
+// applyShift validates a shift request and copies it onto the shift
+func (s *dutyRosterService) applyShift(ctx context.Context, tenantID, campID uuid.UUID, shift *domain.DutyShift, req api.DutyShiftUpdateRequest) error {
+	if _, err := s.typesRepo.GetByID(ctx, tenantID, campID, req.DutyTypeId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Duty type not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get duty type", err)
+	}
+
+	staffMemberIDs, err := s.checkStaffMembers(ctx, tenantID, campID, req.StaffMemberIds)
+	if err != nil {
+		return err
+	}
+
+	shift.DutyTypeID = req.DutyTypeId
+	shift.StartDate = req.StartDate.UTC()
+	shift.EndDate = req.EndDate.UTC()
+	shift.StaffMemberIDs = staffMemberIDs
+	shift.StaffCount = len(staffMemberIDs)
+	if req.StaffCount != nil {
+		shift.StaffCount = *req.StaffCount
+	} else if shift.StaffCount == 0 {
+		shift.StaffCount = 1
+	}
+	shift.Notes = strings.TrimSpace(utils.PtrToString(req.Notes))
+
+	if err := shift.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	return nil
+}
+
+// checkCertifications verifies the certifications exist in the camp and drops duplicates
+func (s *dutyRosterService) checkCertifications(ctx context.Context, tenantID, campID uuid.UUID, ids *[]uuid.UUID) ([]uuid.UUID, error) {
+	certificationIDs := uniqueIDs(ids)
+	if len(certificationIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	certifications, err := s.certificationsRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list certifications", err)
+	}
+
+	known := make(map[uuid.UUID]bool, len(certifications))
+	for _, certification := range certifications {
+		known[certification.ID] = true
+	}
+	for _, id := range certificationIDs {
+		if !known[id] {
+			return nil, pkgerrors.BadRequest("Certification not found: "+id.String(), nil)
+		}
+	}
+
+	return certificationIDs, nil
+}
+
+// checkStaffMembers verifies the staff members exist in the camp and drops duplicates
+func (s *dutyRosterService) checkStaffMembers(ctx context.Context, tenantID, campID uuid.UUID, ids *[]uuid.UUID) ([]uuid.UUID, error) {
+	staffMemberIDs := uniqueIDs(ids)
+	if len(staffMemberIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+
+	known := make(map[uuid.UUID]bool, len(staffMembers))
+	for _, staffMember := range staffMembers {
+		known[staffMember.ID] = true
+	}
+	for _, id := range staffMemberIDs {
+		if !known[id] {
+			return nil, pkgerrors.BadRequest("Staff member not found: "+id.String(), nil)
+		}
+	}
+
+	return staffMemberIDs, nil
+}
+
+// getDutyType loads a duty type, reporting it as not found when it does not exist in the camp
+func (s *dutyRosterService) getDutyType(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyType, error) {
+	dutyType, err := s.typesRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Duty type not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get duty type", err)
+	}
+	return dutyType, nil
+}
+
+// getRotation loads a duty rotation, reporting it as not found when it does not exist in the camp
+func (s *dutyRosterService) getRotation(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyRotation, error) {
+	rotation, err := s.rotationsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Duty rotation not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get duty rotation", err)
+	}
+	return rotation, nil
+}
+
+// getShift loads a duty shift, reporting it as not found when it does not exist in the camp
+func (s *dutyRosterService) getShift(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyShift, error) {
+	shift, err := s.shiftsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Duty shift not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get duty shift", err)
+	}
+	return shift, nil
+}
+
+// campLocation loads the time zone of a camp
+func (s *dutyRosterService) campLocation(ctx context.Context, tenantID, campID uuid.UUID) (*time.Location, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	return camp.TimeLocation(), nil
+}
+
+// loadRoster loads everything the assignment rules and conflict checks need for the period [start, end).
+// Shifts are loaded with a margin so rest times across the edges of the period are taken into account.
+func (s *dutyRosterService) loadRoster(ctx context.Context, tenantID, campID uuid.UUID, start, end time.Time, loc *time.Location) (*roster, error) {
+	margin := domain.MaxDutyRestHours * time.Hour
+	from, to := start.Add(-margin), end.Add(margin)
+
+	dutyTypes, err := s.typesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty types", err)
+	}
+	rotations, err := s.rotationsRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty rotations", err)
+	}
+	shifts, err := s.shiftsRepo.List(ctx, tenantID, campID, &from, &to, nil, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty shifts", err)
+	}
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+	certifications, err := s.certificationsRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list certifications", err)
+	}
+	events, err := s.eventsRepo.ListStaffedBetween(ctx, tenantID, campID, from, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+	timeOff, err := s.timeOffRepo.ListApprovedBetween(ctx, tenantID, campID, nil, from, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list approved time off", err)
+	}
+
+	r := &roster{
+		loc:                loc,
+		types:              make(map[uuid.UUID]*domain.DutyType, len(dutyTypes)),
+		rotations:          make(map[uuid.UUID]*domain.DutyRotation, len(rotations)),
+		staffMembers:       staffMembers,
+		staffByID:          make(map[uuid.UUID]*domain.StaffMember, len(staffMembers)),
+		certificationNames: make(map[uuid.UUID]string, len(certifications)),
+		positions:          make(map[uuid.UUID][]eventPosition),
+		timeOff:            make(map[uuid.UUID][]domain.TimeOffRequest),
+	}
+	for i := range dutyTypes {
+		r.types[dutyTypes[i].ID] = &dutyTypes[i]
+	}
+	for i := range rotations {
+		r.rotations[rotations[i].ID] = &rotations[i]
+	}
+	for i := range staffMembers {
+		r.staffByID[staffMembers[i].ID] = &staffMembers[i]
+	}
+	for _, certification := range certifications {
+		r.certificationNames[certification.ID] = certification.Name
+	}
+	for i := range shifts {
+		r.shifts = append(r.shifts, &shifts[i])
+	}
+	for _, request := range timeOff {
+		r.timeOff[request.StaffMemberID] = append(r.timeOff[request.StaffMemberID], request)
+	}
+	for i := range events {
+		var positions []api.EventRequiredStaffPosition
+		if err := json.Unmarshal(events[i].RequiredStaff, &positions); err != nil {
+			continue
+		}
+		for _, position := range positions {
+			if position.AssignedStaffId != nil {
+				r.positions[*position.AssignedStaffId] = append(r.positions[*position.AssignedStaffId], eventPosition{
+					event: &events[i],
+					name:  position.PositionName,
+				})
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// eventPosition is a required staff position of an event a staff member is assigned to
+type eventPosition struct {
+	event *domain.Event
+	name  string
+}
+
+// roster holds the shifts, event positions and certificates of a period; the assignment rules and
+// conflict checks work on it
+type roster struct {
+	loc                *time.Location
+	types              map[uuid.UUID]*domain.DutyType
+	rotations          map[uuid.UUID]*domain.DutyRotation
+	staffMembers       []domain.StaffMember
+	staffByID          map[uuid.UUID]*domain.StaffMember
+	certificationNames map[uuid.UUID]string
+	shifts             []*domain.DutyShift
+	positions          map[uuid.UUID][]eventPosition
+	timeOff            map[uuid.UUID][]domain.TimeOffRequest
+}
+
+// add puts new shifts on the roster, keeping the shifts ordered by start
+func (r *roster) add(shifts []*domain.DutyShift) {
+	r.shifts = append(r.shifts, shifts...)
+	sort.SliceStable(r.shifts, func(i, j int) bool {
+		return r.shifts[i].StartDate.Before(r.shifts[j].StartDate)
+	})
+}
+
+// fill assigns available staff members to the open positions of the shifts in order of start. Among the
+// available staff members, the one with the fewest hours of the shift's duty type within [start, end) is
+// chosen, then the one with the fewest duty hours overall, then by name. It returns the number of
+// assignments made and of positions left open.
+func (r *roster) fill(shifts []*domain.DutyShift, start, end time.Time) (int, int) {
+	typeHours := make(map[uuid.UUID]map[uuid.UUID]time.Duration)
+	totalHours := make(map[uuid.UUID]time.Duration)
+	count := func(shift *domain.DutyShift, staffMemberID uuid.UUID) {
+		if typeHours[staffMemberID] == nil {
+			typeHours[staffMemberID] = make(map[uuid.UUID]time.Duration)
+		}
+		typeHours[staffMemberID][shift.DutyTypeID] += shift.Duration()
+		if dutyType := r.types[shift.DutyTypeID]; dutyType != nil && !dutyType.IsTimeOff {
+			totalHours[staffMemberID] += shift.Duration()
+		}
+	}
+	for _, shift := range r.shifts {
+		if shift.Overlaps(start, end) {
+			for _, id := range shift.StaffMemberIDs {
+				count(shift, id)
+			}
+		}
+	}
+
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].StartDate.Before(shifts[j].StartDate)
+	})
+
+	assigned, open := 0, 0
+	for _, shift := range shifts {
+		for shift.OpenSlots() > 0 {
+			var best *domain.StaffMember
+			for i := range r.staffMembers {
+				candidate := &r.staffMembers[i]
+				if !r.available(shift, candidate) {
+					continue
+				}
+				if best == nil ||
+					typeHours[candidate.ID][shift.DutyTypeID] < typeHours[best.ID][shift.DutyTypeID] ||
+					(typeHours[candidate.ID][shift.DutyTypeID] == typeHours[best.ID][shift.DutyTypeID] && totalHours[candidate.ID] < totalHours[best.ID]) {
+					best = candidate
+				}
+			}
+			if best == nil {
+				break
+			}
+
+			shift.StaffMemberIDs = append(shift.StaffMemberIDs, best.ID)
+			count(shift, best.ID)
+			assigned++
+		}
+		open += shift.OpenSlots()
+	}
+
+	return assigned, open
+}
+
+// available reports whether a staff member can be assigned to a shift: they belong to the shift's
+// rotation, hold valid certificates for its duty type, are not on another shift or event position
+// at the same time and have rest between the shift and their other shifts. Unless the shift is time off,
+// they must also not be on approved time off or in one of their weekly unavailable windows.
+func (r *roster) available(shift *domain.DutyShift, staffMember *domain.StaffMember) bool {
+	if shift.HasStaffMember(staffMember.ID) {
+		return false
+	}
+	if dutyType, ok := r.types[shift.DutyTypeID]; ok && !dutyType.IsTimeOff {
+		if r.onTimeOff(shift, staffMember.ID) || staffMember.UnavailableDuring(shift.StartDate, shift.EndDate, r.loc) {
+			return false
+		}
+	}
+	if shift.RotationID != nil {
+		if rotation, ok := r.rotations[*shift.RotationID]; ok && !rotation.InPool(staffMember.ID) {
+			return false
+		}
+	}
+	if len(r.missingCertifications(shift, staffMember)) > 0 {
+		return false
+	}
+
+	for _, other := range r.shifts {
+		if other == shift || !other.HasStaffMember(staffMember.ID) {
+			continue
+		}
+		if other.Overlaps(shift.StartDate, shift.EndDate) || r.tooClose(shift, other) {
+			return false
+		}
+	}
+
+	for _, position := range r.positions[staffMember.ID] {
+		if shift.Overlaps(position.event.StartDate, position.event.EndDate) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// onTimeOff reports whether the staff member has approved time off overlapping the shift
+func (r *roster) onTimeOff(shift *domain.DutyShift, staffMemberID uuid.UUID) bool {
+	for i := range r.timeOff[staffMemberID] {
+		if r.timeOff[staffMemberID][i].Overlaps(shift.StartDate, shift.EndDate) {
+			return true
+		}
+	}
+	return false
+}
+
+// missingCertifications returns the certifications a shift's duty type requires that the staff member
+// does not hold or that have expired by the day the shift starts
+func (r *roster) missingCertifications(shift *domain.DutyShift, staffMember *domain.StaffMember) []uuid.UUID {
+	dutyType, ok := r.types[shift.DutyTypeID]
+	if !ok {
+		return nil
+	}
+
+	day := localDate(shift.StartDate, r.loc)
+	var missing []uuid.UUID
+	for _, certificationID := range dutyType.RequiredCertificationIDs {
+		cert := staffMember.Certification(certificationID)
+		if cert == nil || cert.ExpiredOn(day) {
+			missing = append(missing, certificationID)
+		}
+	}
+	return missing
+}
+
+// tooClose reports whether the later of two shifts starts before the rest required after the earlier one
+// is over. Time off needs no rest before or after it.
+func (r *roster) tooClose(a, b *domain.DutyShift) bool {
+	first, second := a, b
+	if b.StartDate.Before(a.StartDate) {
+		first, second = b, a
+	}
+
+	firstType, secondType := r.types[first.DutyTypeID], r.types[second.DutyTypeID]
+	if firstType == nil || secondType == nil || firstType.IsTimeOff || secondType.IsTimeOff {
+		return false
+	}
+	return second.StartDate.Before(first.EndDate.Add(firstType.MinRest()))
+}
+
+// conflicts lists the conflicts of the shifts overlapping [start, end), ordered by shift start
+func (r *roster) conflicts(start, end time.Time) []api.DutyConflict {
+	conflicts := []api.DutyConflict{}
+
+	for i, shift := range r.shifts {
+		dutyType, ok := r.types[shift.DutyTypeID]
+		if !ok {
+			continue
+		}
+		inRange := shift.Overlaps(start, end)
+
+		if inRange && !dutyType.IsTimeOff && shift.OpenSlots() > 0 {
+			conflict := r.conflict(api.DutyConflictTypeUnderstaffed, shift, nil)
+			conflict.Message = fmt.Sprintf("%s shift needs %d staff members but has %d", dutyType.Name, shift.StaffCount, len(shift.StaffMemberIDs))
+			conflicts = append(conflicts, conflict)
+		}
+
+		for _, staffMemberID := range shift.StaffMemberIDs {
+			staffMember, ok := r.staffByID[staffMemberID]
+			if !ok {
+				continue
+			}
+
+			if inRange {
+				for _, certificationID := range r.missingCertifications(shift, staffMember) {
+					conflict := r.conflict(api.DutyConflictTypeMissingCertification, shift, staffMember)
+					conflict.CertificationId = &certificationID
+					conflict.Message = fmt.Sprintf("%s is on a %s shift without a valid %s certificate", staffMember.Name, dutyType.Name, r.certificationNames[certificationID])
+					conflicts = append(conflicts, conflict)
+				}
+
+				if !dutyType.IsTimeOff && r.onTimeOff(shift, staffMemberID) {
+					conflict := r.conflict(api.DutyConflictTypeTimeOff, shift, staffMember)
+					conflict.Message = fmt.Sprintf("%s is on a %s shift during approved time off", staffMember.Name, dutyType.Name)
+					conflicts = append(conflicts, conflict)
+				}
+
+				for _, position := range r.positions[staffMemberID] {
+					if !shift.Overlaps(position.event.StartDate, position.event.EndDate) {
+						continue
+					}
+					conflict := r.conflict(api.DutyConflictTypeEventOverlap, shift, staffMember)
+					conflict.EventId = &position.event.ID
+					conflict.EventName = utils.StringToPtr(position.event.Name)
+					conflict.PositionName = utils.StringToPtr(position.name)
+					conflict.Message = fmt.Sprintf("%s is on a %s shift while assigned as %s at %s", staffMember.Name, dutyType.Name, position.name, position.event.Name)
+					conflicts = append(conflicts, conflict)
+				}
+			}
+
+			// Shifts are ordered by start, so each pair is checked once from its earlier shift
+			for _, other := range r.shifts[i+1:] {
+				if !other.StartDate.Before(shift.EndDate.Add(domain.MaxDutyRestHours * time.Hour)) {
+					break
+				}
+				if !other.HasStaffMember(staffMemberID) || !(inRange || other.Overlaps(start, end)) {
+					continue
+				}
+				otherType, ok := r.types[other.DutyTypeID]
+				if !ok {
+					continue
+				}
+
+				if other.Overlaps(shift.StartDate, shift.EndDate) {
+					conflict := r.conflict(api.DutyConflictTypeDoubleBooked, shift, staffMember)
+					conflict.OtherShiftId = &other.ID
+					conflict.Message = fmt.Sprintf("%s is on overlapping %s and %s shifts", staffMember.Name, dutyType.Name, otherType.Name)
+					conflicts = append(conflicts, conflict)
+				} else if r.tooClose(shift, other) {
+					conflict := r.conflict(api.DutyConflictTypeInsufficientRest, other, staffMember)
+					conflict.OtherShiftId = &shift.ID
+					conflict.Message = fmt.Sprintf("%s starts a %s shift %.1f hours after a %s shift that requires %d hours of rest",
+						staffMember.Name, otherType.Name, other.StartDate.Sub(shift.EndDate).Hours(), dutyType.Name, dutyType.MinRestHours)
+					conflicts = append(conflicts, conflict)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].ShiftStartDate.Before(conflicts[j].ShiftStartDate)
+	})
+
+	return conflicts
+}
+
+// conflict describes a conflict of a shift; staffMember is nil for understaffed shifts
+func (r *roster) conflict(conflictType api.DutyConflictType, shift *domain.DutyShift, staffMember *domain.StaffMember) api.DutyConflict {
+	conflict := api.DutyConflict{
+		Type:           conflictType,
+		ShiftId:        shift.ID,
+		DutyTypeId:     shift.DutyTypeID,
+		ShiftStartDate: shift.StartDate,
+		ShiftEndDate:   shift.EndDate,
+	}
+	if dutyType, ok := r.types[shift.DutyTypeID]; ok {
+		conflict.DutyTypeName = dutyType.Name
+	}
+	if staffMember != nil {
+		conflict.StaffMemberId = &staffMember.ID
+		conflict.StaffMemberName = utils.StringToPtr(staffMember.Name)
+	}
+	return conflict
+}
+
+// checkRosterRange verifies a range of days is ordered and not too long to generate or fill at once
+func checkRosterRange(from, to time.Time) error {
+	if to.Before(from) {
+		return pkgerrors.BadRequest("The last day must not be before the first day", nil)
+	}
+	if to.After(from.AddDate(0, 0, maxRosterDays)) {
+		return pkgerrors.BadRequest(fmt.Sprintf("The range can span at most %d days", maxRosterDays), nil)
+	}
+	return nil
+}
+
+// dayRange returns the instants the first day starts and the day after the last day starts in the time zone
+func dayRange(from, to time.Time, loc *time.Location) (time.Time, time.Time) {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+	return start.UTC(), end.UTC()
+}
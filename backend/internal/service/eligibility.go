@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// defaultEligibilityDays is how many days after the first day events are checked when no last day is given
+const defaultEligibilityDays = 7
+
+// EligibilityService defines the interface for checking the campers of events against activity eligibility rules
+type EligibilityService interface {
+	// GetEventEligibility checks the campers of an event against the eligibility rules of its activity
+	GetEventEligibility(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, eventID uuid.UUID) (*api.EventEligibility, error)
+
+	// GetReport checks the events of a range of days (a week from today by default) and returns those
+	// with ineligible campers
+	GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.EligibilityReport, error)
+}
+
+// eligibilityService implements EligibilityService
+type eligibilityService struct {
+	activitiesRepo   ActivitiesRepository
+	eventsRepo       EventsRepository
+	groupsRepo       GroupsRepository
+	campersRepo      CampersRepository
+	customFieldsRepo CustomFieldsRepository
+	campsRepo        CampsRepository
+	skillTracksRepo  SkillTracksRepository
+}
+
+// NewEligibilityService creates a new eligibility service
+func NewEligibilityService(activitiesRepo ActivitiesRepository, eventsRepo EventsRepository, groupsRepo GroupsRepository, campersRepo CampersRepository, customFieldsRepo CustomFieldsRepository, campsRepo CampsRepository, skillTracksRepo SkillTracksRepository) EligibilityService {
+	return &eligibilityService{
+		activitiesRepo:   activitiesRepo,
+		eventsRepo:       eventsRepo,
+		groupsRepo:       groupsRepo,
+		campersRepo:      campersRepo,
+		customFieldsRepo: customFieldsRepo,
+		campsRepo:        campsRepo,
+		skillTracksRepo:  skillTracksRepo,
+	}
+}
+
+// GetEventEligibility checks the campers of an event against the eligibility rules of its activity
+func (s *eligibilityService) GetEventEligibility(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, eventID uuid.UUID) (*api.EventEligibility, error) {
+	event, err := s.eventsRepo.GetByID(ctx, tenantID, campID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Event not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get event", err)
+	}
+
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	day := localDate(event.StartDate, camp.TimeLocation())
+
+	checker, err := s.newChecker(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+	enrolled, err := s.enrolledOn(ctx, tenantID, campID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _, err := checker.checkEvent(ctx, event, day, enrolled)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetReport checks the events of a range of days and returns those with ineligible campers
+func (s *eligibilityService) GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.EligibilityReport, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	first := localDate(time.Now(), loc)
+	if from != nil {
+		first = *from
+	}
+	last := first.AddDate(0, 0, defaultEligibilityDays)
+	if to != nil {
+		last = *to
+	}
+	if err := checkRosterRange(first, last); err != nil {
+		return nil, err
+	}
+
+	report := &api.EligibilityReport{
+		From:   openapi_types.Date{Time: first},
+		To:     openapi_types.Date{Time: last},
+		Events: []api.EventEligibility{},
+	}
+
+	start, end := dayRange(first, last, loc)
+	events, err := s.eventsRepo.ListBetween(ctx, tenantID, campID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+
+	checker, err := s.newChecker(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Campers enrolled on each day with events
+	enrolled := make(map[time.Time]map[uuid.UUID]*domain.Camper)
+	for i := range events {
+		event := &events[i]
+		if event.StartDate.Before(start) || event.ActivityID == nil {
+			continue
+		}
+
+		day := localDate(event.StartDate, loc)
+		if _, ok := enrolled[day]; !ok {
+			if enrolled[day], err = s.enrolledOn(ctx, tenantID, campID, day); err != nil {
+				return nil, err
+			}
+		}
+
+		result, checked, err := checker.checkEvent(ctx, event, day, enrolled[day])
+		if err != nil {
+			return nil, err
+		}
+		if !checked {
+			continue
+		}
+		report.EventsChecked++
+
+		if len(result.IneligibleCampers) > 0 {
+			report.Events = append(report.Events, *result)
+			report.IneligibleCount += len(result.IneligibleCampers)
+		}
+	}
+
+	sort.SliceStable(report.Events, func(i, j int) bool {
+		return report.Events[i].StartDate.Before(report.Events[j].StartDate)
+	})
+
+	return report, nil
+}
+
+// enrolledOn returns the campers enrolled on a day by ID
+func (s *eligibilityService) enrolledOn(ctx context.Context, tenantID, campID uuid.UUID, day time.Time) (map[uuid.UUID]*domain.Camper, error) {
+	campers, err := s.campersRepo.ListEnrolledOnDate(ctx, tenantID, campID, day)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list enrolled campers", err)
+	}
+	byID := make(map[uuid.UUID]*domain.Camper, len(campers))
+	for i := range campers {
+		byID[campers[i].ID] = &campers[i]
+	}
+	return byID, nil
+}
+
+// newChecker loads the groups, campers, camper custom fields and skill tracks of a camp
+func (s *eligibilityService) newChecker(ctx context.Context, tenantID, campID uuid.UUID) (*eligibilityChecker, error) {
+	groups, err := s.groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+	campers, err := s.campersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list campers", err)
+	}
+	entityType := domain.CustomFieldEntityTypeCamper
+	fields, err := s.customFieldsRepo.List(ctx, tenantID, campID, &entityType)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list custom fields", err)
+	}
+	tracks, err := s.skillTracksRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list skill tracks", err)
+	}
+
+	checker := &eligibilityChecker{
+		service:     s,
+		tenantID:    tenantID,
+		campID:      campID,
+		groupsByID:  make(map[uuid.UUID]*domain.Group, len(groups)),
+		campers:     make(map[uuid.UUID]*domain.Camper, len(campers)),
+		fields:      make(map[uuid.UUID]*domain.CustomFieldDefinition, len(fields)),
+		tracks:      make(map[uuid.UUID]*domain.SkillTrack, len(tracks)),
+		activities:  make(map[uuid.UUID]*domain.Activity),
+		completions: make(map[uuid.UUID]map[uuid.UUID]time.Time),
+	}
+	for i := range groups {
+		checker.groupsByID[groups[i].ID] = &groups[i]
+	}
+	for i := range campers {
+		checker.campers[campers[i].ID] = &campers[i]
+	}
+	for i := range fields {
+		checker.fields[fields[i].ID] = &fields[i]
+	}
+	for i := range tracks {
+		checker.tracks[tracks[i].ID] = &tracks[i]
+	}
+	return checker, nil
+}
+
+// eligibilityChecker checks events of a camp, loading activities and their past participants once
+type eligibilityChecker struct {
+	service    *eligibilityService
+	tenantID   uuid.UUID
+	campID     uuid.UUID
+	groupsByID map[uuid.UUID]*domain.Group
+	campers    map[uuid.UUID]*domain.Camper
+	fields     map[uuid.UUID]*domain.CustomFieldDefinition
+	tracks     map[uuid.UUID]*domain.SkillTrack
+
+	// activities caches activities by ID, nil for deleted ones
+	activities map[uuid.UUID]*domain.Activity
+	// completions holds, per activity, when each camper first finished an event of it
+	completions map[uuid.UUID]map[uuid.UUID]time.Time
+}
+
+// checkEvent checks the enrolled campers of an event against the eligibility rules of its activity,
+// reporting false when the event has no activity with eligibility rules
+func (c *eligibilityChecker) checkEvent(ctx context.Context, event *domain.Event, day time.Time, enrolled map[uuid.UUID]*domain.Camper) (*api.EventEligibility, bool, error) {
+	campers, _ := eventParticipants(event, c.groupsByID, enrolled)
+	result := &api.EventEligibility{
+		EventId:           event.ID,
+		EventName:         event.Name,
+		ActivityId:        event.ActivityID,
+		StartDate:         event.StartDate,
+		EndDate:           event.EndDate,
+		CamperCount:       len(campers),
+		IneligibleCampers: []api.IneligibleCamper{},
+	}
+	if event.ActivityID == nil {
+		return result, false, nil
+	}
+
+	activity, err := c.activity(ctx, *event.ActivityID)
+	if err != nil {
+		return nil, false, err
+	}
+	if activity == nil || activity.Eligibility.IsEmpty() {
+		return result, false, nil
+	}
+	eligibility := activity.Eligibility
+
+	for _, camper := range campers {
+		reasons := eligibility.CheckCamper(camper, day)
+
+		for _, prerequisiteID := range eligibility.PrerequisiteActivityIDs {
+			prerequisite, err := c.activity(ctx, prerequisiteID)
+			if err != nil {
+				return nil, false, err
+			}
+			if prerequisite == nil {
+				continue
+			}
+			completions, err := c.completionsOf(ctx, prerequisiteID)
+			if err != nil {
+				return nil, false, err
+			}
+			if finished, ok := completions[camper.ID]; !ok || finished.After(event.StartDate) {
+				reasons = append(reasons, fmt.Sprintf("has not taken part in %s", prerequisite.Name))
+			}
+		}
+
+		for _, skill := range eligibility.RequiredSkills {
+			field, ok := c.fields[skill.CustomFieldID]
+			if !ok {
+				continue
+			}
+			minimum, err := field.ParseValue(skill.MinimumLevel)
+			if err != nil {
+				continue
+			}
+			minimumRank, _ := field.LevelRank(minimum)
+			value := camper.CustomFields[field.Key]
+			if rank, ok := field.LevelRank(value); !ok {
+				reasons = append(reasons, fmt.Sprintf("has no %s recorded; %s is required", field.Label, skill.MinimumLevel))
+			} else if rank < minimumRank {
+				reasons = append(reasons, fmt.Sprintf("has %s %v; %s is required", field.Label, value, skill.MinimumLevel))
+			}
+		}
+
+		for _, skill := range eligibility.RequiredSkillLevels {
+			track, ok := c.tracks[skill.SkillTrackID]
+			if !ok {
+				continue
+			}
+			minimum, minimumRank := track.Level(skill.MinimumLevelID)
+			if minimum == nil {
+				continue
+			}
+			if c.skillRank(camper.ID, track, day) < minimumRank {
+				reasons = append(reasons, fmt.Sprintf("has not passed %s in %s", minimum.Name, track.Name))
+			}
+		}
+
+		if len(reasons) > 0 {
+			result.IneligibleCampers = append(result.IneligibleCampers, api.IneligibleCamper{
+				CamperId:   camper.ID,
+				CamperName: camper.Name,
+				Reasons:    reasons,
+			})
+		}
+	}
+
+	sort.Slice(result.IneligibleCampers, func(i, j int) bool {
+		return result.IneligibleCampers[i].CamperName < result.IneligibleCampers[j].CamperName
+	})
+	return result, true, nil
+}
+
+// skillRank returns the rank of the highest level of a skill track a camper passed by the day
+func (c *eligibilityChecker) skillRank(camperID uuid.UUID, track *domain.SkillTrack, day time.Time) int {
+	camper, ok := c.campers[camperID]
+	if !ok {
+		return 0
+	}
+	var assessments []domain.SkillAssessment
+	for _, assessment := range camper.SkillAssessments {
+		if !assessment.AssessedOn.After(day) {
+			assessments = append(assessments, assessment)
+		}
+	}
+	_, rank, _ := domain.SkillProgress(track, assessments)
+	return rank
+}
+
+// activity returns an activity of the camp, or nil when it was deleted
+func (c *eligibilityChecker) activity(ctx context.Context, id uuid.UUID) (*domain.Activity, error) {
+	if activity, ok := c.activities[id]; ok {
+		return activity, nil
+	}
+	activity, err := c.service.activitiesRepo.GetByID(ctx, c.tenantID, c.campID, id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.InternalServerError("Failed to get activity", err)
+		}
+		activity = nil
+	}
+	c.activities[id] = activity
+	return activity, nil
+}
+
+// completionsOf returns when each camper first finished an event of the activity, counting the campers of
+// the event's groups without the excluded campers
+func (c *eligibilityChecker) completionsOf(ctx context.Context, activityID uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	if completions, ok := c.completions[activityID]; ok {
+		return completions, nil
+	}
+	events, err := c.service.eventsRepo.GetByActivityID(ctx, c.tenantID, c.campID, activityID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events of prerequisite activity", err)
+	}
+
+	completions := make(map[uuid.UUID]time.Time)
+	for i := range events {
+		event := &events[i]
+		campers, _ := eventParticipants(event, c.groupsByID, c.campers)
+		for _, camper := range campers {
+			if finished, ok := completions[camper.ID]; !ok || event.EndDate.Before(finished) {
+				completions[camper.ID] = event.EndDate
+			}
+		}
+	}
+	c.completions[activityID] = completions
+	return completions, nil
+}
@@ -0,0 +1,600 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultEquipmentDays is how many days after the first day events are checked when no last day is given
+const defaultEquipmentDays = 7
+
+// EquipmentService defines the interface for equipment inventory business logic
+type EquipmentService interface {
+	// List retrieves the equipment items of a camp with the units currently checked out
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListEquipmentItemsParams) (*api.EquipmentItemsListResponse, error)
+
+	// GetByID retrieves a single equipment item
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.EquipmentItem, error)
+
+	// Create adds an equipment item to the inventory
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.EquipmentItemCreationRequest) (*api.EquipmentItem, error)
+
+	// Update changes the details, quantity and condition of an equipment item
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.EquipmentItemUpdateRequest) (*api.EquipmentItem, error)
+
+	// Delete removes an equipment item with its checkout history
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListCheckouts retrieves the checkout history of an equipment item, most recent first
+	ListCheckouts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, params *api.ListEquipmentCheckoutsParams) (*api.EquipmentCheckoutsListResponse, error)
+
+	// CheckOut takes available units of an equipment item out of storage as the current user
+	CheckOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.EquipmentCheckoutRequest) (*api.EquipmentCheckout, error)
+
+	// Return brings checked-out units back as the current user, optionally recording their condition
+	Return(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, checkoutID uuid.UUID, req *api.EquipmentReturnRequest) (*api.EquipmentCheckout, error)
+
+	// GetConflicts checks the events of a range of days (a week from today by default) and returns the times
+	// concurrent events need more units of an equipment item than are available
+	GetConflicts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.EquipmentConflictsReport, error)
+}
+
+// equipmentService implements EquipmentService
+type equipmentService struct {
+	repo             EquipmentItemsRepository
+	checkoutsRepo    EquipmentCheckoutsRepository
+	locationsRepo    LocationsRepository
+	staffMembersRepo StaffMembersRepository
+	activitiesRepo   ActivitiesRepository
+	eventsRepo       EventsRepository
+	groupsRepo       GroupsRepository
+	campersRepo      CampersRepository
+	campsRepo        CampsRepository
+}
+
+// NewEquipmentService creates a new equipment service
+func NewEquipmentService(repo EquipmentItemsRepository, checkoutsRepo EquipmentCheckoutsRepository, locationsRepo LocationsRepository, staffMembersRepo StaffMembersRepository, activitiesRepo ActivitiesRepository, eventsRepo EventsRepository, groupsRepo GroupsRepository, campersRepo CampersRepository, campsRepo CampsRepository) EquipmentService {
+	return &equipmentService{
+		repo:             repo,
+		checkoutsRepo:    checkoutsRepo,
+		locationsRepo:    locationsRepo,
+		staffMembersRepo: staffMembersRepo,
+		activitiesRepo:   activitiesRepo,
+		eventsRepo:       eventsRepo,
+		groupsRepo:       groupsRepo,
+		campersRepo:      campersRepo,
+		campsRepo:        campsRepo,
+	}
+}
+
+// List retrieves the equipment items of a camp by name
+func (s *equipmentService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListEquipmentItemsParams) (*api.EquipmentItemsListResponse, error) {
+	var condition *domain.EquipmentCondition
+	if params.Condition != nil {
+		value := domain.EquipmentCondition(*params.Condition)
+		condition = &value
+	}
+
+	equipment, err := s.repo.List(ctx, tenantID, campID, params.Category, params.HomeLocationId, condition)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list equipment items", err)
+	}
+
+	checkedOut, err := s.checkedOutByItem(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]api.EquipmentItem, len(equipment))
+	for i := range equipment {
+		items[i] = equipment[i].ToAPI(checkedOut[equipment[i].ID])
+	}
+
+	return &api.EquipmentItemsListResponse{Items: items}, nil
+}
+
+// GetByID retrieves a single equipment item
+func (s *equipmentService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.EquipmentItem, error) {
+	item, err := s.getItem(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedOut, err := s.checkedOut(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiItem := item.ToAPI(checkedOut)
+	return &apiItem, nil
+}
+
+// Create adds an equipment item, in good condition unless stated otherwise
+func (s *equipmentService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.EquipmentItemCreationRequest) (*api.EquipmentItem, error) {
+	item := &domain.EquipmentItem{
+		TenantID:       tenantID,
+		CampID:         campID,
+		Name:           strings.TrimSpace(req.Name),
+		Description:    utils.PtrToString(req.Description),
+		Category:       strings.TrimSpace(utils.PtrToString(req.Category)),
+		Quantity:       req.Quantity,
+		HomeLocationID: req.HomeLocationId,
+		Condition:      domain.EquipmentConditionGood,
+		Notes:          utils.PtrToString(req.Notes),
+	}
+	if req.Condition != nil {
+		item.Condition = domain.EquipmentCondition(*req.Condition)
+	}
+	if err := item.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+	if err := s.validateHomeLocation(ctx, tenantID, campID, item.HomeLocationID); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, item); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create equipment item", err)
+	}
+
+	apiItem := item.ToAPI(0)
+	return &apiItem, nil
+}
+
+// Update changes an equipment item; its quantity cannot drop below the units currently checked out
+func (s *equipmentService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.EquipmentItemUpdateRequest) (*api.EquipmentItem, error) {
+	item, err := s.getItem(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Name = strings.TrimSpace(req.Name)
+	item.Description = utils.PtrToString(req.Description)
+	item.Category = strings.TrimSpace(utils.PtrToString(req.Category))
+	item.Quantity = req.Quantity
+	item.HomeLocationID = req.HomeLocationId
+	item.Condition = domain.EquipmentCondition(req.Condition)
+	item.Notes = utils.PtrToString(req.Notes)
+	if err := item.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+	if err := s.validateHomeLocation(ctx, tenantID, campID, item.HomeLocationID); err != nil {
+		return nil, err
+	}
+
+	checkedOut, err := s.checkedOut(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Quantity < checkedOut {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Quantity cannot be less than the %d units checked out", checkedOut), nil)
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, item); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update equipment item", err)
+	}
+
+	return s.GetByID(ctx, tenantID, campID, id)
+}
+
+// Delete removes an equipment item that has no units checked out
+func (s *equipmentService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getItem(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	checkedOut, err := s.checkedOut(ctx, tenantID, campID, id)
+	if err != nil {
+		return err
+	}
+	if checkedOut > 0 {
+		return pkgerrors.Conflict(fmt.Sprintf("Equipment item has %d units checked out", checkedOut), nil)
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete equipment item", err)
+	}
+
+	return nil
+}
+
+// ListCheckouts retrieves the checkout history of an equipment item, most recent first
+func (s *equipmentService) ListCheckouts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, params *api.ListEquipmentCheckoutsParams) (*api.EquipmentCheckoutsListResponse, error) {
+	if _, err := s.getItem(ctx, tenantID, campID, id); err != nil {
+		return nil, err
+	}
+
+	checkouts, err := s.checkoutsRepo.ListByItem(ctx, tenantID, campID, id, params.Open)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list equipment checkouts", err)
+	}
+
+	items := make([]api.EquipmentCheckout, len(checkouts))
+	for i := range checkouts {
+		items[i] = checkouts[i].ToAPI()
+	}
+
+	return &api.EquipmentCheckoutsListResponse{Items: items}, nil
+}
+
+// CheckOut takes available units of a usable equipment item out of storage, optionally for a staff member
+// or an event
+func (s *equipmentService) CheckOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.EquipmentCheckoutRequest) (*api.EquipmentCheckout, error) {
+	item, err := s.getItem(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	checkout := &domain.EquipmentCheckout{
+		TenantID:        tenantID,
+		CampID:          campID,
+		EquipmentItemID: id,
+		Quantity:        req.Quantity,
+		StaffMemberID:   req.StaffMemberId,
+		EventID:         req.EventId,
+		CheckedOutAt:    time.Now().UTC(),
+		DueAt:           req.DueAt,
+		Notes:           utils.PtrToString(req.Notes),
+	}
+	if err := checkout.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	if checkout.StaffMemberID != nil {
+		if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, *checkout.StaffMemberID); err != nil {
+			return nil, pkgerrors.BadRequest("Staff member not found", err)
+		}
+	}
+	if checkout.EventID != nil {
+		if _, err := s.eventsRepo.GetByID(ctx, tenantID, campID, *checkout.EventID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.BadRequest("Event not found", err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to validate event", err)
+		}
+	}
+
+	if !item.Condition.IsUsable() {
+		return nil, pkgerrors.Conflict(fmt.Sprintf("%s cannot be checked out while its condition is %s", item.Name, item.Condition), nil)
+	}
+	checkedOut, err := s.checkedOut(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+	if available := item.Available(checkedOut); checkout.Quantity > available {
+		return nil, pkgerrors.Conflict(fmt.Sprintf("Only %d of %d units of %s are available", available, item.Quantity, item.Name), nil)
+	}
+
+	checkout.CheckedOutBy, checkout.CheckedOutByEmail = currentUser(ctx)
+
+	// Save to database
+	if err := s.checkoutsRepo.Create(ctx, checkout); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to check out equipment", err)
+	}
+
+	apiCheckout := checkout.ToAPI()
+	return &apiCheckout, nil
+}
+
+// Return brings checked-out units back; a reported condition becomes the condition of the equipment item
+func (s *equipmentService) Return(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, checkoutID uuid.UUID, req *api.EquipmentReturnRequest) (*api.EquipmentCheckout, error) {
+	if _, err := s.getItem(ctx, tenantID, campID, id); err != nil {
+		return nil, err
+	}
+
+	checkout, err := s.checkoutsRepo.GetByID(ctx, tenantID, campID, id, checkoutID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Equipment checkout not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get equipment checkout", err)
+	}
+	if !checkout.IsOpen() {
+		return nil, pkgerrors.Conflict("Equipment checkout has already been returned", nil)
+	}
+
+	if req.Condition != nil {
+		condition := domain.EquipmentCondition(*req.Condition)
+		if !condition.IsValid() {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid condition: %s", condition), nil)
+		}
+		checkout.ReturnCondition = &condition
+	}
+	if notes := strings.TrimSpace(utils.PtrToString(req.Notes)); notes != "" {
+		if checkout.Notes != "" {
+			notes = checkout.Notes + "\n" + notes
+		}
+		checkout.Notes = notes
+	}
+	checkout.ReturnedBy, checkout.ReturnedByEmail = currentUser(ctx)
+
+	if err := s.checkoutsRepo.MarkReturned(ctx, tenantID, campID, checkout, time.Now().UTC()); err != nil {
+		return nil, pkgerrors.Conflict("Equipment checkout has already been returned", err)
+	}
+
+	if checkout.ReturnCondition != nil {
+		if err := s.repo.UpdateCondition(ctx, tenantID, campID, id, *checkout.ReturnCondition); err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to update equipment item condition", err)
+		}
+	}
+
+	apiCheckout := checkout.ToAPI()
+	return &apiCheckout, nil
+}
+
+// GetConflicts checks the events of a range of days against the equipment needs of their activities
+func (s *equipmentService) GetConflicts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.EquipmentConflictsReport, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	first := localDate(time.Now(), loc)
+	if from != nil {
+		first = *from
+	}
+	last := first.AddDate(0, 0, defaultEquipmentDays)
+	if to != nil {
+		last = *to
+	}
+	if err := checkRosterRange(first, last); err != nil {
+		return nil, err
+	}
+
+	report := &api.EquipmentConflictsReport{
+		From:      openapi_types.Date{Time: first},
+		To:        openapi_types.Date{Time: last},
+		Shortages: []api.EquipmentShortage{},
+	}
+
+	start, end := dayRange(first, last, loc)
+	events, err := s.eventsRepo.ListBetween(ctx, tenantID, campID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+
+	equipment, err := s.repo.List(ctx, tenantID, campID, nil, nil, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list equipment items", err)
+	}
+	itemsByID := make(map[uuid.UUID]*domain.EquipmentItem, len(equipment))
+	for i := range equipment {
+		itemsByID[equipment[i].ID] = &equipment[i]
+	}
+
+	groups, err := s.groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+	groupsByID := make(map[uuid.UUID]*domain.Group, len(groups))
+	for i := range groups {
+		groupsByID[groups[i].ID] = &groups[i]
+	}
+
+	// Units each event needs of each equipment item
+	demands := make(map[uuid.UUID][]equipmentDemand)
+	activities := make(map[uuid.UUID]*domain.Activity)
+	enrolled := make(map[time.Time]map[uuid.UUID]*domain.Camper)
+	for i := range events {
+		event := &events[i]
+		if event.ActivityID == nil {
+			continue
+		}
+
+		activity, ok := activities[*event.ActivityID]
+		if !ok {
+			activity, err = s.activitiesRepo.GetByID(ctx, tenantID, campID, *event.ActivityID)
+			if err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, pkgerrors.InternalServerError("Failed to get activity", err)
+				}
+				activity = nil
+			}
+			activities[*event.ActivityID] = activity
+		}
+		if activity == nil || len(activity.EquipmentNeeds) == 0 {
+			continue
+		}
+		report.EventsChecked++
+
+		day := localDate(event.StartDate, loc)
+		if _, ok := enrolled[day]; !ok {
+			campers, err := s.campersRepo.ListEnrolledOnDate(ctx, tenantID, campID, day)
+			if err != nil {
+				return nil, pkgerrors.InternalServerError("Failed to list enrolled campers", err)
+			}
+			enrolled[day] = make(map[uuid.UUID]*domain.Camper, len(campers))
+			for j := range campers {
+				enrolled[day][campers[j].ID] = &campers[j]
+			}
+		}
+
+		// Events without campers yet are expected to fill up to their capacity
+		campers, _ := eventParticipants(event, groupsByID, enrolled[day])
+		participants := len(campers)
+		if participants == 0 && event.Capacity != nil {
+			participants = *event.Capacity
+		}
+		if participants == 0 {
+			continue
+		}
+
+		for _, need := range activity.EquipmentNeeds {
+			if _, ok := itemsByID[need.EquipmentItemID]; !ok {
+				continue
+			}
+			demands[need.EquipmentItemID] = append(demands[need.EquipmentItemID], equipmentDemand{
+				event:    event,
+				quantity: need.QuantityPerParticipant * participants,
+			})
+		}
+	}
+	if len(demands) == 0 {
+		return report, nil
+	}
+
+	// Units checked out for other purposes than the events are not available to them
+	checkouts, err := s.checkoutsRepo.ListOpen(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list open equipment checkouts", err)
+	}
+	checkoutsByItem := make(map[uuid.UUID][]domain.EquipmentCheckout)
+	for _, checkout := range checkouts {
+		if checkout.EventID == nil {
+			checkoutsByItem[checkout.EquipmentItemID] = append(checkoutsByItem[checkout.EquipmentItemID], checkout)
+		}
+	}
+
+	for itemID, itemDemands := range demands {
+		report.Shortages = append(report.Shortages, findShortages(itemsByID[itemID], itemDemands, checkoutsByItem[itemID])...)
+	}
+
+	sort.SliceStable(report.Shortages, func(i, j int) bool {
+		if report.Shortages[i].StartDate.Equal(report.Shortages[j].StartDate) {
+			return report.Shortages[i].EquipmentName < report.Shortages[j].EquipmentName
+		}
+		return report.Shortages[i].StartDate.Before(report.Shortages[j].StartDate)
+	})
+
+	return report, nil
+}
+
+// equipmentDemand is the number of units of an equipment item an event needs
+type equipmentDemand struct {
+	event    *domain.Event
+	quantity int
+}
+
+// findShortages sweeps over the starts of the events needing an equipment item and reports each distinct
+// set of concurrent events that together need more units than are available while they all take place
+func findShortages(item *domain.EquipmentItem, demands []equipmentDemand, checkouts []domain.EquipmentCheckout) []api.EquipmentShortage {
+	sort.Slice(demands, func(i, j int) bool {
+		return demands[i].event.StartDate.Before(demands[j].event.StartDate)
+	})
+
+	var shortages []api.EquipmentShortage
+	reported := make(map[string]bool)
+	for _, demand := range demands {
+		at := demand.event.StartDate
+		windowEnd := demand.event.EndDate
+		required := 0
+		var eventIDs []uuid.UUID
+		for _, other := range demands {
+			if other.event.StartDate.After(at) || !other.event.EndDate.After(at) {
+				continue
+			}
+			required += other.quantity
+			eventIDs = append(eventIDs, other.event.ID)
+			if other.event.EndDate.Before(windowEnd) {
+				windowEnd = other.event.EndDate
+			}
+		}
+
+		checkedOut := 0
+		for i := range checkouts {
+			if checkouts[i].OutBetween(at, windowEnd) {
+				checkedOut += checkouts[i].Quantity
+			}
+		}
+		available := item.Available(checkedOut)
+		if required <= available {
+			continue
+		}
+
+		sort.Slice(eventIDs, func(i, j int) bool {
+			return eventIDs[i].String() < eventIDs[j].String()
+		})
+		keys := make([]string, len(eventIDs))
+		for i, id := range eventIDs {
+			keys[i] = id.String()
+		}
+		key := strings.Join(keys, ",")
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+
+		message := fmt.Sprintf("%d units of %s are needed but only %d are available", required, item.Name, available)
+		if len(eventIDs) > 1 {
+			message = fmt.Sprintf("%d concurrent events need %d units of %s but only %d are available", len(eventIDs), required, item.Name, available)
+		}
+		shortages = append(shortages, api.EquipmentShortage{
+			EquipmentItemId:   item.ID,
+			EquipmentName:     item.Name,
+			StartDate:         at,
+			EndDate:           windowEnd,
+			RequiredQuantity:  required,
+			AvailableQuantity: available,
+			EventIds:          eventIDs,
+			Message:           message,
+		})
+	}
+	return shortages
+}
+
+// checkedOutByItem sums the units of each equipment item that are checked out
+func (s *equipmentService) checkedOutByItem(ctx context.Context, tenantID, campID uuid.UUID) (map[uuid.UUID]int, error) {
+	checkouts, err := s.checkoutsRepo.ListOpen(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list open equipment checkouts", err)
+	}
+	checkedOut := make(map[uuid.UUID]int)
+	for _, checkout := range checkouts {
+		checkedOut[checkout.EquipmentItemID] += checkout.Quantity
+	}
+	return checkedOut, nil
+}
+
+// checkedOut sums the units of an equipment item that are checked out
+func (s *equipmentService) checkedOut(ctx context.Context, tenantID, campID, id uuid.UUID) (int, error) {
+	open := true
+	checkouts, err := s.checkoutsRepo.ListByItem(ctx, tenantID, campID, id, &open)
+	if err != nil {
+		return 0, pkgerrors.InternalServerError("Failed to list open equipment checkouts", err)
+	}
+	checkedOut := 0
+	for _, checkout := range checkouts {
+		checkedOut += checkout.Quantity
+	}
+	return checkedOut, nil
+}
+
+// validateHomeLocation checks that the location equipment is stored at exists
+func (s *equipmentService) validateHomeLocation(ctx context.Context, tenantID, campID uuid.UUID, locationID *uuid.UUID) error {
+	if locationID == nil {
+		return nil
+	}
+	if _, err := s.locationsRepo.GetByID(ctx, tenantID, campID, *locationID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Home location not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to validate home location", err)
+	}
+	return nil
+}
+
+// getItem loads an equipment item, mapping a missing item to a not found error
+func (s *equipmentService) getItem(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.EquipmentItem, error) {
+	item, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Equipment item not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get equipment item", err)
+	}
+	return item, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -42,17 +43,45 @@ type eventsService struct {
 	activitiesRepo ActivitiesRepository
 	programsRepo   ProgramsRepository
 	locationsRepo  LocationsRepository
+	ticketsRepo    MaintenanceTicketsRepository
 	groupsRepo     GroupsRepository
+
+	// Staff members assigned to required staff positions must have completed onboarding
+	staffMembersRepo   StaffMembersRepository
+	templatesRepo      OnboardingTemplatesRepository
+	completionsRepo    OnboardingCompletionsRepository
+	certificationsRepo CertificationsRepository
+	campsRepo          CampsRepository
+
+	// The campers of the events' groups must meet the eligibility rules of their activities
+	eligibility *eligibilityService
 }
 
 // NewEventsService creates a new events service
-func NewEventsService(repo EventsRepository, activitiesRepo ActivitiesRepository, programsRepo ProgramsRepository, locationsRepo LocationsRepository, groupsRepo GroupsRepository) EventsService {
+func NewEventsService(repo EventsRepository, activitiesRepo ActivitiesRepository, programsRepo ProgramsRepository, locationsRepo LocationsRepository, ticketsRepo MaintenanceTicketsRepository, groupsRepo GroupsRepository, staffMembersRepo StaffMembersRepository, templatesRepo OnboardingTemplatesRepository, completionsRepo OnboardingCompletionsRepository, certificationsRepo CertificationsRepository, campsRepo CampsRepository, campersRepo CampersRepository, customFieldsRepo CustomFieldsRepository, skillTracksRepo SkillTracksRepository) EventsService {
 	return &eventsService{
 		repo:           repo,
 		activitiesRepo: activitiesRepo,
 		programsRepo:   programsRepo,
 		locationsRepo:  locationsRepo,
+		ticketsRepo:    ticketsRepo,
 		groupsRepo:     groupsRepo,
+
+		staffMembersRepo:   staffMembersRepo,
+		templatesRepo:      templatesRepo,
+		completionsRepo:    completionsRepo,
+		certificationsRepo: certificationsRepo,
+		campsRepo:          campsRepo,
+
+		eligibility: &eligibilityService{
+			activitiesRepo:   activitiesRepo,
+			eventsRepo:       repo,
+			groupsRepo:       groupsRepo,
+			campersRepo:      campersRepo,
+			customFieldsRepo: customFieldsRepo,
+			campsRepo:        campsRepo,
+			skillTracksRepo:  skillTracksRepo,
+		},
 	}
 }
 
@@ -128,6 +157,11 @@ func (s *eventsService) Create(ctx context.Context, tenantID, campID uuid.UUID,
 		}
 	}
 
+	// Validate assigned staff members are ready to work
+	if err := s.checkStaffReady(ctx, tenantID, campID, req.Spec.RequiredStaff, nil); err != nil {
+		return nil, err
+	}
+
 	// Serialize JSONB fields
 	var groupIDsJSON json.RawMessage
 	if req.Spec.GroupIds != nil {
@@ -176,6 +210,16 @@ func (s *eventsService) Create(ctx context.Context, tenantID, campID uuid.UUID,
 		RecurrenceRule:     recurrenceRuleJSON,
 	}
 
+	// Validate the location is not out of service for maintenance
+	if err := s.checkLocationInService(ctx, tenantID, campID, event.LocationID, []domain.Event{*event}); err != nil {
+		return nil, err
+	}
+
+	// Validate the campers of the event's groups are eligible for its activity
+	if err := s.checkCampersEligible(ctx, tenantID, campID, []domain.Event{*event}, nil); err != nil {
+		return nil, err
+	}
+
 	if err := s.repo.Create(ctx, event); err != nil {
 		return nil, pkgerrors.InternalServerError("Failed to create event", err)
 	}
@@ -226,6 +270,11 @@ func (s *eventsService) CreateRecurringSeries(ctx context.Context, tenantID, cam
 		}
 	}
 
+	// Validate assigned staff members are ready to work
+	if err := s.checkStaffReady(ctx, tenantID, campID, req.Spec.RequiredStaff, nil); err != nil {
+		return nil, err
+	}
+
 	// Generate recurrence ID for the series
 	recurrenceID := uuid.New()
 	duration := endDate.Sub(startDate)
@@ -291,6 +340,20 @@ func (s *eventsService) CreateRecurringSeries(ctx context.Context, tenantID, cam
 		}
 	}
 
+	// Validate the location is not out of service for maintenance during any occurrence
+	occurrences := make([]domain.Event, len(events))
+	for i, event := range events {
+		occurrences[i] = *event
+	}
+	if err := s.checkLocationInService(ctx, tenantID, campID, req.Spec.LocationId, occurrences); err != nil {
+		return nil, err
+	}
+
+	// Validate the campers of the series' groups are eligible for its activity on every occurrence
+	if err := s.checkCampersEligible(ctx, tenantID, campID, occurrences, nil); err != nil {
+		return nil, err
+	}
+
 	// Save batch
 	if err := s.repo.CreateBatch(ctx, events); err != nil {
 		return nil, pkgerrors.InternalServerError("Failed to create recurring events", err)
@@ -316,6 +379,11 @@ func (s *eventsService) Update(ctx context.Context, tenantID, campID, id uuid.UU
 		return nil, pkgerrors.InternalServerError("Failed to get event", err)
 	}
 
+	// Validate newly assigned staff members are ready to work
+	if err := s.checkStaffReady(ctx, tenantID, campID, req.Spec.RequiredStaff, existingEvent.RequiredStaff); err != nil {
+		return nil, err
+	}
+
 	// Handle different scopes
 	switch updateScope {
 	case "single":
@@ -366,6 +434,16 @@ func (s *eventsService) updateSingleEvent(ctx context.Context, tenantID, campID
 		return nil, pkgerrors.BadRequest("End date must be after start date", nil)
 	}
 
+	// Validate a new location or time does not fall in a maintenance period
+	if !sameLocation(existing.LocationID, req.Spec.LocationId) || !existing.StartDate.Equal(req.Spec.StartDate) || !existing.EndDate.Equal(req.Spec.EndDate) {
+		moved := domain.Event{StartDate: req.Spec.StartDate, EndDate: req.Spec.EndDate}
+		if err := s.checkLocationInService(ctx, tenantID, campID, req.Spec.LocationId, []domain.Event{moved}); err != nil {
+			return nil, err
+		}
+	}
+
+	previous := *existing
+
 	// Break recurrence link if not parent
 	if !existing.IsRecurrenceParent {
 		existing.RecurrenceID = nil
@@ -397,6 +475,11 @@ func (s *eventsService) updateSingleEvent(ctx context.Context, tenantID, campID
 		existing.RequiredStaff, _ = json.Marshal(req.Spec.RequiredStaff)
 	}
 
+	// Validate the campers of the event's groups are eligible for its activity
+	if err := s.checkCampersEligible(ctx, tenantID, campID, []domain.Event{*existing}, []domain.Event{previous}); err != nil {
+		return nil, err
+	}
+
 	if err := s.repo.Update(ctx, tenantID, campID, existing); err != nil {
 		return nil, pkgerrors.InternalServerError("Failed to update event", err)
 	}
@@ -423,6 +506,18 @@ func (s *eventsService) updateAllInSeries(ctx context.Context, tenantID, campID
 		return nil, pkgerrors.InternalServerError("Failed to get recurrence series", err)
 	}
 
+	// Validate a new location is not out of service for maintenance during the series
+	if !sameLocation(existing.LocationID, req.Spec.LocationId) {
+		if err := s.checkLocationInService(ctx, tenantID, campID, req.Spec.LocationId, seriesEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate the campers of the series' groups are eligible for its activity
+	if err := s.checkCampersEligible(ctx, tenantID, campID, withParticipants(seriesEvents, req), seriesEvents); err != nil {
+		return nil, err
+	}
+
 	// Update all events
 	for i := range seriesEvents {
 		event := &seriesEvents[i]
@@ -475,6 +570,25 @@ func (s *eventsService) updateFutureInSeries(ctx context.Context, tenantID, camp
 		return nil, pkgerrors.InternalServerError("Failed to get recurrence series", err)
 	}
 
+	var futureEvents []domain.Event
+	for _, event := range seriesEvents {
+		if !event.StartDate.Before(existing.StartDate) {
+			futureEvents = append(futureEvents, event)
+		}
+	}
+
+	// Validate a new location is not out of service for maintenance during the future events
+	if !sameLocation(existing.LocationID, req.Spec.LocationId) {
+		if err := s.checkLocationInService(ctx, tenantID, campID, req.Spec.LocationId, futureEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate the campers of the future events' groups are eligible for their activity
+	if err := s.checkCampersEligible(ctx, tenantID, campID, withParticipants(futureEvents, req), futureEvents); err != nil {
+		return nil, err
+	}
+
 	// Update only future events (including current)
 	for i := range seriesEvents {
 		event := &seriesEvents[i]
@@ -519,6 +633,185 @@ func (s *eventsService) updateFutureInSeries(ctx context.Context, tenantID, camp
 	return &apiEvent, nil
 }
 
+// checkLocationInService verifies that no open maintenance ticket takes the location out of service during the events
+func (s *eventsService) checkLocationInService(ctx context.Context, tenantID, campID uuid.UUID, locationID *uuid.UUID, events []domain.Event) error {
+	if locationID == nil || len(events) == 0 {
+		return nil
+	}
+
+	location, err := s.locationsRepo.GetByID(ctx, tenantID, campID, *locationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Location not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to validate location", err)
+	}
+
+	periods := make([]usePeriod, len(events))
+	for i, event := range events {
+		periods[i] = usePeriod{start: event.StartDate, end: event.EndDate}
+	}
+	return checkInService(ctx, s.ticketsRepo, tenantID, campID, domain.MaintenanceEntityTypeLocation, location.ID, location.AreaID, location.Name, periods...)
+}
+
+// sameLocation reports whether two optional location IDs refer to the same location
+func sameLocation(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// checkCampersEligible verifies that the campers of the events' groups meet the eligibility rules of the events'
+// activities. When previous versions of the events are given, campers that were already ineligible for them are
+// not reported again, so unrelated edits of an event are not blocked.
+func (s *eventsService) checkCampersEligible(ctx context.Context, tenantID, campID uuid.UUID, events, previous []domain.Event) error {
+	hasActivity := false
+	for i := range events {
+		if events[i].ActivityID != nil {
+			hasActivity = true
+			break
+		}
+	}
+	if !hasActivity {
+		return nil
+	}
+
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	checker, err := s.eligibility.newChecker(ctx, tenantID, campID)
+	if err != nil {
+		return err
+	}
+
+	// Campers enrolled on each day with events
+	enrolled := make(map[time.Time]map[uuid.UUID]*domain.Camper)
+	ineligibleFor := func(event *domain.Event) ([]api.IneligibleCamper, error) {
+		if event.ActivityID == nil {
+			return nil, nil
+		}
+		day := localDate(event.StartDate, loc)
+		if _, ok := enrolled[day]; !ok {
+			if enrolled[day], err = s.eligibility.enrolledOn(ctx, tenantID, campID, day); err != nil {
+				return nil, err
+			}
+		}
+		result, _, err := checker.checkEvent(ctx, event, day, enrolled[day])
+		if err != nil {
+			return nil, err
+		}
+		return result.IneligibleCampers, nil
+	}
+
+	for i := range events {
+		event := &events[i]
+		ineligible, err := ineligibleFor(event)
+		if err != nil {
+			return err
+		}
+		if len(ineligible) == 0 {
+			continue
+		}
+
+		known := make(map[uuid.UUID]bool)
+		if i < len(previous) {
+			before, err := ineligibleFor(&previous[i])
+			if err != nil {
+				return err
+			}
+			for _, camper := range before {
+				known[camper.CamperId] = true
+			}
+		}
+
+		var added []api.IneligibleCamper
+		for _, camper := range ineligible {
+			if !known[camper.CamperId] {
+				added = append(added, camper)
+			}
+		}
+		if len(added) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("%s is not eligible for %s on %s: %s", added[0].CamperName, event.Name,
+			localDate(event.StartDate, loc).Format("2006-01-02"), strings.Join(added[0].Reasons, "; "))
+		if len(added) > 1 {
+			message += fmt.Sprintf(" (and %d more ineligible campers)", len(added)-1)
+		}
+		return pkgerrors.Conflict(message+"; exclude them from the event or change its groups", nil)
+	}
+	return nil
+}
+
+// withParticipants returns copies of the events of a series with the activity, groups and excluded campers
+// of an update applied, for validating them before they are saved
+func withParticipants(events []domain.Event, req *api.EventUpdateRequest) []domain.Event {
+	updated := make([]domain.Event, len(events))
+	for i, event := range events {
+		event.ActivityID = req.Spec.ActivityId
+		if req.Spec.GroupIds != nil {
+			event.GroupIDs, _ = json.Marshal(req.Spec.GroupIds)
+		}
+		if req.Spec.ExcludeCamperIds != nil {
+			event.ExcludeCamperIDs, _ = json.Marshal(req.Spec.ExcludeCamperIds)
+		}
+		updated[i] = event
+	}
+	return updated
+}
+
+// checkStaffReady verifies that the staff members assigned to required staff positions have completed
+// their onboarding. Staff members already assigned before are not checked again.
+func (s *eventsService) checkStaffReady(ctx context.Context, tenantID, campID uuid.UUID, positions *[]api.EventRequiredStaffPosition, previous json.RawMessage) error {
+	if positions == nil {
+		return nil
+	}
+
+	assigned := make(map[uuid.UUID]bool)
+	if len(previous) > 0 {
+		var previousPositions []api.EventRequiredStaffPosition
+		if err := json.Unmarshal(previous, &previousPositions); err == nil {
+			for _, position := range previousPositions {
+				if position.AssignedStaffId != nil {
+					assigned[*position.AssignedStaffId] = true
+				}
+			}
+		}
+	}
+
+	var staffMemberIDs []uuid.UUID
+	for _, position := range *positions {
+		if position.AssignedStaffId != nil && !assigned[*position.AssignedStaffId] {
+			assigned[*position.AssignedStaffId] = true
+			staffMemberIDs = append(staffMemberIDs, *position.AssignedStaffId)
+		}
+	}
+	if len(staffMemberIDs) == 0 {
+		return nil
+	}
+
+	progress, err := loadOnboardingProgress(ctx, tenantID, campID, nil, s.templatesRepo, s.completionsRepo, s.certificationsRepo, s.campsRepo)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range staffMemberIDs {
+		staffMember, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, id)
+		if err != nil {
+			return pkgerrors.BadRequest("Staff member not found: "+id.String(), err)
+		}
+		if onboarding := progress.staffOnboarding(staffMember); !onboarding.Ready {
+			return pkgerrors.Conflict(fmt.Sprintf("%s has not completed onboarding (%d of %d done) and cannot be assigned to events", staffMember.Name, onboarding.CompletedCount, onboarding.TotalCount), nil)
+		}
+	}
+	return nil
+}
+
 // generateRecurrenceDates generates dates for recurring events based on the recurrence rule
 // This is a simplified implementation - you may want to use the frontend logic
 func generateRecurrenceDates(startDate time.Time, rule *api.RecurrenceRule) []time.Time {
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// groupMembership re-evaluates the camper members of rule-based groups.
+// Members are stored in group_campers like those of static groups, so everything reading
+// group membership (events, attendance, housing) sees rule-based groups the same way.
+type groupMembership struct {
+	groupsRepo   GroupsRepository
+	campersRepo  CampersRepository
+	sessionsRepo SessionsRepository
+}
+
+// newGroupMembership creates a new rule-based group membership evaluator
+func newGroupMembership(groupsRepo GroupsRepository, campersRepo CampersRepository, sessionsRepo SessionsRepository) *groupMembership {
+	return &groupMembership{
+		groupsRepo:   groupsRepo,
+		campersRepo:  campersRepo,
+		sessionsRepo: sessionsRepo,
+	}
+}
+
+// refresh recomputes the members of every rule-based group of the camp
+func (m *groupMembership) refresh(ctx context.Context, tenantID, campID uuid.UUID) error {
+	groups, err := m.groupsRepo.ListRuleBased(ctx, tenantID, campID)
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	return m.apply(ctx, tenantID, campID, groups)
+}
+
+// refreshGroup recomputes the members of a single rule-based group
+func (m *groupMembership) refreshGroup(ctx context.Context, group *domain.Group) error {
+	if !group.IsRuleBased() {
+		return nil
+	}
+
+	return m.apply(ctx, group.TenantID, group.CampID, []domain.Group{*group})
+}
+
+// refreshCamper recomputes the rule-based group memberships of a single camper, leaving the
+// other members of the groups untouched
+func (m *groupMembership) refreshCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) error {
+	groups, err := m.groupsRepo.ListRuleBased(ctx, tenantID, campID)
+	if err != nil {
+		return err
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	camper, err := m.campersRepo.GetWithSkills(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return err
+	}
+
+	sessionStart := m.sessionStarts(ctx, tenantID, campID)
+	groupIDs := make([]uuid.UUID, 0, len(groups))
+	memberOf := []uuid.UUID{}
+	for i := range groups {
+		group := &groups[i]
+		groupIDs = append(groupIDs, group.ID)
+
+		rule, err := domain.ParseMembershipRule(group.MembershipRules)
+		if err != nil {
+			return fmt.Errorf("invalid membership rules of group '%s': %w", group.Name, err)
+		}
+
+		matches, err := matchesGroup(group, rule, camper, sessionStart)
+		if err != nil {
+			return err
+		}
+		if matches {
+			memberOf = append(memberOf, group.ID)
+		}
+	}
+
+	return m.groupsRepo.ReplaceCamperGroups(ctx, tenantID, campID, camperID, groupIDs, memberOf)
+}
+
+// apply evaluates the rules of the given groups against all campers of the camp and stores the matches
+func (m *groupMembership) apply(ctx context.Context, tenantID, campID uuid.UUID, groups []domain.Group) error {
+	campers, err := m.campersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return err
+	}
+
+	sessionStart := m.sessionStarts(ctx, tenantID, campID)
+	for i := range groups {
+		group := &groups[i]
+		rule, err := domain.ParseMembershipRule(group.MembershipRules)
+		if err != nil {
+			return fmt.Errorf("invalid membership rules of group '%s': %w", group.Name, err)
+		}
+
+		camperIDs := []uuid.UUID{}
+		for i := range campers {
+			matches, err := matchesGroup(group, rule, &campers[i], sessionStart)
+			if err != nil {
+				return err
+			}
+			if matches {
+				camperIDs = append(camperIDs, campers[i].ID)
+			}
+		}
+
+		if err := m.groupsRepo.ReplaceCampers(ctx, tenantID, campID, group.ID, camperIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sessionStarts returns a lookup of session start dates. Session start dates are shared by most
+// campers, so each session is looked up only once
+func (m *groupMembership) sessionStarts(ctx context.Context, tenantID, campID uuid.UUID) func(uuid.UUID) (*time.Time, error) {
+	starts := make(map[uuid.UUID]*time.Time)
+	return func(sessionID uuid.UUID) (*time.Time, error) {
+		if start, ok := starts[sessionID]; ok {
+			return start, nil
+		}
+		session, err := m.sessionsRepo.GetByID(ctx, tenantID, campID, sessionID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get session: %w", err)
+		}
+		var start *time.Time
+		if session != nil {
+			start = &session.StartDate
+		}
+		starts[sessionID] = start
+		return start, nil
+	}
+}
+
+// matchesGroup reports whether a camper matches the membership rule of a rule-based group
+func matchesGroup(group *domain.Group, rule domain.MembershipRule, camper *domain.Camper, sessionStart func(uuid.UUID) (*time.Time, error)) (bool, error) {
+	// A group tied to a session only considers campers actively enrolled in it
+	sessionID := camper.SessionID
+	if group.SessionID != nil {
+		if !activelyEnrolled(camper, *group.SessionID) {
+			return false, nil
+		}
+		sessionID = *group.SessionID
+	}
+
+	start, err := sessionStart(sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	return rule.Matches(camper, start), nil
+}
+
+// activelyEnrolled reports whether the camper has an active enrollment in the session
+func activelyEnrolled(camper *domain.Camper, sessionID uuid.UUID) bool {
+	for _, enrollment := range camper.Enrollments {
+		if enrollment.SessionID == sessionID && enrollment.IsActive() {
+			return true
+		}
+	}
+	return false
+}
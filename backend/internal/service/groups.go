@@ -36,14 +36,18 @@ type groupsService struct {
 	repo             GroupsRepository
 	sessionsRepo     SessionsRepository
 	housingRoomsRepo HousingRoomsRepository
+	customFields     CustomFieldsRepository
+	membership       *groupMembership
 }
 
 // NewGroupsService creates a new groups service
-func NewGroupsService(repo GroupsRepository, sessionsRepo SessionsRepository, housingRoomsRepo HousingRoomsRepository) GroupsService {
+func NewGroupsService(repo GroupsRepository, sessionsRepo SessionsRepository, housingRoomsRepo HousingRoomsRepository, campersRepo CampersRepository, customFields CustomFieldsRepository) GroupsService {
 	return &groupsService{
 		repo:             repo,
 		sessionsRepo:     sessionsRepo,
 		housingRoomsRepo: housingRoomsRepo,
+		customFields:     customFields,
+		membership:       newGroupMembership(repo, campersRepo, sessionsRepo),
 	}
 }
 
@@ -89,17 +93,31 @@ func (s *groupsService) Create(ctx context.Context, tenantId uuid.UUID, campId u
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	membershipRules, err := parseMembershipRules(req.Spec.MembershipRules)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Validate custom field values
+	customFields, err := resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeGroup, req.Spec.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
 	domainGroup := domain.Group{
-		TenantID:      tenantId,
-		CampID:        campId,
-		Name:          req.Meta.Name,
-		Description:   utils.PtrToString(req.Meta.Description),
-		SessionID:     req.Spec.SessionId,
-		HousingRoomID: req.Spec.HousingRoomId,
+		TenantID:        tenantId,
+		CampID:          campId,
+		Name:            req.Meta.Name,
+		Description:     utils.PtrToString(req.Meta.Description),
+		SessionID:       req.Spec.SessionId,
+		HousingRoomID:   req.Spec.HousingRoomId,
+		MembershipRules: membershipRules,
+		CustomFields:    customFields,
 	}
 
 	domainGroup.GroupCampers = []domain.GroupCamper{}
-	if req.Spec.CamperIds != nil {
+	// Campers of rule-based groups are derived from the rules, so camperIds are ignored for them
+	if req.Spec.CamperIds != nil && !domainGroup.IsRuleBased() {
 		for _, camperId := range *req.Spec.CamperIds {
 			domainGroup.GroupCampers = append(domainGroup.GroupCampers, domain.GroupCamper{CamperID: camperId, GroupID: domainGroup.ID})
 		}
@@ -122,8 +140,7 @@ func (s *groupsService) Create(ctx context.Context, tenantId uuid.UUID, campId u
 		return nil, pkgerrors.InternalServerError(fmt.Sprintf("Failed to create group: %s", err.Error()), err)
 	}
 
-	apiGroup := domainGroup.ToAPI()
-	return &apiGroup, nil
+	return s.refreshMembership(ctx, &domainGroup)
 }
 
 // Update updates an existing group
@@ -133,6 +150,11 @@ func (s *groupsService) Update(ctx context.Context, tenantId uuid.UUID, campId u
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	membershipRules, err := parseMembershipRules(req.Spec.MembershipRules)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
 	existingGroup, err := s.repo.GetByID(ctx, tenantId, campId, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -146,8 +168,17 @@ func (s *groupsService) Update(ctx context.Context, tenantId uuid.UUID, campId u
 	existingGroup.Description = utils.PtrToString(req.Meta.Description)
 	existingGroup.SessionID = req.Spec.SessionId
 	existingGroup.HousingRoomID = req.Spec.HousingRoomId
+	existingGroup.MembershipRules = membershipRules
+	// Custom field values are only replaced when provided
+	if req.Spec.CustomFields != nil {
+		existingGroup.CustomFields, err = resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeGroup, req.Spec.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
 	existingGroup.GroupCampers = []domain.GroupCamper{}
-	if req.Spec.CamperIds != nil {
+	// Campers of rule-based groups are derived from the rules, so camperIds are ignored for them
+	if req.Spec.CamperIds != nil && !existingGroup.IsRuleBased() {
 		for _, camperId := range *req.Spec.CamperIds {
 			existingGroup.GroupCampers = append(existingGroup.GroupCampers, domain.GroupCamper{CamperID: camperId, GroupID: existingGroup.ID})
 		}
@@ -169,8 +200,7 @@ func (s *groupsService) Update(ctx context.Context, tenantId uuid.UUID, campId u
 		return nil, pkgerrors.InternalServerError("Failed to update group", err)
 	}
 
-	apiGroup := existingGroup.ToAPI()
-	return &apiGroup, nil
+	return s.refreshMembership(ctx, existingGroup)
 }
 
 // Delete deletes a group by ID
@@ -191,6 +221,34 @@ func (s *groupsService) Delete(ctx context.Context, tenantId uuid.UUID, campId u
 	return nil
 }
 
+// refreshMembership evaluates the membership rules of a saved group and returns the group with its resulting members
+func (s *groupsService) refreshMembership(ctx context.Context, group *domain.Group) (*api.Group, error) {
+	if err := s.membership.refreshGroup(ctx, group); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	updatedGroup, err := s.repo.GetByID(ctx, group.TenantID, group.CampID, group.ID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated group", err)
+	}
+
+	apiGroup := updatedGroup.ToAPI()
+	return &apiGroup, nil
+}
+
+// parseMembershipRules validates the membership rules of a group request
+func parseMembershipRules(expressions *[]string) ([]string, error) {
+	if expressions == nil || len(*expressions) == 0 {
+		return []string{}, nil
+	}
+
+	if _, err := domain.ParseMembershipRule(*expressions); err != nil {
+		return nil, fmt.Errorf("invalid membership rules: %w", err)
+	}
+
+	return *expressions, nil
+}
+
 func (s *groupsService) validateGroupRequest(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, sessionId *uuid.UUID, housingRoomId *uuid.UUID, excludeGroupId *uuid.UUID) error {
 	if housingRoomId != nil && sessionId == nil {
 		return fmt.Errorf("group with housing room must have a session ID")
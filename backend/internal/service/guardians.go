@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// GuardiansService defines the interface for guardian business logic
+type GuardiansService interface {
+	// List retrieves guardians with pagination and optional search
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.GuardiansListResponse, error)
+
+	// GetByID retrieves a single guardian by ID
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Guardian, error)
+
+	// Create creates a new guardian
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.GuardianCreationRequest) (*api.Guardian, error)
+
+	// Update updates an existing guardian
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.GuardianUpdateRequest) (*api.Guardian, error)
+
+	// Delete deletes a guardian by ID
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetCamperContacts retrieves the guardians, emergency contacts and authorized pickups of a camper
+	GetCamperContacts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperContacts, error)
+
+	// GetCamperFamily retrieves the guardians and siblings of a camper
+	GetCamperFamily(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperFamily, error)
+}
+
+// guardiansService implements GuardiansService
+type guardiansService struct {
+	repo        GuardiansRepository
+	campersRepo CampersRepository
+}
+
+// NewGuardiansService creates a new guardians service
+func NewGuardiansService(repo GuardiansRepository, campersRepo CampersRepository) GuardiansService {
+	return &guardiansService{
+		repo:        repo,
+		campersRepo: campersRepo,
+	}
+}
+
+// List retrieves guardians with pagination and optional search
+func (s *guardiansService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.GuardiansListResponse, error) {
+	guardians, total, err := s.repo.List(ctx, tenantID, campID, limit, offset, search, filterStrings, sortBy, sortOrder)
+	if err != nil {
+		return nil, pkgerrors.BadRequest("Failed to list guardians", err)
+	}
+
+	// Convert domain guardians to API guardians
+	apiGuardians := make([]api.Guardian, len(guardians))
+	for i, guardian := range guardians {
+		apiGuardians[i] = guardian.ToAPI()
+	}
+
+	return &api.GuardiansListResponse{
+		Items:  apiGuardians,
+		Limit:  limit,
+		Offset: offset,
+		Total:  int(total),
+	}, nil
+}
+
+// GetByID retrieves a single guardian by ID
+func (s *guardiansService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Guardian, error) {
+	guardian, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Guardian not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get guardian", err)
+	}
+
+	apiGuardian := guardian.ToAPI()
+	return &apiGuardian, nil
+}
+
+// Create creates a new guardian
+func (s *guardiansService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.GuardianCreationRequest) (*api.Guardian, error) {
+	guardian := &domain.Guardian{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applySpec(ctx, tenantID, campID, guardian, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, guardian); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create guardian", err)
+	}
+
+	apiGuardian := guardian.ToAPI()
+	return &apiGuardian, nil
+}
+
+// Update updates an existing guardian
+func (s *guardiansService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.GuardianUpdateRequest) (*api.Guardian, error) {
+	// Check if guardian exists and belongs to tenant/camp
+	existingGuardian, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Guardian not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get guardian", err)
+	}
+
+	if err := s.applySpec(ctx, tenantID, campID, existingGuardian, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, existingGuardian); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update guardian", err)
+	}
+
+	// Fetch updated guardian to get latest timestamps
+	updatedGuardian, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated guardian", err)
+	}
+
+	apiGuardian := updatedGuardian.ToAPI()
+	return &apiGuardian, nil
+}
+
+// Delete deletes a guardian by ID
+func (s *guardiansService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	// Check if guardian exists
+	_, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Guardian not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get guardian", err)
+	}
+
+	// Delete the guardian
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete guardian", err)
+	}
+
+	return nil
+}
+
+// GetCamperContacts retrieves the guardians, emergency contacts and authorized pickups of a camper
+func (s *guardiansService) GetCamperContacts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperContacts, error) {
+	guardians, err := s.listCamperGuardians(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.CamperContacts{
+		CamperId:          camperID,
+		Guardians:         make([]api.Guardian, 0, len(guardians)),
+		EmergencyContacts: []api.Guardian{},
+		AuthorizedPickups: []api.AuthorizedPickup{},
+	}
+
+	// Guardians are already ordered by emergency priority
+	for _, guardian := range guardians {
+		apiGuardian := guardian.ToAPI()
+		response.Guardians = append(response.Guardians, apiGuardian)
+		if guardian.IsEmergencyContact {
+			response.EmergencyContacts = append(response.EmergencyContacts, apiGuardian)
+		}
+		response.AuthorizedPickups = append(response.AuthorizedPickups, guardian.PickupList()...)
+	}
+
+	return response, nil
+}
+
+// GetCamperFamily retrieves the guardians and siblings of a camper
+func (s *guardiansService) GetCamperFamily(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperFamily, error) {
+	guardians, err := s.listCamperGuardians(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.CamperFamily{
+		CamperId:  camperID,
+		Guardians: make([]api.Guardian, 0, len(guardians)),
+		Siblings:  []api.Camper{},
+	}
+
+	// Siblings are the other campers linked to any of the camper's guardians
+	seen := map[uuid.UUID]bool{camperID: true}
+	var siblingIDs []uuid.UUID
+	for _, guardian := range guardians {
+		response.Guardians = append(response.Guardians, guardian.ToAPI())
+		for _, gc := range guardian.GuardianCampers {
+			if !seen[gc.CamperID] {
+				seen[gc.CamperID] = true
+				siblingIDs = append(siblingIDs, gc.CamperID)
+			}
+		}
+	}
+
+	siblings, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, siblingIDs)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get siblings", err)
+	}
+
+	for _, sibling := range siblings {
+		response.Siblings = append(response.Siblings, sibling.ToAPI())
+	}
+
+	return response, nil
+}
+
+// listCamperGuardians verifies the camper exists and returns its guardians
+func (s *guardiansService) listCamperGuardians(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) ([]domain.Guardian, error) {
+	if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, camperID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camper not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	guardians, err := s.repo.ListByCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list guardians", err)
+	}
+
+	return guardians, nil
+}
+
+// applySpec validates the request and copies its meta and spec onto the domain guardian
+func (s *guardiansService) applySpec(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, guardian *domain.Guardian, meta api.EntityCreationRequestMeta, spec api.GuardianSpec) error {
+	if strings.TrimSpace(spec.Relationship) == "" {
+		return pkgerrors.BadRequest("Relationship is required", nil)
+	}
+
+	if spec.EmergencyPriority != nil && *spec.EmergencyPriority < 1 {
+		return pkgerrors.BadRequest("Emergency priority must be at least 1", nil)
+	}
+
+	if err := s.validateCampers(ctx, tenantID, campID, spec.CamperIds); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	var phonesJSON []byte
+	if spec.Phones != nil {
+		for _, phone := range *spec.Phones {
+			if strings.TrimSpace(phone.Number) == "" {
+				return pkgerrors.BadRequest("Phone number cannot be empty", nil)
+			}
+		}
+		var err error
+		phonesJSON, err = json.Marshal(spec.Phones)
+		if err != nil {
+			return pkgerrors.BadRequest("Invalid phones format", err)
+		}
+	}
+
+	var authorizedPickupsJSON []byte
+	if spec.AuthorizedPickups != nil {
+		for _, pickup := range *spec.AuthorizedPickups {
+			if strings.TrimSpace(pickup.Name) == "" {
+				return pkgerrors.BadRequest("Authorized pickup name cannot be empty", nil)
+			}
+		}
+		var err error
+		authorizedPickupsJSON, err = json.Marshal(spec.AuthorizedPickups)
+		if err != nil {
+			return pkgerrors.BadRequest("Invalid authorizedPickups format", err)
+		}
+	}
+
+	guardian.Name = meta.Name
+	guardian.Description = utils.PtrToString(meta.Description)
+	guardian.Relationship = spec.Relationship
+	guardian.Phones = phonesJSON
+	guardian.Email = ""
+	if spec.Email != nil {
+		guardian.Email = string(*spec.Email)
+	}
+	guardian.Address = utils.PtrToString(spec.Address)
+	guardian.IsEmergencyContact = utils.PtrToBool(spec.IsEmergencyContact)
+	guardian.EmergencyPriority = spec.EmergencyPriority
+	guardian.CustodyNotes = utils.PtrToString(spec.CustodyNotes)
+	guardian.CanPickUp = utils.PtrToBool(spec.CanPickUp)
+	guardian.AuthorizedPickups = authorizedPickupsJSON
+
+	guardian.GuardianCampers = []domain.GuardianCamper{}
+	if spec.CamperIds != nil {
+		for _, camperID := range *spec.CamperIds {
+			guardian.GuardianCampers = append(guardian.GuardianCampers, domain.GuardianCamper{GuardianID: guardian.ID, CamperID: camperID})
+		}
+	}
+
+	return nil
+}
+
+// validateCampers checks that all linked campers exist in the camp
+func (s *guardiansService) validateCampers(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperIDs *[]uuid.UUID) error {
+	if camperIDs == nil || len(*camperIDs) == 0 {
+		return nil
+	}
+
+	campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, *camperIDs)
+	if err != nil {
+		return fmt.Errorf("failed to check camper existence: %w", err)
+	}
+
+	foundIDs := make(map[uuid.UUID]bool)
+	for _, camper := range campers {
+		foundIDs[camper.ID] = true
+	}
+	for _, camperID := range *camperIDs {
+		if !foundIDs[camperID] {
+			return fmt.Errorf("camper with id '%s' not found", camperID.String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,622 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// HousingAssignmentsService defines the interface for automatic housing assignment
+type HousingAssignmentsService interface {
+	// List retrieves housing assignment proposals, newest first, optionally limited to a session
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID) (*api.HousingAssignmentsListResponse, error)
+
+	// GetByID retrieves a single housing assignment proposal by ID
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.HousingAssignment, error)
+
+	// Create generates a proposal placing the campers of a session into its housing groups
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.HousingAssignmentRequest) (*api.HousingAssignment, error)
+
+	// Accept applies a proposal to the campers of the session
+	Accept(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.HousingAssignment, error)
+}
+
+// housingAssignmentsService implements HousingAssignmentsService
+type housingAssignmentsService struct {
+	repo             HousingAssignmentsRepository
+	sessionsRepo     SessionsRepository
+	groupsRepo       GroupsRepository
+	housingRoomsRepo HousingRoomsRepository
+	staffMembersRepo StaffMembersRepository
+	campersRepo      CampersRepository
+	enrollmentsRepo  CamperEnrollmentsRepository
+	bunkRequestsRepo BunkRequestsRepository
+	membership       *groupMembership
+}
+
+// NewHousingAssignmentsService creates a new housing assignments service
+func NewHousingAssignmentsService(repo HousingAssignmentsRepository, sessionsRepo SessionsRepository, groupsRepo GroupsRepository, housingRoomsRepo HousingRoomsRepository, staffMembersRepo StaffMembersRepository, campersRepo CampersRepository, enrollmentsRepo CamperEnrollmentsRepository, bunkRequestsRepo BunkRequestsRepository) HousingAssignmentsService {
+	return &housingAssignmentsService{
+		repo:             repo,
+		sessionsRepo:     sessionsRepo,
+		groupsRepo:       groupsRepo,
+		housingRoomsRepo: housingRoomsRepo,
+		staffMembersRepo: staffMembersRepo,
+		campersRepo:      campersRepo,
+		enrollmentsRepo:  enrollmentsRepo,
+		bunkRequestsRepo: bunkRequestsRepo,
+		membership:       newGroupMembership(groupsRepo, campersRepo, sessionsRepo),
+	}
+}
+
+// List retrieves housing assignment proposals, newest first, optionally limited to a session
+func (s *housingAssignmentsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID *uuid.UUID) (*api.HousingAssignmentsListResponse, error) {
+	assignments, err := s.repo.List(ctx, tenantID, campID, sessionID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list housing assignments", err)
+	}
+
+	items := make([]api.HousingAssignment, len(assignments))
+	for i, assignment := range assignments {
+		items[i] = assignment.ToAPI()
+	}
+
+	return &api.HousingAssignmentsListResponse{Items: items}, nil
+}
+
+// GetByID retrieves a single housing assignment proposal by ID
+func (s *housingAssignmentsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.HousingAssignment, error) {
+	assignment, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Housing assignment not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get housing assignment", err)
+	}
+
+	apiAssignment := assignment.ToAPI()
+	return &apiAssignment, nil
+}
+
+// Create generates a proposal placing the campers of a session into its housing groups
+func (s *housingAssignmentsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.HousingAssignmentRequest) (*api.HousingAssignment, error) {
+	if req.MaxAgeSpanMonths != nil && *req.MaxAgeSpanMonths < 0 {
+		return nil, pkgerrors.BadRequest("Maximum age span cannot be negative", nil)
+	}
+
+	if _, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, req.SessionId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Session not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get session", err)
+	}
+
+	planner := &housingPlanner{
+		separateGenders: true,
+		maxAgeSpan:      req.MaxAgeSpanMonths,
+		requested:       make(map[uuid.UUID][]uuid.UUID),
+		requestedBy:     make(map[uuid.UUID][]uuid.UUID),
+		placement:       make(map[uuid.UUID]*housingSlot),
+	}
+	if req.SeparateGenders != nil {
+		planner.separateGenders = *req.SeparateGenders
+	}
+	keepExisting := true
+	if req.KeepExisting != nil {
+		keepExisting = *req.KeepExisting
+	}
+
+	if err := s.loadSlots(ctx, tenantID, campID, req.SessionId, planner); err != nil {
+		return nil, err
+	}
+	if len(planner.slots) == 0 {
+		return nil, pkgerrors.BadRequest("Session has no housing groups", nil)
+	}
+
+	// Campers of the session are the ones actively enrolled in it
+	enrollments, err := s.enrollmentsRepo.ListActiveBySession(ctx, tenantID, campID, req.SessionId)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list session enrollments", err)
+	}
+
+	camperIDs := make([]uuid.UUID, 0, len(enrollments))
+	existingGroups := make(map[uuid.UUID]*uuid.UUID, len(enrollments))
+	for _, enrollment := range enrollments {
+		camperIDs = append(camperIDs, enrollment.CamperID)
+		existingGroups[enrollment.CamperID] = enrollment.HousingGroupID
+	}
+
+	campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, camperIDs)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get campers", err)
+	}
+
+	requests, err := s.bunkRequestsRepo.List(ctx, tenantID, campID, &req.SessionId, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list bunk requests", err)
+	}
+
+	inSession := make(map[uuid.UUID]bool, len(campers))
+	for _, camper := range campers {
+		inSession[camper.ID] = true
+	}
+	for _, request := range requests {
+		if inSession[request.CamperID] && inSession[request.RequestedCamperID] {
+			planner.requested[request.CamperID] = append(planner.requested[request.CamperID], request.RequestedCamperID)
+			planner.requestedBy[request.RequestedCamperID] = append(planner.requestedBy[request.RequestedCamperID], request.CamperID)
+		}
+	}
+
+	// Campers that keep their housing group go in first so the others are placed around them
+	slotsByGroup := make(map[uuid.UUID]*housingSlot, len(planner.slots))
+	for _, slot := range planner.slots {
+		slotsByGroup[slot.group.ID] = slot
+	}
+
+	pending := []*domain.Camper{}
+	for i := range campers {
+		camper := &campers[i]
+		if keepExisting {
+			groupID := existingGroups[camper.ID]
+			if groupID == nil && camper.SessionID == req.SessionId {
+				groupID = camper.HousingGroupID
+			}
+			if groupID != nil {
+				if slot, ok := slotsByGroup[*groupID]; ok {
+					planner.place(slot, []*domain.Camper{camper})
+					continue
+				}
+			}
+		}
+		pending = append(pending, camper)
+	}
+
+	unassigned := planner.placeAll(pending)
+
+	groupsJSON, err := json.Marshal(planner.groups())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to encode housing groups", err)
+	}
+	unassignedJSON, err := json.Marshal(unassigned)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to encode unassigned campers", err)
+	}
+	scoreJSON, err := json.Marshal(planner.score())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to encode score", err)
+	}
+
+	assignment := &domain.HousingAssignment{
+		TenantID:         tenantID,
+		CampID:           campID,
+		SessionID:        req.SessionId,
+		Status:           domain.HousingAssignmentStatusProposed,
+		MaxAgeSpanMonths: req.MaxAgeSpanMonths,
+		SeparateGenders:  planner.separateGenders,
+		KeepExisting:     keepExisting,
+		Groups:           groupsJSON,
+		Unassigned:       unassignedJSON,
+		Score:            scoreJSON,
+	}
+	assignment.CreatedBy, _ = currentUser(ctx)
+
+	// Save to database
+	if err := s.repo.Create(ctx, assignment); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create housing assignment", err)
+	}
+
+	apiAssignment := assignment.ToAPI()
+	return &apiAssignment, nil
+}
+
+// Accept applies a proposal to the campers of the session
+func (s *housingAssignmentsService) Accept(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.HousingAssignment, error) {
+	assignment, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Housing assignment not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get housing assignment", err)
+	}
+
+	if assignment.Status != domain.HousingAssignmentStatusProposed {
+		return nil, pkgerrors.Conflict(fmt.Sprintf("Housing assignment is %s, only proposed assignments can be accepted", assignment.Status), nil)
+	}
+
+	groups, err := s.groupsRepo.ListHousingBySession(ctx, tenantID, campID, assignment.SessionID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list housing groups", err)
+	}
+
+	housingGroupIDs := make([]uuid.UUID, 0, len(groups))
+	exists := make(map[uuid.UUID]bool, len(groups))
+	for _, group := range groups {
+		housingGroupIDs = append(housingGroupIDs, group.ID)
+		exists[group.ID] = true
+	}
+
+	placements := assignment.Placements()
+	for _, groupID := range placements {
+		if groupID != nil && !exists[*groupID] {
+			return nil, pkgerrors.Conflict("A housing group of the proposal no longer exists, generate a new proposal", nil)
+		}
+	}
+
+	acceptedBy, _ := currentUser(ctx)
+	if err := s.repo.Accept(ctx, tenantID, campID, assignment, placements, housingGroupIDs, acceptedBy); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to accept housing assignment", err)
+	}
+
+	// Rules may select campers by housing group
+	if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	// Fetch the accepted proposal
+	accepted, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get accepted housing assignment", err)
+	}
+
+	apiAssignment := accepted.ToAPI()
+	return &apiAssignment, nil
+}
+
+// loadSlots loads the housing groups of a session with their beds and staff counselors into the planner
+func (s *housingAssignmentsService) loadSlots(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, sessionID uuid.UUID, planner *housingPlanner) error {
+	groups, err := s.groupsRepo.ListHousingBySession(ctx, tenantID, campID, sessionID)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to list housing groups", err)
+	}
+
+	for _, group := range groups {
+		room, err := s.housingRoomsRepo.GetByID(ctx, tenantID, campID, *group.HousingRoomID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return pkgerrors.InternalServerError("Failed to get housing room", err)
+		}
+
+		slot := &housingSlot{group: group, beds: room.Beds}
+
+		// Counselors take a bed, and a group whose counselors share a gender houses that gender
+		staffGender := ""
+		mixedStaff := false
+		for _, gsm := range group.GroupStaffMembers {
+			staffMember, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, gsm.StaffMemberID)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					continue
+				}
+				return pkgerrors.InternalServerError("Failed to get staff member", err)
+			}
+			slot.staffCount++
+			if staffGender == "" {
+				staffGender = staffMember.Gender
+			} else if !strings.EqualFold(staffGender, staffMember.Gender) {
+				mixedStaff = true
+			}
+		}
+		if planner.separateGenders && !mixedStaff {
+			slot.gender = staffGender
+		}
+
+		planner.slots = append(planner.slots, slot)
+	}
+
+	return nil
+}
+
+// housingSlot is a housing group being filled by the planner
+type housingSlot struct {
+	group      domain.Group
+	beds       int
+	staffCount int
+	gender     string
+	campers    []*domain.Camper
+}
+
+// freeBeds returns the number of beds left for campers
+func (s *housingSlot) freeBeds() int {
+	return s.beds - s.staffCount - len(s.campers)
+}
+
+// housingPlanner places campers into the housing groups of a session. Campers who requested
+// each other are kept together where possible; every camper then goes to the group where the
+// most of their requested bunkmates already are, preferring close ages and free beds.
+type housingPlanner struct {
+	slots           []*housingSlot
+	separateGenders bool
+	maxAgeSpan      *int
+	requested       map[uuid.UUID][]uuid.UUID
+	requestedBy     map[uuid.UUID][]uuid.UUID
+	placement       map[uuid.UUID]*housingSlot
+}
+
+// placeAll places the campers, keeping mutual requests together where possible, and returns
+// the campers that could not be placed
+func (p *housingPlanner) placeAll(campers []*domain.Camper) []api.HousingAssignmentUnassigned {
+	unassigned := []api.HousingAssignmentUnassigned{}
+
+	queue := p.mutualUnits(campers)
+	for len(queue) > 0 {
+		unit := queue[0]
+		queue = queue[1:]
+
+		if slot := p.bestSlot(unit); slot != nil {
+			p.place(slot, unit)
+			continue
+		}
+
+		// A unit that fits nowhere as a whole is placed camper by camper, starting right away
+		// so the first placed member draws the others to their group
+		if len(unit) > 1 {
+			singles := make([][]*domain.Camper, len(unit))
+			for i, camper := range unit {
+				singles[i] = []*domain.Camper{camper}
+			}
+			queue = append(singles, queue...)
+			continue
+		}
+
+		unassigned = append(unassigned, api.HousingAssignmentUnassigned{
+			CamperId: unit[0].ID,
+			Reason:   p.unplacedReason(unit[0]),
+		})
+	}
+
+	return unassigned
+}
+
+// mutualUnits groups the campers connected by mutual bunk requests, largest units first
+func (p *housingPlanner) mutualUnits(campers []*domain.Camper) [][]*domain.Camper {
+	parent := make(map[uuid.UUID]uuid.UUID, len(campers))
+	byID := make(map[uuid.UUID]*domain.Camper, len(campers))
+	for _, camper := range campers {
+		parent[camper.ID] = camper.ID
+		byID[camper.ID] = camper
+	}
+
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+
+	for _, camper := range campers {
+		for _, otherID := range p.requested[camper.ID] {
+			other, ok := byID[otherID]
+			if !ok || !containsID(p.requested[otherID], camper.ID) {
+				continue
+			}
+			if p.separateGenders && !strings.EqualFold(camper.Gender, other.Gender) {
+				continue
+			}
+			parent[find(camper.ID)] = find(otherID)
+		}
+	}
+
+	unitsByRoot := make(map[uuid.UUID][]*domain.Camper)
+	roots := []uuid.UUID{}
+	for _, camper := range campers {
+		root := find(camper.ID)
+		if _, ok := unitsByRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+		unitsByRoot[root] = append(unitsByRoot[root], camper)
+	}
+
+	units := make([][]*domain.Camper, len(roots))
+	for i, root := range roots {
+		units[i] = unitsByRoot[root]
+	}
+	sort.SliceStable(units, func(i, j int) bool {
+		return len(units[i]) > len(units[j])
+	})
+
+	return units
+}
+
+// bestSlot returns the housing group the unit fits in best, or nil if it fits nowhere
+func (p *housingPlanner) bestSlot(unit []*domain.Camper) *housingSlot {
+	var best *housingSlot
+	bestAffinity, bestSpan := 0, 0
+
+	for _, slot := range p.slots {
+		if !p.fits(slot, unit) {
+			continue
+		}
+
+		affinity := p.affinity(slot, unit)
+		span := ageSpanMonths(slot.campers, unit)
+		switch {
+		case best == nil,
+			affinity > bestAffinity,
+			affinity == bestAffinity && span < bestSpan,
+			affinity == bestAffinity && span == bestSpan && slot.freeBeds() > best.freeBeds():
+			best, bestAffinity, bestSpan = slot, affinity, span
+		}
+	}
+
+	return best
+}
+
+// fits reports whether the unit can join the housing group
+func (p *housingPlanner) fits(slot *housingSlot, unit []*domain.Camper) bool {
+	if slot.freeBeds() < len(unit) {
+		return false
+	}
+
+	if p.separateGenders {
+		gender := slot.gender
+		for _, camper := range unit {
+			if gender == "" {
+				gender = camper.Gender
+			} else if !strings.EqualFold(gender, camper.Gender) {
+				return false
+			}
+		}
+	}
+
+	if p.maxAgeSpan != nil && ageSpanMonths(slot.campers, unit) > *p.maxAgeSpan {
+		return false
+	}
+
+	return true
+}
+
+// affinity counts the bunk requests between the unit and the campers already in the housing group
+func (p *housingPlanner) affinity(slot *housingSlot, unit []*domain.Camper) int {
+	count := 0
+	for _, camper := range unit {
+		for _, otherID := range p.requested[camper.ID] {
+			if p.placement[otherID] == slot {
+				count++
+			}
+		}
+		for _, otherID := range p.requestedBy[camper.ID] {
+			if p.placement[otherID] == slot {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// place puts the unit into the housing group
+func (p *housingPlanner) place(slot *housingSlot, unit []*domain.Camper) {
+	for _, camper := range unit {
+		slot.campers = append(slot.campers, camper)
+		p.placement[camper.ID] = slot
+	}
+	if p.separateGenders && slot.gender == "" && len(unit) > 0 {
+		slot.gender = unit[0].Gender
+	}
+}
+
+// unplacedReason explains why a camper fits in none of the housing groups
+func (p *housingPlanner) unplacedReason(camper *domain.Camper) string {
+	freeBeds, genderMatch := false, false
+	for _, slot := range p.slots {
+		if slot.freeBeds() <= 0 {
+			continue
+		}
+		freeBeds = true
+		if !p.separateGenders || slot.gender == "" || strings.EqualFold(slot.gender, camper.Gender) {
+			genderMatch = true
+		}
+	}
+
+	switch {
+	case !freeBeds:
+		return "No free beds left in the housing groups of the session"
+	case !genderMatch:
+		return fmt.Sprintf("No housing group with a free bed for gender '%s'", camper.Gender)
+	default:
+		return "No housing group with a free bed within the age span"
+	}
+}
+
+// groups returns the proposed housing groups with their campers ordered by name
+func (p *housingPlanner) groups() []api.HousingAssignmentGroup {
+	groups := make([]api.HousingAssignmentGroup, 0, len(p.slots))
+	for _, slot := range p.slots {
+		sort.Slice(slot.campers, func(i, j int) bool {
+			return slot.campers[i].Name < slot.campers[j].Name
+		})
+
+		camperIDs := make([]uuid.UUID, len(slot.campers))
+		for i, camper := range slot.campers {
+			camperIDs[i] = camper.ID
+		}
+
+		group := api.HousingAssignmentGroup{
+			GroupId:       slot.group.ID,
+			GroupName:     slot.group.Name,
+			HousingRoomId: *slot.group.HousingRoomID,
+			Beds:          slot.beds,
+			StaffCount:    slot.staffCount,
+			CamperIds:     camperIDs,
+		}
+		if p.separateGenders && slot.gender != "" {
+			gender := slot.gender
+			group.Gender = &gender
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// score measures how many bunk requests the placement satisfies
+func (p *housingPlanner) score() api.HousingAssignmentScore {
+	score := api.HousingAssignmentScore{}
+	for camperID, requested := range p.requested {
+		score.CampersWithRequests++
+		satisfied := false
+		for _, otherID := range requested {
+			score.RequestsTotal++
+			if slot := p.placement[camperID]; slot != nil && slot == p.placement[otherID] {
+				score.RequestsSatisfied++
+				satisfied = true
+			}
+		}
+		if satisfied {
+			score.CampersWithRequestSatisfied++
+		}
+	}
+
+	score.Satisfaction = 100
+	if score.CampersWithRequests > 0 {
+		score.Satisfaction = math.Round(float64(score.CampersWithRequestSatisfied)*1000/float64(score.CampersWithRequests)) / 10
+	}
+	return score
+}
+
+// ageSpanMonths returns the age difference in whole months between the oldest and youngest
+// of the given campers
+func ageSpanMonths(groups ...[]*domain.Camper) int {
+	var oldest, youngest time.Time
+	for _, campers := range groups {
+		for _, camper := range campers {
+			if oldest.IsZero() || camper.Birthday.Before(oldest) {
+				oldest = camper.Birthday
+			}
+			if youngest.IsZero() || camper.Birthday.After(youngest) {
+				youngest = camper.Birthday
+			}
+		}
+	}
+	if oldest.IsZero() {
+		return 0
+	}
+
+	months := (youngest.Year()-oldest.Year())*12 + int(youngest.Month()-oldest.Month())
+	if youngest.Day() < oldest.Day() {
+		months--
+	}
+	return months
+}
+
+// containsID reports whether ids contains id
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
@@ -35,13 +35,15 @@ type HousingRoomsService interface {
 type housingRoomsService struct {
 	repo      HousingRoomsRepository
 	areasRepo AreasRepository
+	bedsRepo  BedsRepository
 }
 
 // NewHousingRoomsService creates a new housing rooms service
-func NewHousingRoomsService(repo HousingRoomsRepository, areasRepo AreasRepository) HousingRoomsService {
+func NewHousingRoomsService(repo HousingRoomsRepository, areasRepo AreasRepository, bedsRepo BedsRepository) HousingRoomsService {
 	return &housingRoomsService{
 		repo:      repo,
 		areasRepo: areasRepo,
+		bedsRepo:  bedsRepo,
 	}
 }
 
@@ -99,14 +101,20 @@ func (s *housingRoomsService) Create(ctx context.Context, tenantID uuid.UUID, ca
 		bathroom = string(*req.Spec.Bathroom)
 	}
 
+	genderPolicy, err := parseGenderPolicy(req.Spec.GenderPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	housingRoom := &domain.HousingRoom{
-		TenantID:    tenantID,
-		CampID:      campID,
-		AreaID:      req.Spec.AreaId,
-		Name:        req.Meta.Name,
-		Description: utils.PtrToString(req.Meta.Description),
-		Beds:        req.Spec.Beds,
-		Bathroom:    bathroom,
+		TenantID:     tenantID,
+		CampID:       campID,
+		AreaID:       req.Spec.AreaId,
+		Name:         req.Meta.Name,
+		Description:  utils.PtrToString(req.Meta.Description),
+		Beds:         req.Spec.Beds,
+		Bathroom:     bathroom,
+		GenderPolicy: genderPolicy,
 	}
 
 	// Save to database
@@ -140,8 +148,23 @@ func (s *housingRoomsService) Update(ctx context.Context, tenantID, campID, id u
 		}
 	}
 
+	genderPolicy, err := parseGenderPolicy(req.Spec.GenderPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// A room cannot have fewer beds than it has bed records
+	bedCount, err := s.bedsRepo.CountByRoom(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to count beds", err)
+	}
+	if int(bedCount) > req.Spec.Beds {
+		return nil, pkgerrors.Conflict(fmt.Sprintf("The room has %d beds; delete some of them before lowering its number of beds", bedCount), nil)
+	}
+
 	// Update fields
 	existingHousingRoom.AreaID = req.Spec.AreaId
+	existingHousingRoom.GenderPolicy = genderPolicy
 	existingHousingRoom.Name = req.Meta.Name
 	existingHousingRoom.Description = utils.PtrToString(req.Meta.Description)
 	existingHousingRoom.Beds = req.Spec.Beds
@@ -166,6 +189,17 @@ func (s *housingRoomsService) Update(ctx context.Context, tenantID, campID, id u
 	return &apiHousingRoom, nil
 }
 
+// parseGenderPolicy validates a room's gender policy, defaulting to mixed
+func parseGenderPolicy(policy *api.HousingRoomGenderPolicy) (string, error) {
+	if policy == nil {
+		return domain.GenderPolicyMixed, nil
+	}
+	if !domain.IsValidGenderPolicy(string(*policy)) {
+		return "", pkgerrors.BadRequest(fmt.Sprintf("Invalid gender policy: %s", *policy), nil)
+	}
+	return string(*policy), nil
+}
+
 // Delete deletes a housing room by ID
 func (s *housingRoomsService) Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error {
 	// Check if housing room exists
@@ -0,0 +1,517 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// IncidentsService defines the interface for incident and injury reporting business logic
+type IncidentsService interface {
+	// List retrieves incidents with pagination and optional search
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.IncidentsListResponse, error)
+
+	// GetByID retrieves a single incident by ID
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Incident, error)
+
+	// Create files a new incident report as a draft
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.IncidentCreationRequest) (*api.Incident, error)
+
+	// Update updates an incident report that has not been approved
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.IncidentUpdateRequest) (*api.Incident, error)
+
+	// Delete deletes an incident by ID
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// Submit submits an incident report for review
+	Submit(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Incident, error)
+
+	// Review approves or returns a submitted incident report
+	Review(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.IncidentReviewRequest) (*api.Incident, error)
+
+	// GetReport counts incidents by location, activity or week
+	GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, groupBy api.IncidentReportGrouping, from *time.Time, to *time.Time) (*api.IncidentReport, error)
+}
+
+// incidentsService implements IncidentsService
+type incidentsService struct {
+	repo             IncidentsRepository
+	campsRepo        CampsRepository
+	campersRepo      CampersRepository
+	staffMembersRepo StaffMembersRepository
+	locationsRepo    LocationsRepository
+	eventsRepo       EventsRepository
+	activitiesRepo   ActivitiesRepository
+}
+
+// NewIncidentsService creates a new incidents service
+func NewIncidentsService(repo IncidentsRepository, campsRepo CampsRepository, campersRepo CampersRepository, staffMembersRepo StaffMembersRepository, locationsRepo LocationsRepository, eventsRepo EventsRepository, activitiesRepo ActivitiesRepository) IncidentsService {
+	return &incidentsService{
+		repo:             repo,
+		campsRepo:        campsRepo,
+		campersRepo:      campersRepo,
+		staffMembersRepo: staffMembersRepo,
+		locationsRepo:    locationsRepo,
+		eventsRepo:       eventsRepo,
+		activitiesRepo:   activitiesRepo,
+	}
+}
+
+// List retrieves incidents with pagination and optional search
+func (s *incidentsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.IncidentsListResponse, error) {
+	incidents, total, err := s.repo.List(ctx, tenantID, campID, limit, offset, search, filterStrings, sortBy, sortOrder)
+	if err != nil {
+		return nil, pkgerrors.BadRequest("Failed to list incidents", err)
+	}
+
+	// Convert domain incidents to API incidents
+	apiIncidents := make([]api.Incident, len(incidents))
+	for i, incident := range incidents {
+		apiIncidents[i] = incident.ToAPI()
+	}
+
+	return &api.IncidentsListResponse{
+		Items:  apiIncidents,
+		Limit:  limit,
+		Offset: offset,
+		Total:  int(total),
+	}, nil
+}
+
+// GetByID retrieves a single incident by ID
+func (s *incidentsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Incident, error) {
+	incident, err := s.getIncident(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiIncident := incident.ToAPI()
+	return &apiIncident, nil
+}
+
+// Create files a new incident report as a draft
+func (s *incidentsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.IncidentCreationRequest) (*api.Incident, error) {
+	incident := &domain.Incident{
+		TenantID: tenantID,
+		CampID:   campID,
+		Status:   domain.IncidentStatusDraft,
+	}
+
+	if err := s.applySpec(ctx, incident, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	incident.ReportedBy, incident.ReportedByEmail = currentUser(ctx)
+
+	// Save to database
+	if err := s.repo.Create(ctx, incident); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create incident", err)
+	}
+
+	apiIncident := incident.ToAPI()
+	return &apiIncident, nil
+}
+
+// Update updates an incident report that has not been approved
+func (s *incidentsService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.IncidentUpdateRequest) (*api.Incident, error) {
+	// Check if incident exists and belongs to tenant/camp
+	existingIncident, err := s.getIncident(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !existingIncident.Status.IsEditable() {
+		return nil, pkgerrors.BadRequest("Approved incident reports cannot be changed; return the report first", nil)
+	}
+
+	if err := s.applySpec(ctx, existingIncident, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, existingIncident); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update incident", err)
+	}
+
+	return s.getUpdated(ctx, tenantID, campID, id)
+}
+
+// Delete deletes an incident by ID
+func (s *incidentsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	// Check if incident exists
+	if _, err := s.getIncident(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	// Delete the incident
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete incident", err)
+	}
+
+	return nil
+}
+
+// Submit submits an incident report for review
+func (s *incidentsService) Submit(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Incident, error) {
+	incident, err := s.getIncident(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !incident.Status.CanTransitionTo(domain.IncidentStatusSubmitted) {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Cannot submit an incident report that is %s", incident.Status), nil)
+	}
+
+	now := time.Now().UTC()
+	incident.Status = domain.IncidentStatusSubmitted
+	incident.SubmittedAt = &now
+
+	if err := s.repo.UpdateReview(ctx, tenantID, campID, incident); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to submit incident", err)
+	}
+
+	return s.getUpdated(ctx, tenantID, campID, id)
+}
+
+// Review approves or returns a submitted incident report
+func (s *incidentsService) Review(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.IncidentReviewRequest) (*api.Incident, error) {
+	incident, err := s.getIncident(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	toStatus := domain.IncidentStatus(req.Status)
+	if toStatus != domain.IncidentStatusApproved && toStatus != domain.IncidentStatusReturned {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid review decision '%s'", req.Status), nil)
+	}
+	if !incident.Status.CanTransitionTo(toStatus) {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Cannot move an incident report from %s to %s", incident.Status, toStatus), nil)
+	}
+
+	comment := strings.TrimSpace(utils.PtrToString(req.Comment))
+	if toStatus == domain.IncidentStatusReturned && comment == "" {
+		return nil, pkgerrors.BadRequest("A comment is required when returning an incident report", nil)
+	}
+
+	now := time.Now().UTC()
+	incident.Status = toStatus
+	incident.ReviewedBy, incident.ReviewedByEmail = currentUser(ctx)
+	incident.ReviewedAt = &now
+	incident.ReviewComment = comment
+
+	if err := s.repo.UpdateReview(ctx, tenantID, campID, incident); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to review incident", err)
+	}
+
+	return s.getUpdated(ctx, tenantID, campID, id)
+}
+
+// GetReport counts incidents by location, activity or week
+func (s *incidentsService) GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, groupBy api.IncidentReportGrouping, from *time.Time, to *time.Time) (*api.IncidentReport, error) {
+	switch groupBy {
+	case api.IncidentReportGroupingLocation, api.IncidentReportGroupingActivity, api.IncidentReportGroupingWeek:
+	default:
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid groupBy '%s'", groupBy), nil)
+	}
+
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, pkgerrors.BadRequest("End date must be after or equal to start date", nil)
+	}
+
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	// The period covers whole days in the camp's time zone
+	var periodStart, periodEnd *time.Time
+	if from != nil {
+		t := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc).UTC()
+		periodStart = &t
+	}
+	if to != nil {
+		t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).UTC()
+		periodEnd = &t
+	}
+
+	counts, err := s.repo.CountBy(ctx, tenantID, campID, string(groupBy), periodStart, periodEnd, loc.String())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to build incident report", err)
+	}
+
+	report := &api.IncidentReport{
+		GroupBy: groupBy,
+		Buckets: []api.IncidentReportBucket{},
+	}
+	if from != nil {
+		report.From = &openapi_types.Date{Time: *from}
+	}
+	if to != nil {
+		report.To = &openapi_types.Date{Time: *to}
+	}
+
+	bucketIndex := make(map[string]int)
+	for _, count := range counts {
+		idx, ok := bucketIndex[count.Key]
+		if !ok {
+			label := count.Label
+			if count.Key == "" {
+				label = fmt.Sprintf("No %s", groupBy)
+			}
+			report.Buckets = append(report.Buckets, api.IncidentReportBucket{
+				Key:   count.Key,
+				Label: label,
+			})
+			idx = len(report.Buckets) - 1
+			bucketIndex[count.Key] = idx
+		}
+
+		addSeverityCount(&report.Buckets[idx].Severity, count.Severity, count.Count)
+		report.Buckets[idx].Total += count.Count
+		addSeverityCount(&report.Severity, count.Severity, count.Count)
+		report.Total += count.Count
+	}
+
+	// Weeks read chronologically, locations and activities with the most incidents first
+	if groupBy != api.IncidentReportGroupingWeek {
+		sort.SliceStable(report.Buckets, func(i, j int) bool {
+			if report.Buckets[i].Total != report.Buckets[j].Total {
+				return report.Buckets[i].Total > report.Buckets[j].Total
+			}
+			return report.Buckets[i].Label < report.Buckets[j].Label
+		})
+	}
+
+	return report, nil
+}
+
+// getIncident retrieves an incident, mapping a missing record to a not found error
+func (s *incidentsService) getIncident(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*domain.Incident, error) {
+	incident, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Incident not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get incident", err)
+	}
+	return incident, nil
+}
+
+// getUpdated fetches an incident after a change to get latest timestamps
+func (s *incidentsService) getUpdated(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Incident, error) {
+	updatedIncident, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated incident", err)
+	}
+
+	apiIncident := updatedIncident.ToAPI()
+	return &apiIncident, nil
+}
+
+// applySpec validates the request and copies its meta and spec onto the domain incident
+func (s *incidentsService) applySpec(ctx context.Context, incident *domain.Incident, meta api.EntityCreationRequestMeta, spec api.IncidentMutationSpec) error {
+	tenantID, campID := incident.TenantID, incident.CampID
+	now := time.Now().UTC()
+
+	if spec.OccurredAt.After(now) {
+		return pkgerrors.BadRequest("Incident cannot occur in the future", nil)
+	}
+	if strings.TrimSpace(spec.Narrative) == "" {
+		return pkgerrors.BadRequest("Narrative is required", nil)
+	}
+
+	camperIDs := uniqueIDs(spec.CamperIds)
+	if len(camperIDs) > 0 {
+		campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, camperIDs)
+		if err != nil {
+			return pkgerrors.InternalServerError("Failed to get campers", err)
+		}
+		if len(campers) != len(camperIDs) {
+			return pkgerrors.BadRequest("One or more campers not found", nil)
+		}
+	}
+
+	staffMemberIDs := uniqueIDs(spec.StaffMemberIds)
+	for _, staffMemberID := range staffMemberIDs {
+		if err := s.checkStaffMember(ctx, tenantID, campID, staffMemberID); err != nil {
+			return err
+		}
+	}
+
+	locationID, activityID := spec.LocationId, spec.ActivityId
+
+	// Incidents during an event default to the event's location and activity
+	if spec.EventId != nil {
+		event, err := s.eventsRepo.GetByID(ctx, tenantID, campID, *spec.EventId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest(fmt.Sprintf("Event %s not found", *spec.EventId), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get event", err)
+		}
+		if locationID == nil {
+			locationID = event.LocationID
+		}
+		if activityID == nil {
+			activityID = event.ActivityID
+		}
+	}
+
+	if locationID != nil {
+		if _, err := s.locationsRepo.GetByID(ctx, tenantID, campID, *locationID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest(fmt.Sprintf("Location %s not found", *locationID), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get location", err)
+		}
+	}
+
+	if activityID != nil {
+		if _, err := s.activitiesRepo.GetByID(ctx, tenantID, campID, *activityID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest(fmt.Sprintf("Activity %s not found", *activityID), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get activity", err)
+		}
+	}
+
+	// Guardians need to be told whenever campers are involved, unless stated otherwise
+	notificationStatus := domain.GuardianNotificationNotRequired
+	if len(camperIDs) > 0 {
+		notificationStatus = domain.GuardianNotificationPending
+	}
+	if spec.GuardianNotificationStatus != nil {
+		notificationStatus = domain.GuardianNotificationStatus(*spec.GuardianNotificationStatus)
+	}
+
+	guardiansNotifiedAt := spec.GuardiansNotifiedAt
+	if notificationStatus == domain.GuardianNotificationNotified {
+		if guardiansNotifiedAt == nil {
+			guardiansNotifiedAt = &now
+		}
+	} else {
+		guardiansNotifiedAt = nil
+	}
+	if guardiansNotifiedAt != nil {
+		t := guardiansNotifiedAt.UTC()
+		guardiansNotifiedAt = &t
+	}
+
+	var followUpTasksJSON []byte
+	if spec.FollowUpTasks != nil {
+		tasks := *spec.FollowUpTasks
+		for i := range tasks {
+			if strings.TrimSpace(tasks[i].Description) == "" {
+				return pkgerrors.BadRequest("Follow-up task description cannot be empty", nil)
+			}
+			if tasks[i].AssigneeId != nil {
+				if err := s.checkStaffMember(ctx, tenantID, campID, *tasks[i].AssigneeId); err != nil {
+					return err
+				}
+			}
+			if utils.PtrToBool(tasks[i].Completed) {
+				if tasks[i].CompletedAt == nil {
+					tasks[i].CompletedAt = &now
+				}
+			} else {
+				tasks[i].CompletedAt = nil
+			}
+		}
+		var err error
+		followUpTasksJSON, err = json.Marshal(tasks)
+		if err != nil {
+			return pkgerrors.BadRequest("Invalid followUpTasks format", err)
+		}
+	}
+
+	incident.Name = meta.Name
+	incident.Description = utils.PtrToString(meta.Description)
+	incident.OccurredAt = spec.OccurredAt.UTC()
+	incident.Type = string(spec.Type)
+	incident.Severity = domain.IncidentSeverity(spec.Severity)
+	incident.CamperIDs = camperIDs
+	incident.StaffMemberIDs = staffMemberIDs
+	incident.LocationID = locationID
+	incident.EventID = spec.EventId
+	incident.ActivityID = activityID
+	incident.Narrative = spec.Narrative
+	incident.ActionsTaken = utils.PtrToString(spec.ActionsTaken)
+	incident.GuardianNotificationStatus = notificationStatus
+	incident.GuardiansNotifiedAt = guardiansNotifiedAt
+	incident.GuardianNotificationNotes = utils.PtrToString(spec.GuardianNotificationNotes)
+	incident.FollowUpTasks = followUpTasksJSON
+
+	return nil
+}
+
+// checkStaffMember verifies that a staff member exists in the camp
+func (s *incidentsService) checkStaffMember(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest(fmt.Sprintf("Staff member %s not found", id), err)
+		}
+		return pkgerrors.InternalServerError("Failed to get staff member", err)
+	}
+	return nil
+}
+
+// addSeverityCount adds incidents of a severity to severity counts
+func addSeverityCount(counts *api.IncidentSeverityCounts, severity domain.IncidentSeverity, n int) {
+	switch severity {
+	case domain.IncidentSeverityMinor:
+		counts.Minor += n
+	case domain.IncidentSeverityModerate:
+		counts.Moderate += n
+	case domain.IncidentSeveritySerious:
+		counts.Serious += n
+	case domain.IncidentSeverityCritical:
+		counts.Critical += n
+	}
+}
+
+// uniqueIDs returns the IDs without duplicates, keeping their order
+func uniqueIDs(ids *[]uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(*ids))
+	result := make([]uuid.UUID, 0, len(*ids))
+	for _, id := range *ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// currentUser returns the ID and email of the user making the request, when known
+func currentUser(ctx context.Context) (*uuid.UUID, string) {
+	var userID *uuid.UUID
+	if userIDStr, err := pkgcontext.ExtractUserID(ctx); err == nil {
+		if id, err := uuid.Parse(userIDStr); err == nil {
+			userID = &id
+		}
+	}
+
+	email, _ := pkgcontext.ExtractEmail(ctx)
+	return userID, email
+}
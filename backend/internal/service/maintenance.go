@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MaintenanceService defines the interface for maintenance ticket business logic
+type MaintenanceService interface {
+	// List retrieves maintenance tickets, most urgent and oldest first
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListMaintenanceTicketsParams) (*api.MaintenanceTicketsListResponse, error)
+
+	// GetByID retrieves a single maintenance ticket
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.MaintenanceTicket, error)
+
+	// Create reports a problem with a location, housing room or area as the current user
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MaintenanceTicketCreationRequest) (*api.MaintenanceTicket, error)
+
+	// Update changes the details, status, assignee and out-of-service period of a ticket
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MaintenanceTicketUpdateRequest) (*api.MaintenanceTicket, error)
+
+	// Delete removes a maintenance ticket
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListOutOfService lists the periods facilities are out of service from a moment (now by default) until another
+	ListOutOfService(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.OutOfServicePeriodsListResponse, error)
+}
+
+// maintenanceService implements MaintenanceService
+type maintenanceService struct {
+	repo             MaintenanceTicketsRepository
+	locationsRepo    LocationsRepository
+	housingRoomsRepo HousingRoomsRepository
+	areasRepo        AreasRepository
+	staffMembersRepo StaffMembersRepository
+}
+
+// NewMaintenanceService creates a new maintenance service
+func NewMaintenanceService(repo MaintenanceTicketsRepository, locationsRepo LocationsRepository, housingRoomsRepo HousingRoomsRepository, areasRepo AreasRepository, staffMembersRepo StaffMembersRepository) MaintenanceService {
+	return &maintenanceService{
+		repo:             repo,
+		locationsRepo:    locationsRepo,
+		housingRoomsRepo: housingRoomsRepo,
+		areasRepo:        areasRepo,
+		staffMembersRepo: staffMembersRepo,
+	}
+}
+
+// List retrieves maintenance tickets, most urgent and oldest first
+func (s *maintenanceService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListMaintenanceTicketsParams) (*api.MaintenanceTicketsListResponse, error) {
+	var entityType *domain.MaintenanceEntityType
+	if params.EntityType != nil {
+		value := domain.MaintenanceEntityType(*params.EntityType)
+		entityType = &value
+	}
+	var status *domain.MaintenanceStatus
+	if params.Status != nil {
+		value := domain.MaintenanceStatus(*params.Status)
+		status = &value
+	}
+	var priority *domain.MaintenancePriority
+	if params.Priority != nil {
+		value := domain.MaintenancePriority(*params.Priority)
+		priority = &value
+	}
+
+	tickets, err := s.repo.List(ctx, tenantID, campID, entityType, params.EntityId, status, priority, params.AssigneeId)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list maintenance tickets", err)
+	}
+
+	items := make([]api.MaintenanceTicket, len(tickets))
+	for i := range tickets {
+		items[i] = tickets[i].ToAPI()
+	}
+
+	return &api.MaintenanceTicketsListResponse{Items: items}, nil
+}
+
+// GetByID retrieves a single maintenance ticket
+func (s *maintenanceService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.MaintenanceTicket, error) {
+	ticket, err := s.getTicket(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTicket := ticket.ToAPI()
+	return &apiTicket, nil
+}
+
+// Create reports a problem with a facility as the current user
+func (s *maintenanceService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MaintenanceTicketCreationRequest) (*api.MaintenanceTicket, error) {
+	ticket := &domain.MaintenanceTicket{
+		TenantID:          tenantID,
+		CampID:            campID,
+		EntityType:        domain.MaintenanceEntityType(req.EntityType),
+		EntityID:          req.EntityId,
+		Title:             strings.TrimSpace(req.Title),
+		Description:       utils.PtrToString(req.Description),
+		Priority:          domain.MaintenancePriorityMedium,
+		Status:            domain.MaintenanceStatusOpen,
+		AssigneeID:        req.AssigneeId,
+		OutOfServiceFrom:  req.OutOfServiceFrom,
+		OutOfServiceUntil: req.OutOfServiceUntil,
+	}
+	if req.Priority != nil {
+		ticket.Priority = domain.MaintenancePriority(*req.Priority)
+	}
+	if err := ticket.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	if _, err := s.facilityName(ctx, tenantID, campID, ticket.EntityType, ticket.EntityID); err != nil {
+		return nil, err
+	}
+	if err := s.validateAssignee(ctx, tenantID, campID, ticket.AssigneeID); err != nil {
+		return nil, err
+	}
+
+	ticket.ReportedBy, ticket.ReportedByEmail = currentUser(ctx)
+
+	// Save to database
+	if err := s.repo.Create(ctx, ticket); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create maintenance ticket", err)
+	}
+
+	apiTicket := ticket.ToAPI()
+	return &apiTicket, nil
+}
+
+// Update changes a ticket; resolving or closing it puts its facility back in service
+func (s *maintenanceService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MaintenanceTicketUpdateRequest) (*api.MaintenanceTicket, error) {
+	ticket, err := s.getTicket(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket.Title = strings.TrimSpace(req.Title)
+	ticket.Description = utils.PtrToString(req.Description)
+	ticket.Priority = domain.MaintenancePriority(req.Priority)
+	ticket.AssigneeID = req.AssigneeId
+	ticket.OutOfServiceFrom = req.OutOfServiceFrom
+	ticket.OutOfServiceUntil = req.OutOfServiceUntil
+	ticket.SetStatus(domain.MaintenanceStatus(req.Status), time.Now().UTC())
+	if err := ticket.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+	if err := s.validateAssignee(ctx, tenantID, campID, ticket.AssigneeID); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, ticket); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update maintenance ticket", err)
+	}
+
+	return s.GetByID(ctx, tenantID, campID, id)
+}
+
+// Delete removes a maintenance ticket
+func (s *maintenanceService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getTicket(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete maintenance ticket", err)
+	}
+
+	return nil
+}
+
+// ListOutOfService lists the out-of-service periods of the tickets that are not done
+func (s *maintenanceService) ListOutOfService(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.OutOfServicePeriodsListResponse, error) {
+	start := time.Now().UTC()
+	if from != nil {
+		start = *from
+	}
+	if to != nil && !to.After(start) {
+		return nil, pkgerrors.BadRequest("to must be after from", nil)
+	}
+
+	tickets, err := s.repo.ListOutOfService(ctx, tenantID, campID, start, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list out-of-service periods", err)
+	}
+
+	items := make([]api.OutOfServicePeriod, 0, len(tickets))
+	for _, ticket := range tickets {
+		name, err := s.facilityName(ctx, tenantID, campID, ticket.EntityType, ticket.EntityID)
+		if err != nil {
+			// The facility was deleted; it cannot be scheduled anyway
+			continue
+		}
+		items = append(items, api.OutOfServicePeriod{
+			TicketId:   ticket.ID,
+			EntityType: api.MaintenanceTicketEntityType(ticket.EntityType),
+			EntityId:   ticket.EntityID,
+			EntityName: name,
+			From:       *ticket.OutOfServiceFrom,
+			Until:      ticket.OutOfServiceUntil,
+		})
+	}
+
+	return &api.OutOfServicePeriodsListResponse{Items: items}, nil
+}
+
+// facilityName checks that the location, housing room or area of a ticket exists in the camp and returns its name
+func (s *maintenanceService) facilityName(ctx context.Context, tenantID, campID uuid.UUID, entityType domain.MaintenanceEntityType, entityID uuid.UUID) (string, error) {
+	var name string
+	var err error
+	var notFound string
+	switch entityType {
+	case domain.MaintenanceEntityTypeLocation:
+		var location *domain.Location
+		if location, err = s.locationsRepo.GetByID(ctx, tenantID, campID, entityID); err == nil {
+			name = location.Name
+		}
+		notFound = "Location not found"
+	case domain.MaintenanceEntityTypeHousingRoom:
+		var room *domain.HousingRoom
+		if room, err = s.housingRoomsRepo.GetByID(ctx, tenantID, campID, entityID); err == nil {
+			name = room.Name
+		}
+		notFound = "Housing room not found"
+	case domain.MaintenanceEntityTypeArea:
+		var area *domain.Area
+		if area, err = s.areasRepo.GetByID(ctx, tenantID, campID, entityID); err == nil {
+			name = area.Name
+		}
+		notFound = "Area not found"
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", pkgerrors.BadRequest(notFound, err)
+		}
+		return "", pkgerrors.InternalServerError("Failed to validate maintenance ticket facility", err)
+	}
+	return name, nil
+}
+
+// validateAssignee checks that the staff member assigned to a ticket exists
+func (s *maintenanceService) validateAssignee(ctx context.Context, tenantID, campID uuid.UUID, assigneeID *uuid.UUID) error {
+	if assigneeID == nil {
+		return nil
+	}
+	if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, *assigneeID); err != nil {
+		return pkgerrors.BadRequest("Assignee not found", err)
+	}
+	return nil
+}
+
+// getTicket loads a maintenance ticket, mapping a missing ticket to a not found error
+func (s *maintenanceService) getTicket(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Maintenance ticket not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get maintenance ticket", err)
+	}
+	return ticket, nil
+}
+
+// usePeriod is a span of time a facility is to be used, e.g. by an event
+type usePeriod struct {
+	start, end time.Time
+}
+
+// checkInService returns a conflict when an open maintenance ticket takes the facility, or the area it is in,
+// out of service during one of the periods
+func checkInService(ctx context.Context, ticketsRepo MaintenanceTicketsRepository, tenantID, campID uuid.UUID, entityType domain.MaintenanceEntityType, entityID uuid.UUID, areaID *uuid.UUID, name string, periods ...usePeriod) error {
+	if len(periods) == 0 {
+		return nil
+	}
+	first, last := periods[0].start, periods[0].end
+	for _, period := range periods {
+		if period.start.Before(first) {
+			first = period.start
+		}
+		if period.end.After(last) {
+			last = period.end
+		}
+	}
+
+	tickets, err := ticketsRepo.ListOutOfService(ctx, tenantID, campID, first, &last)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to check maintenance tickets", err)
+	}
+
+	for _, ticket := range tickets {
+		if !ticket.Covers(entityType, entityID, areaID) {
+			continue
+		}
+		for _, period := range periods {
+			if ticket.OutOfServiceBetween(period.start, period.end) {
+				return pkgerrors.Conflict(fmt.Sprintf("%s is out of service for maintenance (%s)", name, ticket.Title), nil)
+			}
+		}
+	}
+	return nil
+}
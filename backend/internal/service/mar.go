@@ -0,0 +1,479 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MarService defines the interface for the medication administration record (MAR)
+type MarService interface {
+	// ListDueDoses generates the doses due on a day from medication schedules, merged with recorded doses
+	ListDueDoses(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date time.Time, camperID *uuid.UUID, sessionID *uuid.UUID) (*api.DueDosesListResponse, error)
+
+	// GetReport builds the daily MAR of a camper or of every camper enrolled in a session
+	GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date time.Time, camperID *uuid.UUID, sessionID *uuid.UUID) (*api.MarReport, error)
+
+	// RecordDose records a dose as given, refused or missed
+	RecordDose(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MedicationDoseRequest) (*api.MedicationDose, error)
+
+	// UpdateDose corrects a recorded dose
+	UpdateDose(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MedicationDoseRequest) (*api.MedicationDose, error)
+}
+
+// marService implements MarService
+type marService struct {
+	medicationsRepo MedicationsRepository
+	campersRepo     CampersRepository
+	campsRepo       CampsRepository
+	enrollmentsRepo CamperEnrollmentsRepository
+	sessionsRepo    SessionsRepository
+}
+
+// NewMarService creates a new MAR service
+func NewMarService(medicationsRepo MedicationsRepository, campersRepo CampersRepository, campsRepo CampsRepository, enrollmentsRepo CamperEnrollmentsRepository, sessionsRepo SessionsRepository) MarService {
+	return &marService{
+		medicationsRepo: medicationsRepo,
+		campersRepo:     campersRepo,
+		campsRepo:       campsRepo,
+		enrollmentsRepo: enrollmentsRepo,
+		sessionsRepo:    sessionsRepo,
+	}
+}
+
+// ListDueDoses generates the doses due on a day from medication schedules, merged with recorded doses
+func (s *marService) ListDueDoses(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date time.Time, camperID *uuid.UUID, sessionID *uuid.UUID) (*api.DueDosesListResponse, error) {
+	dueDoses, err := s.buildDueDoses(ctx, tenantID, campID, date, camperID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DueDosesListResponse{
+		Date:  openapi_types.Date{Time: date},
+		Items: dueDoses,
+	}, nil
+}
+
+// GetReport builds the daily MAR of a camper or of every camper enrolled in a session
+func (s *marService) GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date time.Time, camperID *uuid.UUID, sessionID *uuid.UUID) (*api.MarReport, error) {
+	if camperID == nil && sessionID == nil {
+		return nil, pkgerrors.BadRequest("Either camperId or sessionId is required", nil)
+	}
+
+	dueDoses, err := s.buildDueDoses(ctx, tenantID, campID, date, camperID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &api.MarReport{
+		Date:      openapi_types.Date{Time: date},
+		CamperId:  camperID,
+		SessionId: sessionID,
+		Campers:   []api.MarCamperReport{},
+	}
+
+	// Due doses are ordered by camper, so each camper's doses are contiguous
+	for _, dueDose := range dueDoses {
+		last := len(report.Campers) - 1
+		if last < 0 || report.Campers[last].CamperId != dueDose.CamperId {
+			report.Campers = append(report.Campers, api.MarCamperReport{
+				CamperId:   dueDose.CamperId,
+				CamperName: dueDose.CamperName,
+				Doses:      []api.DueDose{},
+			})
+			last++
+		}
+		report.Campers[last].Doses = append(report.Campers[last].Doses, dueDose)
+		countDose(&report.Campers[last].Summary, dueDose)
+		countDose(&report.Summary, dueDose)
+	}
+
+	return report, nil
+}
+
+// RecordDose records a dose as given, refused or missed
+func (s *marService) RecordDose(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MedicationDoseRequest) (*api.MedicationDose, error) {
+	dose := &domain.MedicationDose{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applyDoseRequest(ctx, dose, req); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.medicationsRepo.CreateDose(ctx, dose); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to record dose", err)
+	}
+
+	apiDose := dose.ToAPI()
+	return &apiDose, nil
+}
+
+// UpdateDose corrects a recorded dose
+func (s *marService) UpdateDose(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MedicationDoseRequest) (*api.MedicationDose, error) {
+	// Check if dose exists and belongs to tenant/camp
+	existingDose, err := s.medicationsRepo.GetDoseByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Dose record not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get dose record", err)
+	}
+
+	if req.MedicationId != existingDose.MedicationID {
+		return nil, pkgerrors.BadRequest("Cannot move a dose record to another medication", nil)
+	}
+
+	if err := s.applyDoseRequest(ctx, existingDose, req); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.medicationsRepo.UpdateDose(ctx, tenantID, campID, existingDose); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update dose record", err)
+	}
+
+	// Fetch updated dose to get latest timestamps
+	updatedDose, err := s.medicationsRepo.GetDoseByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated dose record", err)
+	}
+
+	apiDose := updatedDose.ToAPI()
+	return &apiDose, nil
+}
+
+// applyDoseRequest validates the request and copies it onto the domain dose record,
+// attributing the record to the current user
+func (s *marService) applyDoseRequest(ctx context.Context, dose *domain.MedicationDose, req *api.MedicationDoseRequest) error {
+	tenantID, campID := dose.TenantID, dose.CampID
+
+	status := domain.MedicationDoseStatus(req.Status)
+	switch status {
+	case domain.MedicationDoseStatusGiven, domain.MedicationDoseStatusRefused, domain.MedicationDoseStatusMissed:
+	default:
+		return pkgerrors.BadRequest(fmt.Sprintf("Invalid dose status '%s'", req.Status), nil)
+	}
+
+	initials := strings.TrimSpace(req.Initials)
+	if initials == "" {
+		return pkgerrors.BadRequest("Initials are required", nil)
+	}
+
+	medication, err := s.medicationsRepo.GetByID(ctx, tenantID, campID, req.MedicationId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Medication not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get medication", err)
+	}
+
+	if req.ScheduledFor != nil {
+		loc, err := s.campLocation(ctx, tenantID, campID)
+		if err != nil {
+			return err
+		}
+
+		scheduledFor := req.ScheduledFor.In(loc)
+		if !isDueAt(medication.DueTimes(scheduledFor, loc), scheduledFor) {
+			return pkgerrors.BadRequest("Medication is not scheduled at this time", nil)
+		}
+
+		// Each scheduled dose is recorded once; corrections go through the existing record
+		existing, err := s.medicationsRepo.GetScheduledDose(ctx, tenantID, campID, medication.ID, req.ScheduledFor.UTC())
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.InternalServerError("Failed to check recorded doses", err)
+		}
+		if existing != nil && existing.ID != dose.ID {
+			return pkgerrors.Conflict("Dose is already recorded for this time", nil)
+		}
+	} else if !medication.AsNeeded {
+		return pkgerrors.BadRequest("Scheduled time is required for scheduled medications", nil)
+	}
+
+	// Times are stored in UTC
+	now := time.Now().UTC()
+	var scheduledFor, administeredAt *time.Time
+	if req.ScheduledFor != nil {
+		t := req.ScheduledFor.UTC()
+		scheduledFor = &t
+	}
+	if req.AdministeredAt != nil {
+		t := req.AdministeredAt.UTC()
+		administeredAt = &t
+	} else if status != domain.MedicationDoseStatusMissed || scheduledFor == nil {
+		administeredAt = &now
+	}
+
+	dose.MedicationID = medication.ID
+	dose.CamperID = medication.CamperID
+	dose.ScheduledFor = scheduledFor
+	dose.Status = status
+	dose.AdministeredAt = administeredAt
+	dose.Initials = initials
+	dose.Notes = utils.PtrToString(req.Notes)
+	dose.RecordedAt = now
+	dose.RecordedBy = nil
+
+	if userIDStr, err := pkgcontext.ExtractUserID(ctx); err == nil {
+		if userID, err := uuid.Parse(userIDStr); err == nil {
+			dose.RecordedBy = &userID
+		}
+	}
+
+	return nil
+}
+
+// buildDueDoses generates the due doses of a day for the selected campers, ordered by
+// camper name and time. Recorded doses settle their scheduled dose; as-needed doses and
+// doses of removed medications are listed from their records.
+func (s *marService) buildDueDoses(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date time.Time, camperID *uuid.UUID, sessionID *uuid.UUID) ([]api.DueDose, error) {
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	camperIDs, err := s.selectCampers(ctx, tenantID, campID, camperID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	medications, err := s.medicationsRepo.ListByCampers(ctx, tenantID, campID, camperIDs)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list medications", err)
+	}
+
+	doses, err := s.medicationsRepo.ListDosesBetween(ctx, tenantID, campID, camperIDs, dayStart.UTC(), dayEnd.UTC())
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list recorded doses", err)
+	}
+
+	medicationsByID := make(map[uuid.UUID]*domain.Medication, len(medications))
+	for i := range medications {
+		medicationsByID[medications[i].ID] = &medications[i]
+	}
+
+	// Records of removed medications still belong on the MAR
+	var missingIDs []uuid.UUID
+	for _, dose := range doses {
+		if _, ok := medicationsByID[dose.MedicationID]; !ok {
+			missingIDs = append(missingIDs, dose.MedicationID)
+			medicationsByID[dose.MedicationID] = nil
+		}
+	}
+	if len(missingIDs) > 0 {
+		removed, err := s.medicationsRepo.GetByIDs(ctx, tenantID, campID, missingIDs)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to get medications", err)
+		}
+		for i := range removed {
+			medicationsByID[removed[i].ID] = &removed[i]
+		}
+	}
+
+	// Look up camper names
+	camperSet := make(map[uuid.UUID]bool)
+	for _, medication := range medicationsByID {
+		if medication != nil {
+			camperSet[medication.CamperID] = true
+		}
+	}
+	nameIDs := make([]uuid.UUID, 0, len(camperSet))
+	for id := range camperSet {
+		nameIDs = append(nameIDs, id)
+	}
+	campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, nameIDs)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get campers", err)
+	}
+	camperNames := make(map[uuid.UUID]string, len(campers))
+	for _, camper := range campers {
+		camperNames[camper.ID] = camper.Name
+	}
+
+	recorded := make(map[string]*domain.MedicationDose, len(doses))
+	for i := range doses {
+		if doses[i].ScheduledFor != nil {
+			recorded[doseKey(doses[i].MedicationID, *doses[i].ScheduledFor)] = &doses[i]
+		}
+	}
+
+	now := time.Now()
+	dueDoses := []api.DueDose{}
+	settled := make(map[uuid.UUID]bool, len(doses))
+
+	for _, medication := range medications {
+		for _, dueAt := range medication.DueTimes(dayStart, loc) {
+			dueDose := newDueDose(&medication, camperNames[medication.CamperID])
+			scheduledFor := dueAt
+			dueDose.ScheduledFor = &scheduledFor
+
+			if dose, ok := recorded[doseKey(medication.ID, dueAt)]; ok {
+				setDueDoseRecord(&dueDose, dose)
+				settled[dose.ID] = true
+			} else {
+				dueDose.Status = api.DueDoseStatusPending
+				dueDose.Overdue = now.After(dueAt.Add(domain.DoseOverdueGracePeriod))
+			}
+
+			dueDoses = append(dueDoses, dueDose)
+		}
+	}
+
+	for i := range doses {
+		medication := medicationsByID[doses[i].MedicationID]
+		if settled[doses[i].ID] || medication == nil {
+			continue
+		}
+		dueDose := newDueDose(medication, camperNames[medication.CamperID])
+		dueDose.ScheduledFor = doses[i].ScheduledFor
+		setDueDoseRecord(&dueDose, &doses[i])
+		dueDoses = append(dueDoses, dueDose)
+	}
+
+	sort.SliceStable(dueDoses, func(i, j int) bool {
+		a, b := dueDoses[i], dueDoses[j]
+		if a.CamperName != b.CamperName {
+			return a.CamperName < b.CamperName
+		}
+		if a.CamperId != b.CamperId {
+			return a.CamperId.String() < b.CamperId.String()
+		}
+		return dueDoseTime(a).Before(dueDoseTime(b))
+	})
+
+	return dueDoses, nil
+}
+
+// selectCampers resolves the camper filter of a MAR query; nil means every camper
+func (s *marService) selectCampers(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID *uuid.UUID, sessionID *uuid.UUID) ([]uuid.UUID, error) {
+	var camperIDs []uuid.UUID
+
+	if sessionID != nil {
+		if _, err := s.sessionsRepo.GetByID(ctx, tenantID, campID, *sessionID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.NotFound("Session not found", err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to get session", err)
+		}
+
+		enrollments, err := s.enrollmentsRepo.ListActiveBySession(ctx, tenantID, campID, *sessionID)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to list session enrollments", err)
+		}
+
+		camperIDs = make([]uuid.UUID, 0, len(enrollments))
+		for _, enrollment := range enrollments {
+			if camperID == nil || enrollment.CamperID == *camperID {
+				camperIDs = append(camperIDs, enrollment.CamperID)
+			}
+		}
+	}
+
+	if camperID != nil {
+		if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, *camperID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.NotFound("Camper not found", err)
+			}
+			return nil, pkgerrors.InternalServerError("Failed to get camper", err)
+		}
+		if sessionID == nil {
+			camperIDs = []uuid.UUID{*camperID}
+		}
+	}
+
+	return camperIDs, nil
+}
+
+// campLocation returns the time zone medication schedules of the camp are interpreted in
+func (s *marService) campLocation(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*time.Location, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	return camp.TimeLocation(), nil
+}
+
+// newDueDose creates a due dose entry with the details of a medication
+func newDueDose(medication *domain.Medication, camperName string) api.DueDose {
+	return api.DueDose{
+		MedicationId:   medication.ID,
+		MedicationName: medication.Name,
+		CamperId:       medication.CamperID,
+		CamperName:     camperName,
+		Dosage:         medication.Dosage,
+		Route:          utils.StringToPtr(medication.Route),
+		Instructions:   utils.StringToPtr(medication.Instructions),
+	}
+}
+
+// setDueDoseRecord settles a due dose with its recorded dose
+func setDueDoseRecord(dueDose *api.DueDose, dose *domain.MedicationDose) {
+	record := dose.ToAPI()
+	dueDose.Record = &record
+	dueDose.Status = api.DueDoseStatus(dose.Status)
+	dueDose.Overdue = false
+}
+
+// dueDoseTime returns the time a due dose is ordered by
+func dueDoseTime(dueDose api.DueDose) time.Time {
+	if dueDose.ScheduledFor != nil {
+		return *dueDose.ScheduledFor
+	}
+	if dueDose.Record != nil && dueDose.Record.AdministeredAt != nil {
+		return *dueDose.Record.AdministeredAt
+	}
+	return time.Time{}
+}
+
+// countDose adds a due dose to a MAR summary
+func countDose(summary *api.MarSummary, dueDose api.DueDose) {
+	switch dueDose.Status {
+	case api.DueDoseStatusGiven:
+		summary.Given++
+	case api.DueDoseStatusRefused:
+		summary.Refused++
+	case api.DueDoseStatusMissed:
+		summary.Missed++
+	case api.DueDoseStatusPending:
+		summary.Pending++
+	}
+	if dueDose.Overdue {
+		summary.Overdue++
+	}
+}
+
+// doseKey identifies the scheduled dose of a medication
+func doseKey(medicationID uuid.UUID, scheduledFor time.Time) string {
+	return fmt.Sprintf("%s/%d", medicationID, scheduledFor.Unix())
+}
+
+// isDueAt reports whether t is one of the due times
+func isDueAt(dueTimes []time.Time, t time.Time) bool {
+	for _, dueAt := range dueTimes {
+		if dueAt.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,516 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MealsService defines the interface for meal planning business logic
+type MealsService interface {
+	// ListMealPeriods retrieves the meal periods of a camp in the order of the day
+	ListMealPeriods(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.MealPeriodsListResponse, error)
+
+	// GetMealPeriod retrieves a single meal period
+	GetMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.MealPeriod, error)
+
+	// CreateMealPeriod adds a meal served every day of the camp
+	CreateMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MealPeriodCreationRequest) (*api.MealPeriod, error)
+
+	// UpdateMealPeriod changes the name, times and position of a meal period
+	UpdateMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MealPeriodUpdateRequest) (*api.MealPeriod, error)
+
+	// DeleteMealPeriod removes a meal period together with its menus
+	DeleteMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ListMenus retrieves the menus of a camp, optionally limited to a range of days
+	ListMenus(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.MenusListResponse, error)
+
+	// GetMenu retrieves a single menu
+	GetMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Menu, error)
+
+	// CreateMenu plans the dishes of a meal period on a day
+	CreateMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MenuCreationRequest) (*api.Menu, error)
+
+	// UpdateMenu replaces the dishes and notes of a menu
+	UpdateMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MenuUpdateRequest) (*api.Menu, error)
+
+	// DeleteMenu removes a menu
+	DeleteMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetHeadcounts counts the meals to prepare per meal period of a day (today when date is nil)
+	GetHeadcounts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time) (*api.MealHeadcountReport, error)
+}
+
+// mealsService implements MealsService
+type mealsService struct {
+	periodsRepo      MealPeriodsRepository
+	menusRepo        MenusRepository
+	campsRepo        CampsRepository
+	campersRepo      CampersRepository
+	staffMembersRepo StaffMembersRepository
+	attendanceRepo   AttendanceRepository
+}
+
+// NewMealsService creates a new meals service
+func NewMealsService(periodsRepo MealPeriodsRepository, menusRepo MenusRepository, campsRepo CampsRepository, campersRepo CampersRepository, staffMembersRepo StaffMembersRepository, attendanceRepo AttendanceRepository) MealsService {
+	return &mealsService{
+		periodsRepo:      periodsRepo,
+		menusRepo:        menusRepo,
+		campsRepo:        campsRepo,
+		campersRepo:      campersRepo,
+		staffMembersRepo: staffMembersRepo,
+		attendanceRepo:   attendanceRepo,
+	}
+}
+
+// ListMealPeriods retrieves the meal periods of a camp
+func (s *mealsService) ListMealPeriods(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.MealPeriodsListResponse, error) {
+	periods, err := s.periodsRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list meal periods", err)
+	}
+
+	items := make([]api.MealPeriod, len(periods))
+	for i := range periods {
+		items[i] = periods[i].ToAPI()
+	}
+
+	return &api.MealPeriodsListResponse{Items: items}, nil
+}
+
+// GetMealPeriod retrieves a single meal period
+func (s *mealsService) GetMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.MealPeriod, error) {
+	period, err := s.getMealPeriod(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPeriod := period.ToAPI()
+	return &apiPeriod, nil
+}
+
+// CreateMealPeriod adds a meal served every day of the camp
+func (s *mealsService) CreateMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MealPeriodCreationRequest) (*api.MealPeriod, error) {
+	period := &domain.MealPeriod{
+		TenantID:  tenantID,
+		CampID:    campID,
+		Name:      strings.TrimSpace(req.Name),
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+	if req.Position != nil {
+		period.Position = *req.Position
+	}
+
+	if err := period.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save to database
+	if err := s.periodsRepo.Create(ctx, period); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create meal period", err)
+	}
+
+	apiPeriod := period.ToAPI()
+	return &apiPeriod, nil
+}
+
+// UpdateMealPeriod changes the name, times and position of a meal period
+func (s *mealsService) UpdateMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MealPeriodUpdateRequest) (*api.MealPeriod, error) {
+	period, err := s.getMealPeriod(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	period.Name = strings.TrimSpace(req.Name)
+	period.StartTime = req.StartTime
+	period.EndTime = req.EndTime
+	if req.Position != nil {
+		period.Position = *req.Position
+	}
+
+	if err := period.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save updates
+	if err := s.periodsRepo.Update(ctx, tenantID, campID, period); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update meal period", err)
+	}
+
+	return s.GetMealPeriod(ctx, tenantID, campID, id)
+}
+
+// DeleteMealPeriod removes a meal period together with its menus
+func (s *mealsService) DeleteMealPeriod(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getMealPeriod(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.periodsRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete meal period", err)
+	}
+
+	return nil
+}
+
+// ListMenus retrieves the menus of a camp within [from, to]
+func (s *mealsService) ListMenus(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.MenusListResponse, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, pkgerrors.BadRequest("'to' must not be before 'from'", nil)
+	}
+
+	menus, err := s.menusRepo.List(ctx, tenantID, campID, from, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list menus", err)
+	}
+
+	items := make([]api.Menu, len(menus))
+	for i := range menus {
+		items[i] = menus[i].ToAPI()
+	}
+
+	return &api.MenusListResponse{Items: items}, nil
+}
+
+// GetMenu retrieves a single menu
+func (s *mealsService) GetMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Menu, error) {
+	menu, err := s.getMenu(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiMenu := menu.ToAPI()
+	return &apiMenu, nil
+}
+
+// CreateMenu plans the dishes of a meal period on a day; each meal period has at most one menu per day
+func (s *mealsService) CreateMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MenuCreationRequest) (*api.Menu, error) {
+	if _, err := s.periodsRepo.GetByID(ctx, tenantID, campID, req.MealPeriodId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Meal period not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get meal period", err)
+	}
+
+	items, err := domain.ParseMenuItems(req.Items)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	existing, err := s.menusRepo.GetByDateAndMealPeriod(ctx, tenantID, campID, req.Date.Time, req.MealPeriodId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.InternalServerError("Failed to check menus", err)
+	}
+	if existing != nil {
+		return nil, pkgerrors.Conflict("A menu already exists for this meal on this day", nil)
+	}
+
+	menu := &domain.Menu{
+		TenantID:     tenantID,
+		CampID:       campID,
+		Date:         req.Date.Time,
+		MealPeriodID: req.MealPeriodId,
+		Items:        items,
+		Notes:        utils.PtrToString(req.Notes),
+	}
+
+	// Save to database
+	if err := s.menusRepo.Create(ctx, menu); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create menu", err)
+	}
+
+	apiMenu := menu.ToAPI()
+	return &apiMenu, nil
+}
+
+// UpdateMenu replaces the dishes and notes of a menu
+func (s *mealsService) UpdateMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MenuUpdateRequest) (*api.Menu, error) {
+	menu, err := s.getMenu(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	menu.Items, err = domain.ParseMenuItems(req.Items)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+	menu.Notes = utils.PtrToString(req.Notes)
+
+	// Save updates
+	if err := s.menusRepo.Update(ctx, tenantID, campID, menu); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update menu", err)
+	}
+
+	return s.GetMenu(ctx, tenantID, campID, id)
+}
+
+// DeleteMenu removes a menu
+func (s *mealsService) DeleteMenu(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getMenu(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.menusRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete menu", err)
+	}
+
+	return nil
+}
+
+// GetHeadcounts counts the meals to prepare per meal period of a day. When the day has attendance
+// records, a camper eats a meal if they were checked in when it started or checked in while it was
+// served; otherwise every camper enrolled in a session covering the day is counted. All staff
+// members are counted for every meal.
+func (s *mealsService) GetHeadcounts(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, date *time.Time) (*api.MealHeadcountReport, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	day := localDate(time.Now(), loc)
+	if date != nil {
+		day = *date
+	}
+
+	periods, err := s.periodsRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list meal periods", err)
+	}
+
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+
+	records, err := s.attendanceRepo.ListByDate(ctx, tenantID, campID, day, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list attendance records", err)
+	}
+
+	report := &api.MealHeadcountReport{
+		Date:  openapi_types.Date{Time: day},
+		Basis: api.MealHeadcountBasisEnrollment,
+		Meals: []api.MealHeadcount{},
+	}
+
+	var campers []domain.Camper
+	if len(records) > 0 {
+		report.Basis = api.MealHeadcountBasisAttendance
+
+		camperIDs := []uuid.UUID{}
+		seen := make(map[uuid.UUID]bool)
+		for _, record := range records {
+			if !seen[record.CamperID] {
+				seen[record.CamperID] = true
+				camperIDs = append(camperIDs, record.CamperID)
+			}
+		}
+		campers, err = s.campersRepo.GetByIDs(ctx, tenantID, campID, camperIDs)
+	} else {
+		campers, err = s.campersRepo.ListEnrolledOnDate(ctx, tenantID, campID, day)
+	}
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get campers", err)
+	}
+
+	for i := range periods {
+		period := &periods[i]
+
+		present := campers
+		if report.Basis == api.MealHeadcountBasisAttendance {
+			start, end := period.Window(day, loc)
+			present = campersAtMeal(campers, records, start, end)
+		}
+
+		menu, err := s.menusRepo.GetByDateAndMealPeriod(ctx, tenantID, campID, day, period.ID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, pkgerrors.InternalServerError("Failed to get menu", err)
+			}
+			menu = nil
+		}
+
+		report.Meals = append(report.Meals, mealHeadcount(period, menu, present, staffMembers))
+	}
+
+	return report, nil
+}
+
+// getMealPeriod loads a meal period, reporting it as not found when it does not exist in the camp
+func (s *mealsService) getMealPeriod(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MealPeriod, error) {
+	period, err := s.periodsRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Meal period not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get meal period", err)
+	}
+	return period, nil
+}
+
+// getMenu loads a menu, reporting it as not found when it does not exist in the camp
+func (s *mealsService) getMenu(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Menu, error) {
+	menu, err := s.menusRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Menu not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get menu", err)
+	}
+	return menu, nil
+}
+
+// campersAtMeal returns the campers that were checked in when a meal started or checked in
+// while it was served. Records are ordered by time.
+func campersAtMeal(campers []domain.Camper, records []domain.AttendanceRecord, start, end time.Time) []domain.Camper {
+	atStart := make(map[uuid.UUID]domain.AttendanceType)
+	arrived := make(map[uuid.UUID]bool)
+	for _, record := range records {
+		if !record.OccurredAt.After(start) {
+			atStart[record.CamperID] = record.Type
+		} else if record.OccurredAt.Before(end) && record.Type == domain.AttendanceTypeCheckIn {
+			arrived[record.CamperID] = true
+		}
+	}
+
+	var present []domain.Camper
+	for _, camper := range campers {
+		if atStart[camper.ID] == domain.AttendanceTypeCheckIn || arrived[camper.ID] {
+			present = append(present, camper)
+		}
+	}
+	return present
+}
+
+// mealHeadcount counts the meals, diets and allergens of a meal and warns about campers
+// allergic to a dish on its menu
+func mealHeadcount(period *domain.MealPeriod, menu *domain.Menu, campers []domain.Camper, staffMembers []domain.StaffMember) api.MealHeadcount {
+	headcount := api.MealHeadcount{
+		MealPeriodId:   period.ID,
+		MealPeriodName: period.Name,
+		StartTime:      period.StartTime,
+		EndTime:        period.EndTime,
+		Campers:        len(campers),
+		Staff:          len(staffMembers),
+		Total:          len(campers) + len(staffMembers),
+		Diets:          []api.DietCount{},
+		Allergens:      []api.AllergenCount{},
+		Warnings:       []api.AllergenWarning{},
+	}
+	if menu != nil {
+		headcount.MenuId = &menu.ID
+	}
+
+	dietCounts := make(map[domain.DietaryRestriction]int)
+	allergenCounts := make(map[domain.Allergen]int)
+	count := func(diets []domain.DietaryRestriction, allergies []domain.Allergen) {
+		for _, diet := range diets {
+			dietCounts[diet]++
+		}
+		for _, allergen := range allergies {
+			allergenCounts[allergen]++
+		}
+	}
+
+	for _, camper := range campers {
+		count(camper.DietaryRestrictions, camper.Allergies)
+
+		if menu == nil || len(camper.Allergies) == 0 {
+			continue
+		}
+		for _, item := range menu.Items {
+			if matches := commonAllergens(camper.Allergies, item.Allergens); len(matches) > 0 {
+				headcount.Warnings = append(headcount.Warnings, api.AllergenWarning{
+					CamperId:   camper.ID,
+					CamperName: camper.Name,
+					MenuItem:   item.Name,
+					Allergens:  matches,
+				})
+			}
+		}
+	}
+	for _, staffMember := range staffMembers {
+		count(staffMember.DietaryRestrictions, staffMember.Allergies)
+	}
+
+	// Only diets and allergens someone at the meal has are reported, in their fixed order
+	for _, diet := range domain.DietaryRestrictions {
+		if dietCounts[diet] > 0 {
+			headcount.Diets = append(headcount.Diets, api.DietCount{Diet: api.DietaryRestriction(diet), Count: dietCounts[diet]})
+		}
+	}
+	for _, allergen := range domain.Allergens {
+		if allergenCounts[allergen] > 0 {
+			headcount.Allergens = append(headcount.Allergens, api.AllergenCount{Allergen: api.Allergen(allergen), Count: allergenCounts[allergen]})
+		}
+	}
+
+	return headcount
+}
+
+// commonAllergens returns the allergens of a dish a person is allergic to
+func commonAllergens(allergies []domain.Allergen, dish []domain.Allergen) []api.Allergen {
+	var matches []api.Allergen
+	for _, allergen := range dish {
+		for _, allergy := range allergies {
+			if allergy == allergen {
+				matches = append(matches, api.Allergen(allergen))
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// parseDietAndAllergies validates the diets and allergies of a camper or staff member
+func parseDietAndAllergies(diets *api.DietaryRestrictions, allergies *api.Allergies) ([]domain.DietaryRestriction, []domain.Allergen, error) {
+	parsedDiets := []domain.DietaryRestriction{}
+	parsedAllergies := []domain.Allergen{}
+
+	if diets != nil {
+		var err error
+		if parsedDiets, err = domain.ParseDietaryRestrictions(*diets); err != nil {
+			return nil, nil, pkgerrors.BadRequest(err.Error(), err)
+		}
+	}
+	if allergies != nil {
+		var err error
+		if parsedAllergies, err = domain.ParseAllergens(*allergies); err != nil {
+			return nil, nil, pkgerrors.BadRequest(err.Error(), err)
+		}
+	}
+
+	return parsedDiets, parsedAllergies, nil
+}
+
+// applyDietAndAllergies replaces the diets and allergies of a camper or staff member with the
+// provided values, leaving the ones that were not provided untouched
+func applyDietAndAllergies(diets *[]domain.DietaryRestriction, allergies *[]domain.Allergen, reqDiets *api.DietaryRestrictions, reqAllergies *api.Allergies) error {
+	parsedDiets, parsedAllergies, err := parseDietAndAllergies(reqDiets, reqAllergies)
+	if err != nil {
+		return err
+	}
+
+	if reqDiets != nil {
+		*diets = parsedDiets
+	}
+	if reqAllergies != nil {
+		*allergies = parsedAllergies
+	}
+	return nil
+}
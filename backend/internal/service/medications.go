@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// MedicationsService defines the interface for camper medication business logic
+type MedicationsService interface {
+	// List retrieves medications with pagination and optional search
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.MedicationsListResponse, error)
+
+	// GetByID retrieves a single medication by ID
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Medication, error)
+
+	// Create adds a medication to a camper's medication list
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MedicationCreationRequest) (*api.Medication, error)
+
+	// Update updates an existing medication
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MedicationUpdateRequest) (*api.Medication, error)
+
+	// Delete deletes a medication by ID
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+}
+
+// medicationsService implements MedicationsService
+type medicationsService struct {
+	repo        MedicationsRepository
+	campersRepo CampersRepository
+}
+
+// NewMedicationsService creates a new medications service
+func NewMedicationsService(repo MedicationsRepository, campersRepo CampersRepository) MedicationsService {
+	return &medicationsService{
+		repo:        repo,
+		campersRepo: campersRepo,
+	}
+}
+
+// List retrieves medications with pagination and optional search
+func (s *medicationsService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, limit int, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) (*api.MedicationsListResponse, error) {
+	medications, total, err := s.repo.List(ctx, tenantID, campID, limit, offset, search, filterStrings, sortBy, sortOrder)
+	if err != nil {
+		return nil, pkgerrors.BadRequest("Failed to list medications", err)
+	}
+
+	// Convert domain medications to API medications
+	apiMedications := make([]api.Medication, len(medications))
+	for i, medication := range medications {
+		apiMedications[i] = medication.ToAPI()
+	}
+
+	return &api.MedicationsListResponse{
+		Items:  apiMedications,
+		Limit:  limit,
+		Offset: offset,
+		Total:  int(total),
+	}, nil
+}
+
+// GetByID retrieves a single medication by ID
+func (s *medicationsService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Medication, error) {
+	medication, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Medication not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get medication", err)
+	}
+
+	apiMedication := medication.ToAPI()
+	return &apiMedication, nil
+}
+
+// Create adds a medication to a camper's medication list
+func (s *medicationsService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.MedicationCreationRequest) (*api.Medication, error) {
+	medication := &domain.Medication{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applySpec(ctx, medication, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, medication); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create medication", err)
+	}
+
+	apiMedication := medication.ToAPI()
+	return &apiMedication, nil
+}
+
+// Update updates an existing medication
+func (s *medicationsService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.MedicationUpdateRequest) (*api.Medication, error) {
+	// Check if medication exists and belongs to tenant/camp
+	existingMedication, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Medication not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get medication", err)
+	}
+
+	if err := s.applySpec(ctx, existingMedication, req.Meta, req.Spec); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, existingMedication); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update medication", err)
+	}
+
+	// Fetch updated medication to get latest timestamps
+	updatedMedication, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get updated medication", err)
+	}
+
+	apiMedication := updatedMedication.ToAPI()
+	return &apiMedication, nil
+}
+
+// Delete deletes a medication by ID
+func (s *medicationsService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	// Check if medication exists
+	_, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Medication not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get medication", err)
+	}
+
+	// Delete the medication; recorded doses stay on the MAR
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete medication", err)
+	}
+
+	return nil
+}
+
+// applySpec validates the request and copies its meta and spec onto the domain medication
+func (s *medicationsService) applySpec(ctx context.Context, medication *domain.Medication, meta api.EntityCreationRequestMeta, spec api.MedicationSpec) error {
+	if _, err := s.campersRepo.GetByID(ctx, medication.TenantID, medication.CampID, spec.CamperId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Camper not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	var scheduleTimes []string
+	if spec.ScheduleTimes != nil {
+		for _, scheduleTime := range *spec.ScheduleTimes {
+			if _, err := time.Parse("15:04", scheduleTime); err != nil {
+				return pkgerrors.BadRequest(fmt.Sprintf("Invalid schedule time '%s', expected HH:MM", scheduleTime), err)
+			}
+			scheduleTimes = append(scheduleTimes, scheduleTime)
+		}
+	}
+
+	asNeeded := utils.PtrToBool(spec.AsNeeded)
+	if !asNeeded && len(scheduleTimes) == 0 {
+		return pkgerrors.BadRequest("Scheduled medications need at least one schedule time", nil)
+	}
+
+	// Convert days of week from API to domain
+	var daysOfWeek domain.DaysOfWeek
+	if spec.DaysOfWeek != nil {
+		daysOfWeek = make([]string, len(*spec.DaysOfWeek))
+		for i, day := range *spec.DaysOfWeek {
+			daysOfWeek[i] = string(day)
+		}
+	}
+
+	var startDate, endDate *time.Time
+	if spec.StartDate != nil {
+		startDate = &spec.StartDate.Time
+	}
+	if spec.EndDate != nil {
+		endDate = &spec.EndDate.Time
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		return pkgerrors.BadRequest("End date must be after or equal to start date", nil)
+	}
+
+	medication.Name = meta.Name
+	medication.Description = utils.PtrToString(meta.Description)
+	medication.CamperID = spec.CamperId
+	medication.Dosage = spec.Dosage
+	medication.Route = utils.PtrToString(spec.Route)
+	medication.Instructions = utils.PtrToString(spec.Instructions)
+	medication.ScheduleTimes = scheduleTimes
+	medication.DaysOfWeek = daysOfWeek
+	medication.StartDate = startDate
+	medication.EndDate = endDate
+	medication.AsNeeded = asNeeded
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgcontext "github.com/tbechar/camp-manager-backend/pkg/context"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// NotesService defines the interface for camper and staff member note business logic
+type NotesService interface {
+	// List retrieves the notes the current user can read, optionally limited to one person
+	List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListNotesParams) (*api.NotesListResponse, error)
+
+	// GetByID retrieves a single note the current user can read
+	GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Note, error)
+
+	// Create writes a note about a camper or staff member as the current user
+	Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.NoteCreationRequest) (*api.Note, error)
+
+	// Update changes a note; only its author and admins may do so
+	Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.NoteUpdateRequest) (*api.Note, error)
+
+	// Delete removes a note; only its author and admins may do so
+	Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+}
+
+// notesService implements NotesService
+type notesService struct {
+	repo             NotesRepository
+	campersRepo      CampersRepository
+	staffMembersRepo StaffMembersRepository
+}
+
+// NewNotesService creates a new notes service
+func NewNotesService(repo NotesRepository, campersRepo CampersRepository, staffMembersRepo StaffMembersRepository) NotesService {
+	return &notesService{
+		repo:             repo,
+		campersRepo:      campersRepo,
+		staffMembersRepo: staffMembersRepo,
+	}
+}
+
+// List retrieves the notes the current user can read, pinned notes first
+func (s *notesService) List(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, params *api.ListNotesParams) (*api.NotesListResponse, error) {
+	var entityType *domain.NoteEntityType
+	if params.EntityType != nil {
+		value := domain.NoteEntityType(*params.EntityType)
+		entityType = &value
+	}
+
+	userID, _ := currentUser(ctx)
+	visibilities := domain.VisibleNoteLevels(campRoles(ctx, tenantID, campID))
+
+	notes, err := s.repo.List(ctx, tenantID, campID, entityType, params.EntityId, visibilities, userID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list notes", err)
+	}
+
+	items := make([]api.Note, len(notes))
+	for i := range notes {
+		items[i] = notes[i].ToAPI()
+	}
+
+	return &api.NotesListResponse{Items: items}, nil
+}
+
+// GetByID retrieves a single note, hiding notes the current user cannot read
+func (s *notesService) GetByID(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.Note, error) {
+	note, err := s.getReadable(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiNote := note.ToAPI()
+	return &apiNote, nil
+}
+
+// Create writes a note about a camper or staff member.
+// Users can only choose a visibility level they can read themselves.
+func (s *notesService) Create(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.NoteCreationRequest) (*api.Note, error) {
+	userID, email := currentUser(ctx)
+
+	note := &domain.Note{
+		TenantID:    tenantID,
+		CampID:      campID,
+		EntityType:  domain.NoteEntityType(req.EntityType),
+		EntityID:    req.EntityId,
+		Category:    domain.NoteCategory(req.Category),
+		Visibility:  domain.NoteVisibilityAllStaff,
+		Body:        strings.TrimSpace(req.Body),
+		Pinned:      req.Pinned != nil && *req.Pinned,
+		AuthorID:    userID,
+		AuthorEmail: email,
+	}
+	if req.Visibility != nil {
+		note.Visibility = domain.NoteVisibility(*req.Visibility)
+	}
+
+	if err := s.validate(ctx, tenantID, campID, note); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSubject(ctx, tenantID, campID, note.EntityType, note.EntityID); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, note); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create note", err)
+	}
+
+	apiNote := note.ToAPI()
+	return &apiNote, nil
+}
+
+// Update changes the category, visibility, body and pinned flag of a note
+func (s *notesService) Update(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.NoteUpdateRequest) (*api.Note, error) {
+	note, err := s.getEditable(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	note.Category = domain.NoteCategory(req.Category)
+	note.Visibility = domain.NoteVisibility(req.Visibility)
+	note.Body = strings.TrimSpace(req.Body)
+	note.Pinned = req.Pinned
+
+	if err := s.validate(ctx, tenantID, campID, note); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, note); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update note", err)
+	}
+
+	return s.GetByID(ctx, tenantID, campID, id)
+}
+
+// Delete removes a note
+func (s *notesService) Delete(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getEditable(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete note", err)
+	}
+
+	return nil
+}
+
+// getReadable loads a note and reports it as missing when the current user cannot read it
+func (s *notesService) getReadable(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Note, error) {
+	note, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Note not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get note", err)
+	}
+
+	userID, _ := currentUser(ctx)
+	if !note.IsAuthor(userID) && !canReadNoteVisibility(ctx, tenantID, campID, note.Visibility) {
+		return nil, pkgerrors.NotFound("Note not found", nil)
+	}
+
+	return note, nil
+}
+
+// getEditable loads a note the current user can change: admins can change any note they can
+// read and everyone else only the notes they wrote
+func (s *notesService) getEditable(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Note, error) {
+	note, err := s.getReadable(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := currentUser(ctx)
+	if !note.IsAuthor(userID) && !campRoles(ctx, tenantID, campID)["admin"] {
+		return nil, pkgerrors.Forbidden("Only the author or an admin can change this note", nil)
+	}
+
+	return note, nil
+}
+
+// validate checks the note fields and that the current user can read the chosen visibility
+func (s *notesService) validate(ctx context.Context, tenantID, campID uuid.UUID, note *domain.Note) error {
+	if note.EntityType != domain.NoteEntityTypeCamper && note.EntityType != domain.NoteEntityTypeStaffMember {
+		return pkgerrors.BadRequest("Invalid entity type: "+string(note.EntityType), nil)
+	}
+	if !note.Category.IsValid() {
+		return pkgerrors.BadRequest("Invalid category: "+string(note.Category), nil)
+	}
+	if !note.Visibility.IsValid() {
+		return pkgerrors.BadRequest("Invalid visibility: "+string(note.Visibility), nil)
+	}
+	if note.Body == "" {
+		return pkgerrors.BadRequest("Note body is required", nil)
+	}
+	if !canReadNoteVisibility(ctx, tenantID, campID, note.Visibility) {
+		return pkgerrors.Forbidden("You cannot write notes with visibility "+string(note.Visibility), nil)
+	}
+	return nil
+}
+
+// checkSubject verifies the camper or staff member a note is about exists in the camp
+func (s *notesService) checkSubject(ctx context.Context, tenantID, campID uuid.UUID, entityType domain.NoteEntityType, entityID uuid.UUID) error {
+	var err error
+	if entityType == domain.NoteEntityTypeCamper {
+		_, err = s.campersRepo.GetByID(ctx, tenantID, campID, entityID)
+	} else {
+		_, err = s.staffMembersRepo.GetByID(ctx, tenantID, campID, entityID)
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest("Referenced person does not exist in this camp", err)
+		}
+		return pkgerrors.InternalServerError("Failed to validate note subject", err)
+	}
+	return nil
+}
+
+// canReadNoteVisibility reports whether the current user's roles in the camp grant access to a visibility level
+func canReadNoteVisibility(ctx context.Context, tenantID, campID uuid.UUID, visibility domain.NoteVisibility) bool {
+	for _, level := range domain.VisibleNoteLevels(campRoles(ctx, tenantID, campID)) {
+		if level == visibility {
+			return true
+		}
+	}
+	return false
+}
+
+// campRoles returns the roles the current user holds in a camp, including system and tenant wide roles
+func campRoles(ctx context.Context, tenantID, campID uuid.UUID) map[string]bool {
+	roles := make(map[string]bool)
+
+	accessRules, err := pkgcontext.ExtractAccessRules(ctx)
+	if err != nil {
+		return roles
+	}
+
+	for _, rule := range accessRules {
+		if hasAccessToCamp([]domain.AccessRule{rule}, tenantID, campID) {
+			roles[rule.Role] = true
+		}
+	}
+
+	return roles
+}
@@ -0,0 +1,494 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// OnboardingService defines the interface for staff onboarding templates and progress business logic
+type OnboardingService interface {
+	// ListTemplates retrieves the onboarding templates of a camp by name
+	ListTemplates(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.OnboardingTemplatesListResponse, error)
+
+	// GetTemplate retrieves a single onboarding template
+	GetTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.OnboardingTemplate, error)
+
+	// CreateTemplate adds a checklist staff members have to complete before they can work
+	CreateTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.OnboardingTemplateCreationRequest) (*api.OnboardingTemplate, error)
+
+	// UpdateTemplate changes the details, checklist and required certifications of a template
+	UpdateTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.OnboardingTemplateUpdateRequest) (*api.OnboardingTemplate, error)
+
+	// DeleteTemplate removes a template; staff members following it fall back to the camp's default
+	DeleteTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetStaffOnboarding retrieves the onboarding progress and readiness of a staff member
+	GetStaffOnboarding(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID) (*api.StaffOnboarding, error)
+
+	// CompleteItem marks a checklist item of a staff member completed
+	CompleteItem(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, itemID uuid.UUID, req *api.StaffOnboardingItemCompletionRequest) (*api.StaffOnboarding, error)
+
+	// UncompleteItem marks a checklist item of a staff member not completed
+	UncompleteItem(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, itemID uuid.UUID) (*api.StaffOnboarding, error)
+
+	// GetMyOnboarding retrieves the onboarding progress of the staff member linked to the current user
+	GetMyOnboarding(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffOnboarding, error)
+
+	// GetReport lists the onboarding progress of the camp's staff members, optionally only those (not) ready
+	GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, ready *bool) (*api.OnboardingReport, error)
+}
+
+// onboardingService implements OnboardingService
+type onboardingService struct {
+	templatesRepo      OnboardingTemplatesRepository
+	completionsRepo    OnboardingCompletionsRepository
+	staffMembersRepo   StaffMembersRepository
+	certificationsRepo CertificationsRepository
+	attachmentsRepo    AttachmentsRepository
+	campsRepo          CampsRepository
+}
+
+// NewOnboardingService creates a new onboarding service
+func NewOnboardingService(templatesRepo OnboardingTemplatesRepository, completionsRepo OnboardingCompletionsRepository, staffMembersRepo StaffMembersRepository, certificationsRepo CertificationsRepository, attachmentsRepo AttachmentsRepository, campsRepo CampsRepository) OnboardingService {
+	return &onboardingService{
+		templatesRepo:      templatesRepo,
+		completionsRepo:    completionsRepo,
+		staffMembersRepo:   staffMembersRepo,
+		certificationsRepo: certificationsRepo,
+		attachmentsRepo:    attachmentsRepo,
+		campsRepo:          campsRepo,
+	}
+}
+
+// ListTemplates retrieves the onboarding templates of a camp
+func (s *onboardingService) ListTemplates(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.OnboardingTemplatesListResponse, error) {
+	templates, err := s.templatesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list onboarding templates", err)
+	}
+
+	items := make([]api.OnboardingTemplate, len(templates))
+	for i := range templates {
+		items[i] = templates[i].ToAPI()
+	}
+
+	return &api.OnboardingTemplatesListResponse{Items: items}, nil
+}
+
+// GetTemplate retrieves a single onboarding template
+func (s *onboardingService) GetTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.OnboardingTemplate, error) {
+	template, err := s.getTemplate(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTemplate := template.ToAPI()
+	return &apiTemplate, nil
+}
+
+// CreateTemplate adds a checklist staff members have to complete before they can work
+func (s *onboardingService) CreateTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.OnboardingTemplateCreationRequest) (*api.OnboardingTemplate, error) {
+	template := &domain.OnboardingTemplate{
+		TenantID:    tenantID,
+		CampID:      campID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: utils.PtrToString(req.Description),
+		IsDefault:   req.IsDefault != nil && *req.IsDefault,
+		Items:       []domain.OnboardingItem{},
+	}
+
+	if err := s.applyTemplateContents(ctx, template, req.Items, req.RequiredCertificationIds); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.templatesRepo.Create(ctx, template); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create onboarding template", err)
+	}
+
+	apiTemplate := template.ToAPI()
+	return &apiTemplate, nil
+}
+
+// UpdateTemplate changes the details, checklist and required certifications of a template
+func (s *onboardingService) UpdateTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.OnboardingTemplateUpdateRequest) (*api.OnboardingTemplate, error) {
+	template, err := s.getTemplate(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Name = strings.TrimSpace(req.Name)
+	template.Description = utils.PtrToString(req.Description)
+	template.IsDefault = req.IsDefault != nil && *req.IsDefault
+
+	if err := s.applyTemplateContents(ctx, template, req.Items, req.RequiredCertificationIds); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.templatesRepo.Update(ctx, tenantID, campID, template); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update onboarding template", err)
+	}
+
+	return s.GetTemplate(ctx, tenantID, campID, id)
+}
+
+// DeleteTemplate removes a template; staff members following it fall back to the camp's default
+func (s *onboardingService) DeleteTemplate(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getTemplate(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.templatesRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete onboarding template", err)
+	}
+	return nil
+}
+
+// GetStaffOnboarding retrieves the onboarding progress and readiness of a staff member
+func (s *onboardingService) GetStaffOnboarding(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID) (*api.StaffOnboarding, error) {
+	staffMember, err := s.getStaffMember(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := loadOnboardingProgress(ctx, tenantID, campID, &staffMemberID, s.templatesRepo, s.completionsRepo, s.certificationsRepo, s.campsRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	onboarding := progress.staffOnboarding(staffMember)
+	return &onboarding, nil
+}
+
+// GetMyOnboarding retrieves the onboarding progress of the staff member linked to the current user
+func (s *onboardingService) GetMyOnboarding(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffOnboarding, error) {
+	staffMember, err := linkedStaffMember(ctx, s.staffMembersRepo, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetStaffOnboarding(ctx, tenantID, campID, staffMember.ID)
+}
+
+// CompleteItem marks a checklist item of a staff member completed, linking the document completing it
+func (s *onboardingService) CompleteItem(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, itemID uuid.UUID, req *api.StaffOnboardingItemCompletionRequest) (*api.StaffOnboarding, error) {
+	staffMember, err := s.getStaffMember(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.checklistItem(ctx, tenantID, campID, staffMember, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AttachmentId != nil {
+		attachment, err := s.attachmentsRepo.GetByID(ctx, tenantID, campID, *req.AttachmentId)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.InternalServerError("Failed to get attachment", err)
+		}
+		if err != nil || attachment.EntityType != domain.AttachmentEntityTypeStaffMember || attachment.EntityID != staffMemberID {
+			return nil, pkgerrors.BadRequest("Attachment not found for this staff member", err)
+		}
+	} else if item.RequiresAttachment {
+		return nil, pkgerrors.BadRequest("Completing '"+item.Title+"' requires an attached document", nil)
+	}
+
+	userID, email := currentUser(ctx)
+	completion := &domain.OnboardingCompletion{
+		TenantID:         tenantID,
+		CampID:           campID,
+		StaffMemberID:    staffMemberID,
+		ItemID:           itemID,
+		AttachmentID:     req.AttachmentId,
+		Notes:            utils.PtrToString(req.Notes),
+		CompletedBy:      userID,
+		CompletedByEmail: email,
+		CompletedAt:      time.Now(),
+	}
+	if err := s.completionsRepo.Save(ctx, completion); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to complete onboarding item", err)
+	}
+
+	return s.GetStaffOnboarding(ctx, tenantID, campID, staffMemberID)
+}
+
+// UncompleteItem marks a checklist item of a staff member not completed
+func (s *onboardingService) UncompleteItem(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, itemID uuid.UUID) (*api.StaffOnboarding, error) {
+	staffMember, err := s.getStaffMember(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checklistItem(ctx, tenantID, campID, staffMember, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.completionsRepo.Delete(ctx, tenantID, campID, staffMemberID, itemID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to uncomplete onboarding item", err)
+	}
+
+	return s.GetStaffOnboarding(ctx, tenantID, campID, staffMemberID)
+}
+
+// GetReport lists the onboarding progress of the camp's staff members, those not ready first
+func (s *onboardingService) GetReport(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, ready *bool) (*api.OnboardingReport, error) {
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+
+	progress, err := loadOnboardingProgress(ctx, tenantID, campID, nil, s.templatesRepo, s.completionsRepo, s.certificationsRepo, s.campsRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &api.OnboardingReport{
+		GeneratedAt:  time.Now(),
+		StaffMembers: []api.StaffOnboarding{},
+	}
+	for i := range staffMembers {
+		onboarding := progress.staffOnboarding(&staffMembers[i])
+		if onboarding.Ready {
+			report.ReadyCount++
+		} else {
+			report.NotReadyCount++
+		}
+		if ready != nil && onboarding.Ready != *ready {
+			continue
+		}
+		report.StaffMembers = append(report.StaffMembers, onboarding)
+	}
+
+	// Staff members come by name; keep that order within the not ready and ready ones
+	sort.SliceStable(report.StaffMembers, func(i, j int) bool {
+		return !report.StaffMembers[i].Ready && report.StaffMembers[j].Ready
+	})
+
+	return report, nil
+}
+
+// applyTemplateContents sets the checklist and required certifications of a template from a request
+func (s *onboardingService) applyTemplateContents(ctx context.Context, template *domain.OnboardingTemplate, items *[]api.OnboardingItemInput, certificationIDs *[]uuid.UUID) error {
+	var inputs []api.OnboardingItemInput
+	if items != nil {
+		inputs = *items
+	}
+	parsed, err := domain.ParseOnboardingItems(inputs, template.Items)
+	if err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	template.Items = parsed
+
+	template.RequiredCertificationIDs = uniqueIDs(certificationIDs)
+	if len(template.RequiredCertificationIDs) > 0 {
+		certifications, err := s.certificationsRepo.ListAll(ctx, template.TenantID, template.CampID)
+		if err != nil {
+			return pkgerrors.InternalServerError("Failed to list certifications", err)
+		}
+		known := make(map[uuid.UUID]bool, len(certifications))
+		for _, certification := range certifications {
+			known[certification.ID] = true
+		}
+		for _, id := range template.RequiredCertificationIDs {
+			if !known[id] {
+				return pkgerrors.BadRequest("Certification not found: "+id.String(), nil)
+			}
+		}
+	}
+
+	if err := template.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	return nil
+}
+
+// checklistItem finds an item on the checklist the staff member follows
+func (s *onboardingService) checklistItem(ctx context.Context, tenantID, campID uuid.UUID, staffMember *domain.StaffMember, itemID uuid.UUID) (*domain.OnboardingItem, error) {
+	progress, err := loadOnboardingProgress(ctx, tenantID, campID, &staffMember.ID, s.templatesRepo, s.completionsRepo, s.certificationsRepo, s.campsRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if template := progress.template(staffMember); template != nil {
+		if item := template.Item(itemID); item != nil {
+			return item, nil
+		}
+	}
+	return nil, pkgerrors.NotFound("Onboarding item not found on the staff member's checklist", nil)
+}
+
+// getTemplate loads an onboarding template, reporting it as not found when it does not exist in the camp
+func (s *onboardingService) getTemplate(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.OnboardingTemplate, error) {
+	template, err := s.templatesRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Onboarding template not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get onboarding template", err)
+	}
+	return template, nil
+}
+
+// getStaffMember loads a staff member with their certifications
+func (s *onboardingService) getStaffMember(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.StaffMember, error) {
+	staffMember, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, pkgerrors.NotFound("Staff member not found", err)
+	}
+	return staffMember, nil
+}
+
+// onboardingProgress holds the templates, completions and certifications needed to work out the
+// onboarding progress of a camp's staff members
+type onboardingProgress struct {
+	templates          map[uuid.UUID]*domain.OnboardingTemplate
+	defaultTemplate    *domain.OnboardingTemplate
+	completions        map[uuid.UUID]map[uuid.UUID]*domain.OnboardingCompletion
+	certificationNames map[uuid.UUID]string
+	today              time.Time
+}
+
+// loadOnboardingProgress loads the onboarding state of a camp, optionally only the completions of a staff member
+func loadOnboardingProgress(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, templatesRepo OnboardingTemplatesRepository, completionsRepo OnboardingCompletionsRepository, certificationsRepo CertificationsRepository, campsRepo CampsRepository) (*onboardingProgress, error) {
+	camp, err := campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+
+	templates, err := templatesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list onboarding templates", err)
+	}
+
+	completions, err := completionsRepo.List(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list onboarding completions", err)
+	}
+
+	certifications, err := certificationsRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list certifications", err)
+	}
+
+	progress := &onboardingProgress{
+		templates:          make(map[uuid.UUID]*domain.OnboardingTemplate, len(templates)),
+		completions:        make(map[uuid.UUID]map[uuid.UUID]*domain.OnboardingCompletion),
+		certificationNames: make(map[uuid.UUID]string, len(certifications)),
+		today:              localDate(time.Now(), camp.TimeLocation()),
+	}
+	for i := range templates {
+		progress.templates[templates[i].ID] = &templates[i]
+		if templates[i].IsDefault {
+			progress.defaultTemplate = &templates[i]
+		}
+	}
+	for i := range completions {
+		completion := &completions[i]
+		if progress.completions[completion.StaffMemberID] == nil {
+			progress.completions[completion.StaffMemberID] = make(map[uuid.UUID]*domain.OnboardingCompletion)
+		}
+		progress.completions[completion.StaffMemberID][completion.ItemID] = completion
+	}
+	for _, certification := range certifications {
+		progress.certificationNames[certification.ID] = certification.Name
+	}
+
+	return progress, nil
+}
+
+// template returns the onboarding template a staff member follows: their own, or else the camp's default
+func (p *onboardingProgress) template(staffMember *domain.StaffMember) *domain.OnboardingTemplate {
+	if staffMember.OnboardingTemplateID != nil {
+		if template, ok := p.templates[*staffMember.OnboardingTemplateID]; ok {
+			return template
+		}
+	}
+	return p.defaultTemplate
+}
+
+// staffOnboarding works out a staff member's progress on their checklist and required certifications.
+// Staff members without a template have nothing to complete and are ready.
+func (p *onboardingProgress) staffOnboarding(staffMember *domain.StaffMember) api.StaffOnboarding {
+	onboarding := api.StaffOnboarding{
+		StaffMemberId:   staffMember.ID,
+		StaffMemberName: staffMember.Name,
+		Items:           []api.StaffOnboardingItem{},
+		Certifications:  []api.StaffOnboardingCertification{},
+	}
+
+	template := p.template(staffMember)
+	if template == nil {
+		onboarding.Ready = true
+		return onboarding
+	}
+	onboarding.TemplateId = &template.ID
+	onboarding.TemplateName = &template.Name
+
+	for i := range template.Items {
+		item := &template.Items[i]
+		status := api.StaffOnboardingItem{
+			ItemId:             item.ID,
+			Title:              item.Title,
+			Description:        utils.StringToPtr(item.Description),
+			RequiresAttachment: item.RequiresAttachment,
+		}
+		if item.DueOn != nil {
+			status.DueOn = &openapi_types.Date{Time: *item.DueOn}
+		}
+		if completion, ok := p.completions[staffMember.ID][item.ID]; ok {
+			status.Completed = true
+			status.AttachmentId = completion.AttachmentID
+			status.Notes = utils.StringToPtr(completion.Notes)
+			status.CompletedBy = completion.CompletedBy
+			status.CompletedByEmail = utils.StringToPtr(completion.CompletedByEmail)
+			status.CompletedAt = &completion.CompletedAt
+			onboarding.CompletedCount++
+		} else if item.OverdueOn(p.today) {
+			status.Overdue = true
+			onboarding.OverdueCount++
+		}
+		onboarding.Items = append(onboarding.Items, status)
+	}
+
+	// Certifications deleted since they were required are not counted
+	for _, certificationID := range template.RequiredCertificationIDs {
+		name, ok := p.certificationNames[certificationID]
+		if !ok {
+			continue
+		}
+		status := api.StaffOnboardingCertification{
+			CertificationId:   certificationID,
+			CertificationName: name,
+		}
+		if cert := staffMember.Certification(certificationID); cert != nil {
+			status.Valid = !cert.ExpiredOn(p.today)
+			if cert.ExpiresOn != nil {
+				status.ExpiresOn = &openapi_types.Date{Time: *cert.ExpiresOn}
+			}
+		}
+		if status.Valid {
+			onboarding.CompletedCount++
+		}
+		onboarding.Certifications = append(onboarding.Certifications, status)
+	}
+
+	onboarding.TotalCount = len(onboarding.Items) + len(onboarding.Certifications)
+	onboarding.Ready = onboarding.CompletedCount == onboarding.TotalCount
+	return onboarding
+}
@@ -0,0 +1,485 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultRatioDays is how many days after the first day ratios are checked when no last day is given
+const defaultRatioDays = 7
+
+// RatioComplianceService defines the interface for supervision ratio policies and compliance checks
+type RatioComplianceService interface {
+	// ListPolicies retrieves the ratio policies of a camp by name
+	ListPolicies(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.RatioPoliciesListResponse, error)
+
+	// GetPolicy retrieves a single ratio policy
+	GetPolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.RatioPolicy, error)
+
+	// CreatePolicy adds a supervision ratio for an age band
+	CreatePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.RatioPolicyCreationRequest) (*api.RatioPolicy, error)
+
+	// UpdatePolicy changes the age band, ratio and scope of a ratio policy
+	UpdatePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.RatioPolicyUpdateRequest) (*api.RatioPolicy, error)
+
+	// DeletePolicy removes a ratio policy
+	DeletePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetCompliance checks the events and housing groups of a range of days (a week from today by default)
+	// against the ratio policies, grouping violations by day
+	GetCompliance(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.RatioComplianceReport, error)
+}
+
+// ratioComplianceService implements RatioComplianceService
+type ratioComplianceService struct {
+	policiesRepo   RatioPoliciesRepository
+	activitiesRepo ActivitiesRepository
+	programsRepo   ProgramsRepository
+	eventsRepo     EventsRepository
+	groupsRepo     GroupsRepository
+	campersRepo    CampersRepository
+	campsRepo      CampsRepository
+}
+
+// NewRatioComplianceService creates a new ratio compliance service
+func NewRatioComplianceService(policiesRepo RatioPoliciesRepository, activitiesRepo ActivitiesRepository, programsRepo ProgramsRepository, eventsRepo EventsRepository, groupsRepo GroupsRepository, campersRepo CampersRepository, campsRepo CampsRepository) RatioComplianceService {
+	return &ratioComplianceService{
+		policiesRepo:   policiesRepo,
+		activitiesRepo: activitiesRepo,
+		programsRepo:   programsRepo,
+		eventsRepo:     eventsRepo,
+		groupsRepo:     groupsRepo,
+		campersRepo:    campersRepo,
+		campsRepo:      campsRepo,
+	}
+}
+
+// ListPolicies retrieves the ratio policies of a camp
+func (s *ratioComplianceService) ListPolicies(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.RatioPoliciesListResponse, error) {
+	policies, err := s.policiesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list ratio policies", err)
+	}
+
+	items := make([]api.RatioPolicy, len(policies))
+	for i := range policies {
+		items[i] = policies[i].ToAPI()
+	}
+
+	return &api.RatioPoliciesListResponse{Items: items}, nil
+}
+
+// GetPolicy retrieves a single ratio policy
+func (s *ratioComplianceService) GetPolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.RatioPolicy, error) {
+	policy, err := s.getPolicy(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPolicy := policy.ToAPI()
+	return &apiPolicy, nil
+}
+
+// CreatePolicy adds a supervision ratio for an age band
+func (s *ratioComplianceService) CreatePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.RatioPolicyCreationRequest) (*api.RatioPolicy, error) {
+	policy := &domain.RatioPolicy{
+		TenantID: tenantID,
+		CampID:   campID,
+	}
+
+	if err := s.applyPolicy(ctx, tenantID, campID, policy, api.RatioPolicyUpdateRequest(*req)); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.policiesRepo.Create(ctx, policy); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create ratio policy", err)
+	}
+
+	apiPolicy := policy.ToAPI()
+	return &apiPolicy, nil
+}
+
+// UpdatePolicy changes the age band, ratio and scope of a ratio policy
+func (s *ratioComplianceService) UpdatePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.RatioPolicyUpdateRequest) (*api.RatioPolicy, error) {
+	policy, err := s.getPolicy(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyPolicy(ctx, tenantID, campID, policy, *req); err != nil {
+		return nil, err
+	}
+
+	// Save updates
+	if err := s.policiesRepo.Update(ctx, tenantID, campID, policy); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update ratio policy", err)
+	}
+
+	return s.GetPolicy(ctx, tenantID, campID, id)
+}
+
+// DeletePolicy removes a ratio policy
+func (s *ratioComplianceService) DeletePolicy(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getPolicy(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.policiesRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete ratio policy", err)
+	}
+
+	return nil
+}
+
+// GetCompliance checks every event starting in the range and every housing group on each night of the range.
+// Only campers enrolled on the day are counted, and each counts towards the strictest policy covering their age.
+func (s *ratioComplianceService) GetCompliance(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.RatioComplianceReport, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	first := localDate(time.Now(), loc)
+	if from != nil {
+		first = *from
+	}
+	last := first.AddDate(0, 0, defaultRatioDays)
+	if to != nil {
+		last = *to
+	}
+	if err := checkRosterRange(first, last); err != nil {
+		return nil, err
+	}
+
+	report := &api.RatioComplianceReport{
+		From: openapi_types.Date{Time: first},
+		To:   openapi_types.Date{Time: last},
+		Days: []api.RatioComplianceDay{},
+	}
+
+	policies, err := s.policiesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list ratio policies", err)
+	}
+	if len(policies) == 0 {
+		return report, nil
+	}
+
+	groups, err := s.groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+	groupsByID := make(map[uuid.UUID]*domain.Group, len(groups))
+	for i := range groups {
+		groupsByID[groups[i].ID] = &groups[i]
+	}
+
+	// Campers enrolled on each day of the range
+	enrolled := make(map[time.Time]map[uuid.UUID]*domain.Camper)
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		campers, err := s.campersRepo.ListEnrolledOnDate(ctx, tenantID, campID, day)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to list enrolled campers", err)
+		}
+		byID := make(map[uuid.UUID]*domain.Camper, len(campers))
+		for i := range campers {
+			byID[campers[i].ID] = &campers[i]
+		}
+		enrolled[day] = byID
+	}
+
+	violations := make(map[time.Time][]api.RatioViolation)
+
+	start, end := dayRange(first, last, loc)
+	events, err := s.eventsRepo.ListBetween(ctx, tenantID, campID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+	for i := range events {
+		event := &events[i]
+		if event.StartDate.Before(start) {
+			continue
+		}
+
+		var eventPolicies []domain.RatioPolicy
+		for _, policy := range policies {
+			if policy.CoversEvent(event.ActivityID, event.ProgramID) {
+				eventPolicies = append(eventPolicies, policy)
+			}
+		}
+		if len(eventPolicies) == 0 {
+			continue
+		}
+
+		day := localDate(event.StartDate, loc)
+		campers, staffIDs := eventParticipants(event, groupsByID, enrolled[day])
+		staffCount := len(staffIDs)
+		check := checkRatio(eventPolicies, campers, day)
+		if check.campers == 0 {
+			continue
+		}
+		report.EventsChecked++
+
+		if staffCount < check.requiredStaff {
+			violations[day] = append(violations[day], api.RatioViolation{
+				Type:          api.RatioViolationTypeEvent,
+				EventId:       &event.ID,
+				EventName:     utils.StringToPtr(event.Name),
+				StartDate:     event.StartDate,
+				EndDate:       event.EndDate,
+				CamperCount:   check.campers,
+				StaffCount:    staffCount,
+				RequiredStaff: check.requiredStaff,
+				PolicyIds:     check.policyIDs,
+				Message:       ratioMessage(event.Name, staffCount, check),
+			})
+		}
+	}
+
+	var overnightPolicies []domain.RatioPolicy
+	for _, policy := range policies {
+		if policy.AppliesToOvernight {
+			overnightPolicies = append(overnightPolicies, policy)
+		}
+	}
+	for day := first; len(overnightPolicies) > 0 && !day.After(last); day = day.AddDate(0, 0, 1) {
+		nightStart, nightEnd := nightWindow(camp, day, loc)
+		for i := range groups {
+			group := &groups[i]
+			if group.HousingRoomID == nil {
+				continue
+			}
+
+			var campers []*domain.Camper
+			for _, member := range group.GroupCampers {
+				if camper, ok := enrolled[day][member.CamperID]; ok {
+					campers = append(campers, camper)
+				}
+			}
+			check := checkRatio(overnightPolicies, campers, day)
+			if check.campers == 0 {
+				continue
+			}
+			report.NightsChecked++
+
+			staffCount := len(group.GroupStaffMembers)
+			if staffCount < check.requiredStaff {
+				violations[day] = append(violations[day], api.RatioViolation{
+					Type:          api.RatioViolationTypeOvernight,
+					GroupId:       &group.ID,
+					GroupName:     utils.StringToPtr(group.Name),
+					StartDate:     nightStart,
+					EndDate:       nightEnd,
+					CamperCount:   check.campers,
+					StaffCount:    staffCount,
+					RequiredStaff: check.requiredStaff,
+					PolicyIds:     check.policyIDs,
+					Message:       ratioMessage(group.Name+" overnight", staffCount, check),
+				})
+			}
+		}
+	}
+
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		items := violations[day]
+		if len(items) == 0 {
+			continue
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].StartDate.Before(items[j].StartDate)
+		})
+		report.Days = append(report.Days, api.RatioComplianceDay{
+			Date:       openapi_types.Date{Time: day},
+			Violations: items,
+		})
+		report.ViolationCount += len(items)
+	}
+
+	return report, nil
+}
+
+// applyPolicy validates a policy request and copies it onto the policy
+func (s *ratioComplianceService) applyPolicy(ctx context.Context, tenantID, campID uuid.UUID, policy *domain.RatioPolicy, req api.RatioPolicyUpdateRequest) error {
+	activityIDs := uniqueIDs(req.ActivityIds)
+	for _, id := range activityIDs {
+		if _, err := s.activitiesRepo.GetByID(ctx, tenantID, campID, id); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest("Activity not found: "+id.String(), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get activity", err)
+		}
+	}
+
+	programIDs := uniqueIDs(req.ProgramIds)
+	for _, id := range programIDs {
+		if _, err := s.programsRepo.GetByID(ctx, tenantID, campID, id); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.BadRequest("Program not found: "+id.String(), err)
+			}
+			return pkgerrors.InternalServerError("Failed to get program", err)
+		}
+	}
+
+	policy.Name = strings.TrimSpace(req.Name)
+	policy.Description = utils.PtrToString(req.Description)
+	policy.MinAge = req.MinAge
+	policy.MaxAge = req.MaxAge
+	policy.CampersPerStaff = req.CampersPerStaff
+	policy.AppliesToEvents = req.AppliesToEvents == nil || *req.AppliesToEvents
+	policy.AppliesToOvernight = req.AppliesToOvernight == nil || *req.AppliesToOvernight
+	policy.ActivityIDs = activityIDs
+	policy.ProgramIDs = programIDs
+
+	if err := policy.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+	return nil
+}
+
+// getPolicy loads a ratio policy, reporting it as not found when it does not exist in the camp
+func (s *ratioComplianceService) getPolicy(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.RatioPolicy, error) {
+	policy, err := s.policiesRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Ratio policy not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get ratio policy", err)
+	}
+	return policy, nil
+}
+
+// ratioCheck is the outcome of checking campers against ratio policies
+type ratioCheck struct {
+	campers       int
+	requiredStaff int
+	policyIDs     []uuid.UUID
+}
+
+// checkRatio counts each camper towards the strictest policy covering their age on the day and returns the
+// staff members needed, e.g. 6 campers under a 1:6 policy and 10 under a 1:10 policy need 2 staff members.
+// Campers no policy covers are not counted.
+func checkRatio(policies []domain.RatioPolicy, campers []*domain.Camper, day time.Time) ratioCheck {
+	counts := make([]int, len(policies))
+	check := ratioCheck{policyIDs: []uuid.UUID{}}
+	for _, camper := range campers {
+		age := domain.AgeOn(camper.Birthday, day)
+		strictest := -1
+		for i := range policies {
+			if policies[i].CoversAge(age) && (strictest < 0 || policies[i].CampersPerStaff < policies[strictest].CampersPerStaff) {
+				strictest = i
+			}
+		}
+		if strictest >= 0 {
+			counts[strictest]++
+			check.campers++
+		}
+	}
+
+	staff := 0.0
+	for i, count := range counts {
+		if count > 0 {
+			staff += float64(count) / float64(policies[i].CampersPerStaff)
+			check.policyIDs = append(check.policyIDs, policies[i].ID)
+		}
+	}
+	// Allow for rounding so that e.g. 3 × 1/3 needs exactly one staff member
+	check.requiredStaff = int(math.Ceil(staff - 1e-9))
+	return check
+}
+
+// eventParticipants resolves the enrolled campers of an event's groups, including nested groups, without the
+// excluded campers, and its staff members: those assigned to its positions and the counselors of its
+// groups, without the excluded staff members
+func eventParticipants(event *domain.Event, groupsByID map[uuid.UUID]*domain.Group, enrolled map[uuid.UUID]*domain.Camper) ([]*domain.Camper, map[uuid.UUID]bool) {
+	var groupIDs, excludedCamperIDs, excludedStaffIDs []uuid.UUID
+	if len(event.GroupIDs) > 0 {
+		_ = json.Unmarshal(event.GroupIDs, &groupIDs)
+	}
+	if len(event.ExcludeCamperIDs) > 0 {
+		_ = json.Unmarshal(event.ExcludeCamperIDs, &excludedCamperIDs)
+	}
+	if len(event.ExcludeStaffIDs) > 0 {
+		_ = json.Unmarshal(event.ExcludeStaffIDs, &excludedStaffIDs)
+	}
+
+	camperIDs := make(map[uuid.UUID]bool)
+	staffIDs := make(map[uuid.UUID]bool)
+	visited := make(map[uuid.UUID]bool)
+	var visit func(id uuid.UUID)
+	visit = func(id uuid.UUID) {
+		group, ok := groupsByID[id]
+		if !ok || visited[id] {
+			return
+		}
+		visited[id] = true
+		for _, member := range group.GroupCampers {
+			camperIDs[member.CamperID] = true
+		}
+		for _, member := range group.GroupStaffMembers {
+			staffIDs[member.StaffMemberID] = true
+		}
+		for _, child := range group.ChildGroups {
+			visit(child.ChildGroupID)
+		}
+	}
+	for _, id := range groupIDs {
+		visit(id)
+	}
+
+	if len(event.RequiredStaff) > 0 {
+		var positions []api.EventRequiredStaffPosition
+		if err := json.Unmarshal(event.RequiredStaff, &positions); err == nil {
+			for _, position := range positions {
+				if position.AssignedStaffId != nil {
+					staffIDs[*position.AssignedStaffId] = true
+				}
+			}
+		}
+	}
+
+	for _, id := range excludedCamperIDs {
+		delete(camperIDs, id)
+	}
+	for _, id := range excludedStaffIDs {
+		delete(staffIDs, id)
+	}
+
+	campers := make([]*domain.Camper, 0, len(camperIDs))
+	for id := range camperIDs {
+		if camper, ok := enrolled[id]; ok {
+			campers = append(campers, camper)
+		}
+	}
+	return campers, staffIDs
+}
+
+// nightWindow returns the instants the night after a day starts and ends: from the end of the camp day
+// to the start of the next camp day
+func nightWindow(camp *domain.Camp, day time.Time, loc *time.Location) (time.Time, time.Time) {
+	at := func(clock string, offset int) time.Time {
+		t, _ := time.Parse("15:04", clock)
+		return time.Date(day.Year(), day.Month(), day.Day()+offset, t.Hour(), t.Minute(), 0, 0, loc)
+	}
+	return at(camp.DailyEndTime, 0), at(camp.DailyStartTime, 1)
+}
+
+// ratioMessage describes a ratio violation
+func ratioMessage(name string, staffCount int, check ratioCheck) string {
+	return fmt.Sprintf("%s has %d staff members for %d campers; the ratio policies require %d", name, staffCount, check.campers, check.requiredStaff)
+}
@@ -18,6 +18,19 @@ type ActivitiesRepository interface {
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// ApplicationsRepository defines the data access interface for camper applications
+type ApplicationsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Application, int64, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Application, error)
+	ListTransitions(ctx context.Context, tenantID, campID, applicationID uuid.UUID) ([]domain.ApplicationTransition, error)
+	NextWaitlistPosition(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (int, error)
+	FirstWaitlisted(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (*domain.Application, error)
+	Create(ctx context.Context, application *domain.Application, transition *domain.ApplicationTransition) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, application *domain.Application) error
+	Transition(ctx context.Context, tenantID, campID uuid.UUID, application *domain.Application, transition *domain.ApplicationTransition, newCamper *domain.Camper, takesPlace bool) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // AreasRepository defines the data access interface for areas
 type AreasRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Area, int64, error)
@@ -27,19 +40,89 @@ type AreasRepository interface {
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// AttachmentsRepository defines the data access interface for attachment metadata
+type AttachmentsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.AttachmentEntityType, entityID *uuid.UUID) ([]domain.Attachment, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Attachment, error)
+	Create(ctx context.Context, attachment *domain.Attachment) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// AttendanceRepository defines the data access interface for daily camper sign-ins and sign-outs
+type AttendanceRepository interface {
+	ListByDate(ctx context.Context, tenantID, campID uuid.UUID, date time.Time, camperID *uuid.UUID) ([]domain.AttendanceRecord, error)
+	ListForCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID, from, to *time.Time) ([]domain.AttendanceRecord, error)
+	GetLatestForCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID, date time.Time) (*domain.AttendanceRecord, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.AttendanceRecord, error)
+	Create(ctx context.Context, record *domain.AttendanceRecord) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// BedsRepository defines the data access interface for beds
+type BedsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, housingRoomID *uuid.UUID) ([]domain.Bed, error)
+	CountByRoom(ctx context.Context, tenantID, campID, housingRoomID uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Bed, error)
+	Create(ctx context.Context, bed *domain.Bed) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, bed *domain.Bed) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// BedAssignmentsRepository defines the data access interface for bed assignments
+type BedAssignmentsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, sessionID, housingRoomID *uuid.UUID) ([]domain.BedAssignment, error)
+	ListOverlapping(ctx context.Context, tenantID, campID uuid.UUID, start, end time.Time) ([]domain.BedAssignment, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.BedAssignment, error)
+	Create(ctx context.Context, assignment *domain.BedAssignment) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.BedAssignment) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// BunkRequestsRepository defines the data access interface for bunkmate requests
+type BunkRequestsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, sessionID, camperID *uuid.UUID) ([]domain.BunkRequest, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.BunkRequest, error)
+	GetByCampers(ctx context.Context, tenantID, campID, sessionID, camperID, requestedCamperID uuid.UUID) (*domain.BunkRequest, error)
+	Create(ctx context.Context, request *domain.BunkRequest) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // CampersRepository defines the data access interface for campers
 type CampersRepository interface {
 	List(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Camper, int64, error)
 	GetByID(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) (*domain.Camper, error)
+	GetByIDs(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, ids []uuid.UUID) ([]domain.Camper, error)
+	GetWithSkills(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Camper, error)
+	ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Camper, error)
+	ListEnrolledOnDate(ctx context.Context, tenantID, campID uuid.UUID, date time.Time) ([]domain.Camper, error)
 	Create(ctx context.Context, camper *domain.Camper) error
 	Update(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID, camper *domain.Camper) error
 	Delete(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) error
 }
 
+// CamperMergesRepository defines the data access interface for merging duplicate campers
+type CamperMergesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.CamperMerge, error)
+	Merge(ctx context.Context, tenantID, campID uuid.UUID, survivor, duplicate *domain.Camper, merge *domain.CamperMerge) error
+}
+
+// CamperEnrollmentsRepository defines the data access interface for camper session enrollments
+type CamperEnrollmentsRepository interface {
+	ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.CamperEnrollment, error)
+	GetByID(ctx context.Context, tenantID, campID, camperID, id uuid.UUID) (*domain.CamperEnrollment, error)
+	GetByCamperAndSession(ctx context.Context, tenantID, campID, camperID, sessionID uuid.UUID) (*domain.CamperEnrollment, error)
+	ListActiveBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) ([]domain.CamperEnrollment, error)
+	CountActiveBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) (int64, error)
+	Create(ctx context.Context, enrollment *domain.CamperEnrollment) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, enrollment *domain.CamperEnrollment) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // CertificationsRepository defines the data access interface for certifications
 type CertificationsRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Certification, int64, error)
 	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Certification, error)
+	ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Certification, error)
 	Create(ctx context.Context, certification *domain.Certification) error
 	Update(ctx context.Context, tenantID, campID uuid.UUID, certification *domain.Certification) error
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
@@ -52,6 +135,16 @@ type CampsRepository interface {
 	Create(ctx context.Context, camp *domain.Camp) error
 	Update(ctx context.Context, camp *domain.Camp) error
 	Delete(ctx context.Context, tenantID, campID uuid.UUID) error
+	Clone(ctx context.Context, source *domain.Camp, target *domain.Camp, copyEvents bool, shift time.Duration) (domain.CampCloneCounts, error)
+}
+
+// CampCloneJobsRepository defines the data access interface for camp clone jobs
+type CampCloneJobsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.CampCloneJob, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.CampCloneJob, error)
+	Create(ctx context.Context, job *domain.CampCloneJob) error
+	Start(ctx context.Context, id uuid.UUID, startedAt time.Time) (bool, error)
+	Finish(ctx context.Context, job *domain.CampCloneJob) error
 }
 
 // ColorsRepository defines the data access interface for colors
@@ -63,10 +156,71 @@ type ColorsRepository interface {
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// CustomFieldsRepository defines the data access interface for custom field definitions
+type CustomFieldsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.CustomFieldEntityType) ([]domain.CustomFieldDefinition, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.CustomFieldDefinition, error)
+	GetByKey(ctx context.Context, tenantID, campID uuid.UUID, entityType domain.CustomFieldEntityType, key string) (*domain.CustomFieldDefinition, error)
+	Create(ctx context.Context, definition *domain.CustomFieldDefinition) error
+	Update(ctx context.Context, definition *domain.CustomFieldDefinition) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// DutyTypesRepository defines the data access interface for duty types
+type DutyTypesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.DutyType, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyType, error)
+	Create(ctx context.Context, dutyType *domain.DutyType) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, dutyType *domain.DutyType) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// DutyRotationsRepository defines the data access interface for duty rotation templates
+type DutyRotationsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.DutyRotation, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyRotation, error)
+	Create(ctx context.Context, rotation *domain.DutyRotation) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, rotation *domain.DutyRotation) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// DutyShiftsRepository defines the data access interface for duty shifts
+type DutyShiftsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, from, to *time.Time, staffMemberID, dutyTypeID *uuid.UUID) ([]domain.DutyShift, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.DutyShift, error)
+	Create(ctx context.Context, shift *domain.DutyShift) error
+	CreateBatch(ctx context.Context, shifts []*domain.DutyShift) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, shift *domain.DutyShift) error
+	UpdateStaff(ctx context.Context, tenantID, campID uuid.UUID, shift *domain.DutyShift) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// EquipmentItemsRepository defines the data access interface for equipment items
+type EquipmentItemsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, category *string, homeLocationID *uuid.UUID, condition *domain.EquipmentCondition) ([]domain.EquipmentItem, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.EquipmentItem, error)
+	Create(ctx context.Context, item *domain.EquipmentItem) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, item *domain.EquipmentItem) error
+	UpdateCondition(ctx context.Context, tenantID, campID, id uuid.UUID, condition domain.EquipmentCondition) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// EquipmentCheckoutsRepository defines the data access interface for equipment checkouts
+type EquipmentCheckoutsRepository interface {
+	ListByItem(ctx context.Context, tenantID, campID, equipmentItemID uuid.UUID, open *bool) ([]domain.EquipmentCheckout, error)
+	ListOpen(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.EquipmentCheckout, error)
+	GetByID(ctx context.Context, tenantID, campID, equipmentItemID, id uuid.UUID) (*domain.EquipmentCheckout, error)
+	Create(ctx context.Context, checkout *domain.EquipmentCheckout) error
+	MarkReturned(ctx context.Context, tenantID, campID uuid.UUID, checkout *domain.EquipmentCheckout, returnedAt time.Time) error
+}
+
 // EventsRepository defines the data access interface for events
 type EventsRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Event, int64, error)
 	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Event, error)
+	ListStaffedFrom(ctx context.Context, tenantID, campID uuid.UUID, from time.Time) ([]domain.Event, error)
+	ListStaffedBetween(ctx context.Context, tenantID, campID uuid.UUID, from, to time.Time) ([]domain.Event, error)
+	ListBetween(ctx context.Context, tenantID, campID uuid.UUID, from, to time.Time) ([]domain.Event, error)
 	Create(ctx context.Context, event *domain.Event) error
 	CreateBatch(ctx context.Context, events []*domain.Event) error
 	Update(ctx context.Context, tenantID, campID uuid.UUID, event *domain.Event) error
@@ -87,20 +241,58 @@ type GroupsRepository interface {
 	GetByIDs(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, ids []uuid.UUID) ([]domain.Group, error)
 	GetByName(ctx context.Context, tenantID, campID uuid.UUID, name string) (*domain.Group, error)
 	FindByHousingRoomAndSession(ctx context.Context, tenantId, campId, housingRoomId, sessionId uuid.UUID) (*domain.Group, error)
+	ListHousingBySession(ctx context.Context, tenantID, campID, sessionID uuid.UUID) ([]domain.Group, error)
+	ListWithMembers(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Group, error)
+	ListRuleBased(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Group, error)
+	ListMembershipChanges(ctx context.Context, tenantID, campID uuid.UUID, memberType domain.NoteEntityType, memberID uuid.UUID, from, to *time.Time) ([]domain.GroupMembershipChange, error)
+	ReplaceCampers(ctx context.Context, tenantID, campID, id uuid.UUID, camperIDs []uuid.UUID) error
+	ReplaceCamperGroups(ctx context.Context, tenantID, campID, camperID uuid.UUID, groupIDs, memberOf []uuid.UUID) error
 	Create(ctx context.Context, group *domain.Group) error
 	Update(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID, group *domain.Group) error
 	Delete(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) error
 }
 
+// GuardiansRepository defines the data access interface for guardians
+type GuardiansRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Guardian, int64, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Guardian, error)
+	ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.Guardian, error)
+	ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.Guardian, error)
+	Create(ctx context.Context, guardian *domain.Guardian) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, guardian *domain.Guardian) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // HousingRoomsRepository defines the data access interface for housing rooms
 type HousingRoomsRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.HousingRoom, int64, error)
+	ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.HousingRoom, error)
 	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.HousingRoom, error)
 	Create(ctx context.Context, room *domain.HousingRoom) error
 	Update(ctx context.Context, tenantID, campID uuid.UUID, room *domain.HousingRoom) error
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// HousingAssignmentsRepository defines the data access interface for housing assignment proposals
+type HousingAssignmentsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, sessionID *uuid.UUID) ([]domain.HousingAssignment, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.HousingAssignment, error)
+	Create(ctx context.Context, assignment *domain.HousingAssignment) error
+	Accept(ctx context.Context, tenantID, campID uuid.UUID, assignment *domain.HousingAssignment, placements map[uuid.UUID]*uuid.UUID, housingGroupIDs []uuid.UUID, acceptedBy *uuid.UUID) error
+}
+
+// IncidentsRepository defines the data access interface for incident reports
+type IncidentsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Incident, int64, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Incident, error)
+	ListInvolving(ctx context.Context, tenantID, campID uuid.UUID, camperID, staffMemberID *uuid.UUID, from, to *time.Time) ([]domain.Incident, error)
+	Create(ctx context.Context, incident *domain.Incident) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, incident *domain.Incident) error
+	UpdateReview(ctx context.Context, tenantID, campID uuid.UUID, incident *domain.Incident) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+	CountBy(ctx context.Context, tenantID, campID uuid.UUID, groupBy string, from, to *time.Time, timezone string) ([]domain.IncidentCount, error)
+}
+
 // LocationsRepository defines the data access interface for locations
 type LocationsRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Location, int64, error)
@@ -110,6 +302,76 @@ type LocationsRepository interface {
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// MaintenanceTicketsRepository defines the data access interface for maintenance tickets
+type MaintenanceTicketsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.MaintenanceEntityType, entityID *uuid.UUID, status *domain.MaintenanceStatus, priority *domain.MaintenancePriority, assigneeID *uuid.UUID) ([]domain.MaintenanceTicket, error)
+	ListOutOfService(ctx context.Context, tenantID, campID uuid.UUID, from time.Time, to *time.Time) ([]domain.MaintenanceTicket, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MaintenanceTicket, error)
+	Create(ctx context.Context, ticket *domain.MaintenanceTicket) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, ticket *domain.MaintenanceTicket) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// MealPeriodsRepository defines the data access interface for meal periods
+type MealPeriodsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.MealPeriod, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MealPeriod, error)
+	Create(ctx context.Context, period *domain.MealPeriod) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, period *domain.MealPeriod) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// MedicationsRepository defines the data access interface for camper medications and dose records
+type MedicationsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Medication, int64, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Medication, error)
+	ListByCampers(ctx context.Context, tenantID, campID uuid.UUID, camperIDs []uuid.UUID) ([]domain.Medication, error)
+	GetByIDs(ctx context.Context, tenantID, campID uuid.UUID, ids []uuid.UUID) ([]domain.Medication, error)
+	Create(ctx context.Context, medication *domain.Medication) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, medication *domain.Medication) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+	GetDoseByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.MedicationDose, error)
+	GetScheduledDose(ctx context.Context, tenantID, campID, medicationID uuid.UUID, scheduledFor time.Time) (*domain.MedicationDose, error)
+	ListDosesBetween(ctx context.Context, tenantID, campID uuid.UUID, camperIDs []uuid.UUID, from, to time.Time) ([]domain.MedicationDose, error)
+	CreateDose(ctx context.Context, dose *domain.MedicationDose) error
+	UpdateDose(ctx context.Context, tenantID, campID uuid.UUID, dose *domain.MedicationDose) error
+}
+
+// MenusRepository defines the data access interface for menus
+type MenusRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, from, to *time.Time) ([]domain.Menu, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Menu, error)
+	GetByDateAndMealPeriod(ctx context.Context, tenantID, campID uuid.UUID, date time.Time, mealPeriodID uuid.UUID) (*domain.Menu, error)
+	Create(ctx context.Context, menu *domain.Menu) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, menu *domain.Menu) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// NotesRepository defines the data access interface for camper and staff member notes
+type NotesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.NoteEntityType, entityID *uuid.UUID, visibilities []domain.NoteVisibility, authorID *uuid.UUID) ([]domain.Note, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.Note, error)
+	Create(ctx context.Context, note *domain.Note) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, note *domain.Note) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// OnboardingTemplatesRepository defines the data access interface for staff onboarding templates
+type OnboardingTemplatesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.OnboardingTemplate, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.OnboardingTemplate, error)
+	Create(ctx context.Context, template *domain.OnboardingTemplate) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, template *domain.OnboardingTemplate) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// OnboardingCompletionsRepository defines the data access interface for completed onboarding checklist items
+type OnboardingCompletionsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID) ([]domain.OnboardingCompletion, error)
+	Save(ctx context.Context, completion *domain.OnboardingCompletion) error
+	Delete(ctx context.Context, tenantID, campID, staffMemberID, itemID uuid.UUID) error
+}
+
 // ProgramsRepository defines the data access interface for programs
 type ProgramsRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Program, int64, error)
@@ -119,6 +381,15 @@ type ProgramsRepository interface {
 	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
 }
 
+// RatioPoliciesRepository defines the data access interface for supervision ratio policies
+type RatioPoliciesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.RatioPolicy, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.RatioPolicy, error)
+	Create(ctx context.Context, policy *domain.RatioPolicy) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, policy *domain.RatioPolicy) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // RolesRepository defines the data access interface for roles
 type RolesRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.Role, int64, error)
@@ -138,12 +409,35 @@ type SessionsRepository interface {
 	Delete(ctx context.Context, tenantId uuid.UUID, campID uuid.UUID, id uuid.UUID) error
 }
 
+// SkillTracksRepository defines the data access interface for skill tracks
+type SkillTracksRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.SkillTrack, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.SkillTrack, error)
+	Create(ctx context.Context, track *domain.SkillTrack) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, track *domain.SkillTrack) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// SkillAssessmentsRepository defines the data access interface for skill assessments and camper badges
+type SkillAssessmentsRepository interface {
+	ListByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.SkillAssessment, error)
+	CountByLevels(ctx context.Context, tenantID, campID, skillTrackID uuid.UUID, levelIDs []uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, tenantID, campID, camperID, id uuid.UUID) (*domain.SkillAssessment, error)
+	Create(ctx context.Context, assessment *domain.SkillAssessment, badge *domain.CamperBadge) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID, replacement *domain.CamperBadge) error
+	ListBadgesByCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) ([]domain.CamperBadge, error)
+}
+
 // StaffMembersRepository defines the data access interface for staff members
 type StaffMembersRepository interface {
 	List(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.StaffMember, int64, error)
 	GetByID(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) (*domain.StaffMember, error)
+	ListAll(ctx context.Context, tenantID, campID uuid.UUID) ([]domain.StaffMember, error)
 	Create(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, staffMember *domain.StaffMember) error
 	Update(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID, staffMember *domain.StaffMember) error
+	GetByUserID(ctx context.Context, tenantID, campID, userID uuid.UUID) (*domain.StaffMember, error)
+	UpdateUnavailableWindows(ctx context.Context, tenantID, campID, id uuid.UUID, windows []domain.UnavailableWindow) error
+	UpdateCertificationVerification(ctx context.Context, cert *domain.StaffCertification) error
 	Delete(ctx context.Context, tenantId uuid.UUID, campID uuid.UUID, id uuid.UUID) error
 }
 
@@ -169,6 +463,28 @@ type TenantsRepository interface {
 	Delete(ctx context.Context, tenantID uuid.UUID) error
 }
 
+// TimeEntriesRepository defines the data access interface for staff clock-ins and clock-outs
+type TimeEntriesRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to *time.Time, status *domain.TimeEntryStatus) ([]domain.TimeEntry, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeEntry, error)
+	GetOpen(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID) (*domain.TimeEntry, error)
+	HasOverlap(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID, excludeID *uuid.UUID, start time.Time, end *time.Time) (bool, error)
+	Create(ctx context.Context, entry *domain.TimeEntry) error
+	Update(ctx context.Context, tenantID, campID uuid.UUID, entry *domain.TimeEntry) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
+// TimeOffRequestsRepository defines the data access interface for staff time-off requests
+type TimeOffRequestsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, status *domain.TimeOffRequestStatus) ([]domain.TimeOffRequest, error)
+	ListApprovedBetween(ctx context.Context, tenantID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to time.Time) ([]domain.TimeOffRequest, error)
+	GetByID(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeOffRequest, error)
+	HasOverlap(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID, start, end time.Time) (bool, error)
+	Create(ctx context.Context, request *domain.TimeOffRequest) error
+	UpdateReview(ctx context.Context, tenantID, campID uuid.UUID, request *domain.TimeOffRequest) error
+	Delete(ctx context.Context, tenantID, campID, id uuid.UUID) error
+}
+
 // TimeBlocksRepository defines the data access interface for time blocks
 type TimeBlocksRepository interface {
 	List(ctx context.Context, tenantID, campID uuid.UUID, limit, offset int, search *string, filterStrings []string, sortBy *string, sortOrder string) ([]domain.TimeBlock, int64, error)
@@ -32,13 +32,15 @@ type SessionsService interface {
 
 // sessionsService implements SessionsService
 type sessionsService struct {
-	repo SessionsRepository
+	repo       SessionsRepository
+	membership *groupMembership
 }
 
 // NewSessionsService creates a new sessions service
-func NewSessionsService(repo SessionsRepository) SessionsService {
+func NewSessionsService(repo SessionsRepository, groupsRepo GroupsRepository, campersRepo CampersRepository) SessionsService {
 	return &sessionsService{
-		repo: repo,
+		repo:       repo,
+		membership: newGroupMembership(groupsRepo, campersRepo, repo),
 	}
 }
 
@@ -88,6 +90,10 @@ func (s *sessionsService) Create(ctx context.Context, tenantId uuid.UUID, campId
 		return nil, pkgerrors.BadRequest("End date must be after or equal to start date", nil)
 	}
 
+	if req.Spec.Capacity != nil && *req.Spec.Capacity < 1 {
+		return nil, pkgerrors.BadRequest("Capacity must be at least 1", nil)
+	}
+
 	// Create domain session from request
 	session := &domain.Session{
 		TenantID:    tenantId,
@@ -96,6 +102,7 @@ func (s *sessionsService) Create(ctx context.Context, tenantId uuid.UUID, campId
 		Description: utils.PtrToString(req.Meta.Description),
 		StartDate:   startDate,
 		EndDate:     endDate,
+		Capacity:    req.Spec.Capacity,
 	}
 
 	// Save to database
@@ -127,17 +134,27 @@ func (s *sessionsService) Update(ctx context.Context, tenantID uuid.UUID, campID
 		return nil, pkgerrors.BadRequest("End date must be after or equal to start date", nil)
 	}
 
+	if req.Spec.Capacity != nil && *req.Spec.Capacity < 1 {
+		return nil, pkgerrors.BadRequest("Capacity must be at least 1", nil)
+	}
+
 	// Update fields
 	existingSession.Name = req.Meta.Name
 	existingSession.Description = utils.PtrToString(req.Meta.Description)
 	existingSession.StartDate = startDate
 	existingSession.EndDate = endDate
+	existingSession.Capacity = req.Spec.Capacity
 
 	// Save updates
 	if err := s.repo.Update(ctx, tenantID, campID, existingSession); err != nil {
 		return nil, pkgerrors.InternalServerError("Failed to update session", err)
 	}
 
+	// Ages at session start change with the start date
+	if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
 	// Fetch updated session to get latest timestamps
 	updatedSession, err := s.repo.GetByID(ctx, tenantID, campID, id)
 	if err != nil {
@@ -0,0 +1,461 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// SkillsService defines the interface for skill track, assessment and badge business logic
+type SkillsService interface {
+	// ListTracks retrieves the skill tracks of a camp by name
+	ListTracks(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.SkillTracksListResponse, error)
+
+	// GetTrack retrieves a single skill track
+	GetTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.SkillTrack, error)
+
+	// CreateTrack creates a skill track with its ordered levels
+	CreateTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.SkillTrackCreationRequest) (*api.SkillTrack, error)
+
+	// UpdateTrack changes a skill track and its levels; levels campers were assessed at cannot be removed
+	UpdateTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.SkillTrackUpdateRequest) (*api.SkillTrack, error)
+
+	// DeleteTrack removes a skill track with its assessments and badges
+	DeleteTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetCamperSkills retrieves the levels a camper passed per skill track, their badges and assessment history
+	GetCamperSkills(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperSkills, error)
+
+	// CreateAssessment records an assessment of a camper, awarding the badge of a passed level
+	CreateAssessment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, req *api.SkillAssessmentCreationRequest) (*api.SkillAssessment, error)
+
+	// DeleteAssessment removes an assessment recorded by mistake with the badge it awarded
+	DeleteAssessment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID) error
+}
+
+// skillsService implements SkillsService
+type skillsService struct {
+	repo             SkillTracksRepository
+	assessmentsRepo  SkillAssessmentsRepository
+	campersRepo      CampersRepository
+	staffMembersRepo StaffMembersRepository
+	groupsRepo       GroupsRepository
+	membership       *groupMembership
+}
+
+// NewSkillsService creates a new skills service
+func NewSkillsService(repo SkillTracksRepository, assessmentsRepo SkillAssessmentsRepository, campersRepo CampersRepository, staffMembersRepo StaffMembersRepository, groupsRepo GroupsRepository, sessionsRepo SessionsRepository) SkillsService {
+	return &skillsService{
+		repo:             repo,
+		assessmentsRepo:  assessmentsRepo,
+		campersRepo:      campersRepo,
+		staffMembersRepo: staffMembersRepo,
+		groupsRepo:       groupsRepo,
+		membership:       newGroupMembership(groupsRepo, campersRepo, sessionsRepo),
+	}
+}
+
+// ListTracks retrieves the skill tracks of a camp by name
+func (s *skillsService) ListTracks(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.SkillTracksListResponse, error) {
+	tracks, err := s.repo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list skill tracks", err)
+	}
+
+	items := make([]api.SkillTrack, len(tracks))
+	for i := range tracks {
+		items[i] = tracks[i].ToAPI()
+	}
+
+	return &api.SkillTracksListResponse{Items: items}, nil
+}
+
+// GetTrack retrieves a single skill track
+func (s *skillsService) GetTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.SkillTrack, error) {
+	track, err := s.getTrack(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTrack := track.ToAPI()
+	return &apiTrack, nil
+}
+
+// CreateTrack creates a skill track with its ordered levels
+func (s *skillsService) CreateTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.SkillTrackCreationRequest) (*api.SkillTrack, error) {
+	levels, err := domain.ParseSkillLevels(req.Levels, nil)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid levels: %v", err), err)
+	}
+
+	track := &domain.SkillTrack{
+		TenantID:    tenantID,
+		CampID:      campID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: utils.PtrToString(req.Description),
+		Levels:      levels,
+	}
+	if err := track.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save to database
+	if err := s.repo.Create(ctx, track); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create skill track", err)
+	}
+
+	apiTrack := track.ToAPI()
+	return &apiTrack, nil
+}
+
+// UpdateTrack changes a skill track; reordering levels changes the levels campers rank at in group rules
+func (s *skillsService) UpdateTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.SkillTrackUpdateRequest) (*api.SkillTrack, error) {
+	track, err := s.getTrack(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := domain.ParseSkillLevels(req.Levels, track.Levels)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid levels: %v", err), err)
+	}
+
+	// Assessments refer to their level, so assessed levels must stay
+	kept := make(map[uuid.UUID]bool, len(levels))
+	for _, level := range levels {
+		kept[level.ID] = true
+	}
+	for _, level := range track.Levels {
+		if kept[level.ID] {
+			continue
+		}
+		count, err := s.assessmentsRepo.CountByLevels(ctx, tenantID, campID, id, []uuid.UUID{level.ID})
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to check skill assessments", err)
+		}
+		if count > 0 {
+			return nil, pkgerrors.Conflict(fmt.Sprintf("Level '%s' cannot be removed: %d assessments were recorded at it", level.Name, count), nil)
+		}
+	}
+
+	track.Name = strings.TrimSpace(req.Name)
+	track.Description = utils.PtrToString(req.Description)
+	track.Levels = levels
+	if err := track.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	// Save updates
+	if err := s.repo.Update(ctx, tenantID, campID, track); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update skill track", err)
+	}
+
+	if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	return s.GetTrack(ctx, tenantID, campID, id)
+}
+
+// DeleteTrack removes a skill track with its assessments and badges
+func (s *skillsService) DeleteTrack(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	if _, err := s.getTrack(ctx, tenantID, campID, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete skill track", err)
+	}
+
+	if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+		return pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+	}
+
+	return nil
+}
+
+// GetCamperSkills retrieves the highest level a camper passed per skill track, their badges and history
+func (s *skillsService) GetCamperSkills(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID) (*api.CamperSkills, error) {
+	if err := s.checkCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, err
+	}
+	if _, err := s.checkStaffCamper(ctx, tenantID, campID, camperID, "admin", "program-admin", "viewer"); err != nil {
+		return nil, err
+	}
+
+	assessments, err := s.assessmentsRepo.ListByCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list skill assessments", err)
+	}
+	badges, err := s.assessmentsRepo.ListBadgesByCamper(ctx, tenantID, campID, camperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list camper badges", err)
+	}
+	tracks, err := s.repo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list skill tracks", err)
+	}
+
+	assessed := make(map[uuid.UUID]bool)
+	for _, assessment := range assessments {
+		assessed[assessment.SkillTrackID] = true
+	}
+
+	skills := &api.CamperSkills{
+		CamperId:    camperID,
+		Progress:    []api.CamperSkillProgress{},
+		Badges:      make([]api.CamperBadge, len(badges)),
+		Assessments: make([]api.SkillAssessment, len(assessments)),
+	}
+	for i := range tracks {
+		track := &tracks[i]
+		if !assessed[track.ID] {
+			continue
+		}
+		progress := api.CamperSkillProgress{
+			SkillTrackId:   track.ID,
+			SkillTrackName: track.Name,
+			LevelCount:     len(track.Levels),
+		}
+		if level, rank, assessment := domain.SkillProgress(track, assessments); level != nil {
+			progress.LevelId = &level.ID
+			progress.LevelName = &level.Name
+			progress.LevelRank = rank
+			progress.AchievedOn = &openapi_types.Date{Time: assessment.AssessedOn}
+		}
+		skills.Progress = append(skills.Progress, progress)
+	}
+	for i := range badges {
+		skills.Badges[i] = badges[i].ToAPI()
+	}
+	for i := range assessments {
+		skills.Assessments[i] = assessments[i].ToAPI()
+	}
+
+	return skills, nil
+}
+
+// CreateAssessment records an assessment of a camper by a staff member, by default the one linked to the
+// current user. Staff may only assess the campers of their own groups under their own name. Passing a level
+// with a badge awards it unless the camper already holds it.
+func (s *skillsService) CreateAssessment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, req *api.SkillAssessmentCreationRequest) (*api.SkillAssessment, error) {
+	if err := s.checkCamper(ctx, tenantID, campID, camperID); err != nil {
+		return nil, err
+	}
+	staffMember, err := s.checkStaffCamper(ctx, tenantID, campID, camperID, "admin", "program-admin")
+	if err != nil {
+		return nil, err
+	}
+	if staffMember != nil && req.EvaluatorId != nil && *req.EvaluatorId != staffMember.ID {
+		return nil, pkgerrors.Forbidden("Staff can only record assessments as the evaluator themselves", nil)
+	}
+
+	track, err := s.repo.GetByID(ctx, tenantID, campID, req.SkillTrackId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.BadRequest("Skill track not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get skill track", err)
+	}
+	level, _ := track.Level(req.LevelId)
+	if level == nil {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Level %s is not a level of %s", req.LevelId, track.Name), nil)
+	}
+
+	var evaluatorID *uuid.UUID
+	if staffMember != nil {
+		evaluatorID = &staffMember.ID
+	} else if req.EvaluatorId != nil {
+		if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, *req.EvaluatorId); err != nil {
+			return nil, pkgerrors.BadRequest("Evaluator not found", err)
+		}
+		evaluatorID = req.EvaluatorId
+	} else {
+		linked, err := linkedStaffMember(ctx, s.staffMembersRepo, tenantID, campID)
+		if err != nil {
+			return nil, pkgerrors.BadRequest("evaluatorId is required when your account is not linked to a staff member of this camp", err)
+		}
+		evaluatorID = &linked.ID
+	}
+
+	assessment := &domain.SkillAssessment{
+		TenantID:     tenantID,
+		CampID:       campID,
+		CamperID:     camperID,
+		SkillTrackID: track.ID,
+		LevelID:      level.ID,
+		LevelName:    level.Name,
+		Passed:       req.Passed,
+		AssessedOn:   req.AssessedOn.Time,
+		EvaluatorID:  evaluatorID,
+		Notes:        utils.PtrToString(req.Notes),
+	}
+	assessment.RecordedBy, assessment.RecordedByEmail = currentUser(ctx)
+
+	var badge *domain.CamperBadge
+	if assessment.Passed {
+		badge = levelBadge(assessment, level)
+	}
+
+	// Save to database
+	if err := s.assessmentsRepo.Create(ctx, assessment, badge); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create skill assessment", err)
+	}
+
+	if assessment.Passed {
+		if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+		}
+	}
+
+	apiAssessment := assessment.ToAPI()
+	return &apiAssessment, nil
+}
+
+// DeleteAssessment removes an assessment with the badge it awarded. When another passed assessment of the
+// camper is at the same level, the badge is awarded for that one instead.
+func (s *skillsService) DeleteAssessment(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, id uuid.UUID) error {
+	assessment, err := s.assessmentsRepo.GetByID(ctx, tenantID, campID, camperID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Skill assessment not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get skill assessment", err)
+	}
+
+	var replacement *domain.CamperBadge
+	if assessment.Passed {
+		replacement, err = s.replacementBadge(ctx, tenantID, campID, assessment)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.assessmentsRepo.Delete(ctx, tenantID, campID, id, replacement); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete skill assessment", err)
+	}
+
+	if assessment.Passed {
+		if err := s.membership.refresh(ctx, tenantID, campID); err != nil {
+			return pkgerrors.InternalServerError("Failed to update rule-based group members", err)
+		}
+	}
+
+	return nil
+}
+
+// replacementBadge returns the badge of the level of a passed assessment awarded for the camper's earliest
+// other passed assessment at that level, or nil when there is none or the level has no badge
+func (s *skillsService) replacementBadge(ctx context.Context, tenantID, campID uuid.UUID, deleted *domain.SkillAssessment) (*domain.CamperBadge, error) {
+	track, err := s.repo.GetByID(ctx, tenantID, campID, deleted.SkillTrackID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get skill track", err)
+	}
+	level, _ := track.Level(deleted.LevelID)
+	if level == nil || level.BadgeName == "" {
+		return nil, nil
+	}
+
+	assessments, err := s.assessmentsRepo.ListByCamper(ctx, tenantID, campID, deleted.CamperID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list skill assessments", err)
+	}
+
+	var earliest *domain.SkillAssessment
+	for i := range assessments {
+		other := &assessments[i]
+		if other.ID == deleted.ID || !other.Passed || other.LevelID != deleted.LevelID {
+			continue
+		}
+		if earliest == nil || !other.AssessedOn.After(earliest.AssessedOn) {
+			earliest = other
+		}
+	}
+	if earliest == nil {
+		return nil, nil
+	}
+
+	badge := levelBadge(earliest, level)
+	badge.AssessmentID = earliest.ID
+	return badge, nil
+}
+
+// levelBadge returns the badge a passed assessment at a level awards, or nil when the level has none
+func levelBadge(assessment *domain.SkillAssessment, level *domain.SkillLevel) *domain.CamperBadge {
+	if level.BadgeName == "" {
+		return nil
+	}
+	return &domain.CamperBadge{
+		TenantID:     assessment.TenantID,
+		CampID:       assessment.CampID,
+		CamperID:     assessment.CamperID,
+		SkillTrackID: assessment.SkillTrackID,
+		LevelID:      level.ID,
+		Name:         level.BadgeName,
+		AwardedOn:    assessment.AssessedOn,
+	}
+}
+
+// checkCamper checks that the camper exists in the camp
+func (s *skillsService) checkCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID) error {
+	if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, camperID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.NotFound("Camper not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+	return nil
+}
+
+// checkStaffCamper limits users who hold the staff role in a camp but none of the given roles to the campers
+// of their own groups, returning their linked staff member; it returns nil for everybody else
+func (s *skillsService) checkStaffCamper(ctx context.Context, tenantID, campID, camperID uuid.UUID, unrestrictedRoles ...string) (*domain.StaffMember, error) {
+	roles := campRoles(ctx, tenantID, campID)
+	if !roles["staff"] {
+		return nil, nil
+	}
+	for _, role := range unrestrictedRoles {
+		if roles[role] {
+			return nil, nil
+		}
+	}
+
+	staffMember, err := linkedStaffMember(ctx, s.staffMembersRepo, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.Forbidden("Your account is not linked to a staff member of this camp", err)
+	}
+	camperIDs, err := staffCamperIDs(ctx, s.groupsRepo, tenantID, campID, staffMember)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range camperIDs {
+		if id == camperID {
+			return staffMember, nil
+		}
+	}
+	return nil, pkgerrors.Forbidden("Camper is not in one of your groups", nil)
+}
+
+// getTrack loads a skill track, mapping a missing track to a not found error
+func (s *skillsService) getTrack(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.SkillTrack, error) {
+	track, err := s.repo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Skill track not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get skill track", err)
+	}
+	return track, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/api"
@@ -29,21 +30,30 @@ type StaffMembersService interface {
 
 	// Delete deletes a staff member by ID
 	Delete(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID) error
+
+	// VerifyCertification records the current user as the verifier of a certificate the staff member holds
+	VerifyCertification(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID, req *api.StaffCertificationVerificationRequest) (*api.StaffMember, error)
 }
 
 // staffMembersService implements StaffMembersService
 type staffMembersService struct {
-	repo       StaffMembersRepository
-	groupsRepo GroupsRepository
-	rolesRepo  RolesRepository
+	repo         StaffMembersRepository
+	groupsRepo   GroupsRepository
+	rolesRepo    RolesRepository
+	customFields CustomFieldsRepository
+	usersRepo    UsersRepository
+	onboarding   OnboardingTemplatesRepository
 }
 
 // NewStaffMembersService creates a new staff members service
-func NewStaffMembersService(repo StaffMembersRepository, groupsRepo GroupsRepository, rolesRepo RolesRepository) StaffMembersService {
+func NewStaffMembersService(repo StaffMembersRepository, groupsRepo GroupsRepository, rolesRepo RolesRepository, customFields CustomFieldsRepository, usersRepo UsersRepository, onboarding OnboardingTemplatesRepository) StaffMembersService {
 	return &staffMembersService{
-		repo:       repo,
-		groupsRepo: groupsRepo,
-		rolesRepo:  rolesRepo,
+		repo:         repo,
+		groupsRepo:   groupsRepo,
+		rolesRepo:    rolesRepo,
+		customFields: customFields,
+		usersRepo:    usersRepo,
+		onboarding:   onboarding,
 	}
 }
 
@@ -94,16 +104,43 @@ func (s *staffMembersService) Create(ctx context.Context, tenantId uuid.UUID, ca
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	// Validate the linked user account
+	if err := s.validateUser(ctx, tenantId, campId, nil, req.Spec.UserId); err != nil {
+		return nil, err
+	}
+
+	// Validate the onboarding template
+	if err := s.validateOnboardingTemplate(ctx, tenantId, campId, req.Spec.OnboardingTemplateId); err != nil {
+		return nil, err
+	}
+
+	// Validate custom field values
+	customFields, err := resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeStaffMember, req.Spec.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate diets and allergies
+	dietaryRestrictions, allergies, err := parseDietAndAllergies(req.Spec.DietaryRestrictions, req.Spec.Allergies)
+	if err != nil {
+		return nil, err
+	}
+
 	domainStaffMember := domain.StaffMember{
-		TenantID:       tenantId,
-		CampID:         campId,
-		Name:           req.Meta.Name,
-		Description:    utils.PtrToString(req.Meta.Description),
-		Birthday:       req.Spec.Birthday.Time,
-		Gender:         string(req.Spec.Gender),
-		RoleID:         req.Spec.RoleId,
-		Phone:          utils.PtrToString(req.Spec.Phone),
-		HousingGroupID: housingGroupId,
+		TenantID:             tenantId,
+		CampID:               campId,
+		Name:                 req.Meta.Name,
+		Description:          utils.PtrToString(req.Meta.Description),
+		Birthday:             req.Spec.Birthday.Time,
+		Gender:               string(req.Spec.Gender),
+		RoleID:               req.Spec.RoleId,
+		Phone:                utils.PtrToString(req.Spec.Phone),
+		HousingGroupID:       housingGroupId,
+		UserID:               req.Spec.UserId,
+		OnboardingTemplateID: req.Spec.OnboardingTemplateId,
+		CustomFields:         customFields,
+		DietaryRestrictions:  dietaryRestrictions,
+		Allergies:            allergies,
 	}
 	domainStaffMember.GroupStaffMembers = []domain.GroupStaffMember{}
 	if req.Spec.GroupIds != nil {
@@ -111,11 +148,9 @@ func (s *staffMembersService) Create(ctx context.Context, tenantId uuid.UUID, ca
 			domainStaffMember.GroupStaffMembers = append(domainStaffMember.GroupStaffMembers, domain.GroupStaffMember{GroupID: groupId, StaffMemberID: domainStaffMember.ID})
 		}
 	}
-	domainStaffMember.StaffCertifications = []domain.StaffCertification{}
-	if req.Spec.CertificationIds != nil {
-		for _, certificationId := range *req.Spec.CertificationIds {
-			domainStaffMember.StaffCertifications = append(domainStaffMember.StaffCertifications, domain.StaffCertification{CertificationID: certificationId, StaffMemberID: domainStaffMember.ID})
-		}
+	domainStaffMember.StaffCertifications, err = resolveStaffCertifications(domainStaffMember.ID, domainStaffMember.StaffCertifications, req.Spec.CertificationIds, req.Spec.Certifications)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.repo.Create(ctx, tenantId, campId, &domainStaffMember); err != nil {
@@ -149,6 +184,16 @@ func (s *staffMembersService) Update(ctx context.Context, tenantId uuid.UUID, ca
 		return nil, pkgerrors.BadRequest(err.Error(), err)
 	}
 
+	// Validate the linked user account
+	if err := s.validateUser(ctx, tenantId, campId, &id, req.Spec.UserId); err != nil {
+		return nil, err
+	}
+
+	// Validate the onboarding template
+	if err := s.validateOnboardingTemplate(ctx, tenantId, campId, req.Spec.OnboardingTemplateId); err != nil {
+		return nil, err
+	}
+
 	domainStaffMember.Name = req.Meta.Name
 	domainStaffMember.Description = utils.PtrToString(req.Meta.Description)
 	domainStaffMember.Birthday = req.Spec.Birthday.Time
@@ -156,17 +201,28 @@ func (s *staffMembersService) Update(ctx context.Context, tenantId uuid.UUID, ca
 	domainStaffMember.RoleID = req.Spec.RoleId
 	domainStaffMember.Phone = utils.PtrToString(req.Spec.Phone)
 	domainStaffMember.HousingGroupID = housingGroupId
+	domainStaffMember.UserID = req.Spec.UserId
+	domainStaffMember.OnboardingTemplateID = req.Spec.OnboardingTemplateId
+	// Custom field values are only replaced when provided
+	if req.Spec.CustomFields != nil {
+		domainStaffMember.CustomFields, err = resolveCustomFields(ctx, s.customFields, tenantId, campId, domain.CustomFieldEntityTypeStaffMember, req.Spec.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Diets and allergies are only replaced when provided
+	if err := applyDietAndAllergies(&domainStaffMember.DietaryRestrictions, &domainStaffMember.Allergies, req.Spec.DietaryRestrictions, req.Spec.Allergies); err != nil {
+		return nil, err
+	}
 	domainStaffMember.GroupStaffMembers = []domain.GroupStaffMember{}
 	if req.Spec.GroupIds != nil {
 		for _, groupId := range *req.Spec.GroupIds {
 			domainStaffMember.GroupStaffMembers = append(domainStaffMember.GroupStaffMembers, domain.GroupStaffMember{GroupID: groupId, StaffMemberID: domainStaffMember.ID})
 		}
 	}
-	domainStaffMember.StaffCertifications = []domain.StaffCertification{}
-	if req.Spec.CertificationIds != nil {
-		for _, certificationId := range *req.Spec.CertificationIds {
-			domainStaffMember.StaffCertifications = append(domainStaffMember.StaffCertifications, domain.StaffCertification{CertificationID: certificationId, StaffMemberID: domainStaffMember.ID})
-		}
+	domainStaffMember.StaffCertifications, err = resolveStaffCertifications(domainStaffMember.ID, domainStaffMember.StaffCertifications, req.Spec.CertificationIds, req.Spec.Certifications)
+	if err != nil {
+		return nil, err
 	}
 	if err := s.repo.Update(ctx, tenantId, campId, id, domainStaffMember); err != nil {
 		return nil, pkgerrors.InternalServerError("Failed to update staff member", err)
@@ -248,3 +304,113 @@ func (s *staffMembersService) validateRole(ctx context.Context, tenantId uuid.UU
 	}
 	return nil
 }
+
+// validateUser checks that a user account to link belongs to the tenant and is not linked to another
+// staff member of the camp
+func (s *staffMembersService) validateUser(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, staffMemberId *uuid.UUID, userId *uuid.UUID) error {
+	if userId == nil {
+		return nil
+	}
+
+	user, err := s.usersRepo.FindByID(ctx, userId.String())
+	if err != nil || user.TenantId != tenantId.String() {
+		return pkgerrors.BadRequest(fmt.Sprintf("user with id '%s' not found", userId.String()), err)
+	}
+
+	linked, err := s.repo.GetByUserID(ctx, tenantId, campId, *userId)
+	if err == nil && (staffMemberId == nil || linked.ID != *staffMemberId) {
+		return pkgerrors.Conflict(fmt.Sprintf("User is already linked to staff member '%s'", linked.Name), nil)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return pkgerrors.InternalServerError("Failed to check linked staff member", err)
+	}
+	return nil
+}
+
+// validateOnboardingTemplate checks that the onboarding template a staff member follows exists in the camp
+func (s *staffMembersService) validateOnboardingTemplate(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, templateId *uuid.UUID) error {
+	if templateId == nil {
+		return nil
+	}
+
+	if _, err := s.onboarding.GetByID(ctx, tenantId, campId, *templateId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgerrors.BadRequest(fmt.Sprintf("onboarding template with id '%s' not found", templateId.String()), err)
+		}
+		return pkgerrors.InternalServerError("Failed to check onboarding template", err)
+	}
+	return nil
+}
+
+// VerifyCertification records the current user as the verifier of a certificate the staff member holds
+func (s *staffMembersService) VerifyCertification(ctx context.Context, tenantId uuid.UUID, campId uuid.UUID, id uuid.UUID, req *api.StaffCertificationVerificationRequest) (*api.StaffMember, error) {
+	domainStaffMember, err := s.repo.GetByID(ctx, tenantId, campId, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Staff member not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get staff member", err)
+	}
+
+	cert := domainStaffMember.Certification(req.CertificationId)
+	if cert == nil {
+		return nil, pkgerrors.BadRequest("Staff member does not hold this certification", nil)
+	}
+
+	verifiedAt := time.Now().UTC()
+	cert.VerifiedBy, cert.VerifiedByEmail = currentUser(ctx)
+	cert.VerifiedAt = &verifiedAt
+
+	if err := s.repo.UpdateCertificationVerification(ctx, cert); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to verify certification", err)
+	}
+
+	apiStaffMember := domainStaffMember.ToAPI()
+	return &apiStaffMember, nil
+}
+
+// resolveStaffCertifications builds the certification rows of a staff member from a request.
+// Certificate details take precedence over plain certification IDs, and certificates that did
+// not change keep their details and verification. Expired certificates are not listed in the
+// certification IDs of a staff member, so they are kept when only IDs are given.
+func resolveStaffCertifications(staffMemberID uuid.UUID, existing []domain.StaffCertification, ids *[]uuid.UUID, details *[]api.StaffCertificationInput) ([]domain.StaffCertification, error) {
+	previous := make(map[uuid.UUID]domain.StaffCertification, len(existing))
+	for _, cert := range existing {
+		previous[cert.CertificationID] = cert
+	}
+
+	if details != nil {
+		certs, err := domain.ParseStaffCertifications(staffMemberID, *details)
+		if err != nil {
+			return nil, pkgerrors.BadRequest(err.Error(), err)
+		}
+		for i := range certs {
+			if old, ok := previous[certs[i].CertificationID]; ok && old.SameCertificate(&certs[i]) {
+				certs[i].VerifiedBy = old.VerifiedBy
+				certs[i].VerifiedByEmail = old.VerifiedByEmail
+				certs[i].VerifiedAt = old.VerifiedAt
+			}
+		}
+		return certs, nil
+	}
+
+	certs := []domain.StaffCertification{}
+	listed := make(map[uuid.UUID]bool)
+	if ids != nil {
+		for _, certificationID := range *ids {
+			listed[certificationID] = true
+			if old, ok := previous[certificationID]; ok {
+				certs = append(certs, old)
+				continue
+			}
+			certs = append(certs, domain.StaffCertification{CertificationID: certificationID, StaffMemberID: staffMemberID})
+		}
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for _, cert := range existing {
+		if !listed[cert.CertificationID] && cert.ExpiredOn(today) {
+			certs = append(certs, cert)
+		}
+	}
+	return certs, nil
+}
@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultScheduleDays is how many days after the first day a schedule covers when no last day is given
+const defaultScheduleDays = 7
+
+// StaffPortalService defines the interface for the self-service of staff members linked to a user account
+// and the review of their time-off requests
+type StaffPortalService interface {
+	// GetMyStaffMember retrieves the staff member linked to the current user
+	GetMyStaffMember(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffMember, error)
+
+	// GetMySchedule lists the events, duty shifts and approved time off of the current user in a range of days
+	GetMySchedule(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.StaffSchedule, error)
+
+	// ListMyGroups retrieves the groups the current user's staff member belongs to
+	ListMyGroups(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.GroupsListResponse, error)
+
+	// ListMyCampers retrieves the campers of the current user's groups, including nested groups
+	ListMyCampers(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.CampersListResponse, error)
+
+	// GetMyAvailability retrieves the weekly times the current user cannot work
+	GetMyAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffAvailability, error)
+
+	// UpdateMyAvailability replaces the weekly times the current user cannot work
+	UpdateMyAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.StaffAvailabilityUpdateRequest) (*api.StaffAvailability, error)
+
+	// ListMyTimeOffRequests retrieves the current user's time-off requests, most recent first
+	ListMyTimeOffRequests(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.TimeOffRequestsListResponse, error)
+
+	// CreateMyTimeOffRequest asks for time off for the current user; the request waits for review
+	CreateMyTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.TimeOffRequestCreationRequest) (*api.TimeOffRequest, error)
+
+	// CancelMyTimeOffRequest withdraws a pending time-off request of the current user
+	CancelMyTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// GetStaffMemberAvailability retrieves the weekly times a staff member cannot work
+	GetStaffMemberAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID) (*api.StaffAvailability, error)
+
+	// ListTimeOffRequests retrieves time-off requests by start, optionally of a staff member or in a review state
+	ListTimeOffRequests(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID *uuid.UUID, status *api.TimeOffRequestStatus) (*api.TimeOffRequestsListResponse, error)
+
+	// ReviewTimeOffRequest approves or rejects a time-off request, or reopens it as pending
+	ReviewTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeOffRequestReviewRequest) (*api.TimeOffRequest, error)
+}
+
+// staffPortalService implements StaffPortalService
+type staffPortalService struct {
+	staffMembersRepo StaffMembersRepository
+	timeOffRepo      TimeOffRequestsRepository
+	groupsRepo       GroupsRepository
+	campersRepo      CampersRepository
+	eventsRepo       EventsRepository
+	dutyShiftsRepo   DutyShiftsRepository
+	dutyTypesRepo    DutyTypesRepository
+	campsRepo        CampsRepository
+}
+
+// NewStaffPortalService creates a new staff portal service
+func NewStaffPortalService(staffMembersRepo StaffMembersRepository, timeOffRepo TimeOffRequestsRepository, groupsRepo GroupsRepository, campersRepo CampersRepository, eventsRepo EventsRepository, dutyShiftsRepo DutyShiftsRepository, dutyTypesRepo DutyTypesRepository, campsRepo CampsRepository) StaffPortalService {
+	return &staffPortalService{
+		staffMembersRepo: staffMembersRepo,
+		timeOffRepo:      timeOffRepo,
+		groupsRepo:       groupsRepo,
+		campersRepo:      campersRepo,
+		eventsRepo:       eventsRepo,
+		dutyShiftsRepo:   dutyShiftsRepo,
+		dutyTypesRepo:    dutyTypesRepo,
+		campsRepo:        campsRepo,
+	}
+}
+
+// GetMyStaffMember retrieves the staff member linked to the current user
+func (s *staffPortalService) GetMyStaffMember(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffMember, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiStaffMember := staffMember.ToAPI()
+	return &apiStaffMember, nil
+}
+
+// GetMySchedule lists the events the current user takes part in, through a required staff position or as a
+// member of one of the event's groups, together with their duty shifts and approved time off
+func (s *staffPortalService) GetMySchedule(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to *time.Time) (*api.StaffSchedule, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	loc := camp.TimeLocation()
+
+	first := localDate(time.Now(), loc)
+	if from != nil {
+		first = *from
+	}
+	last := first.AddDate(0, 0, defaultScheduleDays)
+	if to != nil {
+		last = *to
+	}
+	if err := checkRosterRange(first, last); err != nil {
+		return nil, err
+	}
+	start, end := dayRange(first, last, loc)
+
+	items := []api.ScheduleItem{}
+
+	events, err := s.eventsRepo.ListBetween(ctx, tenantID, campID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+	groups, err := s.groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+	groupsByID := make(map[uuid.UUID]*domain.Group, len(groups))
+	for i := range groups {
+		groupsByID[groups[i].ID] = &groups[i]
+	}
+	for i := range events {
+		event := &events[i]
+		if _, staffIDs := eventParticipants(event, groupsByID, nil); !staffIDs[staffMember.ID] {
+			continue
+		}
+		items = append(items, api.ScheduleItem{
+			Type:         api.ScheduleItemTypeEvent,
+			Id:           event.ID,
+			Name:         event.Name,
+			StartDate:    event.StartDate,
+			EndDate:      event.EndDate,
+			PositionName: utils.StringToPtr(positionName(event, staffMember.ID)),
+			LocationId:   event.LocationID,
+		})
+	}
+
+	shifts, err := s.dutyShiftsRepo.List(ctx, tenantID, campID, &start, &end, &staffMember.ID, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty shifts", err)
+	}
+	dutyTypes, err := s.dutyTypesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty types", err)
+	}
+	dutyTypeNames := make(map[uuid.UUID]string, len(dutyTypes))
+	for _, dutyType := range dutyTypes {
+		dutyTypeNames[dutyType.ID] = dutyType.Name
+	}
+	for _, shift := range shifts {
+		items = append(items, api.ScheduleItem{
+			Type:      api.ScheduleItemTypeDuty,
+			Id:        shift.ID,
+			Name:      dutyTypeNames[shift.DutyTypeID],
+			StartDate: shift.StartDate,
+			EndDate:   shift.EndDate,
+		})
+	}
+
+	timeOff, err := s.timeOffRepo.ListApprovedBetween(ctx, tenantID, campID, &staffMember.ID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list approved time off", err)
+	}
+	for _, request := range timeOff {
+		items = append(items, api.ScheduleItem{
+			Type:      api.ScheduleItemTypeTimeOff,
+			Id:        request.ID,
+			Name:      "Time off",
+			StartDate: request.StartDate,
+			EndDate:   request.EndDate,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].StartDate.Before(items[j].StartDate)
+	})
+
+	return &api.StaffSchedule{
+		StaffMemberId: staffMember.ID,
+		From:          openapi_types.Date{Time: first},
+		To:            openapi_types.Date{Time: last},
+		Items:         items,
+	}, nil
+}
+
+// ListMyGroups retrieves the groups the current user's staff member belongs to
+func (s *staffPortalService) ListMyGroups(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.GroupsListResponse, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+
+	memberOf := staffGroupIDs(staffMember)
+	items := []api.Group{}
+	for i := range groups {
+		if memberOf[groups[i].ID] {
+			items = append(items, groups[i].ToAPI())
+		}
+	}
+
+	return &api.GroupsListResponse{
+		Items:  items,
+		Limit:  len(items),
+		Offset: 0,
+		Total:  len(items),
+	}, nil
+}
+
+// ListMyCampers retrieves the campers of the current user's groups, including nested groups, by name
+func (s *staffPortalService) ListMyCampers(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.CampersListResponse, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	camperIDs, err := staffCamperIDs(ctx, s.groupsRepo, tenantID, campID, staffMember)
+	if err != nil {
+		return nil, err
+	}
+
+	campers, err := s.campersRepo.GetByIDs(ctx, tenantID, campID, uniqueIDs(&camperIDs))
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list campers", err)
+	}
+
+	items := make([]api.Camper, len(campers))
+	for i := range campers {
+		items[i] = campers[i].ToAPI()
+	}
+
+	return &api.CampersListResponse{
+		Items:  items,
+		Limit:  len(items),
+		Offset: 0,
+		Total:  len(items),
+	}, nil
+}
+
+// GetMyAvailability retrieves the weekly times the current user cannot work
+func (s *staffPortalService) GetMyAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.StaffAvailability, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := staffMember.AvailabilityToAPI()
+	return &availability, nil
+}
+
+// UpdateMyAvailability replaces the weekly times the current user cannot work
+func (s *staffPortalService) UpdateMyAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.StaffAvailabilityUpdateRequest) (*api.StaffAvailability, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := domain.ParseUnavailableWindows(req.UnavailableWindows)
+	if err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	if err := s.staffMembersRepo.UpdateUnavailableWindows(ctx, tenantID, campID, staffMember.ID, windows); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update availability", err)
+	}
+
+	staffMember.UnavailableWindows = windows
+	availability := staffMember.AvailabilityToAPI()
+	return &availability, nil
+}
+
+// ListMyTimeOffRequests retrieves the current user's time-off requests, most recent first
+func (s *staffPortalService) ListMyTimeOffRequests(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID) (*api.TimeOffRequestsListResponse, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.timeOffRepo.List(ctx, tenantID, campID, &staffMember.ID, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list time-off requests", err)
+	}
+
+	items := make([]api.TimeOffRequest, len(requests))
+	for i := range requests {
+		items[len(requests)-1-i] = requests[i].ToAPI()
+	}
+
+	return &api.TimeOffRequestsListResponse{Items: items}, nil
+}
+
+// CreateMyTimeOffRequest asks for time off for the current user; the request waits for review
+func (s *staffPortalService) CreateMyTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.TimeOffRequestCreationRequest) (*api.TimeOffRequest, error) {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &domain.TimeOffRequest{
+		TenantID:      tenantID,
+		CampID:        campID,
+		StaffMemberID: staffMember.ID,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		Reason:        strings.TrimSpace(utils.PtrToString(req.Reason)),
+		Status:        domain.TimeOffRequestStatusPending,
+	}
+	if err := request.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	overlap, err := s.timeOffRepo.HasOverlap(ctx, tenantID, campID, staffMember.ID, request.StartDate, request.EndDate)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to check time-off requests", err)
+	}
+	if overlap {
+		return nil, pkgerrors.Conflict("Time off overlaps another pending or approved request", nil)
+	}
+
+	if err := s.timeOffRepo.Create(ctx, request); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create time-off request", err)
+	}
+
+	apiRequest := request.ToAPI()
+	return &apiRequest, nil
+}
+
+// CancelMyTimeOffRequest withdraws a pending time-off request of the current user
+func (s *staffPortalService) CancelMyTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	staffMember, err := s.myStaffMember(ctx, tenantID, campID)
+	if err != nil {
+		return err
+	}
+
+	request, err := s.getTimeOffRequest(ctx, tenantID, campID, id)
+	if err != nil {
+		return err
+	}
+	// Requests of other staff members are reported as missing rather than forbidden
+	if request.StaffMemberID != staffMember.ID {
+		return pkgerrors.NotFound("Time-off request not found", nil)
+	}
+	if request.Status != domain.TimeOffRequestStatusPending {
+		return pkgerrors.Conflict("Only pending time-off requests can be withdrawn", nil)
+	}
+
+	if err := s.timeOffRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to withdraw time-off request", err)
+	}
+	return nil
+}
+
+// GetStaffMemberAvailability retrieves the weekly times a staff member cannot work
+func (s *staffPortalService) GetStaffMemberAvailability(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID) (*api.StaffAvailability, error) {
+	staffMember, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		return nil, pkgerrors.NotFound("Staff member not found", err)
+	}
+
+	availability := staffMember.AvailabilityToAPI()
+	return &availability, nil
+}
+
+// ListTimeOffRequests retrieves time-off requests by start, optionally of a staff member or in a review state
+func (s *staffPortalService) ListTimeOffRequests(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID *uuid.UUID, status *api.TimeOffRequestStatus) (*api.TimeOffRequestsListResponse, error) {
+	var statusFilter *domain.TimeOffRequestStatus
+	if status != nil {
+		value := domain.TimeOffRequestStatus(*status)
+		if !value.IsValid() {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid status '%s'", *status), nil)
+		}
+		statusFilter = &value
+	}
+
+	requests, err := s.timeOffRepo.List(ctx, tenantID, campID, staffMemberID, statusFilter)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list time-off requests", err)
+	}
+
+	items := make([]api.TimeOffRequest, len(requests))
+	for i := range requests {
+		items[i] = requests[i].ToAPI()
+	}
+
+	return &api.TimeOffRequestsListResponse{Items: items}, nil
+}
+
+// ReviewTimeOffRequest approves or rejects a time-off request, or reopens it as pending
+func (s *staffPortalService) ReviewTimeOffRequest(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeOffRequestReviewRequest) (*api.TimeOffRequest, error) {
+	request, err := s.getTimeOffRequest(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := domain.TimeOffRequestStatus(req.Status)
+	if !status.IsValid() {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid review decision '%s'", req.Status), nil)
+	}
+
+	comment := strings.TrimSpace(utils.PtrToString(req.Comment))
+	if status == domain.TimeOffRequestStatusRejected && comment == "" {
+		return nil, pkgerrors.BadRequest("A comment is required when rejecting a time-off request", nil)
+	}
+
+	now := time.Now().UTC()
+	request.Status = status
+	request.ReviewedBy, request.ReviewedByEmail = currentUser(ctx)
+	request.ReviewedAt = &now
+	request.ReviewComment = comment
+
+	if err := s.timeOffRepo.UpdateReview(ctx, tenantID, campID, request); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to review time-off request", err)
+	}
+
+	apiRequest := request.ToAPI()
+	return &apiRequest, nil
+}
+
+// myStaffMember resolves the staff member of the camp linked to the current user
+func (s *staffPortalService) myStaffMember(ctx context.Context, tenantID, campID uuid.UUID) (*domain.StaffMember, error) {
+	return linkedStaffMember(ctx, s.staffMembersRepo, tenantID, campID)
+}
+
+// linkedStaffMember resolves the staff member of the camp linked to the current user
+func linkedStaffMember(ctx context.Context, staffMembersRepo StaffMembersRepository, tenantID, campID uuid.UUID) (*domain.StaffMember, error) {
+	userID, _ := currentUser(ctx)
+	if userID == nil {
+		return nil, pkgerrors.NotFound("Your account is not linked to a staff member of this camp", nil)
+	}
+
+	staffMember, err := staffMembersRepo.GetByUserID(ctx, tenantID, campID, *userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Your account is not linked to a staff member of this camp", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get linked staff member", err)
+	}
+	return staffMember, nil
+}
+
+// getTimeOffRequest retrieves a time-off request, mapping a missing record to a not found error
+func (s *staffPortalService) getTimeOffRequest(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeOffRequest, error) {
+	request, err := s.timeOffRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Time-off request not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get time-off request", err)
+	}
+	return request, nil
+}
+
+// staffGroupIDs returns the IDs of the groups a staff member belongs to directly
+func staffGroupIDs(staffMember *domain.StaffMember) map[uuid.UUID]bool {
+	ids := make(map[uuid.UUID]bool, len(staffMember.GroupStaffMembers))
+	for _, member := range staffMember.GroupStaffMembers {
+		ids[member.GroupID] = true
+	}
+	return ids
+}
+
+// staffCamperIDs returns the IDs of the campers in a staff member's groups, including their nested groups
+func staffCamperIDs(ctx context.Context, groupsRepo GroupsRepository, tenantID, campID uuid.UUID, staffMember *domain.StaffMember) ([]uuid.UUID, error) {
+	groups, err := groupsRepo.ListWithMembers(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list groups", err)
+	}
+	groupsByID := make(map[uuid.UUID]*domain.Group, len(groups))
+	for i := range groups {
+		groupsByID[groups[i].ID] = &groups[i]
+	}
+
+	var camperIDs []uuid.UUID
+	visited := make(map[uuid.UUID]bool)
+	var visit func(id uuid.UUID)
+	visit = func(id uuid.UUID) {
+		group, ok := groupsByID[id]
+		if !ok || visited[id] {
+			return
+		}
+		visited[id] = true
+		for _, member := range group.GroupCampers {
+			camperIDs = append(camperIDs, member.CamperID)
+		}
+		for _, child := range group.ChildGroups {
+			visit(child.ChildGroupID)
+		}
+	}
+	for id := range staffGroupIDs(staffMember) {
+		visit(id)
+	}
+
+	return camperIDs, nil
+}
+
+// positionName returns the name of the event's required staff position the staff member is assigned to,
+// or an empty string when they take part as a member of one of its groups
+func positionName(event *domain.Event, staffMemberID uuid.UUID) string {
+	if len(event.RequiredStaff) == 0 {
+		return ""
+	}
+	var positions []api.EventRequiredStaffPosition
+	if err := json.Unmarshal(event.RequiredStaff, &positions); err != nil {
+		return ""
+	}
+	for _, position := range positions {
+		if position.AssignedStaffId != nil && *position.AssignedStaffId == staffMemberID {
+			return position.PositionName
+		}
+	}
+	return ""
+}
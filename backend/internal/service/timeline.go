@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// timelineIncidentRoles lists the roles that see incidents on a person's timeline,
+// matching the roles that can read incident reports
+var timelineIncidentRoles = []string{"admin", "program-admin", "health"}
+
+// TimelineService defines the interface for combined person timelines
+type TimelineService interface {
+	// CamperTimeline merges a camper's notes, attendance, incidents and group changes, most recent first
+	CamperTimeline(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, from, to *time.Time) (*api.Timeline, error)
+
+	// StaffMemberTimeline merges a staff member's notes, incidents and group changes, most recent first
+	StaffMemberTimeline(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, from, to *time.Time) (*api.Timeline, error)
+}
+
+// timelineService implements TimelineService
+type timelineService struct {
+	notesRepo        NotesRepository
+	campersRepo      CampersRepository
+	staffMembersRepo StaffMembersRepository
+	attendanceRepo   AttendanceRepository
+	incidentsRepo    IncidentsRepository
+	groupsRepo       GroupsRepository
+}
+
+// NewTimelineService creates a new timeline service
+func NewTimelineService(notesRepo NotesRepository, campersRepo CampersRepository, staffMembersRepo StaffMembersRepository, attendanceRepo AttendanceRepository, incidentsRepo IncidentsRepository, groupsRepo GroupsRepository) TimelineService {
+	return &timelineService{
+		notesRepo:        notesRepo,
+		campersRepo:      campersRepo,
+		staffMembersRepo: staffMembersRepo,
+		attendanceRepo:   attendanceRepo,
+		incidentsRepo:    incidentsRepo,
+		groupsRepo:       groupsRepo,
+	}
+}
+
+// CamperTimeline merges a camper's notes, check-ins and check-outs, incidents and group changes
+func (s *timelineService) CamperTimeline(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, camperID uuid.UUID, from, to *time.Time) (*api.Timeline, error) {
+	if _, err := s.campersRepo.GetByID(ctx, tenantID, campID, camperID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camper not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camper", err)
+	}
+
+	records, err := s.attendanceRepo.ListForCamper(ctx, tenantID, campID, camperID, from, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to load attendance", err)
+	}
+
+	var entries []api.TimelineEntry
+	for _, record := range records {
+		entries = append(entries, attendanceTimelineEntry(record))
+	}
+
+	personEntries, err := s.personEntries(ctx, tenantID, campID, domain.NoteEntityTypeCamper, camperID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTimeline(append(entries, personEntries...)), nil
+}
+
+// StaffMemberTimeline merges a staff member's notes, incidents and group changes
+func (s *timelineService) StaffMemberTimeline(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, from, to *time.Time) (*api.Timeline, error) {
+	if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, staffMemberID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Staff member not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get staff member", err)
+	}
+
+	entries, err := s.personEntries(ctx, tenantID, campID, domain.NoteEntityTypeStaffMember, staffMemberID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTimeline(entries), nil
+}
+
+// personEntries collects the timeline entries campers and staff members have in common:
+// the notes the current user can read, incidents when the user can read incident reports
+// and group membership changes
+func (s *timelineService) personEntries(ctx context.Context, tenantID, campID uuid.UUID, entityType domain.NoteEntityType, entityID uuid.UUID, from, to *time.Time) ([]api.TimelineEntry, error) {
+	roles := campRoles(ctx, tenantID, campID)
+	userID, _ := currentUser(ctx)
+
+	notes, err := s.notesRepo.List(ctx, tenantID, campID, &entityType, &entityID, domain.VisibleNoteLevels(roles), userID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to load notes", err)
+	}
+
+	var entries []api.TimelineEntry
+	for _, note := range notes {
+		if !withinRange(note.CreatedAt, from, to) {
+			continue
+		}
+		entries = append(entries, noteTimelineEntry(note))
+	}
+
+	if hasAnyRole(roles, timelineIncidentRoles) {
+		var camperID, staffMemberID *uuid.UUID
+		if entityType == domain.NoteEntityTypeCamper {
+			camperID = &entityID
+		} else {
+			staffMemberID = &entityID
+		}
+
+		incidents, err := s.incidentsRepo.ListInvolving(ctx, tenantID, campID, camperID, staffMemberID, from, to)
+		if err != nil {
+			return nil, pkgerrors.InternalServerError("Failed to load incidents", err)
+		}
+		for _, incident := range incidents {
+			entries = append(entries, incidentTimelineEntry(incident))
+		}
+	}
+
+	changes, err := s.groupsRepo.ListMembershipChanges(ctx, tenantID, campID, entityType, entityID, from, to)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to load group changes", err)
+	}
+	for _, change := range changes {
+		entries = append(entries, groupChangeTimelineEntry(change))
+	}
+
+	return entries, nil
+}
+
+// newTimeline orders timeline entries from the most recent to the oldest
+func newTimeline(entries []api.TimelineEntry) *api.Timeline {
+	if entries == nil {
+		entries = []api.TimelineEntry{}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].OccurredAt.After(entries[j].OccurredAt)
+	})
+
+	return &api.Timeline{Items: entries}
+}
+
+// noteTimelineEntry converts a note to a timeline entry
+func noteTimelineEntry(note domain.Note) api.TimelineEntry {
+	apiNote := note.ToAPI()
+	return api.TimelineEntry{
+		Type:        api.TimelineEntryTypeNote,
+		OccurredAt:  note.CreatedAt,
+		ReferenceId: note.ID,
+		Summary:     fmt.Sprintf("%s note", strings.ReplaceAll(string(note.Category), "_", " ")),
+		Details:     utils.StringToPtr(note.Body),
+		ActorEmail:  utils.StringToPtr(note.AuthorEmail),
+		Note:        &apiNote,
+	}
+}
+
+// attendanceTimelineEntry converts a check-in or check-out to a timeline entry
+func attendanceTimelineEntry(record domain.AttendanceRecord) api.TimelineEntry {
+	entryType := api.TimelineEntryTypeCheckIn
+	summary := "Checked in"
+	if record.Type == domain.AttendanceTypeCheckOut {
+		entryType = api.TimelineEntryTypeCheckOut
+		summary = "Checked out"
+	}
+
+	summary = fmt.Sprintf("%s (%s)", summary, strings.ReplaceAll(string(record.Method), "_", " "))
+	if record.PersonName != "" {
+		summary = fmt.Sprintf("%s by %s", summary, record.PersonName)
+	}
+
+	return api.TimelineEntry{
+		Type:        entryType,
+		OccurredAt:  record.OccurredAt,
+		ReferenceId: record.ID,
+		Summary:     summary,
+		Details:     utils.StringToPtr(record.Notes),
+	}
+}
+
+// incidentTimelineEntry converts an incident report to a timeline entry
+func incidentTimelineEntry(incident domain.Incident) api.TimelineEntry {
+	return api.TimelineEntry{
+		Type:        api.TimelineEntryTypeIncident,
+		OccurredAt:  incident.OccurredAt,
+		ReferenceId: incident.ID,
+		Summary:     fmt.Sprintf("%s (%s %s)", incident.Name, incident.Severity, incident.Type),
+		Details:     utils.StringToPtr(incident.Description),
+		ActorEmail:  utils.StringToPtr(incident.ReportedByEmail),
+	}
+}
+
+// groupChangeTimelineEntry converts a group membership change to a timeline entry
+func groupChangeTimelineEntry(change domain.GroupMembershipChange) api.TimelineEntry {
+	entry := api.TimelineEntry{
+		Type:        api.TimelineEntryTypeGroupJoined,
+		OccurredAt:  change.CreatedAt,
+		ReferenceId: change.GroupID,
+		Summary:     fmt.Sprintf("Joined %s", change.GroupName),
+	}
+	if change.Change == domain.GroupMembershipRemoved {
+		entry.Type = api.TimelineEntryTypeGroupLeft
+		entry.Summary = fmt.Sprintf("Left %s", change.GroupName)
+	}
+	return entry
+}
+
+// withinRange reports whether t lies within [from, to), treating missing bounds as open
+func withinRange(t time.Time, from, to *time.Time) bool {
+	if from != nil && t.Before(*from) {
+		return false
+	}
+	if to != nil && !t.Before(*to) {
+		return false
+	}
+	return true
+}
+
+// hasAnyRole reports whether any of the given roles is held
+func hasAnyRole(roles map[string]bool, wanted []string) bool {
+	for _, role := range wanted {
+		if roles[role] {
+			return true
+		}
+	}
+	return false
+}
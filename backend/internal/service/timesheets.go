@@ -0,0 +1,595 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tbechar/camp-manager-backend/internal/api"
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+	pkgerrors "github.com/tbechar/camp-manager-backend/pkg/errors"
+	"github.com/tbechar/camp-manager-backend/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// defaultOvertimeThresholdHours is the weekly hours beyond which hours count as overtime when no threshold is given
+const defaultOvertimeThresholdHours = 40
+
+// TimesheetsService defines the interface for staff time entries and timesheet business logic
+type TimesheetsService interface {
+	// ListEntries retrieves time entries by clock-in time, optionally of a staff member, days or review state
+	ListEntries(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to *time.Time, status *api.TimeEntryStatus) (*api.TimeEntriesListResponse, error)
+
+	// GetEntry retrieves a single time entry
+	GetEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.TimeEntry, error)
+
+	// CreateEntry records hours a staff member worked; the entry waits for approval
+	CreateEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.TimeEntryCreationRequest) (*api.TimeEntry, error)
+
+	// UpdateEntry corrects a time entry that is not approved
+	UpdateEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeEntryUpdateRequest) (*api.TimeEntry, error)
+
+	// DeleteEntry removes a time entry that is not approved
+	DeleteEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error
+
+	// ReviewEntry approves or rejects a time entry, or reopens it as pending
+	ReviewEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeEntryReviewRequest) (*api.TimeEntry, error)
+
+	// ClockIn opens a time entry for a staff member who is not clocked in
+	ClockIn(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, req *api.TimeClockRequest) (*api.TimeEntry, error)
+
+	// ClockOut closes the open time entry of a staff member
+	ClockOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, req *api.TimeClockRequest) (*api.TimeEntry, error)
+
+	// GetTimesheets totals the hours of a pay period by staff member and week
+	GetTimesheets(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to time.Time, staffMemberID *uuid.UUID, overtimeThreshold *float64) (*api.TimesheetReport, error)
+
+	// ExportTimesheets renders the timesheets of a pay period as CSV
+	ExportTimesheets(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to time.Time, staffMemberID *uuid.UUID, overtimeThreshold *float64) ([]byte, error)
+}
+
+// timesheetsService implements TimesheetsService
+type timesheetsService struct {
+	entriesRepo      TimeEntriesRepository
+	staffMembersRepo StaffMembersRepository
+	eventsRepo       EventsRepository
+	dutyShiftsRepo   DutyShiftsRepository
+	dutyTypesRepo    DutyTypesRepository
+	campsRepo        CampsRepository
+}
+
+// NewTimesheetsService creates a new timesheets service
+func NewTimesheetsService(entriesRepo TimeEntriesRepository, staffMembersRepo StaffMembersRepository, eventsRepo EventsRepository, dutyShiftsRepo DutyShiftsRepository, dutyTypesRepo DutyTypesRepository, campsRepo CampsRepository) TimesheetsService {
+	return &timesheetsService{
+		entriesRepo:      entriesRepo,
+		staffMembersRepo: staffMembersRepo,
+		eventsRepo:       eventsRepo,
+		dutyShiftsRepo:   dutyShiftsRepo,
+		dutyTypesRepo:    dutyTypesRepo,
+		campsRepo:        campsRepo,
+	}
+}
+
+// ListEntries retrieves time entries; the days are whole days in the camp's time zone
+func (s *timesheetsService) ListEntries(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID *uuid.UUID, from, to *time.Time, status *api.TimeEntryStatus) (*api.TimeEntriesListResponse, error) {
+	var statusFilter *domain.TimeEntryStatus
+	if status != nil {
+		value := domain.TimeEntryStatus(*status)
+		if !value.IsValid() {
+			return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid status '%s'", *status), nil)
+		}
+		statusFilter = &value
+	}
+
+	var start, end *time.Time
+	if from != nil || to != nil {
+		loc, err := s.campLocation(ctx, tenantID, campID)
+		if err != nil {
+			return nil, err
+		}
+		if from != nil {
+			t := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc).UTC()
+			start = &t
+		}
+		if to != nil {
+			t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).UTC()
+			end = &t
+		}
+	}
+
+	entries, err := s.entriesRepo.List(ctx, tenantID, campID, staffMemberID, start, end, statusFilter)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list time entries", err)
+	}
+
+	items := make([]api.TimeEntry, len(entries))
+	for i := range entries {
+		items[i] = entries[i].ToAPI()
+	}
+
+	return &api.TimeEntriesListResponse{Items: items}, nil
+}
+
+// GetEntry retrieves a single time entry
+func (s *timesheetsService) GetEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) (*api.TimeEntry, error) {
+	entry, err := s.getEntry(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEntry := entry.ToAPI()
+	return &apiEntry, nil
+}
+
+// CreateEntry records hours a staff member worked; the entry waits for approval
+func (s *timesheetsService) CreateEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, req *api.TimeEntryCreationRequest) (*api.TimeEntry, error) {
+	entry := &domain.TimeEntry{
+		TenantID: tenantID,
+		CampID:   campID,
+		Status:   domain.TimeEntryStatusPending,
+	}
+
+	if err := s.applyEntry(ctx, tenantID, campID, entry, api.TimeEntryUpdateRequest(*req)); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.entriesRepo.Create(ctx, entry); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to create time entry", err)
+	}
+
+	apiEntry := entry.ToAPI()
+	return &apiEntry, nil
+}
+
+// UpdateEntry corrects a time entry that is not approved; a corrected rejected entry waits for approval again
+func (s *timesheetsService) UpdateEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeEntryUpdateRequest) (*api.TimeEntry, error) {
+	entry, err := s.getEntry(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.Status.IsEditable() {
+		return nil, pkgerrors.Conflict("Approved time entries cannot be changed; reopen the entry first", nil)
+	}
+
+	if err := s.applyEntry(ctx, tenantID, campID, entry, *req); err != nil {
+		return nil, err
+	}
+	entry.Status = domain.TimeEntryStatusPending
+
+	// Save updates
+	if err := s.entriesRepo.Update(ctx, tenantID, campID, entry); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to update time entry", err)
+	}
+
+	return s.GetEntry(ctx, tenantID, campID, id)
+}
+
+// DeleteEntry removes a time entry that is not approved
+func (s *timesheetsService) DeleteEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID) error {
+	entry, err := s.getEntry(ctx, tenantID, campID, id)
+	if err != nil {
+		return err
+	}
+	if !entry.Status.IsEditable() {
+		return pkgerrors.Conflict("Approved time entries cannot be deleted; reopen the entry first", nil)
+	}
+
+	if err := s.entriesRepo.Delete(ctx, tenantID, campID, id); err != nil {
+		return pkgerrors.InternalServerError("Failed to delete time entry", err)
+	}
+
+	return nil
+}
+
+// ReviewEntry approves or rejects a time entry, or reopens it as pending
+func (s *timesheetsService) ReviewEntry(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, id uuid.UUID, req *api.TimeEntryReviewRequest) (*api.TimeEntry, error) {
+	entry, err := s.getEntry(ctx, tenantID, campID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := domain.TimeEntryStatus(req.Status)
+	if !status.IsValid() {
+		return nil, pkgerrors.BadRequest(fmt.Sprintf("Invalid review decision '%s'", req.Status), nil)
+	}
+	if status == domain.TimeEntryStatusApproved && entry.IsOpen() {
+		return nil, pkgerrors.BadRequest("Only clocked out time entries can be approved", nil)
+	}
+
+	comment := strings.TrimSpace(utils.PtrToString(req.Comment))
+	if status == domain.TimeEntryStatusRejected && comment == "" {
+		return nil, pkgerrors.BadRequest("A comment is required when rejecting a time entry", nil)
+	}
+
+	now := time.Now().UTC()
+	entry.Status = status
+	entry.ReviewedBy, entry.ReviewedByEmail = currentUser(ctx)
+	entry.ReviewedAt = &now
+	entry.ReviewComment = comment
+
+	if err := s.entriesRepo.Update(ctx, tenantID, campID, entry); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to review time entry", err)
+	}
+
+	return s.GetEntry(ctx, tenantID, campID, id)
+}
+
+// ClockIn opens a time entry for a staff member who is not clocked in
+func (s *timesheetsService) ClockIn(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, req *api.TimeClockRequest) (*api.TimeEntry, error) {
+	if err := s.checkStaffMember(ctx, tenantID, campID, staffMemberID, pkgerrors.NotFound); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.entriesRepo.GetOpen(ctx, tenantID, campID, staffMemberID); err == nil {
+		return nil, pkgerrors.Conflict("Staff member is already clocked in", nil)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, pkgerrors.InternalServerError("Failed to get open time entry", err)
+	}
+
+	entry := &domain.TimeEntry{
+		TenantID:      tenantID,
+		CampID:        campID,
+		StaffMemberID: staffMemberID,
+		ClockInAt:     clockTime(req),
+		Status:        domain.TimeEntryStatusPending,
+	}
+	if req != nil {
+		entry.Notes = strings.TrimSpace(utils.PtrToString(req.Notes))
+	}
+
+	if err := s.checkOverlap(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	// Save to database
+	if err := s.entriesRepo.Create(ctx, entry); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to clock in", err)
+	}
+
+	apiEntry := entry.ToAPI()
+	return &apiEntry, nil
+}
+
+// ClockOut closes the open time entry of a staff member
+func (s *timesheetsService) ClockOut(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, staffMemberID uuid.UUID, req *api.TimeClockRequest) (*api.TimeEntry, error) {
+	if err := s.checkStaffMember(ctx, tenantID, campID, staffMemberID, pkgerrors.NotFound); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.entriesRepo.GetOpen(ctx, tenantID, campID, staffMemberID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.Conflict("Staff member is not clocked in", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get open time entry", err)
+	}
+
+	clockOutAt := clockTime(req)
+	entry.ClockOutAt = &clockOutAt
+	if req != nil && req.Notes != nil {
+		entry.Notes = strings.TrimSpace(strings.Join([]string{entry.Notes, strings.TrimSpace(*req.Notes)}, "\n"))
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, pkgerrors.BadRequest(err.Error(), err)
+	}
+	if err := s.checkOverlap(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.entriesRepo.Update(ctx, tenantID, campID, entry); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to clock out", err)
+	}
+
+	return s.GetEntry(ctx, tenantID, campID, entry.ID)
+}
+
+// GetTimesheets totals the hours of the entries clocked in during a pay period by staff member and week,
+// comparing them with the hours of the events and duty shifts the staff members are assigned to
+func (s *timesheetsService) GetTimesheets(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to time.Time, staffMemberID *uuid.UUID, overtimeThreshold *float64) (*api.TimesheetReport, error) {
+	threshold := float64(defaultOvertimeThresholdHours)
+	if overtimeThreshold != nil {
+		threshold = *overtimeThreshold
+	}
+	if threshold < 0 {
+		return nil, pkgerrors.BadRequest("The overtime threshold must not be negative", nil)
+	}
+	if err := checkRosterRange(from, to); err != nil {
+		return nil, err
+	}
+
+	loc, err := s.campLocation(ctx, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+	start, end := dayRange(from, to, loc)
+
+	staffMembers, err := s.staffMembersRepo.ListAll(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list staff members", err)
+	}
+	names := make(map[uuid.UUID]string, len(staffMembers))
+	for _, staffMember := range staffMembers {
+		names[staffMember.ID] = staffMember.Name
+	}
+
+	weeks := make(map[timesheetKey]*timesheetTotals)
+	totals := func(staffID uuid.UUID, at time.Time) *timesheetTotals {
+		key := timesheetKey{staffMemberID: staffID, weekStart: weekStart(localDate(at, loc))}
+		if weeks[key] == nil {
+			weeks[key] = &timesheetTotals{}
+		}
+		return weeks[key]
+	}
+	wanted := func(staffID uuid.UUID) bool {
+		return staffMemberID == nil || *staffMemberID == staffID
+	}
+
+	// Entries are loaded from the Monday of the first week, so approved hours of the week's days
+	// before the pay period count towards its overtime threshold
+	weekFrom, _ := dayRange(weekStart(from), to, loc)
+	approvedBefore := make(map[timesheetKey]time.Duration)
+
+	entries, err := s.entriesRepo.List(ctx, tenantID, campID, staffMemberID, &weekFrom, &end, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list time entries", err)
+	}
+	for _, entry := range entries {
+		if entry.ClockInAt.Before(start) {
+			if !entry.IsOpen() && entry.Status == domain.TimeEntryStatusApproved {
+				key := timesheetKey{staffMemberID: entry.StaffMemberID, weekStart: weekStart(localDate(entry.ClockInAt, loc))}
+				approvedBefore[key] += entry.ClockOutAt.Sub(entry.ClockInAt).Round(time.Minute)
+			}
+			continue
+		}
+
+		t := totals(entry.StaffMemberID, entry.ClockInAt)
+		if entry.IsOpen() {
+			t.openEntries++
+			continue
+		}
+		worked := entry.ClockOutAt.Sub(entry.ClockInAt).Round(time.Minute)
+		switch entry.Status {
+		case domain.TimeEntryStatusApproved:
+			t.worked += worked
+			t.approved += worked
+		case domain.TimeEntryStatusPending:
+			t.worked += worked
+			t.pendingEntries++
+		}
+	}
+
+	// Scheduled hours: events the staff members hold a position at and duty shifts other than time off
+	events, err := s.eventsRepo.ListStaffedBetween(ctx, tenantID, campID, start, end)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list events", err)
+	}
+	for _, event := range events {
+		if event.StartDate.Before(start) {
+			continue
+		}
+		var positions []api.EventRequiredStaffPosition
+		if err := json.Unmarshal(event.RequiredStaff, &positions); err != nil {
+			continue
+		}
+		assigned := make(map[uuid.UUID]bool)
+		for _, position := range positions {
+			if position.AssignedStaffId != nil && wanted(*position.AssignedStaffId) && !assigned[*position.AssignedStaffId] {
+				assigned[*position.AssignedStaffId] = true
+				totals(*position.AssignedStaffId, event.StartDate).scheduled += event.EndDate.Sub(event.StartDate)
+			}
+		}
+	}
+
+	dutyTypes, err := s.dutyTypesRepo.List(ctx, tenantID, campID)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty types", err)
+	}
+	timeOff := make(map[uuid.UUID]bool, len(dutyTypes))
+	for _, dutyType := range dutyTypes {
+		timeOff[dutyType.ID] = dutyType.IsTimeOff
+	}
+
+	shifts, err := s.dutyShiftsRepo.List(ctx, tenantID, campID, &start, &end, staffMemberID, nil)
+	if err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to list duty shifts", err)
+	}
+	for _, shift := range shifts {
+		if shift.StartDate.Before(start) || timeOff[shift.DutyTypeID] {
+			continue
+		}
+		for _, id := range shift.StaffMemberIDs {
+			if wanted(id) {
+				totals(id, shift.StartDate).scheduled += shift.Duration()
+			}
+		}
+	}
+
+	report := &api.TimesheetReport{
+		From:                   openapi_types.Date{Time: from},
+		To:                     openapi_types.Date{Time: to},
+		OvertimeThresholdHours: threshold,
+		Items:                  make([]api.TimesheetWeek, 0, len(weeks)),
+	}
+	for key, t := range weeks {
+		// Only approved hours are paid, split in the order they were worked: the threshold is
+		// first used up by the week's approved hours before the pay period
+		approved := domain.RoundHours(t.approved)
+		regular := math.Max(0, math.Min(approved, threshold-domain.RoundHours(approvedBefore[key])))
+		report.Items = append(report.Items, api.TimesheetWeek{
+			StaffMemberId:   key.staffMemberID,
+			StaffMemberName: names[key.staffMemberID],
+			WeekStart:       openapi_types.Date{Time: key.weekStart},
+			Hours:           domain.RoundHours(t.worked),
+			ApprovedHours:   approved,
+			RegularHours:    regular,
+			OvertimeHours:   math.Round((approved-regular)*100) / 100,
+			ScheduledHours:  domain.RoundHours(t.scheduled),
+			PendingEntries:  t.pendingEntries,
+			OpenEntries:     t.openEntries,
+		})
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if a.StaffMemberName != b.StaffMemberName {
+			return a.StaffMemberName < b.StaffMemberName
+		}
+		if a.StaffMemberId != b.StaffMemberId {
+			return a.StaffMemberId.String() < b.StaffMemberId.String()
+		}
+		return a.WeekStart.Time.Before(b.WeekStart.Time)
+	})
+
+	return report, nil
+}
+
+// ExportTimesheets renders the timesheets of a pay period as CSV, one row per staff member and week
+func (s *timesheetsService) ExportTimesheets(ctx context.Context, tenantID uuid.UUID, campID uuid.UUID, from, to time.Time, staffMemberID *uuid.UUID, overtimeThreshold *float64) ([]byte, error) {
+	report, err := s.GetTimesheets(ctx, tenantID, campID, from, to, staffMemberID, overtimeThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	hours := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	rows := [][]string{{
+		"staffMemberId", "staffMemberName", "weekStart", "hours", "approvedHours",
+		"regularHours", "overtimeHours", "scheduledHours", "pendingEntries", "openEntries",
+	}}
+	for _, week := range report.Items {
+		rows = append(rows, []string{
+			week.StaffMemberId.String(),
+			week.StaffMemberName,
+			week.WeekStart.Time.Format("2006-01-02"),
+			hours(week.Hours),
+			hours(week.ApprovedHours),
+			hours(week.RegularHours),
+			hours(week.OvertimeHours),
+			hours(week.ScheduledHours),
+			strconv.Itoa(week.PendingEntries),
+			strconv.Itoa(week.OpenEntries),
+		})
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, pkgerrors.InternalServerError("Failed to write timesheet export", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// applyEntry validates an entry request and copies it onto the entry
+func (s *timesheetsService) applyEntry(ctx context.Context, tenantID, campID uuid.UUID, entry *domain.TimeEntry, req api.TimeEntryUpdateRequest) error {
+	if err := s.checkStaffMember(ctx, tenantID, campID, req.StaffMemberId, pkgerrors.BadRequest); err != nil {
+		return err
+	}
+
+	entry.StaffMemberID = req.StaffMemberId
+	entry.ClockInAt = req.ClockInAt.UTC()
+	entry.ClockOutAt = nil
+	if req.ClockOutAt != nil {
+		clockOutAt := req.ClockOutAt.UTC()
+		entry.ClockOutAt = &clockOutAt
+	}
+	entry.Notes = strings.TrimSpace(utils.PtrToString(req.Notes))
+
+	if err := entry.Validate(); err != nil {
+		return pkgerrors.BadRequest(err.Error(), err)
+	}
+
+	return s.checkOverlap(ctx, entry)
+}
+
+// checkOverlap rejects an entry overlapping another entry of the same staff member
+func (s *timesheetsService) checkOverlap(ctx context.Context, entry *domain.TimeEntry) error {
+	var excludeID *uuid.UUID
+	if entry.ID != uuid.Nil {
+		excludeID = &entry.ID
+	}
+
+	overlaps, err := s.entriesRepo.HasOverlap(ctx, entry.TenantID, entry.CampID, entry.StaffMemberID, excludeID, entry.ClockInAt, entry.ClockOutAt)
+	if err != nil {
+		return pkgerrors.InternalServerError("Failed to check time entries", err)
+	}
+	if overlaps {
+		return pkgerrors.Conflict("The time entry overlaps another time entry of the staff member", nil)
+	}
+	return nil
+}
+
+// checkStaffMember verifies the staff member exists in the camp, reporting a missing one with the given error
+func (s *timesheetsService) checkStaffMember(ctx context.Context, tenantID, campID, staffMemberID uuid.UUID, notFound func(string, error) *pkgerrors.AppError) error {
+	if _, err := s.staffMembersRepo.GetByID(ctx, tenantID, campID, staffMemberID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return notFound("Staff member not found", err)
+		}
+		return pkgerrors.InternalServerError("Failed to get staff member", err)
+	}
+	return nil
+}
+
+// getEntry loads a time entry, reporting it as not found when it does not exist in the camp
+func (s *timesheetsService) getEntry(ctx context.Context, tenantID, campID, id uuid.UUID) (*domain.TimeEntry, error) {
+	entry, err := s.entriesRepo.GetByID(ctx, tenantID, campID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Time entry not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get time entry", err)
+	}
+	return entry, nil
+}
+
+// campLocation returns the time zone of the camp
+func (s *timesheetsService) campLocation(ctx context.Context, tenantID, campID uuid.UUID) (*time.Location, error) {
+	camp, err := s.campsRepo.GetByID(ctx, tenantID, campID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NotFound("Camp not found", err)
+		}
+		return nil, pkgerrors.InternalServerError("Failed to get camp", err)
+	}
+	return camp.TimeLocation(), nil
+}
+
+// timesheetKey identifies the week of a staff member's timesheet
+type timesheetKey struct {
+	staffMemberID uuid.UUID
+	weekStart     time.Time
+}
+
+// timesheetTotals accumulates the durations of a staff member's week
+type timesheetTotals struct {
+	worked         time.Duration
+	approved       time.Duration
+	scheduled      time.Duration
+	pendingEntries int
+	openEntries    int
+}
+
+// clockTime returns when a clock-in or clock-out happened, now unless the request says otherwise
+func clockTime(req *api.TimeClockRequest) time.Time {
+	if req != nil && req.At != nil {
+		return req.At.UTC()
+	}
+	return time.Now().UTC()
+}
+
+// weekStart returns the Monday of the week of a date
+func weekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
@@ -0,0 +1,97 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tbechar/camp-manager-backend/internal/domain"
+)
+
+// CampCloneJobsRepository defines the repository interface for polling camp clone jobs
+type CampCloneJobsRepository interface {
+	GetPendingJobs(ctx context.Context) ([]domain.CampCloneJob, error)
+}
+
+// CampCloner defines the service interface that runs a camp clone job
+type CampCloner interface {
+	RunCloneJob(ctx context.Context, job *domain.CampCloneJob) error
+}
+
+// CampCloneWorker processes pending camp clone jobs in the background
+type CampCloneWorker struct {
+	repo         CampCloneJobsRepository
+	cloner       CampCloner
+	pollInterval time.Duration
+	stopChan     chan bool
+}
+
+// CampCloneWorkerConfig holds configuration for the camp clone worker
+type CampCloneWorkerConfig struct {
+	PollInterval time.Duration
+}
+
+// NewCampCloneWorker creates a new camp clone worker
+func NewCampCloneWorker(
+	repo CampCloneJobsRepository,
+	cloner CampCloner,
+	config CampCloneWorkerConfig,
+) *CampCloneWorker {
+	if config.PollInterval == 0 {
+		config.PollInterval = 10 * time.Second
+	}
+
+	return &CampCloneWorker{
+		repo:         repo,
+		cloner:       cloner,
+		pollInterval: config.PollInterval,
+		stopChan:     make(chan bool),
+	}
+}
+
+// Start begins the worker's polling loop
+func (w *CampCloneWorker) Start(ctx context.Context) {
+	log.Println("Camp clone worker started")
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Camp clone worker stopped (context done)")
+			return
+		case <-w.stopChan:
+			log.Println("Camp clone worker stopped (stop signal)")
+			return
+		case <-ticker.C:
+			w.processJobs(ctx)
+		}
+	}
+}
+
+// Stop signals the worker to stop
+func (w *CampCloneWorker) Stop() {
+	close(w.stopChan)
+}
+
+// processJobs runs every pending clone job, oldest first
+func (w *CampCloneWorker) processJobs(ctx context.Context) {
+	jobs, err := w.repo.GetPendingJobs(ctx)
+	if err != nil {
+		log.Printf("Failed to fetch pending camp clone jobs: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		log.Printf("Processing camp clone job %s (camp %s)", job.ID, job.CampID)
+
+		if err := w.cloner.RunCloneJob(ctx, job); err != nil {
+			log.Printf("Camp clone job %s failed: %v", job.ID, err)
+			continue
+		}
+
+		log.Printf("Camp clone job %s completed", job.ID)
+	}
+}
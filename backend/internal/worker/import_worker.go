@@ -177,6 +177,13 @@ func (w *ImportWorker) processJob(ctx context.Context, job *domain.ImportJob) er
 		return fmt.Errorf("failed to update status to importing: %w", err)
 	}
 
+	// Load the camp data shared by every row once for the whole import
+	mapper, err = csvimport.PrepareMapper(ctx, mapper, job.TenantID, job.CampID)
+	if err != nil {
+		w.repo.UpdateStatus(ctx, job.ID, domain.ImportJobStatusFailed)
+		return fmt.Errorf("failed to prepare import: %w", err)
+	}
+
 	// Process rows
 	successCount := 0
 	errorCount := 0
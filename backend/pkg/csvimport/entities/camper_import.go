@@ -3,12 +3,14 @@ package entities
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tbechar/camp-manager-backend/internal/api"
 	"github.com/tbechar/camp-manager-backend/internal/domain"
+	"github.com/tbechar/camp-manager-backend/pkg/csvimport"
 	"github.com/tbechar/camp-manager-backend/pkg/utils"
 )
 
@@ -25,18 +27,38 @@ type GroupsRepository interface {
 	GetByIDs(ctx context.Context, tenantID, campID uuid.UUID, ids []uuid.UUID) ([]domain.Group, error)
 }
 
+// CustomFieldsRepository interface for custom field definition lookups
+type CustomFieldsRepository interface {
+	List(ctx context.Context, tenantID, campID uuid.UUID, entityType *domain.CustomFieldEntityType) ([]domain.CustomFieldDefinition, error)
+}
+
 // CamperImportValidator validates camper CSV rows
 type CamperImportValidator struct {
-	sessionsRepo SessionsRepository
-	groupsRepo   GroupsRepository
+	sessionsRepo     SessionsRepository
+	groupsRepo       GroupsRepository
+	customFieldsRepo CustomFieldsRepository
+	definitions      []domain.CustomFieldDefinition // loaded once per import by PrepareValidator
 }
 
 // NewCamperImportValidator creates a new camper validator
-func NewCamperImportValidator(sessionsRepo SessionsRepository, groupsRepo GroupsRepository) *CamperImportValidator {
+func NewCamperImportValidator(sessionsRepo SessionsRepository, groupsRepo GroupsRepository, customFieldsRepo CustomFieldsRepository) *CamperImportValidator {
 	return &CamperImportValidator{
-		sessionsRepo: sessionsRepo,
-		groupsRepo:   groupsRepo,
+		sessionsRepo:     sessionsRepo,
+		groupsRepo:       groupsRepo,
+		customFieldsRepo: customFieldsRepo,
+	}
+}
+
+// PrepareValidator returns a copy of the validator with the camp's camper custom fields loaded
+func (v *CamperImportValidator) PrepareValidator(ctx context.Context, tenantID, campID uuid.UUID) (csvimport.EntityValidator, error) {
+	definitions, err := listCamperCustomFields(ctx, v.customFieldsRepo, tenantID, campID)
+	if err != nil {
+		return nil, err
 	}
+
+	prepared := *v
+	prepared.definitions = definitions
+	return &prepared, nil
 }
 
 // GetRequiredColumns returns required columns for camper CSV
@@ -46,7 +68,8 @@ func (v *CamperImportValidator) GetRequiredColumns() []string {
 
 // GetOptionalColumns returns optional columns for camper CSV
 func (v *CamperImportValidator) GetOptionalColumns() []string {
-	return []string{"description", "groupNames"}
+	// Custom fields are imported from customFields.<key> columns
+	return []string{"description", "groupNames", domain.CustomFieldsPrefix + "*"}
 }
 
 // ValidateRow validates a single camper CSV row
@@ -165,23 +188,51 @@ func (v *CamperImportValidator) ValidateRow(ctx context.Context, row map[string]
 		}
 	}
 
+	// Validate custom fields
+	definitions, err := camperCustomFields(ctx, v.definitions, v.customFieldsRepo, tenantID, campID)
+	if err != nil {
+		errors = append(errors, domain.ValidationError{
+			Row:     rowNumber,
+			Field:   "customFields",
+			Message: err.Error(),
+		})
+	} else {
+		_, customFieldErrors := parseCustomFieldColumns(definitions, row, rowNumber)
+		errors = append(errors, customFieldErrors...)
+	}
+
 	return errors
 }
 
 // CamperImportMapper maps camper CSV rows to creation requests
 type CamperImportMapper struct {
-	sessionsRepo SessionsRepository
-	groupsRepo   GroupsRepository
+	sessionsRepo     SessionsRepository
+	groupsRepo       GroupsRepository
+	customFieldsRepo CustomFieldsRepository
+	definitions      []domain.CustomFieldDefinition // loaded once per import by PrepareMapper
 }
 
 // NewCamperImportMapper creates a new camper mapper
-func NewCamperImportMapper(sessionsRepo SessionsRepository, groupsRepo GroupsRepository) *CamperImportMapper {
+func NewCamperImportMapper(sessionsRepo SessionsRepository, groupsRepo GroupsRepository, customFieldsRepo CustomFieldsRepository) *CamperImportMapper {
 	return &CamperImportMapper{
-		sessionsRepo: sessionsRepo,
-		groupsRepo:   groupsRepo,
+		sessionsRepo:     sessionsRepo,
+		groupsRepo:       groupsRepo,
+		customFieldsRepo: customFieldsRepo,
 	}
 }
 
+// PrepareMapper returns a copy of the mapper with the camp's camper custom fields loaded
+func (m *CamperImportMapper) PrepareMapper(ctx context.Context, tenantID, campID uuid.UUID) (csvimport.EntityMapper, error) {
+	definitions, err := listCamperCustomFields(ctx, m.customFieldsRepo, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+
+	prepared := *m
+	prepared.definitions = definitions
+	return &prepared, nil
+}
+
 // MapRowToEntity converts a CSV row to a camper domain object
 func (m *CamperImportMapper) MapRowToEntity(ctx context.Context, row map[string]string, tenantID, campID uuid.UUID) (interface{}, error) {
 	// Parse birthday
@@ -223,6 +274,16 @@ func (m *CamperImportMapper) MapRowToEntity(ctx context.Context, row map[string]
 		}
 	}
 
+	// Parse custom fields (optional)
+	definitions, err := camperCustomFields(ctx, m.definitions, m.customFieldsRepo, tenantID, campID)
+	if err != nil {
+		return nil, err
+	}
+	customFields, customFieldErrors := parseCustomFieldColumns(definitions, row, 0)
+	if len(customFieldErrors) > 0 {
+		return nil, fmt.Errorf("%s", customFieldErrors[0].Message)
+	}
+
 	// Create the API creation request
 	req := &api.CamperCreationRequest{
 		Meta: api.EntityCreationRequestMeta{
@@ -236,7 +297,97 @@ func (m *CamperImportMapper) MapRowToEntity(ctx context.Context, row map[string]
 			GroupIds:  &groupIDs,
 		},
 	}
+	if len(customFields) > 0 {
+		values := api.CustomFieldValues(customFields)
+		req.Spec.CustomFields = &values
+	}
 
 	return req, nil
 }
 
+// listCamperCustomFields loads the camper custom field definitions of a camp
+func listCamperCustomFields(ctx context.Context, repo CustomFieldsRepository, tenantID, campID uuid.UUID) ([]domain.CustomFieldDefinition, error) {
+	entityType := domain.CustomFieldEntityTypeCamper
+	definitions, err := repo.List(ctx, tenantID, campID, &entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load custom fields: %w", err)
+	}
+	if definitions == nil {
+		definitions = []domain.CustomFieldDefinition{}
+	}
+	return definitions, nil
+}
+
+// camperCustomFields returns the definitions loaded for the import, loading them when the
+// validator or mapper was not prepared
+func camperCustomFields(ctx context.Context, definitions []domain.CustomFieldDefinition, repo CustomFieldsRepository, tenantID, campID uuid.UUID) ([]domain.CustomFieldDefinition, error) {
+	if definitions != nil {
+		return definitions, nil
+	}
+	return listCamperCustomFields(ctx, repo, tenantID, campID)
+}
+
+// parseCustomFieldColumns parses the customFields.<key> columns of a row into custom field values.
+// Empty cells are skipped, but required custom fields must have a value.
+func parseCustomFieldColumns(definitions []domain.CustomFieldDefinition, row map[string]string, rowNumber int) (map[string]interface{}, []domain.ValidationError) {
+	var errors []domain.ValidationError
+	values := make(map[string]interface{})
+
+	byKey := make(map[string]*domain.CustomFieldDefinition, len(definitions))
+	for i := range definitions {
+		byKey[definitions[i].Key] = &definitions[i]
+	}
+
+	// Go through the columns in a stable order so errors are reported consistently
+	var columns []string
+	for column := range row {
+		if strings.HasPrefix(column, domain.CustomFieldsPrefix) {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		raw := row[column]
+		key := strings.TrimPrefix(column, domain.CustomFieldsPrefix)
+
+		definition, ok := byKey[key]
+		if !ok {
+			errors = append(errors, domain.ValidationError{
+				Row:     rowNumber,
+				Field:   column,
+				Message: fmt.Sprintf("unknown custom field: %s", key),
+			})
+			continue
+		}
+
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+
+		value, err := definition.ParseValue(raw)
+		if err != nil {
+			errors = append(errors, domain.ValidationError{
+				Row:     rowNumber,
+				Field:   column,
+				Message: err.Error(),
+			})
+			continue
+		}
+		values[key] = value
+	}
+
+	for _, definition := range definitions {
+		column := domain.CustomFieldsPrefix + definition.Key
+		if definition.Required && strings.TrimSpace(row[column]) == "" {
+			errors = append(errors, domain.ValidationError{
+				Row:     rowNumber,
+				Field:   column,
+				Message: fmt.Sprintf("custom field %s is required", definition.Key),
+			})
+		}
+	}
+
+	return values, errors
+}
+
@@ -13,3 +13,18 @@ type EntityMapper interface {
 	MapRowToEntity(ctx context.Context, row map[string]string, tenantID, campID uuid.UUID) (interface{}, error)
 }
 
+// MapperPreparer is implemented by mappers that look up camp data shared by every row,
+// so it is loaded once per import instead of once per row
+type MapperPreparer interface {
+	// PrepareMapper returns a mapper bound to the camp's data
+	PrepareMapper(ctx context.Context, tenantID, campID uuid.UUID) (EntityMapper, error)
+}
+
+// PrepareMapper binds the mapper to the camp's data when it supports it
+func PrepareMapper(ctx context.Context, mapper EntityMapper, tenantID, campID uuid.UUID) (EntityMapper, error) {
+	if preparer, ok := mapper.(MapperPreparer); ok {
+		return preparer.PrepareMapper(ctx, tenantID, campID)
+	}
+	return mapper, nil
+}
+
@@ -98,6 +98,7 @@ func ParseCSV(reader io.Reader) ([]map[string]string, []string, error) {
 }
 
 // ValidateHeaders checks if the CSV contains all required headers
+// Optional columns ending in "*" accept any column starting with the text before it
 func ValidateHeaders(headers []string, required []string, optional []string) error {
 	headerSet := make(map[string]bool)
 	for _, header := range headers {
@@ -123,16 +124,21 @@ func ValidateHeaders(headers []string, required []string, optional []string) err
 
 	// Check for unknown headers
 	validHeaders := make(map[string]bool)
+	var validPrefixes []string
 	for _, h := range required {
 		validHeaders[h] = true
 	}
 	for _, h := range optional {
+		if strings.HasSuffix(h, "*") {
+			validPrefixes = append(validPrefixes, strings.TrimSuffix(h, "*"))
+			continue
+		}
 		validHeaders[h] = true
 	}
 
 	var unknown []string
 	for _, header := range headers {
-		if !validHeaders[header] {
+		if !validHeaders[header] && !hasAnyPrefix(header, validPrefixes) {
 			unknown = append(unknown, header)
 		}
 	}
@@ -148,3 +154,13 @@ func ValidateHeaders(headers []string, required []string, optional []string) err
 
 	return nil
 }
+
+// hasAnyPrefix reports whether s starts with one of the prefixes
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
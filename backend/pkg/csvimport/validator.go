@@ -19,6 +19,21 @@ type EntityValidator interface {
 	GetOptionalColumns() []string
 }
 
+// ValidatorPreparer is implemented by validators that look up camp data shared by every row,
+// so it is loaded once per import instead of once per row
+type ValidatorPreparer interface {
+	// PrepareValidator returns a validator bound to the camp's data
+	PrepareValidator(ctx context.Context, tenantID, campID uuid.UUID) (EntityValidator, error)
+}
+
+// PrepareValidator binds the validator to the camp's data when it supports it
+func PrepareValidator(ctx context.Context, validator EntityValidator, tenantID, campID uuid.UUID) (EntityValidator, error) {
+	if preparer, ok := validator.(ValidatorPreparer); ok {
+		return preparer.PrepareValidator(ctx, tenantID, campID)
+	}
+	return validator, nil
+}
+
 // ValidateCSV validates all rows in the CSV using the provided validator
 func ValidateCSV(ctx context.Context, rows []map[string]string, headers []string, validator EntityValidator, tenantID, campID uuid.UUID) []domain.ValidationError {
 	var allErrors []domain.ValidationError
@@ -33,6 +48,16 @@ func ValidateCSV(ctx context.Context, rows []map[string]string, headers []string
 		return allErrors
 	}
 
+	validator, err := PrepareValidator(ctx, validator, tenantID, campID)
+	if err != nil {
+		allErrors = append(allErrors, domain.ValidationError{
+			Row:     0,
+			Field:   "file",
+			Message: err.Error(),
+		})
+		return allErrors
+	}
+
 	// Validate each row
 	for i, row := range rows {
 		rowNumber := i + 2 // +2 because: 1 for header, 1 for 1-based indexing
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Supported storage backends
+const (
+	BackendLocal = "local"
+)
+
+// ErrNotFound is returned when no object is stored under a key
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores file contents under slash separated keys chosen by the caller.
+// Implementations must be safe for concurrent use.
+type Storage interface {
+	// Put stores the contents of r under key, replacing any existing object
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Open returns a reader for the object stored under key
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key
+	Delete(ctx context.Context, key string) error
+}
+
+// Config selects and configures a storage backend
+type Config struct {
+	Backend  string // Storage backend, currently only "local"
+	LocalDir string // Root directory of the local backend
+}
+
+// New creates the storage backend selected by the config
+func New(config Config) (Storage, error) {
+	switch config.Backend {
+	case BackendLocal, "":
+		return NewLocalStorage(config.LocalDir)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %s", config.Backend)
+	}
+}